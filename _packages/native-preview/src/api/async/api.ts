@@ -55,7 +55,16 @@ import type {
     EmitOutputResponse as ProtocolEmitOutputResponse,
     ImportAdderAction,
     IntrinsicTypeMethod,
+    LSPCodeAction,
+    LSPDocumentSymbol,
+    LSPEvaluatedType,
+    LSPHover,
+    LSPInlayHint,
+    LSPLocation,
+    LSPRelationExplanation,
+    LSPSignatureHelp,
     LSPUpdateSnapshotParams,
+    LSPWorkspaceEdit,
     ParsedCommandLine,
     ProjectReference,
     ProjectResponse,
@@ -105,6 +114,7 @@ import type {
     GetImportEditsForSymbolsOptions,
     IdentifierTypePredicate,
     ImportAdderAction as APIImportAdderAction,
+    InlayHintsOptions,
     IndexedAccessType,
     IndexInfo,
     IndexType,
@@ -943,6 +953,102 @@ export class LanguageService {
             })),
         };
     }
+
+    async getHoverAtPosition(document: DocumentIdentifier, position: number): Promise<LSPHover | undefined> {
+        const data = await this.client.apiRequest("getHoverAtPosition", {
+            snapshot: this.snapshotId,
+            project: this.project.id,
+            file: document,
+            position,
+        });
+        return data ?? undefined;
+    }
+
+    async getDefinitionAtPosition(document: DocumentIdentifier, position: number): Promise<LSPLocation[]> {
+        const data = await this.client.apiRequest("getDefinitionAtPosition", {
+            snapshot: this.snapshotId,
+            project: this.project.id,
+            file: document,
+            position,
+        });
+        return data ?? [];
+    }
+
+    async getRenameLocations(document: DocumentIdentifier, position: number, newName?: string): Promise<LSPWorkspaceEdit | undefined> {
+        const data = await this.client.apiRequest("getRenameLocations", {
+            snapshot: this.snapshotId,
+            project: this.project.id,
+            file: document,
+            position,
+            ...(newName !== undefined ? { newName } : {}),
+        });
+        return data ?? undefined;
+    }
+
+    async getCodeFixesInRange(document: DocumentIdentifier, start: number, end: number, preferences?: Record<string, unknown>): Promise<LSPCodeAction[]> {
+        return this.client.apiRequest("getCodeFixesInRange", {
+            snapshot: this.snapshotId,
+            project: this.project.id,
+            file: document,
+            start,
+            end,
+            ...(preferences !== undefined ? { preferences } : {}),
+        });
+    }
+
+    async getSignatureHelpAtPosition(document: DocumentIdentifier, position: number, triggerCharacter?: string): Promise<LSPSignatureHelp | undefined> {
+        const data = await this.client.apiRequest("getSignatureHelpAtPosition", {
+            snapshot: this.snapshotId,
+            project: this.project.id,
+            file: document,
+            position,
+            ...(triggerCharacter !== undefined ? { triggerCharacter } : {}),
+        });
+        return data ?? undefined;
+    }
+
+    async getInlayHints(document: DocumentIdentifier, options?: InlayHintsOptions): Promise<LSPInlayHint[]> {
+        return this.client.apiRequest("getInlayHints", {
+            snapshot: this.snapshotId,
+            project: this.project.id,
+            file: document,
+            ...(options?.start !== undefined ? { start: options.start } : {}),
+            ...(options?.end !== undefined ? { end: options.end } : {}),
+            ...(options?.preferences !== undefined ? { preferences: options.preferences } : {}),
+        });
+    }
+
+    async getDocumentSymbols(document: DocumentIdentifier): Promise<LSPDocumentSymbol[]> {
+        return this.client.apiRequest("getDocumentSymbols", {
+            snapshot: this.snapshotId,
+            project: this.project.id,
+            file: document,
+        });
+    }
+
+    async explainRelationError(document: DocumentIdentifier, start: number, end: number, code?: number): Promise<LSPRelationExplanation | undefined> {
+        const data = await this.client.apiRequest("explainRelationError", {
+            snapshot: this.snapshotId,
+            project: this.project.id,
+            file: document,
+            start,
+            end,
+            ...(code !== undefined ? { code } : {}),
+        });
+        return data ?? undefined;
+    }
+
+    async evaluateType(document: DocumentIdentifier, position: number, expression: string, verbosityLevel?: number): Promise<LSPEvaluatedType | undefined> {
+        const data = await this.client.apiRequest("evaluateType", {
+            snapshot: this.snapshotId,
+            project: this.project.id,
+            file: document,
+            position,
+            expression,
+            ...(verbosityLevel !== undefined ? { verbosityLevel } : {}),
+        });
+        return data ?? undefined;
+    }
 }
 
 export class Program {
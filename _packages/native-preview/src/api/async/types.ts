@@ -348,6 +348,16 @@ export interface CompletionOptions {
     includeSymbol?: boolean | undefined;
 }
 
+/** Options for {@link LanguageService.getInlayHints}. */
+export interface InlayHintsOptions {
+    /** Offset at which to start collecting hints. Defaults to the start of the document. */
+    start?: number | undefined;
+    /** Offset at which to stop collecting hints. Defaults to the end of the document. */
+    end?: number | undefined;
+    /** User preferences in tsserver format. Hints are only produced for the kinds enabled here, e.g. `includeInlayParameterNameHints`. */
+    preferences?: Record<string, unknown> | undefined;
+}
+
 /** A single completion item returned by {@link LanguageService.getCompletionsAtPosition}. */
 export interface CompletionEntry {
     readonly name: string;
@@ -116,6 +116,15 @@ export interface APIMethodInfo {
     getReferencedSymbolsForNode: APIMethod<GetReferencedSymbolsForNodeParams, ReferencedSymbolEntry[] | null>;
     getSignatureUsages: APIMethod<GetSignatureUsagesParams, SignatureUsageResponse[] | null>;
     getCompletionsAtPosition: APIMethod<GetCompletionsAtPositionParams, CompletionInfoResponse | null>;
+    getHoverAtPosition: APIMethod<LanguageServicePositionParams, LSPHover | null>;
+    getDefinitionAtPosition: APIMethod<LanguageServicePositionParams, LSPLocation[] | null>;
+    getRenameLocations: APIMethod<GetRenameLocationsParams, LSPWorkspaceEdit | null>;
+    getCodeFixesInRange: APIMethod<GetCodeFixesInRangeParams, LSPCodeAction[]>;
+    getSignatureHelpAtPosition: APIMethod<GetSignatureHelpAtPositionParams, LSPSignatureHelp | null>;
+    getInlayHints: APIMethod<GetInlayHintsParams, LSPInlayHint[]>;
+    getDocumentSymbols: APIMethod<LanguageServiceFileParams, LSPDocumentSymbol[]>;
+    explainRelationError: APIMethod<ExplainRelationErrorParams, LSPRelationExplanation | null>;
+    evaluateType: APIMethod<EvaluateTypeParams, LSPEvaluatedType | null>;
     getSyntacticDiagnostics: APIMethod<GetDiagnosticsParams, DiagnosticResponse[] | null>;
     getBindDiagnostics: APIMethod<GetDiagnosticsParams, DiagnosticResponse[] | null>;
     getSemanticDiagnostics: APIMethod<GetDiagnosticsParams, DiagnosticResponse[] | null>;
@@ -144,6 +153,8 @@ export interface APIMethodInfo {
     getNonPrimitiveType: APIMethod<GetIntrinsicTypeParams, TypeResponse>;
     getWellKnownSymbols: APIMethod<GetIntrinsicTypeParams, WellKnownSymbolsResponse>;
     getWellKnownSignatures: APIMethod<GetIntrinsicTypeParams, WellKnownSignaturesResponse>;
+    watch: APIMethod<WatchParams, WatchResponse>;
+    unwatch: APIMethod<null, boolean>;
     startCPUProfile: APIMethod<ProfileParams, void>;
     stopCPUProfile: APIMethod<null, ProfileResult>;
     saveHeapProfile: APIMethod<ProfileParams, ProfileResult>;
@@ -162,6 +173,11 @@ export interface InitializeResponse {
     useCaseSensitiveFileNames: boolean;
     /** CurrentDirectory is the server's current working directory. */
     currentDirectory: string;
+    /**
+     * PositionEncoding is the encoding of line/character positions in
+     * LSP-shaped language service results.
+     */
+    positionEncoding: "utf-16" | "utf-32" | "utf-8";
 }
 
 /**
@@ -747,6 +763,413 @@ export interface CompletionInfoResponse {
     entries: CompletionEntryResponse[];
 }
 
+/**
+ * LanguageServicePositionParams are the parameters for language service methods
+ * that operate at a position in a document. Results of these methods are shaped
+ * like the corresponding LSP responses, with line/character positions in the
+ * encoding reported by the initialize method.
+ */
+export interface LanguageServicePositionParams {
+    snapshot: number;
+    project: string;
+    file: DocumentIdentifier;
+    position: number;
+}
+
+/** The result of a hover request. */
+export interface LSPHover {
+    /** The hover's content */
+    contents: LSPMarkupContent | string | LSPMarkedStringWithLanguage | (string | LSPMarkedStringWithLanguage)[];
+    /**
+     * An optional range inside the text document that is used to
+     * visualize the hover, e.g. by changing the background color.
+     */
+    range?: LSPRange;
+    /** Whether the verbosity level can be increased for this hover. */
+    canIncreaseVerbosity?: boolean;
+}
+
+/**
+ * Represents a location inside a resource, such as a line
+ * inside a text file.
+ */
+export interface LSPLocation {
+    uri: string;
+    range: LSPRange;
+}
+
+/** GetRenameLocationsParams are the parameters for the getRenameLocations method. */
+export interface GetRenameLocationsParams {
+    snapshot: number;
+    project: string;
+    file: DocumentIdentifier;
+    position: number;
+    /**
+     * NewName is the text written at each rename location. Defaults to the
+     * current name of the symbol, so the edits only report where it occurs.
+     */
+    newName?: string;
+}
+
+/**
+ * A workspace edit represents changes to many resources managed in the workspace. The edit
+ * should either provide `changes` or `documentChanges`. If documentChanges are present
+ * they are preferred over `changes` if the client can handle versioned document edits.
+ * 
+ * Since version 3.13.0 a workspace edit can contain resource operations as well. If resource
+ * operations are present clients need to execute the operations in the order in which they
+ * are provided. So a workspace edit for example can consist of the following two changes:
+ * (1) a create file a.txt and (2) a text document edit which insert text into file a.txt.
+ * 
+ * An invalid sequence (e.g. (1) delete file a.txt and (2) insert text into file a.txt) will
+ * cause failure of the operation. How the client recovers from the failure is described by
+ * the client capability: `workspace.workspaceEdit.failureHandling`
+ */
+export interface LSPWorkspaceEdit {
+    /** Holds changes to existing resources. */
+    changes?: Record<string, LSPTextEdit[] | null>;
+    /**
+     * Depending on the client capability `workspace.workspaceEdit.resourceOperations` document changes
+     * are either an array of `TextDocumentEdit`s to express changes to n different text documents
+     * where each text document edit addresses a specific version of a text document. Or it can contain
+     * above `TextDocumentEdit`s mixed with create, rename and delete file / folder operations.
+     * 
+     * Whether a client supports versioned document edits is expressed via
+     * `workspace.workspaceEdit.documentChanges` client capability.
+     * 
+     * If a client neither supports `documentChanges` nor `workspace.workspaceEdit.resourceOperations` then
+     * only plain `TextEdit`s using the `changes` property are supported.
+     */
+    documentChanges?: (LSPTextDocumentEdit | LSPCreateFile | LSPRenameFile | LSPDeleteFile)[];
+    /**
+     * A map of change annotations that can be referenced in `AnnotatedTextEdit`s or create, rename and
+     * delete file / folder operations.
+     * 
+     * Whether clients honor this property depends on the client capability `workspace.changeAnnotationSupport`.
+     * 
+     * Since: 3.16.0
+     */
+    changeAnnotations?: Record<string, LSPChangeAnnotation | null>;
+}
+
+/** GetCodeFixesInRangeParams are the parameters for the getCodeFixesInRange method. */
+export interface GetCodeFixesInRangeParams {
+    snapshot: number;
+    project: string;
+    file: DocumentIdentifier;
+    start: number;
+    end: number;
+    /** Preferences are user preferences in tsserver format, e.g. quotePreference. */
+    preferences?: Record<string, unknown>;
+}
+
+/**
+ * A code action represents a change that can be performed in code, e.g. to fix a problem or
+ * to refactor code.
+ * 
+ * A CodeAction must set either `edit` and/or a `command`. If both are supplied, the `edit` is applied first, then the `command` is executed.
+ */
+export interface LSPCodeAction {
+    /** A short, human-readable, title for this code action. */
+    title: string;
+    /**
+     * The kind of the code action.
+     * 
+     * Used to filter code actions.
+     */
+    kind?: "" | "quickfix" | "refactor" | "refactor.extract" | "refactor.inline" | "refactor.move" | "refactor.rewrite" | "source" | "source.fixAll" | "source.organizeImports" | "source.removeUnusedImports" | "source.sortImports";
+    /** The diagnostics that this code action resolves. */
+    diagnostics?: LSPDiagnostic[];
+    /**
+     * Marks this as a preferred action. Preferred actions are used by the `auto fix` command and can be targeted
+     * by keybindings.
+     * 
+     * A quick fix should be marked preferred if it properly addresses the underlying error.
+     * A refactoring should be marked preferred if it is the most reasonable choice of actions to take.
+     * 
+     * Since: 3.15.0
+     */
+    isPreferred?: boolean;
+    /**
+     * Marks that the code action cannot currently be applied.
+     * 
+     * Clients should follow the following guidelines regarding disabled code actions:
+     * 
+     *  - Disabled code actions are not shown in automatic [lightbulbs](https://code.visualstudio.com/docs/editor/editingevolved#_code-action)
+     *  code action menus.
+     * 
+     *  - Disabled actions are shown as faded out in the code action menu when the user requests a more specific type
+     *  of code action, such as refactorings.
+     * 
+     *  - If the user has a [keybinding](https://code.visualstudio.com/docs/editor/refactoring#_keybindings-for-code-actions)
+     *  that auto applies a code action and only disabled code actions are returned, the client should show the user an
+     *  error message with `reason` in the editor.
+     * 
+     * Since: 3.16.0
+     */
+    disabled?: LSPCodeActionDisabled;
+    /** The workspace edit this code action performs. */
+    edit?: LSPWorkspaceEdit;
+    /**
+     * A command this code action executes. If a code action
+     * provides an edit and a command, first the edit is
+     * executed and then the command.
+     */
+    command?: LSPCommand;
+    /**
+     * A data entry field that is preserved on a code action between
+     * a `textDocument/codeAction` and a `codeAction/resolve` request.
+     * 
+     * Since: 3.16.0
+     */
+    data?: LSPCodeActionData;
+    /**
+     * Tags for this code action.
+     * 
+     * Since: 3.18.0 - proposed
+     */
+    tags?: number[];
+}
+
+/** GetSignatureHelpAtPositionParams are the parameters for the getSignatureHelpAtPosition method. */
+export interface GetSignatureHelpAtPositionParams {
+    snapshot: number;
+    project: string;
+    file: DocumentIdentifier;
+    position: number;
+    triggerCharacter?: string;
+}
+
+/**
+ * Signature help represents the signature of something
+ * callable. There can be multiple signature but only one
+ * active and only one active parameter.
+ */
+export interface LSPSignatureHelp {
+    /** One or more signatures. */
+    signatures: LSPSignatureInformation[] | null;
+    /**
+     * The active signature. If omitted or the value lies outside the
+     * range of `signatures` the value defaults to zero or is ignored if
+     * the `SignatureHelp` has no signatures.
+     * 
+     * Whenever possible implementors should make an active decision about
+     * the active signature and shouldn't rely on a default value.
+     * 
+     * In future version of the protocol this property might become
+     * mandatory to better express this.
+     */
+    activeSignature?: number;
+    /**
+     * The active parameter of the active signature.
+     * 
+     * If `null`, no parameter of the signature is active (for example a named
+     * argument that does not match any declared parameters). This is only valid
+     * if the client specifies the client capability
+     * `textDocument.signatureHelp.noActiveParameterSupport === true`
+     * 
+     * If omitted or the value lies outside the range of
+     * `signatures[activeSignature].parameters` defaults to 0 if the active
+     * signature has parameters.
+     * 
+     * If the active signature has no parameters it is ignored.
+     * 
+     * In future version of the protocol this property might become
+     * mandatory (but still nullable) to better express the active parameter if
+     * the active signature does have any.
+     * 
+     * Since version 3.16.0 the `SignatureInformation` itself provides a
+     * `activeParameter` property and it should be used instead of this one.
+     */
+    activeParameter?: number | null;
+}
+
+/** GetInlayHintsParams are the parameters for the getInlayHints method. */
+export interface GetInlayHintsParams {
+    snapshot: number;
+    project: string;
+    file: DocumentIdentifier;
+    /**
+     * Start and End limit the hints to a span of the document. Both default to
+     * the bounds of the document.
+     */
+    start?: number;
+    end?: number;
+    /**
+     * Preferences are user preferences in tsserver format. Inlay hints are off
+     * by default and are enabled with preferences such as includeInlayParameterNameHints.
+     */
+    preferences?: Record<string, unknown>;
+}
+
+/**
+ * Inlay hint information.
+ * 
+ * Since: 3.17.0
+ */
+export interface LSPInlayHint {
+    /**
+     * The position of this hint.
+     * 
+     * If multiple hints have the same position, they will be shown in the order
+     * they appear in the response.
+     */
+    position: LSPPosition;
+    /**
+     * The label of this hint. A human readable string or an array of
+     * InlayHintLabelPart label parts.
+     * 
+     * *Note* that neither the string nor the label part can be empty.
+     */
+    label: string | LSPInlayHintLabelPart[];
+    /**
+     * The kind of this hint. Can be omitted in which case the client
+     * should fall back to a reasonable default.
+     */
+    kind?: number;
+    /**
+     * Optional text edits that are performed when accepting this inlay hint.
+     * 
+     * *Note* that edits are expected to change the document so that the inlay
+     * hint (or its nearest variant) is now part of the document and the inlay
+     * hint itself is now obsolete.
+     */
+    textEdits?: LSPTextEdit[];
+    /** The tooltip text when you hover over this item. */
+    tooltip?: string | LSPMarkupContent;
+    /**
+     * Render padding before the hint.
+     * 
+     * Note: Padding should use the editor's background color, not the
+     * background color of the hint itself. That means padding can be used
+     * to visually align/separate an inlay hint.
+     */
+    paddingLeft?: boolean;
+    /**
+     * Render padding after the hint.
+     * 
+     * Note: Padding should use the editor's background color, not the
+     * background color of the hint itself. That means padding can be used
+     * to visually align/separate an inlay hint.
+     */
+    paddingRight?: boolean;
+    /**
+     * A data entry field that is preserved on an inlay hint between
+     * a `textDocument/inlayHint` and a `inlayHint/resolve` request.
+     */
+    data?: LSPInlayHintData;
+}
+
+/**
+ * LanguageServiceFileParams are the parameters for language service methods
+ * that operate on a whole document.
+ */
+export interface LanguageServiceFileParams {
+    snapshot: number;
+    project: string;
+    file: DocumentIdentifier;
+}
+
+/**
+ * Represents programming constructs like variables, classes, interfaces etc.
+ * that appear in a document. Document symbols can be hierarchical and they
+ * have two ranges: one that encloses its definition and one that points to
+ * its most interesting range, e.g. the range of an identifier.
+ */
+export interface LSPDocumentSymbol {
+    /**
+     * The name of this symbol. Will be displayed in the user interface and therefore must not be
+     * an empty string or a string only consisting of white spaces.
+     */
+    name: string;
+    /** More detail for this symbol, e.g the signature of a function. */
+    detail?: string;
+    /** The kind of this symbol. */
+    kind: number;
+    /**
+     * Tags for this document symbol.
+     * 
+     * Since: 3.16.0
+     */
+    tags?: number[];
+    /**
+     * Indicates if this symbol is deprecated.
+     * 
+     * @deprecated Use tags instead
+     */
+    deprecated?: boolean;
+    /**
+     * The range enclosing this symbol not including leading/trailing whitespace but everything else
+     * like comments. This information is typically used to determine if the clients cursor is
+     * inside the symbol to reveal in the symbol in the UI.
+     */
+    range: LSPRange;
+    /**
+     * The range that should be selected and revealed when this symbol is being picked, e.g the name of a function.
+     * Must be contained by the `range`.
+     */
+    selectionRange: LSPRange;
+    /** Children of this symbol, e.g. properties of a class. */
+    children?: LSPDocumentSymbol[];
+}
+
+/** ExplainRelationErrorParams are the parameters for the explainRelationError method. */
+export interface ExplainRelationErrorParams {
+    snapshot: number;
+    project: string;
+    file: DocumentIdentifier;
+    /** Start and End are the span of the diagnostic to explain. */
+    start: number;
+    end: number;
+    /**
+     * Code is the code of the diagnostic to explain. If omitted, the first
+     * relation error at the span is explained.
+     */
+    code?: number;
+}
+
+/** A failed comparison of a source type with a target type in the explanation of a relation error. */
+export interface LSPRelationExplanation {
+    /** The path of members from the root comparison to this one, such as `a.b[0]`. */
+    path?: string;
+    /** The member of the parent comparison's types compared here: a property name, a tuple element such as `[0]`, an index signature such as `[string]`, or a signature `()` or `new ()`. */
+    member?: string;
+    /** The source type or signature. */
+    source: string;
+    /** The target type or signature. */
+    target: string;
+    /** The elaborations reported for this comparison. */
+    messages: string[] | null;
+    /** The failed comparisons that caused this comparison to fail. */
+    children: LSPRelationExplanation[] | null;
+}
+
+/** EvaluateTypeParams are the parameters for the evaluateType method. */
+export interface EvaluateTypeParams {
+    snapshot: number;
+    project: string;
+    file: DocumentIdentifier;
+    /** Position is the position in whose scope the expression is evaluated. */
+    position: number;
+    /** Expression is the type expression to evaluate, e.g. `Partial<Options>`. */
+    expression: string;
+    /**
+     * VerbosityLevel is the level up to which named types in the result are
+     * expanded, as in hover. Defaults to 0.
+     */
+    verbosityLevel?: number;
+}
+
+/** The result of the custom/textDocument/evaluateType request. */
+export interface LSPEvaluatedType {
+    /** The evaluated type, printed structurally. Omitted if the expression could not be parsed. */
+    type?: string;
+    /** The messages of the errors in the type expression. */
+    diagnostics: string[] | null;
+    /** Whether evaluating with a higher verbosity level would expand more of the type. */
+    canIncreaseVerbosity?: boolean;
+}
+
 /** GetDiagnosticsParams are parameters for per-file diagnostic methods. */
 export interface GetDiagnosticsParams {
     snapshot: number;
@@ -803,6 +1226,7 @@ export interface EmitParams {
     snapshot: number;
     project: string;
     emitOnly?: number;
+    transformers?: EmitTransformers;
 }
 
 export interface EmitResponse {
@@ -849,6 +1273,22 @@ export interface WellKnownSignaturesResponse {
     unknown: number;
 }
 
+/** WatchParams are the parameters for the watch method. */
+export interface WatchParams {
+    /**
+     * Diagnostics requests that snapshotChanged notifications list the files
+     * whose syntactic or semantic diagnostics changed. Computing this checks
+     * every changed project in full.
+     */
+    diagnostics?: boolean;
+}
+
+/** WatchResponse is returned by the watch method. */
+export interface WatchResponse {
+    /** Directories lists the directories being watched. */
+    directories: string[];
+}
+
 export interface ProfileParams {
     dir: string;
 }
@@ -956,6 +1396,7 @@ export interface CompilerOptions {
     noUncheckedSideEffectImports?: boolean;
     outDir?: string;
     paths?: Record<string, string[]>;
+    plugins?: { name: string; [key: string]: unknown; }[];
     preserveConstEnums?: boolean;
     preserveSymlinks?: boolean;
     project?: string;
@@ -1033,6 +1474,406 @@ export interface CompletionEntryResponse {
     symbol?: SymbolResponse;
 }
 
+/**
+ * A `MarkupContent` literal represents a string value which content is interpreted base on its
+ * kind flag. Currently the protocol supports `plaintext` and `markdown` as markup kinds.
+ * 
+ * If the kind is `markdown` then the value can contain fenced code blocks like in GitHub issues.
+ * See https://help.github.com/articles/creating-and-highlighting-code-blocks/#syntax-highlighting
+ * 
+ * Here is an example how such a string can be constructed using JavaScript / TypeScript:
+ * ```ts
+ * 
+ * 	let markdown: MarkdownContent = {
+ * 	 kind: MarkupKind.Markdown,
+ * 	 value: [
+ * 	 '# Header',
+ * 	 'Some text',
+ * 	 '```typescript',
+ * 	 'someCode();',
+ * 	 '```'
+ * 	 ].join('\n')
+ * 	};
+ * 
+ * ```
+ * 
+ * *Please Note* that clients might sanitize the return markdown. A client could decide to
+ * remove HTML from the markdown to avoid script execution.
+ */
+export interface LSPMarkupContent {
+    /** The type of the Markup */
+    kind: "markdown" | "plaintext";
+    /** The content itself */
+    value: string;
+}
+
+/**
+ * Since: 3.18.0
+ * 
+ * @deprecated use MarkupContent instead.
+ */
+export interface LSPMarkedStringWithLanguage {
+    language: string;
+    value: string;
+}
+
+/**
+ * A range in a text document expressed as (zero-based) start and end positions.
+ * 
+ * If you want to specify a range that contains a line including the line ending
+ * character(s) then use an end position denoting the start of the next line.
+ * For example:
+ * ```ts
+ * 
+ * 	{
+ * 	 start: { line: 5, character: 23 }
+ * 	 end : { line 6, character : 0 }
+ * 	}
+ * 
+ * ```
+ */
+export interface LSPRange {
+    /** The range's start position. */
+    start: LSPPosition;
+    /** The range's end position. */
+    end: LSPPosition;
+}
+
+/** A text edit applicable to a text document. */
+export interface LSPTextEdit {
+    /**
+     * The range of the text document to be manipulated. To insert
+     * text into a document create a range where start === end.
+     */
+    range: LSPRange;
+    /**
+     * The string to be inserted. For delete operations use an
+     * empty string.
+     */
+    newText: string;
+}
+
+/**
+ * Describes textual changes on a text document. A TextDocumentEdit describes all changes
+ * on a document version Si and after they are applied move the document to version Si+1.
+ * So the creator of a TextDocumentEdit doesn't need to sort the array of edits or do any
+ * kind of ordering. However the edits must be non overlapping.
+ */
+export interface LSPTextDocumentEdit {
+    /** The text document to change. */
+    textDocument: LSPOptionalVersionedTextDocumentIdentifier;
+    /**
+     * The edits to be applied.
+     * 
+     * Since: 3.16.0 - support for AnnotatedTextEdit. This is guarded using a
+     * client capability.
+     * 
+     * Since: 3.18.0 - support for SnippetTextEdit. This is guarded using a
+     * client capability.
+     */
+    edits: (LSPTextEdit | LSPAnnotatedTextEdit | LSPSnippetTextEdit)[] | null;
+}
+
+/** Create file operation. */
+export interface LSPCreateFile {
+    /** A create */
+    kind: "create";
+    /**
+     * An optional annotation identifier describing the operation.
+     * 
+     * Since: 3.16.0
+     */
+    annotationId?: string;
+    /** The resource to create. */
+    uri: string;
+    /** Additional options */
+    options?: LSPCreateFileOptions;
+}
+
+/** Rename file operation */
+export interface LSPRenameFile {
+    /** A rename */
+    kind: "rename";
+    /**
+     * An optional annotation identifier describing the operation.
+     * 
+     * Since: 3.16.0
+     */
+    annotationId?: string;
+    /** The old (existing) location. */
+    oldUri: string;
+    /** The new location. */
+    newUri: string;
+    /** Rename options. */
+    options?: LSPRenameFileOptions;
+}
+
+/** Delete file operation */
+export interface LSPDeleteFile {
+    /** A delete */
+    kind: "delete";
+    /**
+     * An optional annotation identifier describing the operation.
+     * 
+     * Since: 3.16.0
+     */
+    annotationId?: string;
+    /** The file to delete. */
+    uri: string;
+    /** Delete options. */
+    options?: LSPDeleteFileOptions;
+}
+
+/**
+ * Additional information that describes document changes.
+ * 
+ * Since: 3.16.0
+ */
+export interface LSPChangeAnnotation {
+    /**
+     * A human-readable string describing the actual change. The string
+     * is rendered prominent in the user interface.
+     */
+    label: string;
+    /**
+     * A flag which indicates that user confirmation is needed
+     * before applying the change.
+     */
+    needsConfirmation?: boolean;
+    /**
+     * A human-readable string which is rendered less prominent in
+     * the user interface.
+     */
+    description?: string;
+}
+
+/**
+ * Represents a diagnostic, such as a compiler error or warning. Diagnostic objects
+ * are only valid in the scope of a resource.
+ */
+export interface LSPDiagnostic {
+    /** The range at which the message applies */
+    range: LSPRange;
+    /**
+     * The diagnostic's severity. To avoid interpretation mismatches when a
+     * server is used with different clients it is highly recommended that servers
+     * always provide a severity value.
+     */
+    severity?: number;
+    /** The diagnostic's code, which usually appear in the user interface. */
+    code?: number | string;
+    /**
+     * An optional property to describe the error code.
+     * Requires the code field (above) to be present/not null.
+     * 
+     * Since: 3.16.0
+     */
+    codeDescription?: LSPCodeDescription;
+    /**
+     * A human-readable string describing the source of this
+     * diagnostic, e.g. 'typescript' or 'super lint'. It usually
+     * appears in the user interface.
+     */
+    source?: string;
+    /**
+     * The diagnostic's message. It usually appears in the user interface.
+     * 
+     * Since: 3.18.0 - support for MarkupContent. This is guarded by the client
+     * capability `textDocument.diagnostic.markupMessageSupport`.
+     */
+    message: string | LSPMarkupContent;
+    /**
+     * Additional metadata about the diagnostic.
+     * 
+     * Since: 3.15.0
+     */
+    tags?: number[];
+    /**
+     * An array of related diagnostic information, e.g. when symbol-names within
+     * a scope collide all definitions can be marked via this property.
+     */
+    relatedInformation?: LSPDiagnosticRelatedInformation[];
+    /**
+     * A data entry field that is preserved between a `textDocument/publishDiagnostics`
+     * notification and `textDocument/codeAction` request.
+     * 
+     * Since: 3.16.0
+     */
+    data?: LSPDiagnosticData;
+}
+
+/**
+ * Captures why the code action is currently disabled.
+ * 
+ * Since: 3.18.0
+ */
+export interface LSPCodeActionDisabled {
+    /**
+     * Human readable description of why the code action is currently disabled.
+     * 
+     * This is displayed in the code actions UI.
+     */
+    reason: string;
+}
+
+/**
+ * Represents a reference to a command. Provides a title which
+ * will be used to represent a command in the UI and, optionally,
+ * an array of arguments which will be passed to the command handler
+ * function when invoked.
+ */
+export interface LSPCommand {
+    /** Title of the command, like `save`. */
+    title: string;
+    /**
+     * An optional tooltip.
+     * 
+     * Since: 3.18.0
+     */
+    tooltip?: string;
+    /** The identifier of the actual command handler. */
+    command: string;
+    /**
+     * Arguments that the command handler should be
+     * invoked with.
+     */
+    arguments?: unknown[];
+}
+
+/** CodeActionData is preserved on a CodeAction. */
+export interface LSPCodeActionData {
+    /** The ID of the code fix whose fixes in the whole file are combined by this code action. */
+    fixId?: string;
+}
+
+/**
+ * Represents the signature of something callable. A signature
+ * can have a label, like a function-name, a doc-comment, and
+ * a set of parameters.
+ */
+export interface LSPSignatureInformation {
+    /**
+     * The label of this signature. Will be shown in
+     * the UI.
+     */
+    label: string;
+    /**
+     * The human-readable doc-comment of this signature. Will be shown
+     * in the UI but can be omitted.
+     */
+    documentation?: string | LSPMarkupContent;
+    /** The parameters of this signature. */
+    parameters?: LSPParameterInformation[];
+    /**
+     * The index of the active parameter.
+     * 
+     * If `null`, no parameter of the signature is active (for example a named
+     * argument that does not match any declared parameters). This is only valid
+     * if the client specifies the client capability
+     * `textDocument.signatureHelp.noActiveParameterSupport === true`
+     * 
+     * If provided (or `null`), this is used in place of
+     * `SignatureHelp.activeParameter`.
+     * 
+     * Since: 3.16.0
+     */
+    activeParameter?: number | null;
+}
+
+/**
+ * Position in a text document expressed as zero-based line and character
+ * offset. Prior to 3.17 the offsets were always based on a UTF-16 string
+ * representation. So a string of the form `a𐐀b` the character offset of the
+ * character `a` is 0, the character offset of `𐐀` is 1 and the character
+ * offset of b is 3 since `𐐀` is represented using two code units in UTF-16.
+ * Since 3.17 clients and servers can agree on a different string encoding
+ * representation (e.g. UTF-8). The client announces it's supported encoding
+ * via the client capability [`general.positionEncodings`](https://microsoft.github.io/language-server-protocol/specifications/specification-current/#clientCapabilities).
+ * The value is an array of position encodings the client supports, with
+ * decreasing preference (e.g. the encoding at index `0` is the most preferred
+ * one). To stay backwards compatible the only mandatory encoding is UTF-16
+ * represented via the string `utf-16`. The server can pick one of the
+ * encodings offered by the client and signals that encoding back to the
+ * client via the initialize result's property
+ * [`capabilities.positionEncoding`](https://microsoft.github.io/language-server-protocol/specifications/specification-current/#serverCapabilities). If the string value
+ * `utf-16` is missing from the client's capability `general.positionEncodings`
+ * servers can safely assume that the client supports UTF-16. If the server
+ * omits the position encoding in its initialize result the encoding defaults
+ * to the string value `utf-16`. Implementation considerations: since the
+ * conversion from one encoding into another requires the content of the
+ * file / line the conversion is best done where the file is read which is
+ * usually on the server side.
+ * 
+ * Positions are line end character agnostic. So you can not specify a position
+ * that denotes `\r|\n` or `\n|` where `|` represents the character offset.
+ * 
+ * Since: 3.17.0 - support for negotiated position encoding.
+ */
+export interface LSPPosition {
+    /** Line position in a document (zero-based). */
+    line: number;
+    /**
+     * Character offset on a line in a document (zero-based).
+     * 
+     * The meaning of this offset is determined by the negotiated
+     * `PositionEncodingKind`.
+     */
+    character: number;
+}
+
+/**
+ * An inlay hint label part allows for interactive and composite labels
+ * of inlay hints.
+ * 
+ * Since: 3.17.0
+ */
+export interface LSPInlayHintLabelPart {
+    /** The value of this label part. */
+    value: string;
+    /**
+     * The tooltip text when you hover over this label part. Depending on
+     * the client capability `inlayHint.resolveSupport` clients might resolve
+     * this property late using the resolve request.
+     */
+    tooltip?: string | LSPMarkupContent;
+    /**
+     * An optional source code location that represents this
+     * label part.
+     * 
+     * The editor will use this location for the hover and for code navigation
+     * features: This part will become a clickable link that resolves to the
+     * definition of the symbol at the given location (not necessarily the
+     * location itself), it shows the hover that shows at the given location,
+     * and it shows a context menu with further code navigation commands.
+     * 
+     * Depending on the client capability `inlayHint.resolveSupport` clients
+     * might resolve this property late using the resolve request.
+     */
+    location?: LSPLocation;
+    /**
+     * An optional command for this label part.
+     * 
+     * Depending on the client capability `inlayHint.resolveSupport` clients
+     * might resolve this property late using the resolve request.
+     */
+    command?: LSPCommand;
+}
+
+/** InlayHintData is a placeholder for custom data preserved on a InlayHint. */
+export interface LSPInlayHintData {
+}
+
+/**
+ * EmitTransformers lists client-registered transformers to run during emit,
+ * mirroring the customTransformers argument of ts.Program.emit. Each entry is
+ * an ID the server passes back to the client's transform callback.
+ */
+export interface EmitTransformers {
+    before?: string[];
+    after?: string[];
+    afterDeclarations?: string[];
+}
+
 export interface EmitOutputFile {
     fileName: string;
     text: string;
@@ -1052,3 +1893,146 @@ export interface CompletionEntryLabelDetailsResponse {
     detail?: string;
     description?: string;
 }
+
+/** A text document identifier to optionally denote a specific version of a text document. */
+export interface LSPOptionalVersionedTextDocumentIdentifier {
+    /** The text document's uri. */
+    uri: string;
+    /**
+     * The version number of this document. If a versioned text document identifier
+     * is sent from the server to the client and the file is not open in the editor
+     * (the server has not received an open notification before) the server can send
+     * `null` to indicate that the version is unknown and the content on disk is the
+     * truth (as specified with document content ownership).
+     */
+    version: number | null;
+}
+
+/**
+ * A special text edit with an additional change annotation.
+ * 
+ * Since: 3.16.0.
+ */
+export interface LSPAnnotatedTextEdit {
+    /**
+     * The range of the text document to be manipulated. To insert
+     * text into a document create a range where start === end.
+     */
+    range: LSPRange;
+    /**
+     * The string to be inserted. For delete operations use an
+     * empty string.
+     */
+    newText: string;
+    /** The actual identifier of the change annotation */
+    annotationId: string;
+}
+
+/**
+ * An interactive text edit.
+ * 
+ * Since: 3.18.0
+ */
+export interface LSPSnippetTextEdit {
+    /** The range of the text document to be manipulated. */
+    range: LSPRange;
+    /** The snippet to be inserted. */
+    snippet: LSPStringValue | null;
+    /** The actual identifier of the snippet edit. */
+    annotationId?: string;
+}
+
+/** Options to create a file. */
+export interface LSPCreateFileOptions {
+    /** Overwrite existing file. Overwrite wins over `ignoreIfExists` */
+    overwrite?: boolean;
+    /** Ignore if exists. */
+    ignoreIfExists?: boolean;
+}
+
+/** Rename file options */
+export interface LSPRenameFileOptions {
+    /** Overwrite target if existing. Overwrite wins over `ignoreIfExists` */
+    overwrite?: boolean;
+    /** Ignores if target exists. */
+    ignoreIfExists?: boolean;
+}
+
+/** Delete file options */
+export interface LSPDeleteFileOptions {
+    /** Delete the content recursively if a folder is denoted. */
+    recursive?: boolean;
+    /** Ignore the operation if the file doesn't exist. */
+    ignoreIfNotExists?: boolean;
+}
+
+/**
+ * Structure to capture a description for an error code.
+ * 
+ * Since: 3.16.0
+ */
+export interface LSPCodeDescription {
+    /** An URI to open with more information about the diagnostic error. */
+    href: string;
+}
+
+/**
+ * Represents a related message and source code location for a diagnostic. This should be
+ * used to point to code locations that cause or related to a diagnostics, e.g when duplicating
+ * a symbol in a scope.
+ */
+export interface LSPDiagnosticRelatedInformation {
+    /** The location of this related diagnostic information. */
+    location: LSPLocation;
+    /** The message of this related diagnostic information. */
+    message: string;
+}
+
+/** DiagnosticData is a placeholder for custom data preserved on a Diagnostic. */
+export interface LSPDiagnosticData {
+}
+
+/**
+ * Represents a parameter of a callable-signature. A parameter can
+ * have a label and a doc-comment.
+ */
+export interface LSPParameterInformation {
+    /**
+     * The label of this parameter information.
+     * 
+     * Either a string or an inclusive start and exclusive end offsets within its containing
+     * signature label. (see SignatureInformation.label). The offsets are based on a UTF-16
+     * string representation as `Position` and `Range` does.
+     * 
+     * To avoid ambiguities a server should use the [start, end] offset value instead of using
+     * a substring. Whether a client support this is controlled via `labelOffsetSupport` client
+     * capability.
+     * 
+     * *Note*: a label of type string should be a substring of its containing signature label.
+     * Its intended use case is to highlight the parameter label part in the `SignatureInformation.label`.
+     */
+    label: string | number[];
+    /**
+     * The human-readable doc-comment of this parameter. Will be shown
+     * in the UI but can be omitted.
+     */
+    documentation?: string | LSPMarkupContent;
+}
+
+/**
+ * A string value used as a snippet is a template which allows to insert text
+ * and to control the editor cursor when insertion happens.
+ * 
+ * A snippet can define tab stops and placeholders with `$1`, `$2`
+ * and `${3:foo}`. `$0` defines the final tab stop, it defaults to
+ * the end of the snippet. Variables are defined with `$name` and
+ * `${name:default value}`.
+ * 
+ * Since: 3.18.0
+ */
+export interface LSPStringValue {
+    /** The kind of string value. */
+    kind: "snippet";
+    /** The snippet string. */
+    value: string;
+}
@@ -63,7 +63,16 @@ import type {
     EmitOutputResponse as ProtocolEmitOutputResponse,
     ImportAdderAction,
     IntrinsicTypeMethod,
+    LSPCodeAction,
+    LSPDocumentSymbol,
+    LSPEvaluatedType,
+    LSPHover,
+    LSPInlayHint,
+    LSPLocation,
+    LSPRelationExplanation,
+    LSPSignatureHelp,
     LSPUpdateSnapshotParams,
+    LSPWorkspaceEdit,
     ParsedCommandLine,
     ProjectReference,
     ProjectResponse,
@@ -113,6 +122,7 @@ import type {
     GetImportEditsForSymbolsOptions,
     IdentifierTypePredicate,
     ImportAdderAction as APIImportAdderAction,
+    InlayHintsOptions,
     IndexedAccessType,
     IndexInfo,
     IndexType,
@@ -951,6 +961,102 @@ export class LanguageService {
             })),
         };
     }
+
+    getHoverAtPosition(document: DocumentIdentifier, position: number): LSPHover | undefined {
+        const data = this.client.apiRequest("getHoverAtPosition", {
+            snapshot: this.snapshotId,
+            project: this.project.id,
+            file: document,
+            position,
+        });
+        return data ?? undefined;
+    }
+
+    getDefinitionAtPosition(document: DocumentIdentifier, position: number): LSPLocation[] {
+        const data = this.client.apiRequest("getDefinitionAtPosition", {
+            snapshot: this.snapshotId,
+            project: this.project.id,
+            file: document,
+            position,
+        });
+        return data ?? [];
+    }
+
+    getRenameLocations(document: DocumentIdentifier, position: number, newName?: string): LSPWorkspaceEdit | undefined {
+        const data = this.client.apiRequest("getRenameLocations", {
+            snapshot: this.snapshotId,
+            project: this.project.id,
+            file: document,
+            position,
+            ...(newName !== undefined ? { newName } : {}),
+        });
+        return data ?? undefined;
+    }
+
+    getCodeFixesInRange(document: DocumentIdentifier, start: number, end: number, preferences?: Record<string, unknown>): LSPCodeAction[] {
+        return this.client.apiRequest("getCodeFixesInRange", {
+            snapshot: this.snapshotId,
+            project: this.project.id,
+            file: document,
+            start,
+            end,
+            ...(preferences !== undefined ? { preferences } : {}),
+        });
+    }
+
+    getSignatureHelpAtPosition(document: DocumentIdentifier, position: number, triggerCharacter?: string): LSPSignatureHelp | undefined {
+        const data = this.client.apiRequest("getSignatureHelpAtPosition", {
+            snapshot: this.snapshotId,
+            project: this.project.id,
+            file: document,
+            position,
+            ...(triggerCharacter !== undefined ? { triggerCharacter } : {}),
+        });
+        return data ?? undefined;
+    }
+
+    getInlayHints(document: DocumentIdentifier, options?: InlayHintsOptions): LSPInlayHint[] {
+        return this.client.apiRequest("getInlayHints", {
+            snapshot: this.snapshotId,
+            project: this.project.id,
+            file: document,
+            ...(options?.start !== undefined ? { start: options.start } : {}),
+            ...(options?.end !== undefined ? { end: options.end } : {}),
+            ...(options?.preferences !== undefined ? { preferences: options.preferences } : {}),
+        });
+    }
+
+    getDocumentSymbols(document: DocumentIdentifier): LSPDocumentSymbol[] {
+        return this.client.apiRequest("getDocumentSymbols", {
+            snapshot: this.snapshotId,
+            project: this.project.id,
+            file: document,
+        });
+    }
+
+    explainRelationError(document: DocumentIdentifier, start: number, end: number, code?: number): LSPRelationExplanation | undefined {
+        const data = this.client.apiRequest("explainRelationError", {
+            snapshot: this.snapshotId,
+            project: this.project.id,
+            file: document,
+            start,
+            end,
+            ...(code !== undefined ? { code } : {}),
+        });
+        return data ?? undefined;
+    }
+
+    evaluateType(document: DocumentIdentifier, position: number, expression: string, verbosityLevel?: number): LSPEvaluatedType | undefined {
+        const data = this.client.apiRequest("evaluateType", {
+            snapshot: this.snapshotId,
+            project: this.project.id,
+            file: document,
+            position,
+            expression,
+            ...(verbosityLevel !== undefined ? { verbosityLevel } : {}),
+        });
+        return data ?? undefined;
+    }
 }
 
 export class Program {
@@ -491,6 +491,8 @@ func (r *typeRenderer) namedType(named *types.Named) string {
 		return "DocumentIdentifier"
 	case "github.com/microsoft/typescript-go/internal/packagejson.JSONValue":
 		return "unknown"
+	case "github.com/microsoft/typescript-go/internal/core.PluginImport":
+		return "{ name: string; [key: string]: unknown; }"
 	case "github.com/microsoft/typescript-go/internal/core.Tristate":
 		return "boolean"
 	case "github.com/microsoft/typescript-go/internal/core.JsxEmit":
@@ -511,13 +513,16 @@ func (r *typeRenderer) namedType(named *types.Named) string {
 		}
 		return fmt.Sprintf("Record<string, %s>", r.typeString(named.TypeArgs().At(1), false))
 	}
+	if union, ok := r.lspUnion(named); ok {
+		return union
+	}
 	if _, ok := named.Underlying().(*types.Struct); !ok {
 		if literals := r.stringLiterals(named); len(literals) > 0 {
 			return strings.Join(literals, " | ")
 		}
 		return r.typeString(named.Underlying(), false)
 	}
-	tsName := exportedName(obj.Name())
+	tsName := typeName(obj)
 	if previous := r.names[tsName]; previous != nil && previous != obj {
 		panic(fmt.Sprintf("TypeScript name collision between %s and %s", previous, obj))
 	}
@@ -529,6 +534,43 @@ func (r *typeRenderer) namedType(named *types.Named) string {
 	return tsName
 }
 
+const lspPackagePath = "github.com/microsoft/typescript-go/internal/lsp/lsproto"
+
+// lspUnion renders the LSP protocol's union and string literal types, which are
+// structs with custom JSON marshaling rather than json-tagged fields.
+func (r *typeRenderer) lspUnion(named *types.Named) (string, bool) {
+	obj := named.Obj()
+	if obj.Pkg().Path() != lspPackagePath {
+		return "", false
+	}
+	structType, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return "", false
+	}
+	if structType.NumFields() == 0 {
+		// StringLiteralX is a literal type for "x"
+		doc := r.docs[obj]
+		if _, literal, ok := strings.Cut(doc, "literal type for "); ok && strings.HasPrefix(obj.Name(), "StringLiteral") {
+			return literal, true
+		}
+		return "", false
+	}
+	var members []string
+	for i := range structType.NumFields() {
+		if structType.Tag(i) != "" {
+			return "", false
+		}
+		member := r.typeString(structType.Field(i).Type(), false)
+		if !slices.Contains(members, member) {
+			members = append(members, member)
+		}
+	}
+	if strings.HasSuffix(obj.Name(), "OrNull") {
+		members = append(members, "null")
+	}
+	return strings.Join(members, " | "), true
+}
+
 func (r *typeRenderer) stringLiterals(named *types.Named) []string {
 	if basic, ok := named.Underlying().(*types.Basic); !ok || basic.Info()&types.IsString == 0 {
 		return nil
@@ -594,7 +636,7 @@ func (r *typeRenderer) declarations() (string, error) {
 		structType := named.Underlying().(*types.Struct)
 		isParams := strings.HasSuffix(named.Obj().Name(), "Params")
 		writeDoc(&out, "", r.docs[named.Obj()])
-		fmt.Fprintf(&out, "export interface %s {\n", exportedName(named.Obj().Name()))
+		fmt.Fprintf(&out, "export interface %s {\n", typeName(named.Obj()))
 		for i := range structType.NumFields() {
 			field, include, optional, nonnil, deprecated, internal := jsonField(structType, i)
 			if !include || deprecated || internal {
@@ -680,7 +722,7 @@ func jsonField(structType *types.Struct, index int) (name string, include bool,
 	internaltag := reflect.StructTag(structType.Tag(index)).Get("internal")
 	parts := strings.Split(tag, ",")
 	name = parts[0]
-	if name == "-" {
+	if name == "-" || strings.HasPrefix(name, "_vs_") {
 		return "", false, false, noniltag == "true", deprecatedtag == "true", internaltag == "true"
 	}
 	if name == "" {
@@ -694,6 +736,15 @@ func jsonField(structType *types.Struct, index int) (name string, include bool,
 	return name, true, optional, noniltag == "true", deprecatedtag == "true", internaltag == "true"
 }
 
+// typeName returns the TypeScript name of a struct type. LSP types share names
+// with API types (TextEdit, Diagnostic), so they are prefixed to keep them apart.
+func typeName(obj *types.TypeName) string {
+	if obj.Pkg().Path() == lspPackagePath {
+		return "LSP" + obj.Name()
+	}
+	return exportedName(obj.Name())
+}
+
 func exportedName(value string) string {
 	if value == "" {
 		return value
@@ -76,6 +76,11 @@ export interface CompilerOptions`,
 		`/** Snapshot is the current client snapshot on which to layer the temporary update. */
     snapshot: number;`,
 		`kind: "importSymbol";`,
+		`getHoverAtPosition: APIMethod<LanguageServicePositionParams, LSPHover | null>;`,
+		`getDocumentSymbols: APIMethod<LanguageServiceFileParams, LSPDocumentSymbol[]>;`,
+		`export interface LSPTextEdit {`,
+		`label: string | LSPInlayHintLabelPart[];`,
+		`kind: "rename";`,
 	} {
 		if !strings.Contains(generated, expected) {
 			t.Errorf("generated output does not contain %q", expected)
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/tracing"
+	"github.com/microsoft/typescript-go/internal/tspath"
+	"github.com/microsoft/typescript-go/internal/vfs/osvfs"
+)
+
+type analyzeTraceFlags struct {
+	traceDir  string
+	threshold float64
+}
+
+func parseAnalyzeTraceFlags(args []string) (analyzeTraceFlags, error) {
+	flags := flag.NewFlagSet("analyze-trace", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: tsgo analyze-trace [-threshold ms] <trace directory>")
+		flags.PrintDefaults()
+	}
+	result := analyzeTraceFlags{}
+	flags.Float64Var(&result.threshold, "threshold", 100, "shortest duration in milliseconds of a checker event reported as a hot spot")
+	if err := flags.Parse(args); err != nil {
+		return analyzeTraceFlags{}, err
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return analyzeTraceFlags{}, flag.ErrHelp
+	}
+	result.traceDir = flags.Arg(0)
+	return result, nil
+}
+
+func runAnalyzeTrace(args []string) int {
+	flags, err := parseAnalyzeTraceFlags(args)
+	if err != nil {
+		return 2
+	}
+
+	fs := osvfs.FS()
+	cwd := tspath.NormalizePath(core.Must(os.Getwd()))
+	err = tracing.AnalyzeTrace(fs, tspath.GetNormalizedAbsolutePath(flags.traceDir, cwd), os.Stdout, tracing.AnalyzeTraceOptions{
+		ThresholdMillis: flags.threshold,
+		ComparePathsOptions: tspath.ComparePathsOptions{
+			CurrentDirectory:          cwd,
+			UseCaseSensitiveFileNames: fs.UseCaseSensitiveFileNames(),
+		},
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/microsoft/typescript-go/internal/lsp"
 	"github.com/microsoft/typescript-go/internal/pprof"
 	"github.com/microsoft/typescript-go/internal/vfs/osvfs"
+	"github.com/microsoft/typescript-go/internal/vfs/zipvfs"
 )
 
 func runLSP(args []string) int {
@@ -41,7 +42,7 @@ func runLSP(args []string) int {
 		defer profileSession.Stop()
 	}
 
-	fs := bundled.WrapFS(osvfs.FS())
+	fs := bundled.WrapFS(zipvfs.From(osvfs.FS()))
 	defaultLibraryPath := bundled.LibPath()
 	typingsLocation := osvfs.GetGlobalTypingsCacheLocation()
 
@@ -61,6 +62,11 @@ func runLSP(args []string) int {
 			cmd.Dir = cwd
 			return cmd.Output()
 		},
+		RunPackageManager: func(cwd string, command string, args []string) ([]byte, error) {
+			cmd := exec.Command(command, args...)
+			cmd.Dir = cwd
+			return cmd.Output()
+		},
 		Spawn:              spawnProcess,
 		ProgressDelay:      250 * time.Millisecond,
 		SetParentProcessID: newParentProcessWatchdog(ctx, stop, *clientProcessID),
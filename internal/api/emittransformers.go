@@ -0,0 +1,336 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+
+	"github.com/microsoft/typescript-go/internal/api/encoder"
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/compiler"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/ipc"
+	"github.com/microsoft/typescript-go/internal/json"
+	"github.com/microsoft/typescript-go/internal/printer"
+	"github.com/microsoft/typescript-go/internal/transformers"
+	"github.com/zeebo/xxh3"
+)
+
+// callbackTransform is the client method invoked to run a custom emit transformer.
+const callbackTransform = "transform"
+
+// Transformer stages, matching the properties of ts.CustomTransformers.
+const (
+	transformStageBefore            = "before"
+	transformStageAfter             = "after"
+	transformStageAfterDeclarations = "afterDeclarations"
+)
+
+// clientTransformers runs emit transformers registered by the API client. Each
+// transformer receives the binary-encoded source file and may answer with a
+// replacement source file, whose statements are decoded back into the emit
+// pipeline.
+//
+// Transformers run on the emit worker goroutines, so the first error is
+// recorded and reported once emit completes; after a failure, the remaining
+// files pass through unchanged.
+type clientTransformers struct {
+	ctx  context.Context
+	conn ipc.Conn
+
+	mu  sync.Mutex
+	err error
+}
+
+func newClientTransformers(ctx context.Context, conn ipc.Conn, params *EmitTransformers) (*clientTransformers, *compiler.CustomTransformers, error) {
+	if conn == nil {
+		return nil, nil, fmt.Errorf("%w: custom transformers require a connection that supports callbacks", ErrClientError)
+	}
+	ct := &clientTransformers{ctx: ctx, conn: conn}
+	return ct, &compiler.CustomTransformers{
+		Before:            ct.factories(transformStageBefore, params.Before),
+		After:             ct.factories(transformStageAfter, params.After),
+		AfterDeclarations: ct.factories(transformStageAfterDeclarations, params.AfterDeclarations),
+	}, nil
+}
+
+func (ct *clientTransformers) factories(stage string, ids []string) []transformers.TransformerFactory {
+	if len(ids) == 0 {
+		return nil
+	}
+	factories := make([]transformers.TransformerFactory, 0, len(ids))
+	for _, id := range ids {
+		factories = append(factories, func(opts *transformers.TransformOptions) *transformers.Transformer {
+			tx := &clientTransformer{owner: ct, id: id, stage: stage}
+			return tx.NewTransformer(tx.visit, opts.Context)
+		})
+	}
+	return factories
+}
+
+// Err returns the first error encountered while running client transformers.
+func (ct *clientTransformers) Err() error {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	return ct.err
+}
+
+func (ct *clientTransformers) fail(err error) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	if ct.err == nil {
+		ct.err = err
+	}
+}
+
+type clientTransformer struct {
+	transformers.Transformer
+	owner *clientTransformers
+	id    string
+	stage string
+}
+
+func (tx *clientTransformer) visit(node *ast.Node) *ast.Node {
+	if tx.owner.Err() != nil {
+		return node
+	}
+	sourceFile := node.AsSourceFile()
+	statements, err := tx.call(sourceFile)
+	if err != nil {
+		tx.owner.fail(fmt.Errorf("transformer %q (%s) failed on %s: %w", tx.id, tx.stage, sourceFile.FileName(), err))
+		return node
+	}
+	if statements == nil {
+		return node
+	}
+	return tx.Factory().UpdateSourceFile(sourceFile, statements, sourceFile.EndOfFileToken)
+}
+
+// call sends the source file to the client and returns the statements of the
+// replacement source file, or nil if the client left the file unchanged.
+func (tx *clientTransformer) call(sourceFile *ast.SourceFile) (*ast.NodeList, error) {
+	data, _, err := encoder.EncodeNode(sourceFile.AsNode(), sourceFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode source file: %w", err)
+	}
+
+	result, err := tx.owner.conn.Call(tx.owner.ctx, callbackTransform, &TransformParams{
+		Transformer: tx.id,
+		Stage:       tx.stage,
+		FileName:    sourceFile.FileName(),
+		Data:        base64.StdEncoding.EncodeToString(data),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 || string(result) == "null" {
+		return nil, nil
+	}
+
+	var response TransformResponse
+	if err := json.Unmarshal(result, &response); err != nil {
+		return nil, fmt.Errorf("invalid transform response: %w", err)
+	}
+	replacementData, err := base64.StdEncoding.DecodeString(response.Data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 data: %w", err)
+	}
+	replacement, err := encoder.DecodeSourceFile(replacementData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode AST: %w", err)
+	}
+
+	reconcileDecodedNodes(tx.EmitContext(), sourceFile, replacement)
+	return replacement.Statements, nil
+}
+
+type encodedNodeKey struct {
+	kind     ast.Kind
+	pos, end int
+}
+
+// reconcileDecodedNodes prepares a tree decoded from the client for further
+// emit. Decoded nodes are marked synthesized so they are never mistaken for
+// parse tree nodes. Each decoded node that corresponds to a node of the source
+// file that was sent to the client is linked to it as its original and takes
+// over its emit state, which keeps comments, source maps, generated names and
+// checker-backed emit decisions working for the parts of the tree the client
+// did not rewrite. Parse tree nodes are matched by kind and (UTF-16) range;
+// nodes synthesized by earlier transforms have no range and are matched by
+// structure, or by their place in the tree when the client changed them. All
+// other nodes lose their positions, since they have no corresponding source
+// text.
+func reconcileDecodedNodes(emitContext *printer.EmitContext, sourceFile *ast.SourceFile, replacement *ast.SourceFile) {
+	positionMap := sourceFile.GetPositionMap()
+	toUTF16 := func(loc core.TextRange) (int, int) {
+		return positionMap.UTF8ToUTF16(loc.Pos()), positionMap.UTF8ToUTF16(loc.End())
+	}
+
+	sentHashes := make(map[*ast.Node]uint64)
+	decodedHashes := make(map[*ast.Node]uint64)
+	hashNodeStructure(sourceFile.AsNode(), sentHashes)
+	hashNodeStructure(replacement.AsNode(), decodedHashes)
+
+	originals := make(map[encodedNodeKey][]*ast.Node)
+	synthesized := make(map[uint64][]*ast.Node)
+	var collect func(node *ast.Node) bool
+	collect = func(node *ast.Node) bool {
+		if !ast.NodeIsSynthesized(node) {
+			pos, end := toUTF16(node.Loc)
+			key := encodedNodeKey{node.Kind, pos, end}
+			originals[key] = append(originals[key], node)
+		} else {
+			synthesized[sentHashes[node]] = append(synthesized[sentHashes[node]], node)
+		}
+		node.ForEachChild(collect)
+		return false
+	}
+	sourceFile.AsNode().ForEachChild(collect)
+
+	// Positions the client synthesized are encoded as -1 in a uint32 field.
+	decodedRange := func(loc core.TextRange) (int, int, bool) {
+		if loc.Pos() > math.MaxInt32 || loc.End() > math.MaxInt32 || loc.Pos() < 0 || loc.End() < 0 {
+			return 0, 0, false
+		}
+		return loc.Pos(), loc.End(), true
+	}
+	reconcileList := func(list *ast.NodeList) {
+		if pos, end, ok := decodedRange(list.Loc); ok {
+			list.Loc = core.NewTextRange(positionMap.UTF16ToUTF8(pos), positionMap.UTF16ToUTF8(end))
+		} else {
+			list.Loc = core.UndefinedTextRange()
+		}
+	}
+	// Transforms often give several nodes the same range, so candidates are
+	// taken in tree order, preferring one with the same structure.
+	unmatched := make(map[encodedNodeKey][]*ast.Node, len(originals))
+	for key, candidates := range originals {
+		unmatched[key] = slices.Clone(candidates)
+	}
+	take := func(candidates []*ast.Node, hash uint64) ([]*ast.Node, *ast.Node) {
+		for i, candidate := range candidates {
+			if sentHashes[candidate] == hash {
+				return slices.Delete(candidates, i, i+1), candidate
+			}
+		}
+		return candidates, nil
+	}
+	findOriginal := func(node *ast.Node) *ast.Node {
+		var original *ast.Node
+		hash := decodedHashes[node]
+		if pos, end, ok := decodedRange(node.Loc); ok {
+			key := encodedNodeKey{node.Kind, pos, end}
+			unmatched[key], original = take(unmatched[key], hash)
+			if original == nil && len(originals[key]) != 0 {
+				original = originals[key][0]
+			}
+		} else {
+			synthesized[hash], original = take(synthesized[hash], hash)
+		}
+		return original
+	}
+
+	// A synthesized node the client rewrote in place is treated as updated: it
+	// takes over the state of the child of the same kind at the same position
+	// under the original of its parent.
+	sentParent, childIndex := sourceFile.AsNode(), 0
+	findUpdatedOriginal := func(node *ast.Node, index int) *ast.Node {
+		if sentParent == nil {
+			return nil
+		}
+		var original *ast.Node
+		i := 0
+		sentParent.ForEachChild(func(child *ast.Node) bool {
+			if i == index {
+				if child.Kind == node.Kind && ast.NodeIsSynthesized(child) {
+					original = child
+				}
+				return true
+			}
+			i++
+			return false
+		})
+		return original
+	}
+
+	var visitor *ast.NodeVisitor
+	visitor = ast.NewNodeVisitor(func(node *ast.Node) *ast.Node {
+		node.Flags |= ast.NodeFlagsSynthesized
+		index := childIndex
+		childIndex++
+		original := findOriginal(node)
+		if original == nil {
+			original = findUpdatedOriginal(node, index)
+		}
+		if original != nil {
+			node.Loc = original.Loc
+			emitContext.SetOriginalForCopy(node, original)
+		} else {
+			node.Loc = core.UndefinedTextRange()
+		}
+		saveSentParent, saveChildIndex := sentParent, childIndex
+		sentParent, childIndex = original, 0
+		result := node.VisitEachChild(visitor)
+		sentParent, childIndex = saveSentParent, saveChildIndex
+		return result
+	}, nil, ast.NodeVisitorHooks{
+		VisitNodes: func(nodes *ast.NodeList, v *ast.NodeVisitor) *ast.NodeList {
+			if nodes != nil {
+				reconcileList(nodes)
+			}
+			return v.VisitNodes(nodes)
+		},
+		VisitModifiers: func(nodes *ast.ModifierList, v *ast.NodeVisitor) *ast.ModifierList {
+			if nodes != nil {
+				reconcileList(&nodes.NodeList)
+			}
+			return v.VisitModifiers(nodes)
+		},
+	})
+	if replacement.Statements != nil {
+		reconcileList(replacement.Statements)
+		visitor.VisitNodes(replacement.Statements)
+	}
+}
+
+// hashNodeStructure records a hash of the kind, text and children of node and
+// each of its descendants, so that a subtree can be recognized after a round
+// trip through the client regardless of positions.
+func hashNodeStructure(node *ast.Node, hashes map[*ast.Node]uint64) uint64 {
+	var childHashes []uint64
+	node.ForEachChild(func(child *ast.Node) bool {
+		childHashes = append(childHashes, hashNodeStructure(child, hashes))
+		return false
+	})
+
+	h := xxh3.New()
+	var buf [8]byte
+	writeUint64 := func(v uint64) {
+		binary.LittleEndian.PutUint64(buf[:], v)
+		_, _ = h.Write(buf[:])
+	}
+	writeUint64(uint64(node.Kind))
+	switch node.Kind {
+	case ast.KindIdentifier, ast.KindPrivateIdentifier, ast.KindStringLiteral, ast.KindNumericLiteral,
+		ast.KindBigIntLiteral, ast.KindRegularExpressionLiteral, ast.KindNoSubstitutionTemplateLiteral,
+		ast.KindTemplateHead, ast.KindTemplateMiddle, ast.KindTemplateTail:
+		text := node.Text()
+		writeUint64(uint64(len(text)))
+		_, _ = h.WriteString(text)
+	case ast.KindPrefixUnaryExpression:
+		writeUint64(uint64(node.AsPrefixUnaryExpression().Operator))
+	case ast.KindPostfixUnaryExpression:
+		writeUint64(uint64(node.AsPostfixUnaryExpression().Operator))
+	}
+	writeUint64(uint64(len(childHashes)))
+	for _, childHash := range childHashes {
+		writeUint64(childHash)
+	}
+	hash := h.Sum64()
+	hashes[node] = hash
+	return hash
+}
@@ -411,7 +411,7 @@ func GetNodeIndexTable(sourceFile *ast.SourceFile) *NodeIndexTable {
 // EncodeSourceFile encodes an entire source file AST into the binary format.
 // Returns the encoded bytes and a NodeIndexTable mapping encoder indices to AST nodes.
 func EncodeSourceFile(sourceFile *ast.SourceFile) ([]byte, *NodeIndexTable, error) {
-	data, nodeTable, err := encodeTree(sourceFile.AsNode(), sourceFile)
+	data, nodeTable, err := encodeTree(sourceFile.AsNode(), sourceFile, true /*includeJSDoc*/)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -426,10 +426,17 @@ func EncodeSourceFile(sourceFile *ast.SourceFile) ([]byte, *NodeIndexTable, erro
 // When encoding a non-SourceFile node, the header hash and parse options fields will be zero.
 // Returns the encoded bytes and a NodeIndexTable mapping encoder indices to AST nodes.
 func EncodeNode(node *ast.Node, sourceFile *ast.SourceFile) ([]byte, *NodeIndexTable, error) {
-	return encodeTree(node, sourceFile)
+	return encodeTree(node, sourceFile, true /*includeJSDoc*/)
 }
 
-func encodeTree(rootNode *ast.Node, sourceFile *ast.SourceFile) ([]byte, *NodeIndexTable, error) {
+// EncodeSourceFileSyntax encodes a source file like EncodeSourceFile, but leaves out
+// JSDoc nodes so that JSDoc the parser deferred is not parsed to encode it.
+func EncodeSourceFileSyntax(sourceFile *ast.SourceFile) ([]byte, error) {
+	data, _, err := encodeTree(sourceFile.AsNode(), sourceFile, false /*includeJSDoc*/)
+	return data, err
+}
+
+func encodeTree(rootNode *ast.Node, sourceFile *ast.SourceFile, includeJSDoc bool) ([]byte, *NodeIndexTable, error) {
 	var parentIndex, nodeCount, prevIndex uint32
 	var extendedData []byte
 	var structuredData []byte
@@ -549,7 +556,7 @@ func encodeTree(rootNode *ast.Node, sourceFile *ast.SourceFile) ([]byte, *NodeIn
 		prevIndex = 0
 		parentIndex = currentIndex
 		visitor.VisitEachChild(node)
-		if sourceFile != nil {
+		if sourceFile != nil && includeJSDoc {
 			for _, jsdoc := range node.JSDoc(sourceFile) {
 				visitor.Visit(jsdoc)
 			}
@@ -569,7 +576,7 @@ func encodeTree(rootNode *ast.Node, sourceFile *ast.SourceFile) ([]byte, *NodeIn
 	nodes = appendUint32s(nodes, uint32(rootNode.Kind), utf16(rootNode.Pos()), utf16(rootNode.End()), 0, 0, getNodeData(rootNode, strs, positionMap, &extendedData, &structuredData), uint32(rootNode.Flags))
 
 	visitor.VisitEachChild(rootNode)
-	if sourceFile != nil {
+	if sourceFile != nil && includeJSDoc {
 		for _, jsdoc := range rootNode.JSDoc(sourceFile) {
 			visitor.Visit(jsdoc)
 		}
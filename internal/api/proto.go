@@ -177,7 +177,16 @@ const (
 	MethodGetSignatureUsages          Method = "getSignatureUsages"
 
 	// Language service methods
-	MethodGetCompletionsAtPosition Method = "getCompletionsAtPosition"
+	MethodGetCompletionsAtPosition   Method = "getCompletionsAtPosition"
+	MethodGetHoverAtPosition         Method = "getHoverAtPosition"
+	MethodGetDefinitionAtPosition    Method = "getDefinitionAtPosition"
+	MethodGetRenameLocations         Method = "getRenameLocations"
+	MethodGetCodeFixesInRange        Method = "getCodeFixesInRange"
+	MethodGetSignatureHelpAtPosition Method = "getSignatureHelpAtPosition"
+	MethodGetInlayHints              Method = "getInlayHints"
+	MethodGetDocumentSymbols         Method = "getDocumentSymbols"
+	MethodExplainRelationError       Method = "explainRelationError"
+	MethodEvaluateType               Method = "evaluateType"
 
 	// Diagnostic methods
 	MethodGetSyntacticDiagnostics         Method = "getSyntacticDiagnostics"
@@ -217,6 +226,10 @@ const (
 	// Well-known per-checker signatures
 	MethodGetWellKnownSignatures Method = "getWellKnownSignatures"
 
+	// Watch methods
+	MethodWatch   Method = "watch"
+	MethodUnwatch Method = "unwatch"
+
 	// Profiling methods
 	MethodStartCPUProfile Method = "startCPUProfile"
 	MethodStopCPUProfile  Method = "stopCPUProfile"
@@ -229,6 +242,9 @@ type InitializeResponse struct {
 	UseCaseSensitiveFileNames bool `json:"useCaseSensitiveFileNames"`
 	// CurrentDirectory is the server's current working directory.
 	CurrentDirectory string `json:"currentDirectory"`
+	// PositionEncoding is the encoding of line/character positions in
+	// LSP-shaped language service results.
+	PositionEncoding lsproto.PositionEncodingKind `json:"positionEncoding"`
 }
 
 // DocumentIdentifier identifies a document by either a file name (plain string) or a URI object.
@@ -510,6 +526,15 @@ var unmarshalers = map[Method]func([]byte) (any, error){
 	MethodGetReferencedSymbolsForNode:       unmarshallerFor[GetReferencedSymbolsForNodeParams],
 	MethodGetSignatureUsages:                unmarshallerFor[GetSignatureUsagesParams],
 	MethodGetCompletionsAtPosition:          unmarshallerFor[GetCompletionsAtPositionParams],
+	MethodGetHoverAtPosition:                unmarshallerFor[LanguageServicePositionParams],
+	MethodGetDefinitionAtPosition:           unmarshallerFor[LanguageServicePositionParams],
+	MethodGetRenameLocations:                unmarshallerFor[GetRenameLocationsParams],
+	MethodGetCodeFixesInRange:               unmarshallerFor[GetCodeFixesInRangeParams],
+	MethodGetSignatureHelpAtPosition:        unmarshallerFor[GetSignatureHelpAtPositionParams],
+	MethodGetInlayHints:                     unmarshallerFor[GetInlayHintsParams],
+	MethodGetDocumentSymbols:                unmarshallerFor[LanguageServiceFileParams],
+	MethodExplainRelationError:              unmarshallerFor[ExplainRelationErrorParams],
+	MethodEvaluateType:                      unmarshallerFor[EvaluateTypeParams],
 	MethodPrintNode:                         unmarshallerFor[PrintNodeParams],
 	MethodFormatNodeForInsertion:            unmarshallerFor[FormatNodeForInsertionParams],
 	MethodEmit:                              unmarshallerFor[EmitParams],
@@ -538,6 +563,8 @@ var unmarshalers = map[Method]func([]byte) (any, error){
 	MethodGetProgramDiagnostics:             unmarshallerFor[GetProjectDiagnosticsParams],
 	MethodGetGlobalDiagnostics:              unmarshallerFor[GetProjectDiagnosticsParams],
 	MethodGetConfigFileParsingDiagnostics:   unmarshallerFor[GetProjectDiagnosticsParams],
+	MethodWatch:                             unmarshallerFor[WatchParams],
+	MethodUnwatch:                           noParams,
 	MethodStartCPUProfile:                   unmarshallerFor[ProfileParams],
 	MethodStopCPUProfile:                    noParams,
 	MethodSaveHeapProfile:                   unmarshallerFor[ProfileParams],
@@ -617,6 +644,39 @@ type ProfileParams struct {
 	Dir string `json:"dir"`
 }
 
+// WatchParams are the parameters for the watch method.
+type WatchParams struct {
+	// Diagnostics requests that snapshotChanged notifications list the files
+	// whose syntactic or semantic diagnostics changed. Computing this checks
+	// every changed project in full.
+	Diagnostics bool `json:"diagnostics,omitempty"`
+}
+
+// WatchResponse is returned by the watch method.
+type WatchResponse struct {
+	// Directories lists the directories being watched.
+	Directories []string `json:"directories" nonnil:"true"`
+}
+
+// SnapshotChangedParams are sent with the snapshotChanged notification when
+// watched files change and the server has created a new snapshot for them.
+// The notification transfers a reference to the snapshot to the client, which
+// must release it like one returned by updateSnapshot.
+type SnapshotChangedParams struct {
+	// Snapshot is the handle for the new snapshot.
+	Snapshot SnapshotID `json:"snapshot"`
+	// Projects is the list of projects in the snapshot.
+	Projects []*ProjectResponse `json:"projects" nonnil:"true"`
+	// Changes describes source file differences from the previous snapshot.
+	Changes *SnapshotChanges `json:"changes,omitempty"`
+	// FileChanges lists the files the watcher saw change on disk. It is empty
+	// when the watcher lost track of events and all cached state was invalidated.
+	FileChanges *APIFileChangeSummary `json:"fileChanges,omitempty"`
+	// DiagnosticsChanged maps project handles to the files whose diagnostics
+	// changed. Only set when diagnostics were requested from the watch method.
+	DiagnosticsChanged map[ProjectID][]string `json:"diagnosticsChanged,omitempty"`
+}
+
 type ProfileResult struct {
 	File string `json:"file"`
 }
@@ -1119,6 +1179,97 @@ type CompletionInfoResponse struct {
 	Entries      []*CompletionEntryResponse `json:"entries" nonnil:"true"`
 }
 
+// LanguageServicePositionParams are the parameters for language service methods
+// that operate at a position in a document. Results of these methods are shaped
+// like the corresponding LSP responses, with line/character positions in the
+// encoding reported by the initialize method.
+type LanguageServicePositionParams struct {
+	Snapshot SnapshotID         `json:"snapshot"`
+	Project  ProjectID          `json:"project"`
+	File     DocumentIdentifier `json:"file"`
+	Position uint32             `json:"position"`
+}
+
+// LanguageServiceFileParams are the parameters for language service methods
+// that operate on a whole document.
+type LanguageServiceFileParams struct {
+	Snapshot SnapshotID         `json:"snapshot"`
+	Project  ProjectID          `json:"project"`
+	File     DocumentIdentifier `json:"file"`
+}
+
+// GetRenameLocationsParams are the parameters for the getRenameLocations method.
+type GetRenameLocationsParams struct {
+	Snapshot SnapshotID         `json:"snapshot"`
+	Project  ProjectID          `json:"project"`
+	File     DocumentIdentifier `json:"file"`
+	Position uint32             `json:"position"`
+	// NewName is the text written at each rename location. Defaults to the
+	// current name of the symbol, so the edits only report where it occurs.
+	NewName *string `json:"newName,omitempty"`
+}
+
+// GetCodeFixesInRangeParams are the parameters for the getCodeFixesInRange method.
+type GetCodeFixesInRangeParams struct {
+	Snapshot SnapshotID         `json:"snapshot"`
+	Project  ProjectID          `json:"project"`
+	File     DocumentIdentifier `json:"file"`
+	Start    uint32             `json:"start"`
+	End      uint32             `json:"end"`
+	// Preferences are user preferences in tsserver format, e.g. quotePreference.
+	Preferences map[string]any `json:"preferences,omitempty"`
+}
+
+// GetSignatureHelpAtPositionParams are the parameters for the getSignatureHelpAtPosition method.
+type GetSignatureHelpAtPositionParams struct {
+	Snapshot         SnapshotID         `json:"snapshot"`
+	Project          ProjectID          `json:"project"`
+	File             DocumentIdentifier `json:"file"`
+	Position         uint32             `json:"position"`
+	TriggerCharacter *string            `json:"triggerCharacter,omitempty"`
+}
+
+// GetInlayHintsParams are the parameters for the getInlayHints method.
+type GetInlayHintsParams struct {
+	Snapshot SnapshotID         `json:"snapshot"`
+	Project  ProjectID          `json:"project"`
+	File     DocumentIdentifier `json:"file"`
+	// Start and End limit the hints to a span of the document. Both default to
+	// the bounds of the document.
+	Start *uint32 `json:"start,omitempty"`
+	End   *uint32 `json:"end,omitempty"`
+	// Preferences are user preferences in tsserver format. Inlay hints are off
+	// by default and are enabled with preferences such as includeInlayParameterNameHints.
+	Preferences map[string]any `json:"preferences,omitempty"`
+}
+
+// ExplainRelationErrorParams are the parameters for the explainRelationError method.
+type ExplainRelationErrorParams struct {
+	Snapshot SnapshotID         `json:"snapshot"`
+	Project  ProjectID          `json:"project"`
+	File     DocumentIdentifier `json:"file"`
+	// Start and End are the span of the diagnostic to explain.
+	Start uint32 `json:"start"`
+	End   uint32 `json:"end"`
+	// Code is the code of the diagnostic to explain. If omitted, the first
+	// relation error at the span is explained.
+	Code *int32 `json:"code,omitempty"`
+}
+
+// EvaluateTypeParams are the parameters for the evaluateType method.
+type EvaluateTypeParams struct {
+	Snapshot SnapshotID         `json:"snapshot"`
+	Project  ProjectID          `json:"project"`
+	File     DocumentIdentifier `json:"file"`
+	// Position is the position in whose scope the expression is evaluated.
+	Position uint32 `json:"position"`
+	// Expression is the type expression to evaluate, e.g. `Partial<Options>`.
+	Expression string `json:"expression"`
+	// VerbosityLevel is the level up to which named types in the result are
+	// expanded, as in hover. Defaults to 0.
+	VerbosityLevel *int32 `json:"verbosityLevel,omitempty"`
+}
+
 // GetIntrinsicTypeParams is used for intrinsic type getters (anyType, stringType, etc.).
 type GetIntrinsicTypeParams struct {
 	Snapshot SnapshotID `json:"snapshot"`
@@ -1284,9 +1435,34 @@ type PrintNodeParams struct {
 }
 
 type EmitParams struct {
-	Snapshot SnapshotID `json:"snapshot"`
-	Project  ProjectID  `json:"project"`
-	EmitOnly *uint32    `json:"emitOnly,omitempty"`
+	Snapshot     SnapshotID        `json:"snapshot"`
+	Project      ProjectID         `json:"project"`
+	EmitOnly     *uint32           `json:"emitOnly,omitempty"`
+	Transformers *EmitTransformers `json:"transformers,omitempty"`
+}
+
+// EmitTransformers lists client-registered transformers to run during emit,
+// mirroring the customTransformers argument of ts.Program.emit. Each entry is
+// an ID the server passes back to the client's transform callback.
+type EmitTransformers struct {
+	Before            []string `json:"before,omitempty"`
+	After             []string `json:"after,omitempty"`
+	AfterDeclarations []string `json:"afterDeclarations,omitempty"`
+}
+
+// TransformParams are the parameters of the transform callback the server
+// invokes on the client for each transformer and emitted file.
+type TransformParams struct {
+	Transformer string `json:"transformer"` // ID from EmitTransformers
+	Stage       string `json:"stage"`       // "before", "after" or "afterDeclarations"
+	FileName    string `json:"fileName"`
+	Data        string `json:"data"` // base64-encoded binary AST data of the source file
+}
+
+// TransformResponse is the result of the transform callback. A null result
+// leaves the source file unchanged.
+type TransformResponse struct {
+	Data string `json:"data"` // base64-encoded binary AST data of the replacement source file
 }
 
 type SelectedFilesEmitParams struct {
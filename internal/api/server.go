@@ -11,6 +11,7 @@ import (
 	"github.com/microsoft/typescript-go/internal/lsp/lsproto"
 	"github.com/microsoft/typescript-go/internal/project"
 	"github.com/microsoft/typescript-go/internal/vfs/osvfs"
+	"github.com/microsoft/typescript-go/internal/vfs/zipvfs"
 )
 
 // StdioServerOptions configures the STDIO-based API server.
@@ -74,7 +75,7 @@ func (s *StdioServer) Run(ctx context.Context) error {
 		transport = t
 	}
 
-	fs := bundled.WrapFS(osvfs.FS())
+	fs := bundled.WrapFS(zipvfs.From(osvfs.FS()))
 
 	// Wrap the base FS with callbackFS if callbacks are requested
 	var callbackFS *callbackFS
@@ -122,6 +123,8 @@ func (s *StdioServer) Run(ctx context.Context) error {
 		conn = syncConn
 	}
 
+	session.SetConnection(conn)
+
 	// If callbacks are enabled, set the connection on the FS
 	if callbackFS != nil {
 		callbackFS.SetConnection(ctx, conn)
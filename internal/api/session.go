@@ -19,17 +19,20 @@ import (
 	"github.com/microsoft/typescript-go/internal/compiler"
 	"github.com/microsoft/typescript-go/internal/core"
 	"github.com/microsoft/typescript-go/internal/diagnostics"
+	"github.com/microsoft/typescript-go/internal/execute/watchmanager"
 	"github.com/microsoft/typescript-go/internal/format"
 	"github.com/microsoft/typescript-go/internal/ipc"
 	"github.com/microsoft/typescript-go/internal/json"
 	"github.com/microsoft/typescript-go/internal/ls"
 	"github.com/microsoft/typescript-go/internal/ls/autoimport"
 	"github.com/microsoft/typescript-go/internal/ls/lsconv"
+	"github.com/microsoft/typescript-go/internal/ls/lsutil"
 	"github.com/microsoft/typescript-go/internal/lsp/lsproto"
 	"github.com/microsoft/typescript-go/internal/nodebuilder"
 	"github.com/microsoft/typescript-go/internal/pprof"
 	"github.com/microsoft/typescript-go/internal/printer"
 	"github.com/microsoft/typescript-go/internal/project"
+	"github.com/microsoft/typescript-go/internal/spanmap"
 	"github.com/microsoft/typescript-go/internal/transpile"
 	"github.com/microsoft/typescript-go/internal/tsoptions"
 	"github.com/microsoft/typescript-go/internal/tspath"
@@ -407,6 +410,16 @@ type Session struct {
 	// snapshots. Lock ordering is updateMu -> snapshotsMu (never the reverse).
 	updateMu sync.Mutex
 
+	// conn is the client connection, used to invoke client callbacks such as
+	// custom emit transformers. It is nil until SetConnection is called.
+	conn ipc.Conn
+
+	// watcher pushes snapshotChanged notifications while the client is
+	// watching the file system. Guarded by watchMu.
+	watcher      *snapshotWatcher
+	watchBackend watchmanager.WatchBackend
+	watchMu      sync.Mutex
+
 	cpuProfiler pprof.CPUProfiler
 }
 
@@ -417,6 +430,8 @@ var _ ipc.Handler = (*Session)(nil)
 type SessionOptions struct {
 	// UseBinaryResponses enables binary responses for msgpack protocol.
 	UseBinaryResponses bool
+	// WatchBackend overrides the file system watcher used by the watch method.
+	WatchBackend watchmanager.WatchBackend
 }
 
 // NewSession creates a new API session with the given project session.
@@ -429,10 +444,17 @@ func NewSession(projectSession *project.Session, options *SessionOptions) *Sessi
 	}
 	if options != nil {
 		s.useBinaryResponses = options.UseBinaryResponses
+		s.watchBackend = options.WatchBackend
 	}
 	return s
 }
 
+// SetConnection sets the connection used to call back into the client.
+// This must be called before handling any request that uses client callbacks.
+func (s *Session) SetConnection(conn ipc.Conn) {
+	s.conn = conn
+}
+
 // ID returns the unique identifier for this session.
 func (s *Session) ID() string {
 	return s.id
@@ -571,12 +593,17 @@ func (s *Session) setupChecker(ctx context.Context, snapshot SnapshotID, project
 // LS operation acquires a checker exactly once; nested acquisitions (e.g. find-all-
 // references) would deadlock on the single-slot persistent checker.
 func (s *Session) setupLanguageService(sd *snapshotData, program *compiler.Program, projectHandle ProjectID, activeFile string) (*ls.LanguageService, error) {
+	return s.setupLanguageServiceWithHost(sd, program, projectHandle, activeFile, sd.snapshot)
+}
+
+// setupLanguageServiceWithHost is like setupLanguageService, but uses the given host.
+func (s *Session) setupLanguageServiceWithHost(sd *snapshotData, program *compiler.Program, projectHandle ProjectID, activeFile string, host ls.Host) (*ls.LanguageService, error) {
 	projectName := parseProjectHandle(projectHandle)
 	proj := sd.snapshot.ProjectCollection.GetProjectByPath(projectName)
 	if proj == nil {
 		return nil, fmt.Errorf("%w: project %s not found", ErrClientError, projectName)
 	}
-	return ls.NewLanguageService(proj.ID(), program, sd.snapshot, activeFile), nil
+	return ls.NewLanguageService(proj.ID(), program, host, activeFile), nil
 }
 
 // HandleRequest implements Handler.
@@ -859,6 +886,10 @@ func (s *Session) HandleRequest(ctx context.Context, method string, params json.
 		return s.handleGetGlobalDiagnostics(ctx, parsed.(*GetProjectDiagnosticsParams))
 	case string(MethodGetConfigFileParsingDiagnostics):
 		return s.handleGetConfigFileParsingDiagnostics(ctx, parsed.(*GetProjectDiagnosticsParams))
+	case string(MethodWatch):
+		return s.handleWatch(ctx, parsed.(*WatchParams))
+	case string(MethodUnwatch):
+		return s.handleUnwatch(ctx)
 	case string(MethodStartCPUProfile):
 		return s.handleStartCPUProfile(ctx, parsed.(*ProfileParams))
 	case string(MethodStopCPUProfile):
@@ -873,6 +904,24 @@ func (s *Session) HandleRequest(ctx context.Context, method string, params json.
 		return s.handleGetSignatureUsages(ctx, parsed.(*GetSignatureUsagesParams))
 	case string(MethodGetCompletionsAtPosition):
 		return s.handleGetCompletionsAtPosition(ctx, parsed.(*GetCompletionsAtPositionParams))
+	case string(MethodGetHoverAtPosition):
+		return s.handleGetHoverAtPosition(ctx, parsed.(*LanguageServicePositionParams))
+	case string(MethodGetDefinitionAtPosition):
+		return s.handleGetDefinitionAtPosition(ctx, parsed.(*LanguageServicePositionParams))
+	case string(MethodGetRenameLocations):
+		return s.handleGetRenameLocations(ctx, parsed.(*GetRenameLocationsParams))
+	case string(MethodGetCodeFixesInRange):
+		return s.handleGetCodeFixesInRange(ctx, parsed.(*GetCodeFixesInRangeParams))
+	case string(MethodGetSignatureHelpAtPosition):
+		return s.handleGetSignatureHelpAtPosition(ctx, parsed.(*GetSignatureHelpAtPositionParams))
+	case string(MethodGetInlayHints):
+		return s.handleGetInlayHints(ctx, parsed.(*GetInlayHintsParams))
+	case string(MethodGetDocumentSymbols):
+		return s.handleGetDocumentSymbols(ctx, parsed.(*LanguageServiceFileParams))
+	case string(MethodExplainRelationError):
+		return s.handleExplainRelationError(ctx, parsed.(*ExplainRelationErrorParams))
+	case string(MethodEvaluateType):
+		return s.handleEvaluateType(ctx, parsed.(*EvaluateTypeParams))
 	default:
 		return nil, fmt.Errorf("unknown method: %s", method)
 	}
@@ -917,6 +966,7 @@ func (s *Session) handleInitialize(ctx context.Context) (*InitializeResponse, er
 	return &InitializeResponse{
 		UseCaseSensitiveFileNames: s.projectSession.FS().UseCaseSensitiveFileNames(),
 		CurrentDirectory:          s.projectSession.GetCurrentDirectory(),
+		PositionEncoding:          s.projectSession.PositionEncoding(),
 	}, nil
 }
 
@@ -2623,7 +2673,7 @@ func (s *Session) handlePrintNode(_ context.Context, params *PrintNodeParams) (s
 }
 
 func (s *Session) handleEmit(ctx context.Context, params *EmitParams) (*EmitResponse, error) {
-	program, options, err := s.getEmitOptions(params)
+	program, options, clientTransformers, err := s.getEmitOptions(ctx, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2634,6 +2684,11 @@ func (s *Session) handleEmit(ctx context.Context, params *EmitParams) (*EmitResp
 	if err != nil {
 		return nil, err
 	}
+	if clientTransformers != nil {
+		if err := clientTransformers.Err(); err != nil {
+			return nil, err
+		}
+	}
 	emittedFiles := slices.Clone(result.EmittedFiles)
 	if emittedFiles == nil {
 		emittedFiles = []string{}
@@ -2646,11 +2701,20 @@ func (s *Session) handleEmit(ctx context.Context, params *EmitParams) (*EmitResp
 }
 
 func (s *Session) handleEmitToString(ctx context.Context, params *EmitParams) (*EmitOutputResponse, error) {
-	program, options, err := s.getEmitOptions(params)
+	program, options, clientTransformers, err := s.getEmitOptions(ctx, params)
 	if err != nil {
 		return nil, err
 	}
-	return emitToOutput(ctx, program, options)
+	result, err := emitToOutput(ctx, program, options)
+	if err != nil {
+		return nil, err
+	}
+	if clientTransformers != nil {
+		if err := clientTransformers.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
 }
 
 func (s *Session) handleSelectedFilesEmit(ctx context.Context, params *SelectedFilesEmitParams, emitOnly compiler.EmitOnly) (*EmitOutputResponse, error) {
@@ -2705,18 +2769,26 @@ func emitToOutput(ctx context.Context, program *compiler.Program, options compil
 	}, nil
 }
 
-func (s *Session) getEmitOptions(params *EmitParams) (*compiler.Program, compiler.EmitOptions, error) {
+func (s *Session) getEmitOptions(ctx context.Context, params *EmitParams) (*compiler.Program, compiler.EmitOptions, *clientTransformers, error) {
 	program, err := s.getEmitProgram(params.Snapshot, params.Project)
 	if err != nil {
-		return nil, compiler.EmitOptions{}, err
+		return nil, compiler.EmitOptions{}, nil, err
 	}
 	emitOnly, err := getEmitOnly(params.EmitOnly)
 	if err != nil {
-		return nil, compiler.EmitOptions{}, err
+		return nil, compiler.EmitOptions{}, nil, err
 	}
-	return program, compiler.EmitOptions{
+	options := compiler.EmitOptions{
 		EmitOnly: emitOnly,
-	}, nil
+	}
+	var transformers *clientTransformers
+	if params.Transformers != nil {
+		transformers, options.CustomTransformers, err = newClientTransformers(ctx, s.conn, params.Transformers)
+		if err != nil {
+			return nil, compiler.EmitOptions{}, nil, err
+		}
+	}
+	return program, options, transformers, nil
 }
 
 func (s *Session) getEmitProgram(snapshot SnapshotID, projectID ProjectID) (*compiler.Program, error) {
@@ -3551,6 +3623,7 @@ func computeSnapshotChanges(prev *project.Snapshot, next *project.Snapshot) *Sna
 // Close closes the session and releases all active snapshots,
 // regardless of their ref counts.
 func (s *Session) Close() {
+	s.stopWatching()
 	s.releaseOpenRefs()
 
 	s.snapshotsMu.Lock()
@@ -3894,6 +3967,322 @@ func (s *Session) handleGetCompletionsAtPosition(ctx context.Context, params *Ge
 	}, nil
 }
 
+// apiClientCapabilities are the client capabilities assumed for language
+// service requests made through the API, so that results have a stable shape
+// regardless of which client the session is shared with.
+var apiClientCapabilities = func() *lsproto.ResolvedClientCapabilities {
+	caps := &lsproto.ResolvedClientCapabilities{}
+	caps.TextDocument.Hover.ContentFormat = []lsproto.MarkupKind{lsproto.MarkupKindMarkdown, lsproto.MarkupKindPlainText}
+	caps.TextDocument.SignatureHelp.SignatureInformation.DocumentationFormat = []lsproto.MarkupKind{lsproto.MarkupKindMarkdown, lsproto.MarkupKindPlainText}
+	caps.TextDocument.SignatureHelp.SignatureInformation.ActiveParameterSupport = true
+	caps.TextDocument.DocumentSymbol.HierarchicalDocumentSymbolSupport = true
+	return caps
+}()
+
+// preferencesHost overrides the user preferences of a language service host
+// with preferences sent by the API client.
+type preferencesHost struct {
+	ls.Host
+	preferences lsutil.UserPreferences
+}
+
+func (h *preferencesHost) GetPreferences(activeFile string) lsutil.UserPreferences {
+	return h.preferences
+}
+
+// languageServiceRequest holds the state shared by language service methods
+// that operate on a single file.
+type languageServiceRequest struct {
+	sd         *snapshotData
+	langSvc    *ls.LanguageService
+	sourceFile *ast.SourceFile
+	uri        lsproto.DocumentUri
+}
+
+// newLanguageServiceRequest resolves the file of a language service method and
+// creates a language service for it. It returns a nil request if the file is
+// not part of the project. Preferences, when given, replace the preferences of
+// the session.
+func (s *Session) newLanguageServiceRequest(
+	ctx context.Context,
+	snapshot SnapshotID,
+	projectHandle ProjectID,
+	file DocumentIdentifier,
+	preferences map[string]any,
+) (context.Context, *languageServiceRequest, error) {
+	sd, err := s.getSnapshotData(snapshot)
+	if err != nil {
+		return ctx, nil, err
+	}
+	program, err := sd.getProgram(projectHandle)
+	if err != nil {
+		return ctx, nil, err
+	}
+	fileName := file.ToFileName()
+	sourceFile := program.GetSourceFile(fileName)
+	if sourceFile == nil {
+		return ctx, nil, nil
+	}
+	var host ls.Host = sd.snapshot
+	if preferences != nil {
+		data, err := json.Marshal(preferences)
+		if err != nil {
+			return ctx, nil, fmt.Errorf("%w: invalid preferences: %w", ErrClientError, err)
+		}
+		var prefs lsutil.UserPreferences
+		if err := json.Unmarshal(data, &prefs); err != nil {
+			return ctx, nil, fmt.Errorf("%w: invalid preferences: %w", ErrClientError, err)
+		}
+		host = &preferencesHost{Host: sd.snapshot, preferences: prefs}
+	}
+	langSvc, err := s.setupLanguageServiceWithHost(sd, program, projectHandle, fileName, host)
+	if err != nil {
+		return ctx, nil, err
+	}
+	return lsproto.WithClientCapabilities(ctx, apiClientCapabilities), &languageServiceRequest{
+		sd:         sd,
+		langSvc:    langSvc,
+		sourceFile: sourceFile,
+		uri:        file.ToURI(s.projectSession.GetCurrentDirectory()),
+	}, nil
+}
+
+// toLSPPosition converts a UTF-16 offset in the request's file to an LSP position.
+func (r *languageServiceRequest) toLSPPosition(position uint32) lsproto.Position {
+	pos := r.sourceFile.GetPositionMap().UTF16ToUTF8(int(position))
+	lspPos, _ := r.sd.snapshot.Converters().ToLSPPosition(r.sourceFile, core.TextPos(pos))
+	return lspPos
+}
+
+// handleGetHoverAtPosition returns the hover information at a position.
+// @gen-proto-nullable
+func (s *Session) handleGetHoverAtPosition(ctx context.Context, params *LanguageServicePositionParams) (*lsproto.Hover, error) {
+	ctx, req, err := s.newLanguageServiceRequest(ctx, params.Snapshot, params.Project, params.File, nil)
+	if err != nil || req == nil {
+		return nil, err
+	}
+	result, err := req.langSvc.ProvideHover(ctx, &lsproto.HoverParams{
+		TextDocument: lsproto.TextDocumentIdentifier{Uri: req.uri},
+		Position:     req.toLSPPosition(params.Position),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Hover, nil
+}
+
+// handleGetDefinitionAtPosition returns the locations of the definitions of the symbol at a position.
+// @gen-proto-nullable
+func (s *Session) handleGetDefinitionAtPosition(ctx context.Context, params *LanguageServicePositionParams) ([]lsproto.Location, error) {
+	ctx, req, err := s.newLanguageServiceRequest(ctx, params.Snapshot, params.Project, params.File, nil)
+	if err != nil || req == nil {
+		return nil, err
+	}
+	result, err := req.langSvc.ProvideDefinition(ctx, req.uri, req.toLSPPosition(params.Position))
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case result.Location != nil:
+		return []lsproto.Location{*result.Location}, nil
+	case result.Locations != nil:
+		return *result.Locations, nil
+	case result.DefinitionLinks != nil:
+		locations := make([]lsproto.Location, 0, len(*result.DefinitionLinks))
+		for _, link := range *result.DefinitionLinks {
+			locations = append(locations, lsproto.Location{Uri: link.TargetUri, Range: link.TargetSelectionRange})
+		}
+		return locations, nil
+	}
+	return nil, nil
+}
+
+// handleGetRenameLocations returns the edits that rename the symbol at a position.
+// @gen-proto-nullable
+func (s *Session) handleGetRenameLocations(ctx context.Context, params *GetRenameLocationsParams) (*lsproto.WorkspaceEdit, error) {
+	ctx, req, err := s.newLanguageServiceRequest(ctx, params.Snapshot, params.Project, params.File, nil)
+	if err != nil || req == nil {
+		return nil, err
+	}
+	position := req.toLSPPosition(params.Position)
+	var newName string
+	if params.NewName != nil {
+		newName = *params.NewName
+	}
+	info := req.langSvc.GetRenameInfo(ctx, newName, req.uri, position)
+	if !info.CanRename {
+		return nil, nil
+	}
+	if params.NewName == nil {
+		for _, mapped := range lsconv.FromLSPRangeForSourceFile(req.sd.snapshot.Converters(), req.sourceFile, info.TriggerSpan, spanmap.FeatureRename) {
+			newName = mapped.Script.Text()[mapped.Span.Pos():mapped.Span.End()]
+			break
+		}
+	}
+	result, err := req.langSvc.ProvideRename(ctx, &lsproto.RenameParams{
+		TextDocument: lsproto.TextDocumentIdentifier{Uri: req.uri},
+		Position:     position,
+		NewName:      newName,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result.WorkspaceEdit, nil
+}
+
+// handleGetCodeFixesInRange returns the quick fixes for the diagnostics that
+// overlap a range of a file.
+func (s *Session) handleGetCodeFixesInRange(ctx context.Context, params *GetCodeFixesInRangeParams) ([]*lsproto.CodeAction, error) {
+	if params.End < params.Start {
+		return nil, fmt.Errorf("%w: end %d is before start %d", ErrClientError, params.End, params.Start)
+	}
+	ctx, req, err := s.newLanguageServiceRequest(ctx, params.Snapshot, params.Project, params.File, params.Preferences)
+	if err != nil || req == nil {
+		return []*lsproto.CodeAction{}, err
+	}
+	rng := lsproto.Range{Start: req.toLSPPosition(params.Start), End: req.toLSPPosition(params.End)}
+
+	diagnosticsResult, err := req.langSvc.ProvideDiagnostics(ctx, req.uri)
+	if err != nil {
+		return nil, err
+	}
+	var diagnostics []*lsproto.Diagnostic
+	if report := diagnosticsResult.FullDocumentDiagnosticReport; report != nil {
+		for _, diag := range report.Items {
+			if lsproto.ComparePositions(diag.Range.Start, rng.End) <= 0 && lsproto.ComparePositions(rng.Start, diag.Range.End) <= 0 {
+				diagnostics = append(diagnostics, diag)
+			}
+		}
+	}
+	if len(diagnostics) == 0 {
+		return []*lsproto.CodeAction{}, nil
+	}
+
+	result, err := req.langSvc.ProvideCodeActions(ctx, &lsproto.CodeActionParams{
+		TextDocument: lsproto.TextDocumentIdentifier{Uri: req.uri},
+		Range:        rng,
+		Context: &lsproto.CodeActionContext{
+			Diagnostics: diagnostics,
+			Only:        &[]lsproto.CodeActionKind{lsproto.CodeActionKindQuickFix},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	actions := []*lsproto.CodeAction{}
+	if result.CommandOrCodeActionArray != nil {
+		for _, action := range *result.CommandOrCodeActionArray {
+			if action.CodeAction != nil {
+				actions = append(actions, action.CodeAction)
+			}
+		}
+	}
+	return actions, nil
+}
+
+// handleGetSignatureHelpAtPosition returns the signature help at a position.
+// @gen-proto-nullable
+func (s *Session) handleGetSignatureHelpAtPosition(ctx context.Context, params *GetSignatureHelpAtPositionParams) (*lsproto.SignatureHelp, error) {
+	ctx, req, err := s.newLanguageServiceRequest(ctx, params.Snapshot, params.Project, params.File, nil)
+	if err != nil || req == nil {
+		return nil, err
+	}
+	signatureHelpContext := &lsproto.SignatureHelpContext{TriggerKind: lsproto.SignatureHelpTriggerKindInvoked}
+	if params.TriggerCharacter != nil {
+		signatureHelpContext.TriggerKind = lsproto.SignatureHelpTriggerKindTriggerCharacter
+		signatureHelpContext.TriggerCharacter = params.TriggerCharacter
+	}
+	result, err := req.langSvc.ProvideSignatureHelp(ctx, req.uri, req.toLSPPosition(params.Position), signatureHelpContext)
+	if err != nil {
+		return nil, err
+	}
+	return result.SignatureHelp, nil
+}
+
+// handleGetInlayHints returns the inlay hints of a file.
+func (s *Session) handleGetInlayHints(ctx context.Context, params *GetInlayHintsParams) ([]*lsproto.InlayHint, error) {
+	ctx, req, err := s.newLanguageServiceRequest(ctx, params.Snapshot, params.Project, params.File, params.Preferences)
+	if err != nil || req == nil {
+		return []*lsproto.InlayHint{}, err
+	}
+	start := uint32(0)
+	if params.Start != nil {
+		start = *params.Start
+	}
+	end := uint32(req.sourceFile.GetPositionMap().UTF8ToUTF16(len(req.sourceFile.Text())))
+	if params.End != nil {
+		end = *params.End
+	}
+	if end < start {
+		return nil, fmt.Errorf("%w: end %d is before start %d", ErrClientError, end, start)
+	}
+	result, err := req.langSvc.ProvideInlayHint(ctx, &lsproto.InlayHintParams{
+		TextDocument: lsproto.TextDocumentIdentifier{Uri: req.uri},
+		Range:        lsproto.Range{Start: req.toLSPPosition(start), End: req.toLSPPosition(end)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.InlayHints == nil {
+		return []*lsproto.InlayHint{}, nil
+	}
+	return *result.InlayHints, nil
+}
+
+// handleGetDocumentSymbols returns the hierarchy of symbols declared in a file.
+func (s *Session) handleGetDocumentSymbols(ctx context.Context, params *LanguageServiceFileParams) ([]*lsproto.DocumentSymbol, error) {
+	ctx, req, err := s.newLanguageServiceRequest(ctx, params.Snapshot, params.Project, params.File, nil)
+	if err != nil || req == nil {
+		return []*lsproto.DocumentSymbol{}, err
+	}
+	result, err := req.langSvc.ProvideDocumentSymbols(ctx, req.uri)
+	if err != nil {
+		return nil, err
+	}
+	if result.DocumentSymbols == nil {
+		return []*lsproto.DocumentSymbol{}, nil
+	}
+	return *result.DocumentSymbols, nil
+}
+
+// handleExplainRelationError explains the relation error reported at a span of
+// a file, such as an assignability error, as a tree of the comparisons that failed.
+// @gen-proto-nullable
+func (s *Session) handleExplainRelationError(ctx context.Context, params *ExplainRelationErrorParams) (*lsproto.RelationExplanation, error) {
+	if params.End < params.Start {
+		return nil, fmt.Errorf("%w: end %d is before start %d", ErrClientError, params.End, params.Start)
+	}
+	ctx, req, err := s.newLanguageServiceRequest(ctx, params.Snapshot, params.Project, params.File, nil)
+	if err != nil || req == nil {
+		return nil, err
+	}
+	rng := lsproto.Range{Start: req.toLSPPosition(params.Start), End: req.toLSPPosition(params.End)}
+	result, err := req.langSvc.ProvideRelationErrorExplanation(ctx, req.uri, rng, params.Code)
+	if err != nil {
+		return nil, err
+	}
+	return result.RelationExplanation, nil
+}
+
+// handleEvaluateType evaluates a type expression in the scope of a position and
+// returns the resulting type, printed structurally.
+// @gen-proto-nullable
+func (s *Session) handleEvaluateType(ctx context.Context, params *EvaluateTypeParams) (*lsproto.EvaluatedType, error) {
+	ctx, req, err := s.newLanguageServiceRequest(ctx, params.Snapshot, params.Project, params.File, nil)
+	if err != nil || req == nil {
+		return nil, err
+	}
+	var verbosityLevel int
+	if params.VerbosityLevel != nil {
+		verbosityLevel = int(*params.VerbosityLevel)
+	}
+	result, err := req.langSvc.ProvideEvaluatedType(ctx, req.uri, req.toLSPPosition(params.Position), params.Expression, verbosityLevel)
+	if err != nil {
+		return nil, err
+	}
+	return result.EvaluatedType, nil
+}
+
 // handleGetReferencedSymbolsForNode returns node handles for all references found at a node.
 // @gen-proto-nullable
 func (s *Session) handleGetReferencedSymbolsForNode(ctx context.Context, params *GetReferencedSymbolsForNodeParams) ([]ReferencedSymbolEntry, error) {
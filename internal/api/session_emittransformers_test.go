@@ -0,0 +1,222 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/microsoft/typescript-go/internal/api/encoder"
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/bundled"
+	"github.com/microsoft/typescript-go/internal/ipc"
+	"github.com/microsoft/typescript-go/internal/json"
+	"github.com/microsoft/typescript-go/internal/testutil/projecttestutil"
+	"gotest.tools/v3/assert"
+)
+
+// transformTestConn stands in for a client that registered emit transformers.
+type transformTestConn struct {
+	mu        sync.Mutex
+	calls     []TransformParams
+	transform func(params *TransformParams, sourceFile *ast.SourceFile) (*ast.SourceFile, error)
+}
+
+var _ ipc.Conn = (*transformTestConn)(nil)
+
+func (c *transformTestConn) Run(context.Context) error { return nil }
+
+func (c *transformTestConn) Notify(context.Context, string, any) error { return nil }
+
+func (c *transformTestConn) Call(_ context.Context, method string, params any) (json.Value, error) {
+	if method != callbackTransform {
+		return nil, errors.New("unexpected callback " + method)
+	}
+	p := params.(*TransformParams)
+	c.mu.Lock()
+	c.calls = append(c.calls, *p)
+	c.mu.Unlock()
+
+	data, err := base64.StdEncoding.DecodeString(p.Data)
+	if err != nil {
+		return nil, err
+	}
+	sourceFile, err := encoder.DecodeSourceFile(data)
+	if err != nil {
+		return nil, err
+	}
+	replacement, err := c.transform(p, sourceFile)
+	if err != nil || replacement == nil {
+		return json.Value("null"), err
+	}
+	encoded, _, err := encoder.EncodeNode(replacement.AsNode(), replacement)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&TransformResponse{Data: base64.StdEncoding.EncodeToString(encoded)})
+}
+
+func setupEmitTransformerTest(t *testing.T, conn ipc.Conn) (*Session, *EmitParams) {
+	t.Helper()
+	return setupEmitTransformerTestWithFiles(t, conn, `{ "compilerOptions": { "declaration": true, "module": "esnext" } }`, "// greeting\nexport const greeting: string = \"hello\";\n")
+}
+
+func setupEmitTransformerTestWithFiles(t *testing.T, conn ipc.Conn, tsconfig string, source string) (*Session, *EmitParams) {
+	t.Helper()
+	const fileName = "/home/projects/p/src/index.ts"
+	files := map[string]any{
+		"/home/projects/p/tsconfig.json": tsconfig,
+		fileName:                         source,
+	}
+	projectSession, _ := projecttestutil.Setup(files)
+	t.Cleanup(projectSession.Close)
+	session := NewSession(projectSession, nil)
+	t.Cleanup(session.Close)
+	session.SetConnection(conn)
+
+	ctx := context.Background()
+	snapshotResp, err := session.handleUpdateSnapshot(ctx, &UpdateSnapshotParams{
+		OpenFiles: []DocumentIdentifier{{FileName: fileName}},
+	})
+	assert.NilError(t, err)
+	proj, err := session.handleGetDefaultProjectForFile(ctx, &GetDefaultProjectForFileParams{
+		Snapshot: snapshotResp.Snapshot,
+		File:     DocumentIdentifier{FileName: fileName},
+	})
+	assert.NilError(t, err)
+	assert.Assert(t, proj != nil)
+	return session, &EmitParams{Snapshot: snapshotResp.Snapshot, Project: proj.Id}
+}
+
+func TestEmitWithClientTransformers(t *testing.T) {
+	t.Parallel()
+	if !bundled.Embedded {
+		t.Skip("bundled files are not embedded")
+	}
+
+	conn := &transformTestConn{
+		transform: func(params *TransformParams, sourceFile *ast.SourceFile) (*ast.SourceFile, error) {
+			if params.Transformer != "inject" {
+				return nil, nil
+			}
+			// Append a statement the client synthesized to the statements it was sent.
+			factory := ast.NewNodeFactory(ast.NodeFactoryHooks{})
+			statements := append(sourceFile.Statements.Nodes, factory.NewExpressionStatement(factory.NewStringLiteral("injected", 0)))
+			return factory.UpdateSourceFile(sourceFile, factory.NewNodeList(statements), sourceFile.EndOfFileToken).AsSourceFile(), nil
+		},
+	}
+	session, params := setupEmitTransformerTest(t, conn)
+	params.Transformers = &EmitTransformers{
+		Before:            []string{"inject"},
+		After:             []string{"noop"},
+		AfterDeclarations: []string{"noop"},
+	}
+
+	result, err := session.handleEmitToString(context.Background(), params)
+	assert.NilError(t, err)
+	assert.Equal(t, len(result.OutputFiles), 2)
+	assert.Equal(t, result.OutputFiles[0].FileName, "/home/projects/p/src/index.d.ts")
+	assert.Equal(t, result.OutputFiles[0].Text, "export declare const greeting: string;\n")
+	assert.Equal(t, result.OutputFiles[1].FileName, "/home/projects/p/src/index.js")
+	assert.Equal(t, result.OutputFiles[1].Text, "// greeting\nexport const greeting = \"hello\";\n\"injected\";\n")
+
+	stages := make(map[string]string)
+	for _, call := range conn.calls {
+		assert.Equal(t, call.FileName, "/home/projects/p/src/index.ts")
+		stages[call.Stage] = call.Transformer
+	}
+	assert.DeepEqual(t, stages, map[string]string{
+		"before":            "inject",
+		"after":             "noop",
+		"afterDeclarations": "noop",
+	})
+}
+
+func TestEmitWithClientAfterTransformer(t *testing.T) {
+	t.Parallel()
+	if !bundled.Embedded {
+		t.Skip("bundled files are not embedded")
+	}
+
+	// By the "after" stage the tree is mostly made of nodes synthesized by the
+	// built-in transforms, which carry comments, source map ranges and
+	// generated names only in the emit context.
+	const tsconfig = `{ "compilerOptions": { "module": "commonjs", "target": "es2015", "sourceMap": true } }`
+	const source = "// greeting\nexport const greeting: string = \"hello\";\n" +
+		"export const { a, b } = { a: 1, b: 2 };\n" +
+		"export async function f() { for (const x of [a]) { await x ?? b; } }\n"
+
+	conn := &transformTestConn{
+		transform: func(params *TransformParams, sourceFile *ast.SourceFile) (*ast.SourceFile, error) {
+			factory := ast.NewNodeFactory(ast.NodeFactoryHooks{})
+			statements := sourceFile.Statements.Nodes
+			if params.Transformer == "rewrite" {
+				var visitor *ast.NodeVisitor
+				visitor = ast.NewNodeVisitor(func(node *ast.Node) *ast.Node {
+					if ast.IsStringLiteral(node) && node.Text() == "hello" {
+						return factory.NewStringLiteral("goodbye", 0)
+					}
+					return visitor.VisitEachChild(node)
+				}, factory, ast.NodeVisitorHooks{})
+				statements = visitor.VisitNodes(sourceFile.Statements).Nodes
+			}
+			statements = append(statements, factory.NewExpressionStatement(factory.NewStringLiteral("appended", 0)))
+			return factory.UpdateSourceFile(sourceFile, factory.NewNodeList(statements), sourceFile.EndOfFileToken).AsSourceFile(), nil
+		},
+	}
+	session, params := setupEmitTransformerTestWithFiles(t, conn, tsconfig, source)
+	expected, err := session.handleEmitToString(context.Background(), params)
+	assert.NilError(t, err)
+	assert.Equal(t, expected.OutputFiles[0].FileName, "/home/projects/p/src/index.js")
+	assert.Assert(t, strings.Contains(expected.OutputFiles[0].Text, "// greeting\n"))
+	assert.Assert(t, strings.Contains(expected.OutputFiles[0].Text, "var _a;"))
+	const sourceMapComment = "//# sourceMappingURL=index.js.map"
+	expectedText := strings.Replace(expected.OutputFiles[0].Text, sourceMapComment, "\"appended\";\n"+sourceMapComment, 1)
+
+	params.Transformers = &EmitTransformers{After: []string{"append"}}
+	result, err := session.handleEmitToString(context.Background(), params)
+	assert.NilError(t, err)
+	assert.Equal(t, len(result.OutputFiles), 2)
+	assert.Equal(t, result.OutputFiles[0].Text, expectedText)
+	assert.Equal(t, result.OutputFiles[1].FileName, "/home/projects/p/src/index.js.map")
+	assert.Equal(t, result.OutputFiles[1].Text, expected.OutputFiles[1].Text)
+
+	// Statements containing a node the client replaced keep their comments.
+	params.Transformers = &EmitTransformers{After: []string{"rewrite"}}
+	result, err = session.handleEmitToString(context.Background(), params)
+	assert.NilError(t, err)
+	assert.Equal(t, result.OutputFiles[0].Text, strings.Replace(expectedText, `"hello"`, `"goodbye"`, 1))
+}
+
+func TestEmitWithFailingClientTransformer(t *testing.T) {
+	t.Parallel()
+	if !bundled.Embedded {
+		t.Skip("bundled files are not embedded")
+	}
+
+	conn := &transformTestConn{
+		transform: func(*TransformParams, *ast.SourceFile) (*ast.SourceFile, error) {
+			return nil, errors.New("boom")
+		},
+	}
+	session, params := setupEmitTransformerTest(t, conn)
+	params.Transformers = &EmitTransformers{Before: []string{"broken"}}
+
+	_, err := session.handleEmitToString(context.Background(), params)
+	assert.ErrorContains(t, err, `transformer "broken" (before) failed on /home/projects/p/src/index.ts: boom`)
+}
+
+func TestEmitWithClientTransformersRequiresConnection(t *testing.T) {
+	t.Parallel()
+	if !bundled.Embedded {
+		t.Skip("bundled files are not embedded")
+	}
+
+	session, params := setupEmitTransformerTest(t, nil)
+	params.Transformers = &EmitTransformers{After: []string{"any"}}
+
+	_, err := session.handleEmit(context.Background(), params)
+	assert.Assert(t, errors.Is(err, ErrClientError))
+}
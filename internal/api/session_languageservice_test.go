@@ -0,0 +1,311 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/microsoft/typescript-go/internal/bundled"
+	"github.com/microsoft/typescript-go/internal/lsp/lsproto"
+	"github.com/microsoft/typescript-go/internal/testutil/projecttestutil"
+	"gotest.tools/v3/assert"
+)
+
+const languageServiceTestFile = "/home/projects/p/src/index.ts"
+
+// languageServiceTestContent is pure ASCII, so UTF-16 offsets equal byte offsets.
+const languageServiceTestContent = `/** Adds two numbers. */
+export function add(first: number, second: number): number {
+    return first + second;
+}
+export class Counter {
+    count = 0;
+    increment() { this.count = add(this.count, 1); }
+}
+const total = add(1, 2);
+new Counter().incremnt();
+`
+
+func setupLanguageServiceTest(t *testing.T, content string) (*Session, SnapshotID, ProjectID) {
+	t.Helper()
+	files := map[string]any{
+		"/home/projects/p/tsconfig.json": `{ "compilerOptions": { "strict": true } }`,
+		languageServiceTestFile:          content,
+	}
+	projectSession, _ := projecttestutil.Setup(files)
+	t.Cleanup(projectSession.Close)
+	session := NewSession(projectSession, nil)
+	t.Cleanup(session.Close)
+
+	ctx := context.Background()
+	snapshotResp, err := session.handleUpdateSnapshot(ctx, &UpdateSnapshotParams{
+		OpenFiles: []DocumentIdentifier{{FileName: languageServiceTestFile}},
+	})
+	assert.NilError(t, err)
+	proj, err := session.handleGetDefaultProjectForFile(ctx, &GetDefaultProjectForFileParams{
+		Snapshot: snapshotResp.Snapshot,
+		File:     DocumentIdentifier{FileName: languageServiceTestFile},
+	})
+	assert.NilError(t, err)
+	assert.Assert(t, proj != nil)
+	return session, snapshotResp.Snapshot, proj.Id
+}
+
+func positionOf(t *testing.T, text string, occurrence int) uint32 {
+	t.Helper()
+	pos := -1
+	for range occurrence + 1 {
+		next := strings.Index(languageServiceTestContent[pos+1:], text)
+		assert.Assert(t, next >= 0, "%q not found", text)
+		pos += next + 1
+	}
+	return uint32(pos)
+}
+
+func TestLanguageServiceMethods(t *testing.T) {
+	t.Parallel()
+	if !bundled.Embedded {
+		t.Skip("bundled files are not embedded")
+	}
+
+	session, snapshot, project := setupLanguageServiceTest(t, languageServiceTestContent)
+	ctx := context.Background()
+	file := DocumentIdentifier{FileName: languageServiceTestFile}
+	at := func(text string, occurrence int) *LanguageServicePositionParams {
+		return &LanguageServicePositionParams{Snapshot: snapshot, Project: project, File: file, Position: positionOf(t, text, occurrence)}
+	}
+
+	t.Run("hover", func(t *testing.T) {
+		t.Parallel()
+		hover, err := session.handleGetHoverAtPosition(ctx, at("add(1, 2)", 0))
+		assert.NilError(t, err)
+		assert.Assert(t, hover != nil)
+		assert.Assert(t, hover.Contents.MarkupContent != nil)
+		assert.Equal(t, hover.Contents.MarkupContent.Kind, lsproto.MarkupKindMarkdown)
+		assert.Assert(t, strings.Contains(hover.Contents.MarkupContent.Value, "function add(first: number, second: number): number"))
+		assert.Assert(t, strings.Contains(hover.Contents.MarkupContent.Value, "Adds two numbers."))
+	})
+
+	t.Run("definition", func(t *testing.T) {
+		t.Parallel()
+		locations, err := session.handleGetDefinitionAtPosition(ctx, at("add(1, 2)", 0))
+		assert.NilError(t, err)
+		assert.Equal(t, len(locations), 1)
+		assert.Equal(t, locations[0].Uri, lsproto.DocumentUri("file:///home/projects/p/src/index.ts"))
+		assert.Equal(t, locations[0].Range.Start, lsproto.Position{Line: 1, Character: 16})
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		t.Parallel()
+		edit, err := session.handleGetRenameLocations(ctx, &GetRenameLocationsParams{
+			Snapshot: snapshot, Project: project, File: file, Position: positionOf(t, "add", 0),
+		})
+		assert.NilError(t, err)
+		assert.Assert(t, edit != nil && edit.Changes != nil)
+		edits := (*edit.Changes)["file:///home/projects/p/src/index.ts"]
+		assert.Equal(t, len(edits), 3)
+		for _, e := range edits {
+			assert.Equal(t, e.NewText, "add")
+		}
+
+		newName := "sum"
+		edit, err = session.handleGetRenameLocations(ctx, &GetRenameLocationsParams{
+			Snapshot: snapshot, Project: project, File: file, Position: positionOf(t, "add", 0), NewName: &newName,
+		})
+		assert.NilError(t, err)
+		for _, e := range (*edit.Changes)["file:///home/projects/p/src/index.ts"] {
+			assert.Equal(t, e.NewText, "sum")
+		}
+	})
+
+	t.Run("code fixes", func(t *testing.T) {
+		t.Parallel()
+		start := positionOf(t, "incremnt", 0)
+		fixes, err := session.handleGetCodeFixesInRange(ctx, &GetCodeFixesInRangeParams{
+			Snapshot: snapshot, Project: project, File: file, Start: start, End: start + uint32(len("incremnt")),
+		})
+		assert.NilError(t, err)
+		assert.Assert(t, len(fixes) > 0, "expected a spelling fix")
+		assert.Equal(t, fixes[0].Title, "Change spelling to 'increment'")
+		assert.Equal(t, *fixes[0].Kind, lsproto.CodeActionKindQuickFix)
+
+		fixes, err = session.handleGetCodeFixesInRange(ctx, &GetCodeFixesInRangeParams{
+			Snapshot: snapshot, Project: project, File: file, Start: 0, End: 1,
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, len(fixes), 0)
+	})
+
+	t.Run("signature help", func(t *testing.T) {
+		t.Parallel()
+		params := at("1, 2)", 0)
+		help, err := session.handleGetSignatureHelpAtPosition(ctx, &GetSignatureHelpAtPositionParams{
+			Snapshot: params.Snapshot, Project: params.Project, File: params.File, Position: params.Position,
+		})
+		assert.NilError(t, err)
+		assert.Assert(t, help != nil)
+		assert.Equal(t, len(help.Signatures), 1)
+		assert.Equal(t, help.Signatures[0].Label, "add(first: number, second: number): number")
+	})
+
+	t.Run("inlay hints", func(t *testing.T) {
+		t.Parallel()
+		hints, err := session.handleGetInlayHints(ctx, &GetInlayHintsParams{Snapshot: snapshot, Project: project, File: file})
+		assert.NilError(t, err)
+		assert.Equal(t, len(hints), 0, "inlay hints are off by default")
+
+		hints, err = session.handleGetInlayHints(ctx, &GetInlayHintsParams{
+			Snapshot:    snapshot,
+			Project:     project,
+			File:        file,
+			Preferences: map[string]any{"includeInlayParameterNameHints": "all"},
+		})
+		assert.NilError(t, err)
+		var labels []string
+		for _, hint := range hints {
+			assert.Assert(t, hint.Label.InlayHintLabelParts != nil)
+			var label strings.Builder
+			for _, part := range *hint.Label.InlayHintLabelParts {
+				label.WriteString(part.Value)
+			}
+			labels = append(labels, label.String())
+		}
+		assert.DeepEqual(t, labels, []string{"first:", "second:", "first:", "second:"})
+	})
+
+	t.Run("document symbols", func(t *testing.T) {
+		t.Parallel()
+		symbols, err := session.handleGetDocumentSymbols(ctx, &LanguageServiceFileParams{Snapshot: snapshot, Project: project, File: file})
+		assert.NilError(t, err)
+		var names []string
+		for _, symbol := range symbols {
+			names = append(names, symbol.Name)
+		}
+		assert.DeepEqual(t, names, []string{"add", "Counter", "total"})
+		counter := symbols[1]
+		assert.Assert(t, counter.Children != nil)
+		assert.Equal(t, len(*counter.Children), 2)
+	})
+
+	t.Run("file outside project", func(t *testing.T) {
+		t.Parallel()
+		hover, err := session.handleGetHoverAtPosition(ctx, &LanguageServicePositionParams{
+			Snapshot: snapshot, Project: project, File: DocumentIdentifier{FileName: "/home/projects/p/src/missing.ts"},
+		})
+		assert.NilError(t, err)
+		assert.Assert(t, hover == nil)
+	})
+}
+
+func TestExplainRelationError(t *testing.T) {
+	t.Parallel()
+	if !bundled.Embedded {
+		t.Skip("bundled files are not embedded")
+	}
+
+	content := `declare const value: { a: { b: [string, number] }; c: boolean };
+const nested: { a: { b: [string, string] }; c: boolean } = value;
+`
+	session, snapshot, project := setupLanguageServiceTest(t, content)
+	ctx := context.Background()
+	file := DocumentIdentifier{FileName: languageServiceTestFile}
+	start := uint32(strings.Index(content, "nested"))
+	end := start + uint32(len("nested"))
+
+	explanation, err := session.handleExplainRelationError(ctx, &ExplainRelationErrorParams{
+		Snapshot: snapshot, Project: project, File: file, Start: start, End: end,
+	})
+	assert.NilError(t, err)
+	assert.Assert(t, explanation != nil)
+	assert.Equal(t, explanation.Path, "")
+	assert.Equal(t, explanation.Source, "{ a: { b: [string, number]; }; c: boolean; }")
+	assert.Equal(t, explanation.Target, "{ a: { b: [string, string]; }; c: boolean; }")
+	assert.Assert(t, len(explanation.Children) == 1)
+	a := explanation.Children[0]
+	assert.Equal(t, a.Member, "a")
+	assert.Equal(t, a.Path, "a")
+	assert.Assert(t, len(a.Children) == 1)
+	b := a.Children[0]
+	assert.Equal(t, b.Path, "a.b")
+	assert.Equal(t, b.Source, "[string, number]")
+	assert.Equal(t, b.Target, "[string, string]")
+	assert.Assert(t, len(b.Children) == 1)
+	element := b.Children[0]
+	assert.Equal(t, element.Member, "[1]")
+	assert.Equal(t, element.Path, "a.b[1]")
+	assert.Equal(t, element.Source, "number")
+	assert.Equal(t, element.Target, "string")
+	assert.DeepEqual(t, element.Messages, []string{"Type 'number' is not assignable to type 'string'."})
+	assert.Equal(t, len(element.Children), 0)
+
+	explanation, err = session.handleExplainRelationError(ctx, &ExplainRelationErrorParams{
+		Snapshot: snapshot, Project: project, File: file, Start: 0, End: 1,
+	})
+	assert.NilError(t, err)
+	assert.Assert(t, explanation == nil)
+}
+
+func TestEvaluateType(t *testing.T) {
+	t.Parallel()
+	if !bundled.Embedded {
+		t.Skip("bundled files are not embedded")
+	}
+
+	content := `interface Options {
+    a: string;
+    b?: number;
+    c: boolean;
+}
+type Nullable<T> = { [K in keyof T]: T[K] | null };
+export function f<T extends string>(value: T) {
+    type Local = [T, number];
+    return value;
+}
+`
+	session, snapshot, project := setupLanguageServiceTest(t, content)
+	ctx := context.Background()
+	file := DocumentIdentifier{FileName: languageServiceTestFile}
+	evaluate := func(text string, expression string, level int32) *lsproto.EvaluatedType {
+		params := &EvaluateTypeParams{Snapshot: snapshot, Project: project, File: file, Position: uint32(strings.Index(content, text)), Expression: expression}
+		if level != 0 {
+			params.VerbosityLevel = &level
+		}
+		result, err := session.handleEvaluateType(ctx, params)
+		assert.NilError(t, err)
+		assert.Assert(t, result != nil)
+		return result
+	}
+	result := evaluate("interface", "Nullable<Pick<Options, 'a' | 'b'>>", 0)
+	assert.Equal(t, result.Type, "{\n    a: string | null;\n    b?: number | null | undefined;\n}")
+	assert.DeepEqual(t, result.Diagnostics, []string{})
+
+	result = evaluate("interface", "Options extends { a: infer A } ? A[] : never", 0)
+	assert.Equal(t, result.Type, "string[]")
+
+	result = evaluate("interface", "{ [K in keyof Options as `get${Capitalize<K>}`]: () => Options[K] }", 0)
+	assert.Equal(t, result.Type, "{\n    getA: () => string;\n    getB?: (() => number | undefined) | undefined;\n    getC: () => boolean;\n}")
+
+	result = evaluate("interface", "Record<'x', Options>", 0)
+	assert.Equal(t, result.Type, "{\n    x: Options;\n}")
+	assert.Assert(t, result.CanIncreaseVerbosity)
+	result = evaluate("interface", "Record<'x', Options>", 1)
+	assert.Equal(t, result.Type, "{\n    x: {\n        a: string;\n        b?: number;\n        c: boolean;\n    };\n}")
+
+	// Names are resolved in the scope of the position.
+	result = evaluate("return value", "Local", 0)
+	assert.Equal(t, result.Type, "[T, number]")
+	result = evaluate("interface", "Local", 0)
+	assert.DeepEqual(t, result.Diagnostics, []string{"Cannot find name 'Local'."})
+
+	result = evaluate("interface", "Pick<Options, 'd'>", 0)
+	assert.DeepEqual(t, result.Diagnostics, []string{"Type '\"d\"' does not satisfy the constraint 'keyof Options'."})
+
+	result = evaluate("interface", "Pick<Options,", 0)
+	assert.Equal(t, result.Type, "")
+	assert.DeepEqual(t, result.Diagnostics, []string{"'>' expected."})
+
+	// Errors in type expressions are not reported for the file.
+	diagnostics, err := session.handleGetSemanticDiagnostics(ctx, &GetDiagnosticsParams{Snapshot: snapshot, Project: project, Files: []DocumentIdentifier{file}})
+	assert.NilError(t, err)
+	assert.Equal(t, len(diagnostics), 0)
+}
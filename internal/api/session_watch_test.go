@@ -0,0 +1,166 @@
+package api
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/microsoft/typescript-go/internal/bundled"
+	"github.com/microsoft/typescript-go/internal/execute/watchmanager"
+	"github.com/microsoft/typescript-go/internal/fswatch"
+	"github.com/microsoft/typescript-go/internal/ipc"
+	"github.com/microsoft/typescript-go/internal/json"
+	"github.com/microsoft/typescript-go/internal/testutil/projecttestutil"
+	"gotest.tools/v3/assert"
+)
+
+// watchTestBackend records watched directories and lets tests fire events.
+type watchTestBackend struct {
+	mu        sync.Mutex
+	callbacks map[string]fswatch.WatchCallback
+}
+
+var _ watchmanager.WatchBackend = (*watchTestBackend)(nil)
+
+type watchTestCloser struct {
+	backend *watchTestBackend
+	dir     string
+}
+
+func (c *watchTestCloser) Close() error {
+	c.backend.mu.Lock()
+	defer c.backend.mu.Unlock()
+	delete(c.backend.callbacks, c.dir)
+	return nil
+}
+
+func (b *watchTestBackend) WatchDirectory(dir string, fn fswatch.WatchCallback, recursive bool, ignore func(string) bool) (io.Closer, error) {
+	closers, err := b.WatchDirectories([]watchmanager.WatchDirectoryRequest{{Dir: dir, Callback: fn, Recursive: recursive, Ignore: ignore}})
+	if err != nil {
+		return nil, err
+	}
+	return closers[0], nil
+}
+
+func (b *watchTestBackend) WatchDirectories(requests []watchmanager.WatchDirectoryRequest) ([]io.Closer, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	closers := make([]io.Closer, len(requests))
+	for i, request := range requests {
+		b.callbacks[request.Dir] = request.Callback
+		closers[i] = &watchTestCloser{backend: b, dir: request.Dir}
+	}
+	return closers, nil
+}
+
+func (b *watchTestBackend) fire(dir string, events ...fswatch.Event) {
+	b.mu.Lock()
+	callback := b.callbacks[dir]
+	b.mu.Unlock()
+	callback(events, nil)
+}
+
+// watchTestConn captures the notifications sent to the client.
+type watchTestConn struct {
+	notifications chan *SnapshotChangedParams
+}
+
+var _ ipc.Conn = (*watchTestConn)(nil)
+
+func (c *watchTestConn) Run(context.Context) error { return nil }
+
+func (c *watchTestConn) Call(context.Context, string, any) (json.Value, error) { return nil, nil }
+
+func (c *watchTestConn) Notify(_ context.Context, method string, params any) error {
+	if method == notificationSnapshotChanged {
+		c.notifications <- params.(*SnapshotChangedParams)
+	}
+	return nil
+}
+
+func (c *watchTestConn) next(t *testing.T) *SnapshotChangedParams {
+	t.Helper()
+	select {
+	case params := <-c.notifications:
+		return params
+	case <-time.After(30 * time.Second):
+		t.Fatal("timed out waiting for snapshotChanged notification")
+		return nil
+	}
+}
+
+func TestWatch(t *testing.T) {
+	t.Parallel()
+	if !bundled.Embedded {
+		t.Skip("bundled files are not embedded")
+	}
+
+	const (
+		indexFile = "/home/src/projects/p/src/index.ts"
+		otherFile = "/home/src/projects/p/src/other.ts"
+	)
+	files := map[string]any{
+		"/home/src/projects/p/tsconfig.json": `{ "compilerOptions": { "strict": true }, "include": ["src"] }`,
+		indexFile:                            `import { value } from "./other"; export const text: string = value;`,
+		otherFile:                            `export const value = 1;`,
+	}
+	projectSession, utils := projecttestutil.Setup(files)
+	t.Cleanup(projectSession.Close)
+	backend := &watchTestBackend{callbacks: make(map[string]fswatch.WatchCallback)}
+	session := NewSession(projectSession, &SessionOptions{WatchBackend: backend})
+	t.Cleanup(session.Close)
+
+	ctx := context.Background()
+	_, err := session.handleWatch(ctx, &WatchParams{})
+	assert.ErrorContains(t, err, "connection")
+
+	conn := &watchTestConn{notifications: make(chan *SnapshotChangedParams, 1)}
+	session.SetConnection(conn)
+	_, err = session.handleWatch(ctx, &WatchParams{})
+	assert.ErrorContains(t, err, "call updateSnapshot first")
+
+	snapshotResp, err := session.handleUpdateSnapshot(ctx, &UpdateSnapshotParams{
+		OpenFiles: []DocumentIdentifier{{FileName: indexFile}},
+	})
+	assert.NilError(t, err)
+	proj, err := session.handleGetDefaultProjectForFile(ctx, &GetDefaultProjectForFileParams{
+		Snapshot: snapshotResp.Snapshot,
+		File:     DocumentIdentifier{FileName: indexFile},
+	})
+	assert.NilError(t, err)
+
+	watchResp, err := session.handleWatch(ctx, &WatchParams{Diagnostics: true})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, watchResp.Directories, []string{"/home/src/projects/p", "/home/src/projects/p/src"})
+
+	t.Run("file change", func(t *testing.T) {
+		assert.NilError(t, utils.FS().WriteFile(otherFile, `export const value: string = 1;`))
+		backend.fire("/home/src/projects/p/src", fswatch.Event{Kind: fswatch.EventUpdate, Path: otherFile})
+
+		params := conn.next(t)
+		assert.Assert(t, params.Snapshot != snapshotResp.Snapshot)
+		assert.DeepEqual(t, params.FileChanges, &APIFileChangeSummary{Changed: []DocumentIdentifier{{FileName: otherFile}}})
+		assert.Assert(t, params.Changes.ChangedProjects[proj.Id] != nil)
+		// index.ts no longer assigns a number to a string.
+		assert.DeepEqual(t, params.DiagnosticsChanged, map[ProjectID][]string{proj.Id: {indexFile, otherFile}})
+	})
+
+	t.Run("file created", func(t *testing.T) {
+		const addedFile = "/home/src/projects/p/src/added.ts"
+		assert.NilError(t, utils.FS().WriteFile(addedFile, `export {};`))
+		backend.fire("/home/src/projects/p/src", fswatch.Event{Kind: fswatch.EventUpdate, Path: addedFile})
+
+		params := conn.next(t)
+		assert.DeepEqual(t, params.FileChanges, &APIFileChangeSummary{Created: []DocumentIdentifier{{FileName: addedFile}}})
+		assert.Assert(t, params.DiagnosticsChanged == nil)
+	})
+
+	unwatched, err := session.handleUnwatch(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, unwatched)
+	unwatched, err = session.handleUnwatch(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, !unwatched)
+}
@@ -0,0 +1,422 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/collections"
+	"github.com/microsoft/typescript-go/internal/compiler"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/execute/watchmanager"
+	"github.com/microsoft/typescript-go/internal/fswatch"
+	"github.com/microsoft/typescript-go/internal/ipc"
+	"github.com/microsoft/typescript-go/internal/json"
+	"github.com/microsoft/typescript-go/internal/project"
+	"github.com/microsoft/typescript-go/internal/tspath"
+	"github.com/zeebo/xxh3"
+)
+
+// notificationSnapshotChanged is the client notification sent when watched
+// files change.
+const notificationSnapshotChanged = "snapshotChanged"
+
+// snapshotWatcher watches the directories of the projects in the session's
+// latest snapshot. When files change, it updates the snapshot with the changes
+// and notifies the client of the new snapshot.
+//
+// Cycles run on the watch manager's loop under its lock, which also guards the
+// diagnostics state.
+type snapshotWatcher struct {
+	session     *Session
+	wm          *watchmanager.WatchManager
+	ctx         context.Context
+	cancel      context.CancelFunc
+	done        chan struct{}
+	diagnostics bool
+
+	// programs and fileDiagnostics record the programs whose diagnostics were
+	// last computed and the diagnostics fingerprint of each of their files.
+	programs        map[ProjectID]*compiler.Program
+	fileDiagnostics map[ProjectID]map[tspath.Path]fileDiagnosticsFingerprint
+}
+
+type fileDiagnosticsFingerprint struct {
+	fileName string
+	hash     uint64
+}
+
+// handleWatch starts watching the projects of the latest snapshot, replacing
+// any watch already in progress.
+func (s *Session) handleWatch(ctx context.Context, params *WatchParams) (*WatchResponse, error) {
+	s.watchMu.Lock()
+	defer s.watchMu.Unlock()
+
+	if s.conn == nil {
+		return nil, fmt.Errorf("%w: watch requires a connection that supports notifications", ErrClientError)
+	}
+	if _, ok := s.conn.(*ipc.SyncConn); ok {
+		return nil, fmt.Errorf("%w: watch requires an asynchronous connection", ErrClientError)
+	}
+
+	s.snapshotsMu.RLock()
+	latest := s.latestSnapshot
+	s.snapshotsMu.RUnlock()
+	sd, err := s.retainSnapshotData(latest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: watch requires a snapshot; call updateSnapshot first", ErrClientError)
+	}
+
+	s.stopWatchingLocked()
+
+	wm := watchmanager.NewWatchManager(io.Discard, s.projectSession.FS().DirectoryExists)
+	if s.watchBackend != nil {
+		wm.SetBackend(s.watchBackend)
+	} else {
+		wm.EnsureDefaultBackend()
+	}
+	watchCtx, cancel := context.WithCancel(context.Background())
+	w := &snapshotWatcher{
+		session:     s,
+		wm:          wm,
+		ctx:         watchCtx,
+		cancel:      cancel,
+		done:        make(chan struct{}),
+		diagnostics: params.Diagnostics,
+	}
+
+	wm.Lock()
+	desiredDirs := w.desiredDirectories(sd.snapshot)
+	err = wm.ReconcileWatches(desiredDirs)
+	wm.Unlock()
+	if err != nil {
+		cancel()
+		wm.CloseAllWatches()
+		_ = s.releaseSnapshot(latest)
+		return nil, fmt.Errorf("failed to watch directories: %w", err)
+	}
+	s.watcher = w
+
+	go func() {
+		defer close(w.done)
+		if w.diagnostics {
+			// Record the diagnostics of the current snapshot so the first
+			// notification only reports what changed since watching started.
+			wm.Lock()
+			w.updateDiagnostics(sd.snapshot)
+			wm.Unlock()
+		}
+		_ = s.releaseSnapshot(latest)
+		wm.RunLoop(watchCtx, w.doCycle)
+	}()
+
+	return &WatchResponse{Directories: slices.Sorted(maps.Keys(desiredDirs))}, nil
+}
+
+// handleUnwatch stops watching. It returns false if the session was not watching.
+func (s *Session) handleUnwatch(ctx context.Context) (bool, error) {
+	s.watchMu.Lock()
+	defer s.watchMu.Unlock()
+	return s.stopWatchingLocked(), nil
+}
+
+func (s *Session) stopWatching() {
+	s.watchMu.Lock()
+	defer s.watchMu.Unlock()
+	s.stopWatchingLocked()
+}
+
+func (s *Session) stopWatchingLocked() bool {
+	w := s.watcher
+	if w == nil {
+		return false
+	}
+	s.watcher = nil
+	w.cancel()
+	<-w.done
+	return true
+}
+
+// desiredDirectories returns the directories to watch for the projects in
+// snapshot: the wildcard directories and directories of config files, plus the
+// directories of program files they don't already cover.
+func (w *snapshotWatcher) desiredDirectories(snapshot *project.Snapshot) map[string]bool {
+	desiredDirs := make(map[string]bool)
+	var fileNames []string
+	for _, proj := range snapshot.ProjectCollection.Projects() {
+		if proj.Kind == project.KindConfigured && proj.CommandLine != nil {
+			for dir, recursive := range proj.CommandLine.WildcardDirectories() {
+				desiredDirs[dir] = desiredDirs[dir] || recursive
+			}
+			configDir := tspath.GetDirectoryPath(proj.ConfigFileName())
+			if _, ok := desiredDirs[configDir]; !ok {
+				desiredDirs[configDir] = false
+			}
+		}
+		program := proj.GetProgram()
+		if program == nil {
+			continue
+		}
+		for _, file := range program.GetSourceFiles() {
+			if program.IsSourceFileDefaultLibrary(file.Path()) {
+				continue
+			}
+			fileNames = append(fileNames, file.FileName())
+		}
+	}
+
+	resolvedDirs := w.wm.ResolveDesiredDirs(desiredDirs)
+	fs := w.session.projectSession.FS()
+	coverage := watchmanager.NewDirWatchSet(tspath.ComparePathsOptions{
+		UseCaseSensitiveFileNames: fs.UseCaseSensitiveFileNames(),
+		CurrentDirectory:          w.session.projectSession.GetCurrentDirectory(),
+	})
+	for dir, recursive := range resolvedDirs {
+		coverage.Set(dir, recursive)
+	}
+	for _, fileName := range fileNames {
+		dir := tspath.GetDirectoryPath(fileName)
+		if !coverage.Covered(dir) && watchmanager.CanWatchDirectory(dir) {
+			coverage.Set(dir, false)
+		}
+	}
+	return w.wm.ResolveDesiredDirs(coverage.Dirs())
+}
+
+// doCycle applies the file changes observed since the last cycle to a new
+// snapshot and notifies the client if the snapshot changed.
+func (w *snapshotWatcher) doCycle() {
+	w.wm.Lock()
+	defer w.wm.Unlock()
+
+	changedPaths, overflow := w.wm.DrainEvents()
+	if len(changedPaths) == 0 && !overflow {
+		return
+	}
+
+	s := w.session
+	s.snapshotsMu.RLock()
+	previous := s.latestSnapshot
+	var previousSnapshot *project.Snapshot
+	if sd := s.snapshots[previous]; sd != nil {
+		previousSnapshot = sd.snapshot
+	}
+	s.snapshotsMu.RUnlock()
+
+	fileChanges := w.toFileChanges(previousSnapshot, changedPaths, overflow)
+	response, err := s.handleUpdateSnapshot(w.ctx, &UpdateSnapshotParams{FileChanges: fileChanges})
+	if err != nil {
+		// Retry with a full invalidation on the next change.
+		w.wm.ForceOverflow()
+		return
+	}
+	if response.Snapshot == previous {
+		// Nothing the projects depend on changed.
+		_ = s.releaseSnapshot(response.Snapshot)
+		return
+	}
+	sd, err := s.getSnapshotData(response.Snapshot)
+	if err != nil {
+		return
+	}
+
+	params := &SnapshotChangedParams{
+		Snapshot: response.Snapshot,
+		Projects: response.Projects,
+		Changes:  response.Changes,
+	}
+	if !fileChanges.InvalidateAll {
+		params.FileChanges = &APIFileChangeSummary{
+			Changed: fileChanges.Changed,
+			Created: fileChanges.Created,
+			Deleted: fileChanges.Deleted,
+		}
+	}
+	if w.diagnostics {
+		params.DiagnosticsChanged = w.updateDiagnostics(sd.snapshot)
+	}
+	// A failure to watch new directories is retried on the next cycle.
+	_ = w.wm.ReconcileWatches(w.desiredDirectories(sd.snapshot))
+
+	if err := s.conn.Notify(w.ctx, notificationSnapshotChanged, params); err != nil {
+		_ = s.releaseSnapshot(response.Snapshot)
+	}
+}
+
+// toFileChanges converts watch events to snapshot file changes. Updates of files
+// that are not part of any program in snapshot are reported as creations, since
+// the watcher does not distinguish the two.
+func (w *snapshotWatcher) toFileChanges(snapshot *project.Snapshot, changedPaths map[string]fswatch.EventKind, overflow bool) *APIFileChanges {
+	if overflow {
+		return &APIFileChanges{InvalidateAll: true}
+	}
+	var programs []*compiler.Program
+	if snapshot != nil {
+		for _, proj := range snapshot.ProjectCollection.Projects() {
+			if program := proj.GetProgram(); program != nil {
+				programs = append(programs, program)
+			}
+		}
+	}
+	changes := &APIFileChanges{}
+	for _, fileName := range slices.Sorted(maps.Keys(changedPaths)) {
+		doc := DocumentIdentifier{FileName: fileName}
+		if changedPaths[fileName] == fswatch.EventDelete {
+			changes.Deleted = append(changes.Deleted, doc)
+			continue
+		}
+		path := w.session.toPath(fileName)
+		if slices.ContainsFunc(programs, func(program *compiler.Program) bool { return program.GetSourceFileByPath(path) != nil }) {
+			changes.Changed = append(changes.Changed, doc)
+		} else {
+			changes.Created = append(changes.Created, doc)
+		}
+	}
+	return changes
+}
+
+// updateDiagnostics recomputes the diagnostics of the files affected by the
+// changes to each project's program since the last call and returns the files
+// whose diagnostics differ.
+func (w *snapshotWatcher) updateDiagnostics(snapshot *project.Snapshot) map[ProjectID][]string {
+	ctx := core.WithCheckerLifetime(w.ctx, core.CheckerLifetimeDiagnostics)
+	programs := make(map[ProjectID]*compiler.Program)
+	fileDiagnostics := make(map[ProjectID]map[tspath.Path]fileDiagnosticsFingerprint)
+	var changed map[ProjectID][]string
+	for _, proj := range snapshot.ProjectCollection.Projects() {
+		program := proj.GetProgram()
+		if program == nil {
+			continue
+		}
+		id := ProjectHandle(proj)
+		programs[id] = program
+		previous := w.fileDiagnostics[id]
+		if w.programs[id] == program {
+			fileDiagnostics[id] = previous
+			continue
+		}
+
+		affected, all := affectedFiles(w.programs[id], program)
+		current := make(map[tspath.Path]fileDiagnosticsFingerprint)
+		var changedFiles []string
+		for _, file := range program.GetSourceFiles() {
+			if program.IsSourceFileDefaultLibrary(file.Path()) {
+				continue
+			}
+			if fingerprint, ok := previous[file.Path()]; ok && !all && !affected.Has(file.Path()) {
+				current[file.Path()] = fingerprint
+				continue
+			}
+			diags := slices.Concat(program.GetSyntacticDiagnostics(ctx, file), program.GetSemanticDiagnostics(ctx, file))
+			fingerprint := fileDiagnosticsFingerprint{fileName: file.FileName(), hash: diagnosticsHash(diags)}
+			current[file.Path()] = fingerprint
+			if previous[file.Path()].hash != fingerprint.hash {
+				changedFiles = append(changedFiles, fingerprint.fileName)
+			}
+		}
+		// Files that left the program no longer have diagnostics.
+		for path, fingerprint := range previous {
+			if _, ok := current[path]; !ok && fingerprint.hash != 0 {
+				changedFiles = append(changedFiles, fingerprint.fileName)
+			}
+		}
+		fileDiagnostics[id] = current
+		if len(changedFiles) > 0 {
+			slices.Sort(changedFiles)
+			if changed == nil {
+				changed = make(map[ProjectID][]string)
+			}
+			changed[id] = changedFiles
+		}
+	}
+	w.programs = programs
+	w.fileDiagnostics = fileDiagnostics
+	return changed
+}
+
+// affectedFiles returns the files of program whose diagnostics may differ from
+// those in previous: the files that changed or now reference other files, and
+// the files that reference them, directly or transitively. It reports all if
+// any file may be affected, such as when the options or a global script changed.
+func affectedFiles(previous *compiler.Program, program *compiler.Program) (affected collections.Set[tspath.Path], all bool) {
+	if previous == nil || previous.Options() != program.Options() {
+		return affected, true
+	}
+	referencedBy := make(map[tspath.Path][]tspath.Path)
+	var queue []tspath.Path
+	for _, file := range program.GetSourceFiles() {
+		references := referencedFiles(program, file)
+		for _, reference := range references {
+			referencedBy[reference] = append(referencedBy[reference], file.Path())
+		}
+		previousFile := previous.GetSourceFileByPath(file.Path())
+		if previousFile == file && slices.Equal(references, referencedFiles(previous, previousFile)) {
+			continue
+		}
+		if affectsGlobalScope(file) || previousFile != nil && affectsGlobalScope(previousFile) {
+			return affected, true
+		}
+		queue = append(queue, file.Path())
+	}
+	// Files that reference a removed file now resolve differently, so they are
+	// already queued.
+	for _, file := range previous.GetSourceFiles() {
+		if program.GetSourceFileByPath(file.Path()) == nil && affectsGlobalScope(file) {
+			return affected, true
+		}
+	}
+	for len(queue) > 0 {
+		path := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+		if affected.AddIfAbsent(path) {
+			queue = append(queue, referencedBy[path]...)
+		}
+	}
+	return affected, false
+}
+
+// referencedFiles returns the sorted paths of the files that file imports or
+// references in program.
+func referencedFiles(program *compiler.Program, file *ast.SourceFile) []tspath.Path {
+	var references []tspath.Path
+	toPath := func(fileName string) tspath.Path {
+		return tspath.ToPath(fileName, program.GetCurrentDirectory(), program.UseCaseSensitiveFileNames())
+	}
+	for _, resolved := range program.GetResolvedModules()[file.Path()] {
+		if resolved.IsResolved() {
+			references = append(references, toPath(resolved.ResolvedFileName))
+		}
+	}
+	for _, resolved := range program.GetResolvedTypeReferenceDirectives()[file.Path()] {
+		if resolved.IsResolved() {
+			references = append(references, toPath(resolved.ResolvedFileName))
+		}
+	}
+	for _, reference := range file.ReferencedFiles {
+		references = append(references, toPath(tspath.ResolvePath(tspath.GetDirectoryPath(file.FileName()), reference.FileName)))
+	}
+	slices.Sort(references)
+	return slices.Compact(references)
+}
+
+// affectsGlobalScope reports whether file declares globals or augments modules,
+// so that files may depend on it without referencing it.
+func affectsGlobalScope(file *ast.SourceFile) bool {
+	return !ast.IsExternalOrCommonJSModule(file) && !ast.IsJsonSourceFile(file) || len(file.ModuleAugmentations) > 0
+}
+
+// diagnosticsHash returns a hash of the diagnostics as reported to the client,
+// or zero if there are none.
+func diagnosticsHash(diags []*ast.Diagnostic) uint64 {
+	if len(diags) == 0 {
+		return 0
+	}
+	data, err := json.Marshal(NewDiagnosticResponses(diags))
+	if err != nil {
+		return 0
+	}
+	return xxh3.Hash(data) | 1
+}
@@ -819,6 +819,11 @@ type Checker struct {
 	reverseMappedTargetStack                    []*Type
 	reverseExpandingFlags                       ExpandingFlags
 	freeRelater                                 *Relater
+	recordRelationErrors                        bool
+	relationErrors                              map[*ast.SourceFile][]*relationError
+	explainingRelation                          bool
+	typeExpressionFile                          *ast.SourceFile
+	typeExpressionDiagnostics                   []*ast.Diagnostic
 	subtypeRelation                             *Relation
 	strictSubtypeRelation                       *Relation
 	assignableRelation                          *Relation
@@ -2205,6 +2210,7 @@ func (c *Checker) checkSourceFile(ctx context.Context, sourceFile *ast.SourceFil
 	if !links.typeChecked {
 		if tr := c.tracer; tr != nil {
 			defer tr.Push(tracing.PhaseCheck, "checkSourceFile", map[string]any{"path": sourceFile.FileName()}, true)()
+			defer tr.reportFile(c, sourceFile)()
 		}
 		// Grammar checking
 		c.checkGrammarSourceFile(sourceFile)
@@ -2246,6 +2252,9 @@ func (c *Checker) checkSourceElements(nodes []*ast.Node) {
 
 func (c *Checker) checkSourceElement(node *ast.Node) bool {
 	if node != nil {
+		if tr := c.tracer; tr != nil && isReportedDeclaration(node) {
+			defer tr.reportDeclaration(c, node)()
+		}
 		saveCurrentNode := c.currentNode
 		saveWithinUnreachableCode := c.withinUnreachableCode
 		c.currentNode = node
@@ -2509,6 +2518,9 @@ func (c *Checker) checkDeferredNodes(context *ast.SourceFile) {
 func (c *Checker) checkDeferredNode(node *ast.Node) {
 	if tr := c.tracer; tr != nil {
 		defer tr.Push(tracing.PhaseCheck, "checkDeferredNode", map[string]any{"kind": node.Kind, "pos": node.Pos(), "end": node.End(), "path": ast.GetSourceFileOfNode(node).FileName()}, false)()
+		if isReportedDeclaration(node) {
+			defer tr.reportDeclaration(c, node)()
+		}
 	}
 	saveCurrentNode := c.currentNode
 	c.currentNode = node
@@ -14084,6 +14096,11 @@ func (c *Checker) produceDeferredDiagnostics() {
 }
 
 func (c *Checker) addDiagnostic(diagnostic *ast.Diagnostic) *ast.Diagnostic {
+	// Diagnostics for a type expression being resolved by GetTypeOfTypeExpression are reported to its caller.
+	if c.typeExpressionFile != nil && diagnostic.File() == c.typeExpressionFile {
+		c.typeExpressionDiagnostics = append(c.typeExpressionDiagnostics, diagnostic)
+		return diagnostic
+	}
 	// Discard diagnostics created while at the maximum number of recursive TypeToString invocations.
 	if c.serializationLevel < maxSerializationLevel {
 		return c.diagnostics.Add(diagnostic)
@@ -14092,6 +14109,9 @@ func (c *Checker) addDiagnostic(diagnostic *ast.Diagnostic) *ast.Diagnostic {
 }
 
 func (c *Checker) addSuggestionDiagnostic(diagnostic *ast.Diagnostic) *ast.Diagnostic {
+	if c.typeExpressionFile != nil && diagnostic.File() == c.typeExpressionFile {
+		return diagnostic
+	}
 	// Discard diagnostics created while at the maximum number of recursive TypeToString invocations.
 	if c.serializationLevel < maxSerializationLevel {
 		return c.suggestionDiagnostics.Add(diagnostic)
@@ -61,6 +61,43 @@ foo.bar;`
 	}
 }
 
+func TestExplainRelationError(t *testing.T) {
+	t.Parallel()
+
+	fs := vfstest.FromMap(map[string]string{
+		"/foo.ts": `const x: { a: string } = { a: 1 };`,
+		"/tsconfig.json": `
+				{
+					"compilerOptions": {},
+					"files": ["foo.ts"]
+				}
+			`,
+	}, false /*useCaseSensitiveFileNames*/)
+	fs = bundled.WrapFS(fs)
+
+	host := compiler.NewCompilerHost("/", fs, bundled.LibPath(), nil, nil, nil)
+	parsed, errors := tsoptions.GetParsedCommandLineOfConfigFile("/tsconfig.json", &core.CompilerOptions{}, nil, host, nil)
+	assert.Equal(t, len(errors), 0, "Expected no errors in parsed command line")
+
+	p := compiler.NewProgram(compiler.ProgramOptions{
+		Config: parsed,
+		Host:   host,
+	})
+	file := p.GetSourceFile("/foo.ts")
+	diagnostics := p.GetSemanticDiagnostics(t.Context(), file)
+	assert.Equal(t, len(diagnostics), 1)
+
+	// The checkers of a program do not keep relation errors around.
+	c, done := p.GetTypeCheckerForFile(t.Context(), file)
+	assert.Assert(t, c.ExplainRelationError(t.Context(), diagnostics[0]) == nil)
+	done()
+
+	explanation := checker.NewRelationErrorChecker(p).ExplainRelationError(t.Context(), diagnostics[0])
+	assert.Assert(t, explanation != nil)
+	assert.Equal(t, explanation.Source, "number")
+	assert.Equal(t, explanation.Target, "string")
+}
+
 func BenchmarkNewChecker(b *testing.B) {
 	repo.SkipIfNoTypeScriptSubmodule(b)
 	fs := osvfs.FS()
@@ -68,7 +68,7 @@ func (c *Checker) typeToStringEx(t *Type, enclosingDeclaration *ast.Node, flags
 		newLine = "\n"
 	}
 	writer := printer.NewTextWriter(newLine, 0)
-	noTruncation := ((vc == nil || vc.MaxTruncationLength == 0) && c.compilerOptions.NoErrorTruncation == core.TSTrue) || (flags&TypeFormatFlagsNoTruncation != 0)
+	noTruncation := ((vc == nil || vc.MaxTruncationLength == 0) && (c.compilerOptions.NoErrorTruncation == core.TSTrue || c.explainingRelation)) || (flags&TypeFormatFlagsNoTruncation != 0)
 	combinedFlags := toNodeBuilderFlags(flags) | nodebuilder.FlagsIgnoreErrors
 	if noTruncation {
 		combinedFlags = combinedFlags | nodebuilder.FlagsNoTruncation
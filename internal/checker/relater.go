@@ -391,7 +391,9 @@ func (c *Checker) checkTypeRelatedToEx(
 				}
 			}
 		}
-		c.reportDiagnostic(createDiagnosticChainFromErrorChain(r.errorChain, r.errorNode, r.relatedInfo), diagnosticOutput)
+		diagnostic := createDiagnosticChainFromErrorChain(r.errorChain, r.errorNode, r.relatedInfo)
+		c.recordRelationError(diagnostic, source, target, relation, errorNode, headMessage)
+		c.reportDiagnostic(diagnostic, diagnosticOutput)
 	}
 	c.putRelater(r)
 	return result != TernaryFalse
@@ -3163,6 +3165,7 @@ func (r *Relater) recursiveTypeRelatedTo(source *Type, target *Type, reportError
 	} else {
 		if tr := r.c.tracer; tr != nil {
 			defer tr.Push(tracing.PhaseCheckTypes, "structuredTypeRelatedTo", map[string]any{"sourceId": source.id, "targetId": target.id}, false)()
+			defer tr.reportRelation(r.c, source, target)()
 		}
 		result = r.structuredTypeRelatedTo(source, target, reportErrors, intersectionState)
 	}
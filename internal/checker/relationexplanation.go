@@ -0,0 +1,297 @@
+package checker
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/diagnostics"
+)
+
+// RelationExplanation is a step in the explanation of a relation error: a comparison of a source type with a
+// target type that failed, along with the failed comparisons that caused it to fail.
+type RelationExplanation struct {
+	// Member is the member of the parent step's types that is compared at this step: a property name, a tuple
+	// element such as "[0]", an index signature such as "[string]", or a call "()" or construct "new ()"
+	// signature. It is empty at the root and when comparing constituents of the parent step's types.
+	Member string
+	// Path is the path of members from the root to this step, such as "a.b[0]".
+	Path   string
+	Source string
+	Target string
+	// Messages are the elaborations reported at this step, such as the message of the relation error itself.
+	Messages []*ast.Diagnostic
+	Children []*RelationExplanation
+}
+
+// relationError records a relation error reported by checkTypeRelatedToEx, so that it can be explained later.
+type relationError struct {
+	loc         core.TextRange
+	code        int32
+	source      *Type
+	target      *Type
+	relation    *Relation
+	errorNode   *ast.Node
+	headMessage *diagnostics.Message
+}
+
+func (c *Checker) recordRelationError(diagnostic *ast.Diagnostic, source *Type, target *Type, relation *Relation, errorNode *ast.Node, headMessage *diagnostics.Message) {
+	file := diagnostic.File()
+	if file == nil || !c.recordRelationErrors || c.explainingRelation {
+		return
+	}
+	if c.relationErrors == nil {
+		c.relationErrors = make(map[*ast.SourceFile][]*relationError)
+	}
+	c.relationErrors[file] = append(c.relationErrors[file], &relationError{
+		loc:         diagnostic.Loc(),
+		code:        diagnostic.Code(),
+		source:      source,
+		target:      target,
+		relation:    relation,
+		errorNode:   errorNode,
+		headMessage: headMessage,
+	})
+}
+
+// NewRelationErrorChecker returns a new checker that records the relation errors it reports, so that
+// ExplainRelationError can explain them. Other checkers do not record them, since every compilation
+// would keep them in memory while only explanation requests need them.
+func NewRelationErrorChecker(program Program) *Checker {
+	c, _ := NewChecker(program, nil /*tracer*/)
+	c.recordRelationErrors = true
+	return c
+}
+
+// ExplainRelationError explains a diagnostic reported for a failed type relation, such as an assignability
+// error. The constituents and members of the related types are compared again, recording every failed
+// comparison with type strings that are never truncated, instead of the single elaboration chain of the
+// diagnostic. It returns nil if the diagnostic was
+// not reported for a failed type relation, or if the checker was not created by NewRelationErrorChecker.
+func (c *Checker) ExplainRelationError(ctx context.Context, diagnostic *ast.Diagnostic) *RelationExplanation {
+	file := diagnostic.File()
+	if file == nil {
+		return nil
+	}
+	// Relation errors are recorded as the file is checked.
+	c.GetDiagnostics(ctx, file)
+	for _, e := range c.relationErrors[file] {
+		if e.loc == diagnostic.Loc() && e.code == diagnostic.Code() {
+			return c.explainRelationError(e)
+		}
+	}
+	return nil
+}
+
+func (c *Checker) explainRelationError(e *relationError) *RelationExplanation {
+	c.explainingRelation = true
+	defer func() { c.explainingRelation = false }()
+	x := &relationExplainer{c: c, relation: e.relation, errorNode: e.errorNode}
+	_, messages := x.relate(func(r *Relater) Ternary {
+		return r.isRelatedToEx(e.source, e.target, RecursionFlagsBoth, true /*reportErrors*/, e.headMessage, IntersectionStateNone)
+	})
+	root := x.explain(e.source, e.target, "", messages)
+	simplifyRelationExplanation(root)
+	setRelationExplanationPaths(root, "")
+	return root
+}
+
+// maxRelationExplanationDepth limits how deep an explanation follows the members of recursive types.
+const maxRelationExplanationDepth = 32
+
+// relationExplainer builds a RelationExplanation by relating the constituents and members of the types of a
+// failed relation one pair at a time. Each pair is related by its own Relater, so checking relations does not
+// pay for explanations.
+type relationExplainer struct {
+	c         *Checker
+	relation  *Relation
+	errorNode *ast.Node
+	// path holds the source and target types of the steps from the root to the current step.
+	path [][2]*Type
+}
+
+// relate calls f with a Relater that reports errors, and returns its result along with the messages of the
+// errors it reported, outermost first.
+func (x *relationExplainer) relate(f func(r *Relater) Ternary) (Ternary, []*ast.Diagnostic) {
+	r := x.c.getRelater()
+	r.relation = x.relation
+	r.errorNode = x.errorNode
+	r.relationCount = (16_000_000 - x.relation.size()) / 8
+	result := f(r)
+	var messages []*ast.Diagnostic
+	if result == TernaryFalse {
+		for chain := r.errorChain; chain != nil; chain = chain.next {
+			messages = append(messages, ast.NewCompilerDiagnostic(chain.message, chain.args...))
+		}
+	}
+	x.c.putRelater(r)
+	return result, messages
+}
+
+func (x *relationExplainer) isRelatedTo(source *Type, target *Type) Ternary {
+	result, _ := x.relate(func(r *Relater) Ternary {
+		return r.isRelatedTo(source, target, RecursionFlagsBoth, false /*reportErrors*/)
+	})
+	return result
+}
+
+// child explains the comparison of source with target if it fails, and returns nil otherwise.
+func (x *relationExplainer) child(source *Type, target *Type, member string) *RelationExplanation {
+	result, messages := x.relate(func(r *Relater) Ternary {
+		return r.isRelatedTo(source, target, RecursionFlagsBoth, true /*reportErrors*/)
+	})
+	if result != TernaryFalse {
+		return nil
+	}
+	return x.explain(source, target, member, messages)
+}
+
+// explain returns the step for a failed comparison of source with target. The messages of the errors reported
+// for the comparison are kept only if no failed comparison of constituents or members explains them.
+func (x *relationExplainer) explain(source *Type, target *Type, member string, messages []*ast.Diagnostic) *RelationExplanation {
+	step := &RelationExplanation{Member: member, Source: x.typeToString(source), Target: x.typeToString(target)}
+	pair := [2]*Type{source, target}
+	if len(x.path) < maxRelationExplanationDepth && !slices.Contains(x.path, pair) {
+		x.path = append(x.path, pair)
+		step.Children = x.explainConstituents(source, target)
+		x.path = x.path[:len(x.path)-1]
+	}
+	if len(step.Children) == 0 {
+		step.Messages = messages
+	}
+	return step
+}
+
+func (x *relationExplainer) explainConstituents(source *Type, target *Type) []*RelationExplanation {
+	c := x.c
+	source = c.getNormalizedType(source, false /*writing*/)
+	target = c.getNormalizedType(target, true /*writing*/)
+	var children []*RelationExplanation
+	switch {
+	case source.flags&TypeFlagsUnion != 0:
+		for _, t := range source.Types() {
+			if step := x.child(t, target, ""); step != nil {
+				children = append(children, step)
+			}
+		}
+		// A union whose every constituent fails is better explained by the comparison of the union itself.
+		if len(children) == len(source.Types()) {
+			return nil
+		}
+	case target.flags&TypeFlagsUnion != 0:
+		if t := c.getBestMatchingType(source, target, x.isRelatedTo); t != nil {
+			if step := x.child(source, t, ""); step != nil {
+				children = append(children, step)
+			}
+		}
+	case target.flags&TypeFlagsIntersection != 0:
+		for _, t := range target.Types() {
+			if step := x.child(source, t, ""); step != nil {
+				children = append(children, step)
+			}
+		}
+	case target.flags&TypeFlagsObject != 0:
+		children = x.explainMembers(c.getReducedApparentType(source), target)
+	}
+	return children
+}
+
+func (x *relationExplainer) explainMembers(source *Type, target *Type) []*RelationExplanation {
+	c := x.c
+	if source.flags&(TypeFlagsObject|TypeFlagsIntersection) == 0 {
+		return nil
+	}
+	var children []*RelationExplanation
+	add := func(step *RelationExplanation) {
+		if step != nil {
+			children = append(children, step)
+		}
+	}
+	if isTupleType(source) && isTupleType(target) {
+		sourceTypes := c.getElementTypes(source)
+		targetTypes := c.getElementTypes(target)
+		for i := range min(len(sourceTypes), len(targetTypes)) {
+			add(x.child(sourceTypes[i], targetTypes[i], "["+strconv.Itoa(i)+"]"))
+		}
+		return children
+	}
+	// Missing properties are reported in the messages of the step itself.
+	for _, targetProp := range c.getPropertiesOfType(target) {
+		if sourceProp := c.getPropertyOfType(source, targetProp.Name); sourceProp != nil {
+			add(x.child(c.getNonMissingTypeOfSymbol(sourceProp), c.getNonMissingTypeOfSymbol(targetProp), c.symbolToString(targetProp)))
+		}
+	}
+	for _, kind := range []SignatureKind{SignatureKindCall, SignatureKindConstruct} {
+		sourceSignatures := c.getSignaturesOfType(source, kind)
+		targetSignatures := c.getSignaturesOfType(target, kind)
+		if len(sourceSignatures) == 1 && len(targetSignatures) == 1 {
+			add(x.explainSignatures(sourceSignatures[0], targetSignatures[0], core.IfElse(kind == SignatureKindConstruct, "new ()", "()")))
+		}
+	}
+	for _, targetInfo := range c.getIndexInfosOfType(target) {
+		if sourceInfo := c.getApplicableIndexInfo(source, targetInfo.keyType); sourceInfo != nil {
+			add(x.child(sourceInfo.valueType, targetInfo.valueType, "["+x.typeToString(targetInfo.keyType)+"]"))
+		}
+	}
+	return children
+}
+
+func (x *relationExplainer) explainSignatures(source *Signature, target *Signature, member string) *RelationExplanation {
+	result, messages := x.relate(func(r *Relater) Ternary {
+		return r.signatureRelatedTo(source, target, x.relation == x.c.comparableRelation /*erase*/, true /*reportErrors*/, IntersectionStateNone)
+	})
+	if result != TernaryFalse {
+		return nil
+	}
+	step := &RelationExplanation{Member: member, Source: x.signatureToString(source), Target: x.signatureToString(target)}
+	if returnType := x.child(x.c.getReturnTypeOfSignature(source), x.c.getReturnTypeOfSignature(target), ""); returnType != nil {
+		step.Children = []*RelationExplanation{returnType}
+	} else {
+		step.Messages = messages
+	}
+	return step
+}
+
+func (x *relationExplainer) typeToString(t *Type) string {
+	return x.c.typeToStringEx(t, nil /*enclosingDeclaration*/, TypeFormatFlagsAllowUniqueESSymbolType|TypeFormatFlagsUseAliasDefinedOutsideCurrentScope|TypeFormatFlagsNoTruncation, nil)
+}
+
+func (x *relationExplainer) signatureToString(s *Signature) string {
+	return x.c.signatureToStringEx(s, nil /*enclosingDeclaration*/, TypeFormatFlagsNoTruncation, nil)
+}
+
+// simplifyRelationExplanation merges steps that only restate the comparison of their parent, such as the
+// comparisons of normalized or apparent types, into their parent.
+func simplifyRelationExplanation(step *RelationExplanation) {
+	var children []*RelationExplanation
+	for _, child := range step.Children {
+		simplifyRelationExplanation(child)
+		if child.Member == "" && len(child.Messages) == 0 && child.Source == step.Source && child.Target == step.Target {
+			children = append(children, child.Children...)
+		} else {
+			children = append(children, child)
+		}
+	}
+	step.Children = children
+}
+
+func setRelationExplanationPaths(step *RelationExplanation, path string) {
+	switch {
+	case step.Member == "":
+	case strings.HasPrefix(step.Member, "[") || strings.HasPrefix(step.Member, "("):
+		path += step.Member
+	case strings.HasPrefix(step.Member, "new "):
+		path = "new " + path + step.Member[len("new "):]
+	case path == "":
+		path = step.Member
+	default:
+		path += "." + step.Member
+	}
+	step.Path = path
+	for _, child := range step.Children {
+		setRelationExplanationPaths(child, path)
+	}
+}
@@ -4,29 +4,42 @@ import (
 	"maps"
 
 	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/scanner"
 	"github.com/microsoft/typescript-go/internal/tracing"
 )
 
-// Tracer records types and trace events during type checking. A nil *Tracer
-// is a valid no-op, so call sites can use `if tr := c.tracer; tr != nil` to
-// gate work that only matters under --generateTrace.
+// Tracer records types and trace events during type checking, and the time
+// spent checking files, declarations and type relations. A nil *Tracer is a
+// valid no-op, so call sites can use `if tr := c.tracer; tr != nil` to gate
+// work that only matters under --generateTrace or --checkPerformanceReport.
 type Tracer struct {
 	tracing      *tracing.Tracing
 	recorder     tracing.Tracer
+	report       *tracing.CheckReport
 	checkerIndex int
 }
 
-// NewTracer creates a Tracer for the given checker index that records both
-// type-creation events and trace events through the provided tracing session.
-func NewTracer(tr *tracing.Tracing, checkerIndex int) *Tracer {
-	return &Tracer{tracing: tr, recorder: tr.NewTypeTracer(checkerIndex), checkerIndex: checkerIndex}
+// NewTracer creates a Tracer for the given checker index that records
+// type-creation events and trace events through the provided tracing session,
+// and checking times into the provided report. Either may be nil.
+func NewTracer(tr *tracing.Tracing, report *tracing.CheckReport, checkerIndex int) *Tracer {
+	t := &Tracer{tracing: tr, report: report, checkerIndex: checkerIndex}
+	if tr != nil {
+		t.recorder = tr.NewTypeTracer(checkerIndex)
+	}
+	return t
 }
 
 func (t *Tracer) RecordType(typ *Type) {
-	t.recorder.RecordType(wrapType(typ))
+	if t.recorder != nil {
+		t.recorder.RecordType(wrapType(typ))
+	}
 }
 
 func (t *Tracer) Push(phase tracing.Phase, name string, args map[string]any, separateBeginAndEnd bool) func() {
+	if t.tracing == nil {
+		return func() {}
+	}
 	if !separateBeginAndEnd {
 		return t.tracing.Push(phase, name, t.copyWithCheckerIndex(args), separateBeginAndEnd)
 	}
@@ -43,9 +56,73 @@ func (t *Tracer) Push(phase tracing.Phase, name string, args map[string]any, sep
 }
 
 func (t *Tracer) Instant(phase tracing.Phase, name string, args map[string]any) {
+	if t.tracing == nil {
+		return
+	}
 	t.tracing.Instant(phase, name, t.copyWithCheckerIndex(args))
 }
 
+// reportFile records the time spent checking file and the instantiations it
+// caused when the returned function is called.
+func (t *Tracer) reportFile(c *Checker, file *ast.SourceFile) func() {
+	if t.report == nil {
+		return func() {}
+	}
+	start, instantiations := t.report.Start(), c.TotalInstantiationCount
+	return func() {
+		t.report.AddFile(file.FileName(), start, c.TotalInstantiationCount-instantiations)
+	}
+}
+
+// reportDeclaration records the time spent checking node and the
+// instantiations it caused when the returned function is called.
+func (t *Tracer) reportDeclaration(c *Checker, node *ast.Node) func() {
+	if t.report == nil {
+		return func() {}
+	}
+	start, instantiations := t.report.Start(), c.TotalInstantiationCount
+	return func() {
+		t.report.AddDeclaration(node, func() string { return c.declarationNameForReport(node) }, start, c.TotalInstantiationCount-instantiations)
+	}
+}
+
+// reportRelation records the time spent structurally comparing source to
+// target when the returned function is called.
+func (t *Tracer) reportRelation(c *Checker, source *Type, target *Type) func() {
+	if t.report == nil {
+		return func() {}
+	}
+	start, node := t.report.Start(), c.currentNode
+	return func() {
+		t.report.AddRelation(t.checkerIndex, uint32(source.id), uint32(target.id), node, func() string {
+			return c.TypeToString(source) + " -> " + c.TypeToString(target)
+		}, start)
+	}
+}
+
+// isReportedDeclaration reports whether the time spent checking node is listed
+// per declaration in the check performance report.
+func isReportedDeclaration(node *ast.Node) bool {
+	switch node.Kind {
+	case ast.KindFunctionDeclaration, ast.KindClassDeclaration, ast.KindInterfaceDeclaration, ast.KindTypeAliasDeclaration,
+		ast.KindEnumDeclaration, ast.KindModuleDeclaration, ast.KindVariableDeclaration, ast.KindPropertyDeclaration,
+		ast.KindMethodDeclaration, ast.KindConstructor, ast.KindGetAccessor, ast.KindSetAccessor:
+		return true
+	}
+	return false
+}
+
+func (c *Checker) declarationNameForReport(node *ast.Node) string {
+	name := ast.GetNameOfDeclaration(node)
+	if name != nil && node.Symbol() != nil {
+		return c.symbolToString(node.Symbol())
+	}
+	if name != nil {
+		return scanner.DeclarationNameToString(name)
+	}
+	return "(" + node.Kind.String() + ")"
+}
+
 func (t *Tracer) copyWithCheckerIndex(args map[string]any) map[string]any {
 	withCheckerIndex := make(map[string]any, len(args)+1)
 	maps.Copy(withCheckerIndex, args)
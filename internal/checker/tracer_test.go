@@ -22,7 +22,7 @@ func TestTracerPushPreservesEndArgMutations(t *testing.T) {
 	assert.NilError(t, err)
 
 	args := map[string]any{"id": 1}
-	tracer := NewTracer(tr, 7)
+	tracer := NewTracer(tr, nil, 7)
 	pop := tracer.Push(tracing.PhaseCheckTypes, "getVariancesWorker", args, true)
 	_, hasCheckerID := args["checkerId"]
 	assert.Assert(t, !hasCheckerID)
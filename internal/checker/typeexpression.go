@@ -0,0 +1,61 @@
+package checker
+
+import (
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/binder"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/parser"
+	"github.com/microsoft/typescript-go/internal/tspath"
+)
+
+const typeExpressionPrefix = "type __TypeExpression = "
+
+// TypeExpression is a type expression that is not part of the program, resolved in the scope of a location in
+// the program.
+type TypeExpression struct {
+	// Type is the type of the expression. It is nil if the expression could not be parsed.
+	Type *Type
+	// Diagnostics are the syntactic and semantic errors in the expression. Their locations are relative to the
+	// start of the expression text.
+	Diagnostics []*ast.Diagnostic
+}
+
+// GetTypeOfTypeExpression parses a type expression, such as a conditional or mapped type applied to specific
+// type arguments, and resolves it as if it were written at the given location.
+//
+// The expression is parsed as a type alias in a file of its own whose parent is the location, so that the
+// names in the expression are resolved in the scope of the location and the diagnostics reported for it are
+// not reported for the file of the location.
+func (c *Checker) GetTypeOfTypeExpression(text string, location *ast.Node) *TypeExpression {
+	locationFile := ast.GetSourceFileOfNode(location)
+	fileName := tspath.CombinePaths(tspath.GetDirectoryPath(locationFile.FileName()), "__typeExpression.ts")
+	file := parser.ParseSourceFile(ast.SourceFileParseOptions{
+		FileName: fileName,
+		Path:     tspath.ToPath(fileName, "", true /*useCaseSensitiveFileNames*/),
+	}, typeExpressionPrefix+text+";", core.ScriptKindTS)
+	result := &TypeExpression{}
+	if diagnostics := file.Diagnostics(); len(diagnostics) != 0 || len(file.Statements.Nodes) != 1 || !ast.IsTypeAliasDeclaration(file.Statements.Nodes[0]) {
+		result.Diagnostics = core.Map(diagnostics, relocateTypeExpressionDiagnostic)
+		return result
+	}
+	binder.BindSourceFile(file)
+	file.AsNode().Parent = location
+
+	saveTypeExpressionFile, saveTypeExpressionDiagnostics := c.typeExpressionFile, c.typeExpressionDiagnostics
+	c.typeExpressionFile, c.typeExpressionDiagnostics = file, nil
+	typeNode := file.Statements.Nodes[0].Type()
+	result.Type = c.getTypeFromTypeNode(typeNode)
+	c.checkSourceElement(typeNode)
+	c.checkDeferredNodes(file)
+	result.Diagnostics = core.Map(c.typeExpressionDiagnostics, relocateTypeExpressionDiagnostic)
+	c.typeExpressionFile, c.typeExpressionDiagnostics = saveTypeExpressionFile, saveTypeExpressionDiagnostics
+	return result
+}
+
+func relocateTypeExpressionDiagnostic(diagnostic *ast.Diagnostic) *ast.Diagnostic {
+	loc := diagnostic.Loc()
+	relocated := diagnostic.Clone()
+	relocated.SetFile(nil)
+	relocated.SetLocation(core.NewTextRange(max(loc.Pos()-len(typeExpressionPrefix), 0), max(loc.End()-len(typeExpressionPrefix), 0)))
+	return relocated
+}
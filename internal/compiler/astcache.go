@@ -0,0 +1,346 @@
+package compiler
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/microsoft/typescript-go/internal/api/encoder"
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/parser"
+	"github.com/microsoft/typescript-go/internal/tspath"
+	"github.com/microsoft/typescript-go/internal/vfs"
+	"github.com/zeebo/xxh3"
+)
+
+// astCacheMagic starts every AST cache entry. Its last byte is the entry format
+// version.
+const astCacheMagic = "tsgoast\x01"
+
+// astCacheEntryExtension is the extension of AST cache entries. Only files with
+// it are ever removed from the cache directory.
+const astCacheEntryExtension = ".ast"
+
+// DefaultASTCacheMaxSize is the size, in bytes, beyond which an ASTCache
+// removes its least recently used entries.
+const DefaultASTCacheMaxSize = 512 << 20
+
+// ASTCache is a persistent, content-addressed cache of parsed declaration files.
+// Entries are stored in a directory as serialized syntax trees, keyed by the
+// file's text, name, script kind and parse options along with the compiler
+// version, so an entry is only reused for a file the parser would turn into the
+// same tree. Programs sharing an ASTCache also share the source files it loads.
+//
+// The modification time of an entry is updated whenever it is used. When the
+// cache writes its first entry, and again whenever the entries it wrote since
+// add up to a quarter of its maximum size, the least recently used entries are
+// removed until the directory is within the maximum size.
+type ASTCache struct {
+	fs      vfs.FS
+	dir     string
+	maxSize int64
+	now     func() time.Time
+
+	mu sync.Mutex
+	// files holds the latest version of each loaded file.
+	files map[ast.SourceFileParseOptions]*ast.SourceFile
+	// written is the size of the entries written since the directory was last
+	// trimmed, or -1 if it has not been trimmed yet.
+	written int64
+}
+
+// NewASTCache returns an ASTCache that stores its entries in dir, keeping it
+// within maxSize bytes. now provides the times entries are marked as used at.
+func NewASTCache(fs vfs.FS, dir string, maxSize int64, now func() time.Time) *ASTCache {
+	return &ASTCache{
+		fs:      fs,
+		dir:     dir,
+		maxSize: maxSize,
+		now:     now,
+		files:   make(map[ast.SourceFileParseOptions]*ast.SourceFile),
+		written: -1,
+	}
+}
+
+// NewASTCacheCompilerHost returns a CompilerHost that parses the declaration
+// files read by host through cache, or host itself if cache is nil. Wrap the
+// host that reads and parses files, beneath any host that caches source files,
+// so that the cache only sees files that are actually parsed.
+func NewASTCacheCompilerHost(host CompilerHost, cache *ASTCache) CompilerHost {
+	if cache == nil {
+		return host
+	}
+	return &astCacheCompilerHost{CompilerHost: host, cache: cache}
+}
+
+type astCacheCompilerHost struct {
+	CompilerHost
+	cache *ASTCache
+}
+
+func (h *astCacheCompilerHost) GetSourceFile(opts ast.SourceFileParseOptions) *ast.SourceFile {
+	if !tspath.IsDeclarationFileName(opts.FileName) {
+		return h.CompilerHost.GetSourceFile(opts)
+	}
+	text, ok := h.FS().ReadFile(opts.FileName)
+	if !ok {
+		return nil
+	}
+	return h.cache.getSourceFile(opts, text)
+}
+
+// getSourceFile returns the declaration file described by opts with the given
+// text, loading it from the cache when there is an entry for the text and
+// parsing the text otherwise.
+func (c *ASTCache) getSourceFile(opts ast.SourceFileParseOptions, text string) *ast.SourceFile {
+	c.mu.Lock()
+	file := c.files[opts]
+	c.mu.Unlock()
+	if file != nil && file.Text() == text {
+		return file
+	}
+
+	scriptKind := core.EnsureScriptKindFromFileName(opts.FileName)
+	entryName := c.entryFileName(opts, text, scriptKind)
+	file = c.load(entryName, opts, text, scriptKind)
+	if file == nil {
+		file = parser.ParseSourceFile(opts, text, scriptKind)
+		if !parser.CanRestoreSourceFile(file) {
+			return file
+		}
+		c.store(entryName, file)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if existing := c.files[opts]; existing != nil && existing.Text() == text {
+		return existing
+	}
+	c.files[opts] = file
+	return file
+}
+
+func (c *ASTCache) entryFileName(opts ast.SourceFileParseOptions, text string, scriptKind core.ScriptKind) string {
+	h := xxh3.New()
+	writeString := func(s string) {
+		_, _ = h.Write(binary.AppendUvarint(nil, uint64(len(s))))
+		_, _ = h.WriteString(s)
+	}
+	writeString(astCacheMagic)
+	writeString(core.Version())
+	writeString(opts.FileName)
+	writeString(string(opts.Path))
+	_, _ = h.Write([]byte{
+		byte(scriptKind),
+		boolToByte(opts.ExternalModuleIndicatorOptions.JSX),
+		boolToByte(opts.ExternalModuleIndicatorOptions.Force),
+	})
+	writeString(text)
+	sum := h.Sum128().Bytes()
+	return tspath.CombinePaths(c.dir, hex.EncodeToString(sum[:])+astCacheEntryExtension)
+}
+
+func boolToByte(b bool) byte {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// load decodes the cache entry at entryName, returning nil if there is no
+// usable entry for the file.
+func (c *ASTCache) load(entryName string, opts ast.SourceFileParseOptions, text string, scriptKind core.ScriptKind) *ast.SourceFile {
+	contents, ok := c.fs.ReadFile(entryName)
+	if !ok {
+		return nil
+	}
+	summary, data, ok := decodeASTCacheEntry(contents)
+	if !ok {
+		return nil
+	}
+	file, err := encoder.DecodeSourceFile(data)
+	if err != nil || file.Text() != text || file.ParseOptions() != opts {
+		return nil
+	}
+	restoreUTF8Positions(file)
+	parser.RestoreSourceFile(file, scriptKind, summary)
+	c.touch(entryName)
+	return file
+}
+
+// touch marks the entry at entryName as used, using the cache's clock so that
+// entries can be ordered by use regardless of how the file system records
+// write times.
+func (c *ASTCache) touch(entryName string) {
+	now := c.now()
+	_ = c.fs.Chtimes(entryName, now, now)
+}
+
+// store writes a cache entry for file. Failing to write an entry only costs a
+// later compilation the time to parse the file again, so errors are ignored.
+func (c *ASTCache) store(entryName string, file *ast.SourceFile) {
+	data, err := encoder.EncodeSourceFileSyntax(file)
+	if err != nil {
+		return
+	}
+	entry := encodeASTCacheEntry(parser.SummarizeSourceFile(file), data)
+	if c.fs.WriteFile(entryName, entry) != nil {
+		return
+	}
+	c.touch(entryName)
+
+	c.mu.Lock()
+	trim := c.written < 0 || c.written+int64(len(entry)) >= c.maxSize/4
+	if trim {
+		c.written = 0
+	} else {
+		c.written += int64(len(entry))
+	}
+	c.mu.Unlock()
+	if trim {
+		c.trim()
+	}
+}
+
+// trim removes the least recently used entries until the entries in the cache
+// directory take up at most maxSize bytes.
+func (c *ASTCache) trim() {
+	type cacheEntry struct {
+		name    string
+		size    int64
+		modTime time.Time
+	}
+	var entries []cacheEntry
+	var size int64
+	for _, name := range c.fs.GetAccessibleEntries(c.dir).Files {
+		if !strings.HasSuffix(name, astCacheEntryExtension) {
+			continue
+		}
+		fileName := tspath.CombinePaths(c.dir, name)
+		if info := c.fs.Stat(fileName); info != nil {
+			entries = append(entries, cacheEntry{fileName, info.Size(), info.ModTime()})
+			size += info.Size()
+		}
+	}
+	if size <= c.maxSize {
+		return
+	}
+	slices.SortFunc(entries, func(a, b cacheEntry) int {
+		return a.modTime.Compare(b.modTime)
+	})
+	for _, entry := range entries {
+		if size <= c.maxSize {
+			break
+		}
+		if c.fs.Remove(entry.name) == nil {
+			size -= entry.size
+		}
+	}
+}
+
+// An AST cache entry consists of astCacheMagic, the xxh3 hash of the rest of the
+// entry, the parse summary as uvarints, and the encoded syntax tree.
+func encodeASTCacheEntry(summary parser.ParseSummary, data []byte) string {
+	var body []byte
+	body = binary.AppendUvarint(body, uint64(summary.NodeCount))
+	body = binary.AppendUvarint(body, uint64(summary.TextCount))
+	body = binary.AppendUvarint(body, uint64(summary.IdentifierCount))
+	body = binary.AppendUvarint(body, uint64(len(summary.CommentDirectives)))
+	for _, directive := range summary.CommentDirectives {
+		body = binary.AppendUvarint(body, uint64(directive.Loc.Pos()))
+		body = binary.AppendUvarint(body, uint64(directive.Loc.End()))
+		body = binary.AppendUvarint(body, uint64(directive.Kind))
+	}
+	body = binary.AppendUvarint(body, uint64(len(summary.EagerJSDoc)))
+	for _, index := range summary.EagerJSDoc {
+		body = binary.AppendUvarint(body, uint64(index))
+	}
+	body = append(body, data...)
+
+	entry := make([]byte, 0, len(astCacheMagic)+8+len(body))
+	entry = append(entry, astCacheMagic...)
+	entry = binary.LittleEndian.AppendUint64(entry, xxh3.Hash(body))
+	entry = append(entry, body...)
+	return string(entry)
+}
+
+func decodeASTCacheEntry(entry string) (summary parser.ParseSummary, data []byte, ok bool) {
+	if len(entry) < len(astCacheMagic)+8 || entry[:len(astCacheMagic)] != astCacheMagic {
+		return summary, nil, false
+	}
+	body := []byte(entry[len(astCacheMagic)+8:])
+	if binary.LittleEndian.Uint64([]byte(entry[len(astCacheMagic):])) != xxh3.Hash(body) {
+		return summary, nil, false
+	}
+
+	readInt := func() int {
+		value, n := binary.Uvarint(body)
+		if n <= 0 {
+			ok = false
+			return 0
+		}
+		body = body[n:]
+		return int(value)
+	}
+	ok = true
+	summary.NodeCount = readInt()
+	summary.TextCount = readInt()
+	summary.IdentifierCount = readInt()
+	if count := readInt(); ok && count > 0 && count <= len(body) {
+		summary.CommentDirectives = make([]ast.CommentDirective, count)
+		for i := range summary.CommentDirectives {
+			pos, end := readInt(), readInt()
+			summary.CommentDirectives[i] = ast.CommentDirective{
+				Loc:  core.NewTextRange(pos, end),
+				Kind: ast.CommentDirectiveKind(readInt()),
+			}
+		}
+	}
+	if count := readInt(); ok && count > 0 && count <= len(body) {
+		summary.EagerJSDoc = make([]int, count)
+		for i := range summary.EagerJSDoc {
+			summary.EagerJSDoc[i] = readInt()
+		}
+	}
+	return summary, body, ok
+}
+
+// restoreUTF8Positions converts the UTF-16 positions of a decoded syntax tree
+// back to the UTF-8 byte offsets the compiler uses.
+func restoreUTF8Positions(file *ast.SourceFile) {
+	positionMap := file.GetPositionMap()
+	if positionMap.IsAsciiOnly() {
+		return
+	}
+	toUTF8 := func(loc core.TextRange) core.TextRange {
+		return core.NewTextRange(positionMap.UTF16ToUTF8(loc.Pos()), positionMap.UTF16ToUTF8(loc.End()))
+	}
+	visitor := &ast.NodeVisitor{
+		Hooks: ast.NodeVisitorHooks{
+			VisitNodes: func(nodeList *ast.NodeList, visitor *ast.NodeVisitor) *ast.NodeList {
+				if nodeList != nil {
+					nodeList.Loc = toUTF8(nodeList.Loc)
+					visitor.VisitSlice(nodeList.Nodes)
+				}
+				return nodeList
+			},
+			VisitModifiers: func(modifiers *ast.ModifierList, visitor *ast.NodeVisitor) *ast.ModifierList {
+				if modifiers != nil {
+					modifiers.Loc = toUTF8(modifiers.Loc)
+					visitor.VisitSlice(modifiers.Nodes)
+				}
+				return modifiers
+			},
+		},
+	}
+	visitor.Visit = func(node *ast.Node) *ast.Node {
+		node.Loc = toUTF8(node.Loc)
+		visitor.VisitEachChild(node)
+		return node
+	}
+	visitor.Visit(file.AsNode())
+}
@@ -0,0 +1,207 @@
+package compiler_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/bundled"
+	"github.com/microsoft/typescript-go/internal/compiler"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/tsoptions"
+	"github.com/microsoft/typescript-go/internal/tspath"
+	"github.com/microsoft/typescript-go/internal/vfs"
+	"github.com/microsoft/typescript-go/internal/vfs/vfstest"
+	"gotest.tools/v3/assert"
+)
+
+func TestASTCache(t *testing.T) {
+	t.Parallel()
+	if !bundled.Embedded {
+		t.Skip("bundled files are not embedded")
+	}
+
+	fs := bundled.WrapFS(vfstest.FromMap(map[string]any{
+		"/src/index.ts": `import { greet } from "greeter";
+greet("wörld");
+`,
+		"/src/node_modules/@types/greeter/index.d.ts": `/// <reference types="extra" />
+/// <reference lib="es2016.array.include" />
+import type { Options } from "./options";
+/** Greets someone — politely. {@link Options} */
+export declare function greet(name: string, options?: Options): void;
+// @ts-ignore
+export declare const broken: Missing;
+declare module "other" {
+    export const ünïcode: "😀";
+}
+`,
+		"/src/node_modules/@types/greeter/options.d.ts": `export interface Options { loud?: boolean }`,
+		"/src/node_modules/@types/extra/index.d.ts":     `declare const extra: number;`,
+	}, true /*useCaseSensitiveFileNames*/))
+
+	newProgram := func(hostFS vfs.FS, cache *compiler.ASTCache) *compiler.Program {
+		return compiler.NewProgram(compiler.ProgramOptions{
+			Config: &tsoptions.ParsedCommandLine{
+				ParsedConfig: &tsoptions.ParsedOptions{
+					FileNames: []string{"/src/index.ts"},
+					CompilerOptions: &core.CompilerOptions{
+						Lib:            []string{"lib.es2015.d.ts", "lib.dom.d.ts"},
+						NoUnusedLocals: core.TSTrue,
+						Types:          []string{},
+					},
+				},
+			},
+			Host: compiler.NewASTCacheCompilerHost(compiler.NewCompilerHost("/src", hostFS, bundled.LibPath(), nil, nil, nil), cache),
+		})
+	}
+
+	parsed := newProgram(fs, nil)
+	readsFS := &readCountingFS{FS: fs, reads: make(map[string]int)}
+	newProgram(readsFS, compiler.NewASTCache(fs, "/cache", compiler.DefaultASTCacheMaxSize, time.Now))
+	// Every declaration file is cached; /src/index.ts is not.
+	assert.Equal(t, len(fs.GetAccessibleEntries("/cache").Files), len(parsed.GetSourceFiles())-1)
+	// Files that miss the cache are parsed from the text read to look them up.
+	for _, file := range parsed.GetSourceFiles() {
+		assert.Equal(t, readsFS.reads[file.FileName()], 1, file.FileName())
+	}
+
+	// A fresh cache reads the entries written by the previous program.
+	cacheFS := &writeCountingFS{FS: fs}
+	loaded := newProgram(fs, compiler.NewASTCache(cacheFS, "/cache", compiler.DefaultASTCacheMaxSize, time.Now))
+	assert.Equal(t, cacheFS.writes, 0)
+	assert.Equal(t, len(loaded.GetSourceFiles()), len(parsed.GetSourceFiles()))
+	for _, file := range parsed.GetSourceFiles() {
+		loadedFile := loaded.GetSourceFileByPath(file.Path())
+		assert.Assert(t, loadedFile != nil, file.FileName())
+		assert.Equal(t, dumpSourceFile(loadedFile), dumpSourceFile(file), file.FileName())
+	}
+
+	ctx := context.Background()
+	for _, name := range []string{"/src/index.ts", "/src/node_modules/@types/greeter/index.d.ts"} {
+		assert.DeepEqual(t,
+			dumpDiagnostics(loaded.GetSemanticDiagnostics(ctx, loaded.GetSourceFile(name))),
+			dumpDiagnostics(parsed.GetSemanticDiagnostics(ctx, parsed.GetSourceFile(name))),
+		)
+	}
+}
+
+func TestASTCacheEviction(t *testing.T) {
+	t.Parallel()
+
+	fs := vfstest.FromMap(map[string]any{
+		"/src/a.d.ts": `declare const a: number;`,
+		"/src/b.d.ts": `declare const b: number;`,
+		"/src/c.d.ts": `declare const c: number;`,
+	}, true /*useCaseSensitiveFileNames*/)
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := func() time.Time {
+		clock = clock.Add(time.Minute)
+		return clock
+	}
+	getSourceFile := func(cache *compiler.ASTCache, fileName string) {
+		host := compiler.NewASTCacheCompilerHost(compiler.NewCompilerHost("/src", fs, "", nil, nil, nil), cache)
+		assert.Assert(t, host.GetSourceFile(ast.SourceFileParseOptions{FileName: fileName, Path: tspath.Path(fileName)}) != nil)
+	}
+	entries := func() []string {
+		return fs.GetAccessibleEntries("/cache").Files
+	}
+
+	cache := compiler.NewASTCache(fs, "/cache", compiler.DefaultASTCacheMaxSize, now)
+	getSourceFile(cache, "/src/a.d.ts")
+	getSourceFile(cache, "/src/b.d.ts")
+	assert.Equal(t, len(entries()), 2)
+	entrySize := fs.Stat("/cache/" + entries()[0]).Size()
+
+	// Using the entry of a.d.ts makes the entry of b.d.ts the least recently
+	// used, so it is the one removed to make room for c.d.ts.
+	cache = compiler.NewASTCache(fs, "/cache", 2*entrySize+entrySize/2, now)
+	getSourceFile(cache, "/src/a.d.ts")
+	getSourceFile(cache, "/src/c.d.ts")
+	assert.Equal(t, len(entries()), 2)
+
+	writesFS := &writeCountingFS{FS: fs}
+	cache = compiler.NewASTCache(writesFS, "/cache", compiler.DefaultASTCacheMaxSize, now)
+	getSourceFile(cache, "/src/a.d.ts")
+	getSourceFile(cache, "/src/c.d.ts")
+	assert.Equal(t, writesFS.writes, 0)
+	getSourceFile(cache, "/src/b.d.ts")
+	assert.Equal(t, writesFS.writes, 1)
+}
+
+type readCountingFS struct {
+	vfs.FS
+	mu    sync.Mutex
+	reads map[string]int
+}
+
+func (fs *readCountingFS) ReadFile(path string) (string, bool) {
+	fs.mu.Lock()
+	fs.reads[path]++
+	fs.mu.Unlock()
+	return fs.FS.ReadFile(path)
+}
+
+type writeCountingFS struct {
+	vfs.FS
+	writes int
+}
+
+func (fs *writeCountingFS) WriteFile(path string, data string) error {
+	fs.writes++
+	return fs.FS.WriteFile(path, data)
+}
+
+func dumpDiagnostics(diags []*ast.Diagnostic) []string {
+	var result []string
+	for _, d := range diags {
+		result = append(result, fmt.Sprintf("%d %d-%d %s", d.Code(), d.Pos(), d.End(), d.String()))
+	}
+	return result
+}
+
+// dumpSourceFile renders the syntax tree and parser-computed properties of file.
+func dumpSourceFile(file *ast.SourceFile) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "declaration=%v variant=%v kind=%v nodes=%d text=%d identifiers=%d\n",
+		file.IsDeclarationFile, file.LanguageVariant, file.ScriptKind, file.NodeCount, file.TextCount, file.IdentifierCount)
+	for _, imp := range file.Imports() {
+		fmt.Fprintf(&b, "import %q\n", imp.Text())
+	}
+	for _, aug := range file.ModuleAugmentations {
+		fmt.Fprintf(&b, "augmentation %q\n", aug.Text())
+	}
+	fmt.Fprintf(&b, "ambient %v\n", file.AmbientModuleNames)
+	for _, refs := range [][]*ast.FileReference{file.ReferencedFiles, file.TypeReferenceDirectives, file.LibReferenceDirectives} {
+		for _, ref := range refs {
+			fmt.Fprintf(&b, "reference %q %v\n", ref.FileName, ref.TextRange)
+		}
+	}
+	fmt.Fprintf(&b, "directives %v\n", file.CommentDirectives)
+	if file.ExternalModuleIndicator != nil {
+		fmt.Fprintf(&b, "external module %v %v\n", file.ExternalModuleIndicator.Kind, file.ExternalModuleIndicator.Loc)
+	}
+
+	depth := 0
+	var visit func(node *ast.Node) bool
+	visit = func(node *ast.Node) bool {
+		fmt.Fprintf(&b, "%s%v %v flags=%d parent=%v", strings.Repeat("  ", depth), node.Kind, node.Loc, node.Flags, node.Parent.Kind)
+		if ast.IsIdentifier(node) || ast.IsLiteralKind(node.Kind) {
+			fmt.Fprintf(&b, " %q", node.Text())
+		}
+		if jsdoc := node.EagerJSDoc(file); len(jsdoc) > 0 {
+			fmt.Fprintf(&b, " jsdoc=%v", jsdoc[0].Loc)
+		}
+		b.WriteString("\n")
+		depth++
+		node.ForEachChild(visit)
+		depth--
+		return false
+	}
+	file.AsNode().ForEachChild(visit)
+	return b.String()
+}
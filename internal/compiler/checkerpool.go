@@ -26,6 +26,7 @@ type CheckerPool interface {
 type checkerPool struct {
 	program *Program
 	tracing *tracing.Tracing
+	report  *tracing.CheckReport
 
 	createCheckersOnce sync.Once
 	checkers           []*checker.Checker
@@ -299,10 +300,10 @@ func getCheckerAssociationWeights(baseWeights []int, importCounts []int) []int {
 }
 
 func newCheckerPool(program *Program) *checkerPool {
-	return newCheckerPoolWithTracing(program, nil)
+	return newCheckerPoolWithTracing(program, nil, nil)
 }
 
-func newCheckerPoolWithTracing(program *Program, tr *tracing.Tracing) *checkerPool {
+func newCheckerPoolWithTracing(program *Program, tr *tracing.Tracing, report *tracing.CheckReport) *checkerPool {
 	checkerCount := 4
 	if program.SingleThreaded() {
 		checkerCount = 1
@@ -317,6 +318,7 @@ func newCheckerPoolWithTracing(program *Program, tr *tracing.Tracing) *checkerPo
 		checkers: make([]*checker.Checker, checkerCount),
 		locks:    make([]*sync.Mutex, checkerCount),
 		tracing:  tr,
+		report:   report,
 	}
 
 	return pool
@@ -367,8 +369,8 @@ func (p *checkerPool) createCheckers() {
 		for i := range checkerCount {
 			wg.Queue(func() {
 				var tracer *checker.Tracer
-				if p.tracing != nil {
-					tracer = checker.NewTracer(p.tracing, i)
+				if p.tracing != nil || p.report != nil {
+					tracer = checker.NewTracer(p.tracing, p.report, i)
 				}
 				p.checkers[i], p.locks[i] = checker.NewChecker(p.program, tracer)
 			})
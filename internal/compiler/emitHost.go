@@ -8,6 +8,7 @@ import (
 	"github.com/microsoft/typescript-go/internal/module"
 	"github.com/microsoft/typescript-go/internal/outputpaths"
 	"github.com/microsoft/typescript-go/internal/packagejson"
+	"github.com/microsoft/typescript-go/internal/pnp"
 	"github.com/microsoft/typescript-go/internal/printer"
 	"github.com/microsoft/typescript-go/internal/symlinks"
 	"github.com/microsoft/typescript-go/internal/transformers/declarations"
@@ -75,6 +76,10 @@ func (host *emitHost) GetPackageJsonInfo(pkgJsonPath string) *packagejson.InfoCa
 	return host.program.GetPackageJsonInfo(pkgJsonPath)
 }
 
+func (host *emitHost) GetPnpManifest(directory string) *pnp.Manifest {
+	return host.program.GetPnpManifest(directory)
+}
+
 func (host *emitHost) GetSourceOfProjectReferenceIfOutputIncluded(file ast.HasFileName) string {
 	return host.program.GetSourceOfProjectReferenceIfOutputIncluded(file)
 }
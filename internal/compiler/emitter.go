@@ -40,9 +40,21 @@ type emitter struct {
 	emitResult         EmitResult
 	forceEmit          bool
 	writeFile          func(fileName string, text string, data *WriteFileData) error
+	customTransformers *CustomTransformers
 	tr                 *tracing.Tracing
 }
 
+// CustomTransformers are additional transformers supplied by the caller of Emit,
+// equivalent to the `customTransformers` argument of `Program.emit` in Strada.
+type CustomTransformers struct {
+	// Before runs on the TypeScript syntax tree, ahead of the built-in script transformers.
+	Before []transformers.TransformerFactory
+	// After runs on the JavaScript syntax tree, after the built-in script transformers.
+	After []transformers.TransformerFactory
+	// AfterDeclarations runs on the declaration syntax tree, after the built-in declaration transformers.
+	AfterDeclarations []transformers.TransformerFactory
+}
+
 func (e *emitter) emit() {
 	if e.tr != nil {
 		defer e.tr.Push(tracing.PhaseEmit, "emit", map[string]any{"path": string(e.sourceFile.Path())}, true)()
@@ -57,19 +69,43 @@ type declarationTransformer interface {
 	GetDiagnostics() []*ast.Diagnostic
 }
 
+// customDeclarationTransformer adapts a custom transformer to the declarationTransformer interface.
+type customDeclarationTransformer struct {
+	*transformers.Transformer
+}
+
+func (customDeclarationTransformer) GetDiagnostics() []*ast.Diagnostic {
+	return nil
+}
+
 func (e *emitter) getDeclarationTransformers(emitContext *printer.EmitContext, sourceFile *ast.SourceFile, declarationFilePath string, declarationMapPath string) []declarationTransformer {
 	forceDtsEmit := e.emitOnly == EmitOnlyBuilderSignature || e.forceEmit && e.emitOnly == EmitOnlyDts
-	return []declarationTransformer{
+	tx := []declarationTransformer{
 		declarations.NewDeclarationTransformer(e.host, emitContext, e.host.Options(), declarationFilePath, declarationMapPath),
 		declarations.NewSupplementalReferencesTransformer(e.host, sourceFile, declarationFilePath, forceDtsEmit),
 	}
+	// Custom transformers are only run for declaration files that will actually be written
+	if e.customTransformers != nil && len(e.customTransformers.AfterDeclarations) > 0 && e.emitOnly != EmitOnlyBuilderSignature {
+		opts := transformers.TransformOptions{
+			Context:                   emitContext,
+			CompilerOptions:           e.host.Options(),
+			EmitResolver:              e.host.GetEmitResolver(),
+			GetEmitModuleFormatOfFile: e.host.GetEmitModuleFormatOfFile,
+		}
+		for _, factory := range e.customTransformers.AfterDeclarations {
+			if transformer := factory(&opts); transformer != nil {
+				tx = append(tx, customDeclarationTransformer{transformer})
+			}
+		}
+	}
+	return tx
 }
 
 func (e *emitter) runScriptTransformers(emitContext *printer.EmitContext, sourceFile *ast.SourceFile) *ast.SourceFile {
 	if e.tr != nil {
 		defer e.tr.Push(tracing.PhaseEmit, "transformNodes", map[string]any{"path": string(sourceFile.Path())}, false)()
 	}
-	for _, transformer := range getScriptTransformers(emitContext, e.host, sourceFile) {
+	for _, transformer := range getScriptTransformers(emitContext, e.host, sourceFile, e.customTransformers) {
 		sourceFile = transformer.TransformSourceFile(sourceFile)
 	}
 	return sourceFile
@@ -109,7 +145,7 @@ func getModuleTransformer(opts *transformers.TransformOptions) *transformers.Tra
 	}
 }
 
-func getScriptTransformers(emitContext *printer.EmitContext, host printer.EmitHost, sourceFile *ast.SourceFile) []*transformers.Transformer {
+func getScriptTransformers(emitContext *printer.EmitContext, host printer.EmitHost, sourceFile *ast.SourceFile, customTransformers *CustomTransformers) []*transformers.Transformer {
 	var tx []*transformers.Transformer
 	options := host.Options()
 
@@ -134,6 +170,11 @@ func getScriptTransformers(emitContext *printer.EmitContext, host printer.EmitHo
 		GetEmitModuleFormatOfFile: host.GetEmitModuleFormatOfFile,
 	}
 
+	// custom transformers that see TypeScript syntax
+	if customTransformers != nil {
+		tx = appendCustomTransformers(tx, customTransformers.Before, &opts)
+	}
+
 	// transform TypeScript syntax
 	{
 		// use type nodes to add metadata decorators
@@ -175,6 +216,20 @@ func getScriptTransformers(emitContext *printer.EmitContext, host printer.EmitHo
 	if !options.GetIsolatedModules() {
 		tx = append(tx, inliners.NewConstEnumInliningTransformer(&opts))
 	}
+
+	// custom transformers that see the final JavaScript syntax
+	if customTransformers != nil {
+		tx = appendCustomTransformers(tx, customTransformers.After, &opts)
+	}
+	return tx
+}
+
+func appendCustomTransformers(tx []*transformers.Transformer, factories []transformers.TransformerFactory, opts *transformers.TransformOptions) []*transformers.Transformer {
+	for _, factory := range factories {
+		if transformer := factory(opts); transformer != nil {
+			tx = append(tx, transformer)
+		}
+	}
 	return tx
 }
 
@@ -276,7 +276,7 @@ func (p *fileLoader) resolveAutomaticTypeDirectives(containingFileName string) (
 	typeResolutionsTrace []module.DiagAndArgs,
 	pDiagnostics []*processingDiagnostic,
 ) {
-	automaticTypeDirectiveNames := module.GetAutomaticTypeDirectiveNames(p.opts.Config.CompilerOptions(), p.opts.Host)
+	automaticTypeDirectiveNames := p.resolver.GetAutomaticTypeDirectiveNames(p.opts.Config.CompilerOptions())
 	if len(automaticTypeDirectiveNames) != 0 {
 		toParse = make([]resolvedRef, 0, len(automaticTypeDirectiveNames))
 		typeResolutionsInFile = make(module.ModeAwareCache[*module.ResolvedTypeReferenceDirective], len(automaticTypeDirectiveNames))
@@ -24,6 +24,7 @@ import (
 	"github.com/microsoft/typescript-go/internal/outputpaths"
 	"github.com/microsoft/typescript-go/internal/packagejson"
 	"github.com/microsoft/typescript-go/internal/parser"
+	"github.com/microsoft/typescript-go/internal/pnp"
 	"github.com/microsoft/typescript-go/internal/printer"
 	"github.com/microsoft/typescript-go/internal/scanner"
 	"github.com/microsoft/typescript-go/internal/sourcemap"
@@ -43,6 +44,7 @@ type ProgramOptions struct {
 	TypingsLocation             string
 	ProjectName                 string
 	Tracing                     *tracing.Tracing
+	CheckReport                 *tracing.CheckReport
 }
 
 func (p *ProgramOptions) canUseProjectReferenceSource() bool {
@@ -159,6 +161,11 @@ func (p *Program) GetPackageJsonInfo(pkgJsonPath string) *packagejson.InfoCacheE
 	return nil
 }
 
+// GetPnpManifest implements checker.Program.
+func (p *Program) GetPnpManifest(directory string) *pnp.Manifest {
+	return p.resolver.GetPnpManifest(directory)
+}
+
 // PackageJsonCacheEntries iterates on all package json cache entries.
 func (p *Program) PackageJsonCacheEntries(f func(key tspath.Path, value *packagejson.InfoCacheEntry) bool) {
 	p.resolver.PackageJsonCacheEntries(f)
@@ -420,7 +427,7 @@ func (p *Program) initCheckerPool() {
 	if p.opts.CreateCheckerPool != nil {
 		p.checkerPool = p.opts.CreateCheckerPool(p)
 	} else {
-		pool := newCheckerPoolWithTracing(p, p.opts.Tracing)
+		pool := newCheckerPoolWithTracing(p, p.opts.Tracing, p.opts.CheckReport)
 		p.checkerPool = pool
 		p.compilerCheckerPool = pool
 	}
@@ -509,6 +516,7 @@ func (p *Program) ContentMapperExtensions() []string         { return p.opts.Con
 func (p *Program) CommandLine() *tsoptions.ParsedCommandLine { return p.opts.Config }
 func (p *Program) Host() CompilerHost                        { return p.opts.Host }
 func (p *Program) Tracing() *tracing.Tracing                 { return p.opts.Tracing }
+func (p *Program) CheckReport() *tracing.CheckReport         { return p.opts.CheckReport }
 func (p *Program) GetConfigFileParsingDiagnostics() []*ast.Diagnostic {
 	return slices.Clip(p.opts.Config.GetConfigFileParsingDiagnostics())
 }
@@ -1774,10 +1782,11 @@ type WriteFileData struct {
 type WriteFile func(fileName string, text string, data *WriteFileData) error
 
 type EmitOptions struct {
-	TargetSourceFiles []*ast.SourceFile // Source files to emit. If `nil`, emits all files
-	EmitOnly          EmitOnly
-	ForceEmit         bool
-	WriteFile         WriteFile
+	TargetSourceFiles  []*ast.SourceFile // Source files to emit. If `nil`, emits all files
+	EmitOnly           EmitOnly
+	ForceEmit          bool
+	WriteFile          WriteFile
+	CustomTransformers *CustomTransformers // Additional transformers to run during emit
 }
 
 type EmitResult struct {
@@ -1824,12 +1833,13 @@ func (p *Program) Emit(ctx context.Context, options EmitOptions) *EmitResult {
 
 	for _, sourceFile := range sourceFiles {
 		emitter := &emitter{
-			writer:     nil,
-			sourceFile: sourceFile,
-			emitOnly:   options.EmitOnly,
-			forceEmit:  options.ForceEmit,
-			writeFile:  options.WriteFile,
-			tr:         p.opts.Tracing,
+			writer:             nil,
+			sourceFile:         sourceFile,
+			emitOnly:           options.EmitOnly,
+			forceEmit:          options.ForceEmit,
+			writeFile:          options.WriteFile,
+			customTransformers: options.CustomTransformers,
+			tr:                 p.opts.Tracing,
 		}
 		emitters = append(emitters, emitter)
 		wg.Queue(func() {
@@ -20,7 +20,6 @@ import (
 	"github.com/microsoft/typescript-go/internal/diagnostics"
 	"github.com/microsoft/typescript-go/internal/ipc"
 	"github.com/microsoft/typescript-go/internal/json"
-	"github.com/microsoft/typescript-go/internal/jsonrpc"
 	"github.com/microsoft/typescript-go/internal/locale"
 	"github.com/microsoft/typescript-go/internal/spanmap"
 	"github.com/microsoft/typescript-go/internal/tspath"
@@ -374,117 +373,21 @@ type HostOptions struct {
 	Logger Logger
 }
 
-type loggingProtocol struct {
-	ipc.Protocol
-	mapperName string
-	logger     Logger
-}
-
-func (p *loggingProtocol) log(direction string, message any) {
-	data, err := json.Marshal(message)
-	if err != nil {
-		p.logger(fmt.Sprintf("[content mapper: %s] %s: <failed to serialize: %v>", p.mapperName, direction, err))
-		return
-	}
-	p.logger(fmt.Sprintf("[content mapper: %s] %s: %s", p.mapperName, direction, data))
-}
-
-func (p *loggingProtocol) ReadMessage() (*ipc.Message, error) {
-	message, err := p.Protocol.ReadMessage()
-	if err == nil {
-		p.log("receive", message)
-	}
-	return message, err
-}
-
-func (p *loggingProtocol) WriteRequest(id *jsonrpc.ID, method string, params any) error {
-	message := jsonrpc.RequestMessage{ID: id, Method: method, Params: params}
-	p.log("send", message)
-	return p.Protocol.WriteRequest(id, method, params)
-}
-
-func (p *loggingProtocol) WriteNotification(method string, params any) error {
-	message := jsonrpc.RequestMessage{Method: method, Params: params}
-	p.log("send", message)
-	return p.Protocol.WriteNotification(method, params)
-}
-
-func (p *loggingProtocol) WriteResponse(id *jsonrpc.ID, result any) error {
-	message := jsonrpc.ResponseMessage{ID: id, Result: result}
-	p.log("send", message)
-	return p.Protocol.WriteResponse(id, result)
-}
-
-func (p *loggingProtocol) WriteError(id *jsonrpc.ID, responseError *jsonrpc.ResponseError) error {
-	message := jsonrpc.ResponseMessage{ID: id, Error: responseError}
-	p.log("send", message)
-	return p.Protocol.WriteError(id, responseError)
-}
-
-type stderrLogger struct {
-	mapperName string
-	logger     Logger
-	mu         sync.Mutex
-	pending    string
-}
-
-func (w *stderrLogger) Write(data []byte) (int, error) {
-	w.mu.Lock()
-	defer w.mu.Unlock()
-	w.pending += string(data)
-	for {
-		index := strings.IndexByte(w.pending, '\n')
-		if index < 0 {
-			break
-		}
-		w.log(strings.TrimSuffix(w.pending[:index], "\r"))
-		w.pending = w.pending[index+1:]
-	}
-	return len(data), nil
-}
-
-func (w *stderrLogger) flush() {
-	w.mu.Lock()
-	defer w.mu.Unlock()
-	if w.pending != "" {
-		w.log(strings.TrimSuffix(w.pending, "\r"))
-		w.pending = ""
-	}
-}
-
-func (w *stderrLogger) log(message string) {
-	w.logger(fmt.Sprintf("[content mapper: %s] stderr: %s", w.mapperName, message))
+func mapperLogPrefix(mapperName string) string {
+	return "[content mapper: " + mapperName + "]"
 }
 
 type loggedProcess struct {
 	io.ReadWriteCloser
-	stderr *stderrLogger
+	stderr *ipc.StderrLogger
 }
 
 func (p *loggedProcess) Close() error {
 	err := p.ReadWriteCloser.Close()
-	p.stderr.flush()
+	p.stderr.Flush()
 	return err
 }
 
-type closeOnceReadWriteCloser struct {
-	io.ReadWriteCloser
-	once sync.Once
-	err  error
-}
-
-func (c *closeOnceReadWriteCloser) Close() error {
-	c.once.Do(func() { c.err = c.ReadWriteCloser.Close() })
-	return c.err
-}
-
-func (c *closeOnceReadWriteCloser) ExitCode() (int, bool) {
-	if state, ok := c.ReadWriteCloser.(processExitState); ok {
-		return state.ExitCode()
-	}
-	return 0, false
-}
-
 // NewHost creates a Host that spawns each mapper's process via the given spawner and drives it over a
 // JSON-RPC connection. The host's lifetime is bound to ctx: cancelling it (e.g. the CLI's signal context
 // on SIGINT, or a build/watch session ending) tears every mapper process down, so owners of a session
@@ -505,9 +408,9 @@ func NewHostWithOptions(ctx context.Context, spawner Spawner, diagnosticLocale l
 		diagnosticName := mapper.DiagnosticName()
 		spawnStart := time.Now()
 		var stderr io.Writer = io.Discard
-		var stderrLog *stderrLogger
+		var stderrLog *ipc.StderrLogger
 		if logger != nil {
-			stderrLog = &stderrLogger{mapperName: diagnosticName, logger: logger}
+			stderrLog = &ipc.StderrLogger{Prefix: mapperLogPrefix(diagnosticName), Logger: logger}
 			stderr = stderrLog
 		}
 		rwc, err := spawner.Spawn(mapper.Exec, mapper.PackageDirectory, stderr)
@@ -518,12 +421,12 @@ func NewHostWithOptions(ctx context.Context, spawner Spawner, diagnosticLocale l
 		if stderrLog != nil {
 			rwc = &loggedProcess{ReadWriteCloser: rwc, stderr: stderrLog}
 		}
-		rwc = &closeOnceReadWriteCloser{ReadWriteCloser: rwc}
+		rwc = &ipc.CloseOnceReadWriteCloser{ReadWriteCloser: rwc}
 		protocol := ipc.Protocol(ipc.NewJSONRPCProtocol(rwc))
 		if logger != nil {
-			protocol = &loggingProtocol{Protocol: protocol, mapperName: diagnosticName, logger: logger}
+			protocol = &ipc.LoggingProtocol{Protocol: protocol, Prefix: mapperLogPrefix(diagnosticName), Logger: logger}
 		}
-		conn := ipc.NewAsyncConnWithProtocol(rwc, protocol, rejectHandler{})
+		conn := ipc.NewAsyncConnWithProtocol(rwc, protocol, ipc.RejectHandler{Peer: "content mapper"})
 		go func() {
 			_ = conn.Run(ctx)
 			_ = rwc.Close()
@@ -1367,15 +1270,3 @@ func (n *positionNormalizer) normalize(position int) (int, error) {
 	}
 	return bytePosition, nil
 }
-
-// rejectHandler rejects any request initiated by the mapper. The content mapper protocol is currently
-// parent-driven only; a request from the child is a protocol violation.
-type rejectHandler struct{}
-
-func (rejectHandler) HandleRequest(ctx context.Context, method string, params json.Value) (any, error) {
-	return nil, fmt.Errorf("content mapper sent an unexpected request: %s", method)
-}
-
-func (rejectHandler) HandleNotification(ctx context.Context, method string, params json.Value) error {
-	return nil
-}
@@ -3,14 +3,28 @@ package core
 type BuildOptions struct {
 	_ noCopy
 
-	Dry               Tristate `json:"dry,omitzero"`
-	Force             Tristate `json:"force,omitzero"`
-	Verbose           Tristate `json:"verbose,omitzero"`
-	Builders          *int     `json:"builders,omitzero"`
-	StopBuildOnErrors Tristate `json:"stopBuildOnErrors,omitzero"`
+	Dry               Tristate         `json:"dry,omitzero"`
+	Force             Tristate         `json:"force,omitzero"`
+	Verbose           Tristate         `json:"verbose,omitzero"`
+	Builders          *int             `json:"builders,omitzero"`
+	StopBuildOnErrors Tristate         `json:"stopBuildOnErrors,omitzero"`
+	BuildCacheDir     string           `json:"buildCacheDir,omitzero"`
+	Graph             BuildGraphFormat `json:"graph,omitzero"`
+	AffectedBy        []string         `json:"affectedBy,omitzero"`
+	ListAffected      Tristate         `json:"listAffected,omitzero"`
 
 	// CompilerOptions are not parsed here and will be available on ParsedBuildCommandLine
 
 	// Internal fields
 	Clean Tristate `json:"clean,omitzero"`
 }
+
+// BuildGraphFormat selects the format in which tsc --build --graph prints the
+// project reference graph.
+type BuildGraphFormat int32
+
+const (
+	BuildGraphFormatNone BuildGraphFormat = 0
+	BuildGraphFormatDot  BuildGraphFormat = 1
+	BuildGraphFormatJSON BuildGraphFormat = 2
+)
@@ -84,6 +84,7 @@ type CompilerOptions struct {
 	NoUncheckedSideEffectImports              Tristate                                  `json:"noUncheckedSideEffectImports,omitzero"`
 	OutDir                                    string                                    `json:"outDir,omitzero"`
 	Paths                                     *collections.OrderedMap[string, []string] `json:"paths,omitzero"`
+	Plugins                                   []*PluginImport                           `json:"plugins,omitzero"`
 	PreserveConstEnums                        Tristate                                  `json:"preserveConstEnums,omitzero"`
 	PreserveSymlinks                          Tristate                                  `json:"preserveSymlinks,omitzero"`
 	Project                                   string                                    `json:"project,omitzero"`
@@ -132,32 +133,37 @@ type CompilerOptions struct {
 	OutFile string `json:"outFile,omitzero" deprecated:"true"`
 
 	// Internal fields
-	ConfigFilePath      string   `json:"configFilePath,omitzero"` // internal, but intentionally exposed via API
-	NoDtsResolution     Tristate `json:"noDtsResolution,omitzero" internal:"true"`
-	PathsBasePath       string   `json:"pathsBasePath,omitzero" internal:"true"`
-	Diagnostics         Tristate `json:"diagnostics,omitzero" internal:"true"`
-	ExtendedDiagnostics Tristate `json:"extendedDiagnostics,omitzero" internal:"true"`
-	GenerateCpuProfile  string   `json:"generateCpuProfile,omitzero" internal:"true"`
-	GenerateTrace       string   `json:"generateTrace,omitzero" internal:"true"`
-	ListEmittedFiles    Tristate `json:"listEmittedFiles,omitzero" internal:"true"`
-	ListFiles           Tristate `json:"listFiles,omitzero" internal:"true"`
-	ExplainFiles        Tristate `json:"explainFiles,omitzero" internal:"true"`
-	ListFilesOnly       Tristate `json:"listFilesOnly,omitzero" internal:"true"`
-	NoEmitForJsFiles    Tristate `json:"noEmitForJsFiles,omitzero" internal:"true"`
-	PreserveWatchOutput Tristate `json:"preserveWatchOutput,omitzero" internal:"true"`
-	Pretty              Tristate `json:"pretty,omitzero" internal:"true"`
-	Version             Tristate `json:"version,omitzero" internal:"true"`
-	Watch               Tristate `json:"watch,omitzero" internal:"true"`
-	ShowConfig          Tristate `json:"showConfig,omitzero" internal:"true"`
-	Build               Tristate `json:"build,omitzero" internal:"true"`
-	Help                Tristate `json:"help,omitzero" internal:"true"`
-	All                 Tristate `json:"all,omitzero" internal:"true"`
-	RunExternalCode     Tristate `json:"runExternalCode,omitzero" internal:"true"`
+	ConfigFilePath         string   `json:"configFilePath,omitzero"` // internal, but intentionally exposed via API
+	NoDtsResolution        Tristate `json:"noDtsResolution,omitzero" internal:"true"`
+	PathsBasePath          string   `json:"pathsBasePath,omitzero" internal:"true"`
+	Diagnostics            Tristate `json:"diagnostics,omitzero" internal:"true"`
+	ExtendedDiagnostics    Tristate `json:"extendedDiagnostics,omitzero" internal:"true"`
+	GenerateCpuProfile     string   `json:"generateCpuProfile,omitzero" internal:"true"`
+	GenerateTrace          string   `json:"generateTrace,omitzero" internal:"true"`
+	CheckPerformanceReport Tristate `json:"checkPerformanceReport,omitzero" internal:"true"`
+	ListEmittedFiles       Tristate `json:"listEmittedFiles,omitzero" internal:"true"`
+	ListFiles              Tristate `json:"listFiles,omitzero" internal:"true"`
+	ExplainFiles           Tristate `json:"explainFiles,omitzero" internal:"true"`
+	ListFilesOnly          Tristate `json:"listFilesOnly,omitzero" internal:"true"`
+	NoEmitForJsFiles       Tristate `json:"noEmitForJsFiles,omitzero" internal:"true"`
+	PreserveWatchOutput    Tristate `json:"preserveWatchOutput,omitzero" internal:"true"`
+	Pretty                 Tristate `json:"pretty,omitzero" internal:"true"`
+	Version                Tristate `json:"version,omitzero" internal:"true"`
+	Watch                  Tristate `json:"watch,omitzero" internal:"true"`
+	ShowConfig             Tristate `json:"showConfig,omitzero" internal:"true"`
+	Build                  Tristate `json:"build,omitzero" internal:"true"`
+	Help                   Tristate `json:"help,omitzero" internal:"true"`
+	All                    Tristate `json:"all,omitzero" internal:"true"`
+	RunExternalCode        Tristate `json:"runExternalCode,omitzero" internal:"true"`
 
 	PprofDir       string   `json:"pprofDir,omitzero"  internal:"true"`
+	AstCacheDir    string   `json:"astCacheDir,omitzero" internal:"true"`
 	SingleThreaded Tristate `json:"singleThreaded,omitzero" internal:"true"`
 	Quiet          Tristate `json:"quiet,omitzero" internal:"true"`
 	Checkers       *int     `json:"checkers,omitzero" internal:"true"`
+
+	DiagnosticFormat DiagnosticFormat `json:"diagnosticFormat,omitzero" internal:"true"`
+	WatchStatusPipe  string           `json:"watchStatusPipe,omitzero" internal:"true"`
 }
 
 // noCopy may be embedded into structs which must not be copied
@@ -503,6 +509,15 @@ func (newLine NewLineKind) GetNewLineCharacter() string {
 	}
 }
 
+// DiagnosticFormat selects a machine-readable output format for diagnostics reported by tsc.
+type DiagnosticFormat int32
+
+const (
+	DiagnosticFormatNone  DiagnosticFormat = 0
+	DiagnosticFormatJSON  DiagnosticFormat = 1
+	DiagnosticFormatSARIF DiagnosticFormat = 2
+)
+
 type ScriptTarget int32
 
 const (
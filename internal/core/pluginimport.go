@@ -0,0 +1,41 @@
+package core
+
+import "github.com/microsoft/typescript-go/internal/json"
+
+// PluginImport is an entry of the "plugins" compiler option, naming the npm package of a language
+// service plugin. The entry is kept as written so plugin-specific settings reach the plugin unchanged.
+type PluginImport struct {
+	// Name is the package name of the plugin.
+	Name string
+	// Config is the complete tsconfig entry, including "name".
+	Config json.Value
+}
+
+var (
+	_ json.MarshalerTo     = (*PluginImport)(nil)
+	_ json.UnmarshalerFrom = (*PluginImport)(nil)
+)
+
+func (p *PluginImport) MarshalJSONTo(enc *json.Encoder) error {
+	if len(p.Config) != 0 {
+		return enc.WriteValue(p.Config)
+	}
+	return json.MarshalEncode(enc, struct {
+		Name string `json:"name"`
+	}{p.Name})
+}
+
+func (p *PluginImport) UnmarshalJSONFrom(dec *json.Decoder) error {
+	config, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	var fields struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(config, &fields); err != nil {
+		return err
+	}
+	*p = PluginImport{Name: fields.Name, Config: config.Clone()}
+	return nil
+}
@@ -4414,6 +4414,34 @@ var The_invalid_diagnostic_directive_is_in_supplemental_output_0_returned_by_the
 
 var Diagnostic_directive_0_returned_by_the_content_mapper_has_an_invalid_unusedExpectDirectiveIndex = &Message{code: 100068, category: CategoryMessage, key: "Diagnostic_directive_0_returned_by_the_content_mapper_has_an_invalid_unusedExpectDirectiveIndex_100068", text: "Diagnostic directive {0} returned by the content mapper has an invalid 'unusedExpectDirectiveIndex'."}
 
+var Report_diagnostics_as_a_single_machine_readable_document_instead_of_formatted_text = &Message{code: 100069, category: CategoryMessage, key: "Report_diagnostics_as_a_single_machine_readable_document_instead_of_formatted_text_100069", text: "Report diagnostics as a single machine-readable document instead of formatted text."}
+
+var Cache_parsed_declaration_files_in_the_given_directory_and_reuse_them_in_later_compilations = &Message{code: 100070, category: CategoryMessage, key: "Cache_parsed_declaration_files_in_the_given_directory_and_reuse_them_in_later_compilations_100070", text: "Cache parsed declaration files in the given directory and reuse them in later compilations."}
+
+var Reuse_project_outputs_from_the_build_cache_in_the_given_directory_and_store_the_outputs_of_built_projects_there = &Message{code: 100071, category: CategoryMessage, key: "Reuse_project_outputs_from_the_build_cache_in_the_given_directory_and_store_the_outputs_of_built_projects_there_100071", text: "Reuse project outputs from the build cache in the given directory and store the outputs of built projects there."}
+
+var Restoring_outputs_of_project_0_from_the_build_cache = &Message{code: 100072, category: CategoryMessage, key: "Restoring_outputs_of_project_0_from_the_build_cache_100072", text: "Restoring outputs of project '{0}' from the build cache..."}
+
+var Failed_to_store_outputs_of_project_0_in_the_build_cache_Colon_1 = &Message{code: 100073, category: CategoryMessage, key: "Failed_to_store_outputs_of_project_0_in_the_build_cache_Colon_1_100073", text: "Failed to store outputs of project '{0}' in the build cache: {1}."}
+
+var Print_the_project_reference_graph_and_the_up_to_date_status_of_each_project_instead_of_building = &Message{code: 100074, category: CategoryMessage, key: "Print_the_project_reference_graph_and_the_up_to_date_status_of_each_project_instead_of_building_100074", text: "Print the project reference graph and the up-to-date status of each project instead of building."}
+
+var Build_only_the_projects_affected_by_changes_to_the_given_files_and_the_projects_they_reference = &Message{code: 100075, category: CategoryMessage, key: "Build_only_the_projects_affected_by_changes_to_the_given_files_and_the_projects_they_reference_100075", text: "Build only the projects affected by changes to the given files and the projects they reference."}
+
+var Files_affected_in_project_0_Colon_1 = &Message{code: 100076, category: CategoryMessage, key: "Files_affected_in_project_0_Colon_1_100076", text: "Files affected in project '{0}':{1}"}
+
+var Project_0_is_affected_because_it_references_an_affected_project = &Message{code: 100077, category: CategoryMessage, key: "Project_0_is_affected_because_it_references_an_affected_project_100077", text: "Project '{0}' is affected because it references an affected project."}
+
+var File_0_is_not_part_of_any_project_in_this_build = &Message{code: 100078, category: CategoryMessage, key: "File_0_is_not_part_of_any_project_in_this_build_100078", text: "File '{0}' is not part of any project in this build."}
+
+var Skipping_build_of_project_0_because_it_is_not_affected_by_the_changed_files = &Message{code: 100079, category: CategoryMessage, key: "Skipping_build_of_project_0_because_it_is_not_affected_by_the_changed_files_100079", text: "Skipping build of project '{0}' because it is not affected by the changed files."}
+
+var Report_the_files_declarations_and_type_relations_that_take_the_longest_to_check = &Message{code: 100080, category: CategoryMessage, key: "Report_the_files_declarations_and_type_relations_that_take_the_longest_to_check_100080", text: "Report the files, declarations and type relations that take the longest to check."}
+
+var Serve_the_watch_status_and_rebuild_events_over_JSON_RPC_on_the_given_pipe_or_socket = &Message{code: 100081, category: CategoryMessage, key: "Serve_the_watch_status_and_rebuild_events_over_JSON_RPC_on_the_given_pipe_or_socket_100081", text: "Serve the watch status and rebuild events over JSON-RPC on the given pipe or socket."}
+
+var Print_the_files_affected_by_changes_to_the_files_given_to_affectedBy_instead_of_building = &Message{code: 100082, category: CategoryMessage, key: "Print_the_files_affected_by_changes_to_the_files_given_to_affectedBy_instead_of_building_100082", text: "Print the files affected by changes to the files given to '--affectedBy' instead of building."}
+
 func keyToMessage(key Key) *Message {
 	switch key {
 	case "Unterminated_string_literal_1002":
@@ -8828,6 +8856,34 @@ func keyToMessage(key Key) *Message {
 		return The_invalid_diagnostic_directive_is_in_supplemental_output_0_returned_by_the_content_mapper
 	case "Diagnostic_directive_0_returned_by_the_content_mapper_has_an_invalid_unusedExpectDirectiveIndex_100068":
 		return Diagnostic_directive_0_returned_by_the_content_mapper_has_an_invalid_unusedExpectDirectiveIndex
+	case "Report_diagnostics_as_a_single_machine_readable_document_instead_of_formatted_text_100069":
+		return Report_diagnostics_as_a_single_machine_readable_document_instead_of_formatted_text
+	case "Cache_parsed_declaration_files_in_the_given_directory_and_reuse_them_in_later_compilations_100070":
+		return Cache_parsed_declaration_files_in_the_given_directory_and_reuse_them_in_later_compilations
+	case "Reuse_project_outputs_from_the_build_cache_in_the_given_directory_and_store_the_outputs_of_built_projects_there_100071":
+		return Reuse_project_outputs_from_the_build_cache_in_the_given_directory_and_store_the_outputs_of_built_projects_there
+	case "Restoring_outputs_of_project_0_from_the_build_cache_100072":
+		return Restoring_outputs_of_project_0_from_the_build_cache
+	case "Failed_to_store_outputs_of_project_0_in_the_build_cache_Colon_1_100073":
+		return Failed_to_store_outputs_of_project_0_in_the_build_cache_Colon_1
+	case "Print_the_project_reference_graph_and_the_up_to_date_status_of_each_project_instead_of_building_100074":
+		return Print_the_project_reference_graph_and_the_up_to_date_status_of_each_project_instead_of_building
+	case "Build_only_the_projects_affected_by_changes_to_the_given_files_and_the_projects_they_reference_100075":
+		return Build_only_the_projects_affected_by_changes_to_the_given_files_and_the_projects_they_reference
+	case "Files_affected_in_project_0_Colon_1_100076":
+		return Files_affected_in_project_0_Colon_1
+	case "Project_0_is_affected_because_it_references_an_affected_project_100077":
+		return Project_0_is_affected_because_it_references_an_affected_project
+	case "File_0_is_not_part_of_any_project_in_this_build_100078":
+		return File_0_is_not_part_of_any_project_in_this_build
+	case "Skipping_build_of_project_0_because_it_is_not_affected_by_the_changed_files_100079":
+		return Skipping_build_of_project_0_because_it_is_not_affected_by_the_changed_files
+	case "Report_the_files_declarations_and_type_relations_that_take_the_longest_to_check_100080":
+		return Report_the_files_declarations_and_type_relations_that_take_the_longest_to_check
+	case "Serve_the_watch_status_and_rebuild_events_over_JSON_RPC_on_the_given_pipe_or_socket_100081":
+		return Serve_the_watch_status_and_rebuild_events_over_JSON_RPC_on_the_given_pipe_or_socket
+	case "Print_the_files_affected_by_changes_to_the_files_given_to_affectedBy_instead_of_building_100082":
+		return Print_the_files_affected_by_changes_to_the_files_given_to_affectedBy_instead_of_building
 	default:
 		return nil
 	}
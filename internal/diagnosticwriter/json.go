@@ -0,0 +1,132 @@
+package diagnosticwriter
+
+import (
+	"io"
+
+	"github.com/microsoft/typescript-go/internal/json"
+	"github.com/microsoft/typescript-go/internal/scanner"
+	"github.com/microsoft/typescript-go/internal/tspath"
+)
+
+// ProjectDiagnostics is the set of diagnostics reported for a single project of a build.
+type ProjectDiagnostics struct {
+	Project     string
+	Diagnostics []Diagnostic
+}
+
+type jsonReport struct {
+	Diagnostics []*jsonDiagnostic `json:"diagnostics"`
+	Projects    []*jsonProject    `json:"projects,omitzero"`
+}
+
+type jsonProject struct {
+	Project     string            `json:"project"`
+	Diagnostics []*jsonDiagnostic `json:"diagnostics"`
+}
+
+type jsonDiagnostic struct {
+	Code               int32               `json:"code"`
+	Category           string              `json:"category"`
+	Source             string              `json:"source,omitzero"`
+	File               string              `json:"file,omitzero"`
+	Range              *jsonRange          `json:"range,omitzero"`
+	Message            string              `json:"message"`
+	MessageChain       []*jsonMessageChain `json:"messageChain,omitzero"`
+	RelatedInformation []*jsonDiagnostic   `json:"relatedInformation,omitzero"`
+}
+
+type jsonMessageChain struct {
+	Code     int32               `json:"code"`
+	Category string              `json:"category"`
+	Message  string              `json:"message"`
+	Next     []*jsonMessageChain `json:"next,omitzero"`
+}
+
+type jsonRange struct {
+	Start jsonPosition `json:"start"`
+	End   jsonPosition `json:"end"`
+}
+
+// jsonPosition is a one-based line and column; columns are counted in UTF-16 code units.
+type jsonPosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// WriteJSONDiagnostics writes diagnostics as a single JSON document. Diagnostics that do not belong to a
+// project are listed under "diagnostics"; the diagnostics of each project of a build are listed under
+// "projects", in the order given.
+func WriteJSONDiagnostics(output io.Writer, diags []Diagnostic, projects []ProjectDiagnostics, formatOpts *FormattingOptions) error {
+	if err := json.MarshalIndentWrite(output, toJSONReport(diags, projects, formatOpts), "", "  "); err != nil {
+		return err
+	}
+	_, err := io.WriteString(output, formatOpts.NewLine)
+	return err
+}
+
+// MarshalJSONDiagnostics encodes diagnostics in the same shape as WriteJSONDiagnostics, for embedding
+// in other JSON messages.
+func MarshalJSONDiagnostics(diags []Diagnostic, projects []ProjectDiagnostics, formatOpts *FormattingOptions) (json.Value, error) {
+	return json.Marshal(toJSONReport(diags, projects, formatOpts))
+}
+
+func toJSONReport(diags []Diagnostic, projects []ProjectDiagnostics, formatOpts *FormattingOptions) *jsonReport {
+	report := &jsonReport{Diagnostics: toJSONDiagnostics(diags, formatOpts)}
+	for _, project := range projects {
+		report.Projects = append(report.Projects, &jsonProject{
+			Project:     project.Project,
+			Diagnostics: toJSONDiagnostics(project.Diagnostics, formatOpts),
+		})
+	}
+	return report
+}
+
+func toJSONDiagnostics(diags []Diagnostic, formatOpts *FormattingOptions) []*jsonDiagnostic {
+	result := make([]*jsonDiagnostic, 0, len(diags))
+	for _, d := range diags {
+		result = append(result, toJSONDiagnostic(d, formatOpts))
+	}
+	return result
+}
+
+func toJSONDiagnostic(d Diagnostic, formatOpts *FormattingOptions) *jsonDiagnostic {
+	result := &jsonDiagnostic{
+		Code:     d.Code(),
+		Category: d.Category().Name(),
+		Source:   d.Source(),
+		Message:  d.Localize(formatOpts.Locale),
+	}
+	if file := d.File(); file != nil {
+		result.File = tspath.ConvertToRelativePath(file.FileName(), formatOpts.ComparePathsOptions)
+		result.Range = &jsonRange{
+			Start: toJSONPosition(file, d.Pos()),
+			End:   toJSONPosition(file, d.End()),
+		}
+	}
+	result.MessageChain = toJSONMessageChain(d.MessageChain(), formatOpts)
+	for _, related := range d.RelatedInformation() {
+		result.RelatedInformation = append(result.RelatedInformation, toJSONDiagnostic(related, formatOpts))
+	}
+	return result
+}
+
+func toJSONMessageChain(chain []Diagnostic, formatOpts *FormattingOptions) []*jsonMessageChain {
+	if len(chain) == 0 {
+		return nil
+	}
+	result := make([]*jsonMessageChain, 0, len(chain))
+	for _, c := range chain {
+		result = append(result, &jsonMessageChain{
+			Code:     c.Code(),
+			Category: c.Category().Name(),
+			Message:  c.Localize(formatOpts.Locale),
+			Next:     toJSONMessageChain(c.MessageChain(), formatOpts),
+		})
+	}
+	return result
+}
+
+func toJSONPosition(file FileLike, pos int) jsonPosition {
+	line, character := scanner.GetECMALineAndUTF16CharacterOfPosition(file, pos)
+	return jsonPosition{Line: line + 1, Column: int(character) + 1}
+}
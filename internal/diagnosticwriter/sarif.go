@@ -0,0 +1,179 @@
+package diagnosticwriter
+
+import (
+	"io"
+	"net/url"
+	"strconv"
+
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/diagnostics"
+	"github.com/microsoft/typescript-go/internal/json"
+	"github.com/microsoft/typescript-go/internal/tspath"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+type sarifLog struct {
+	Schema  string      `json:"$schema"`
+	Version string      `json:"version"`
+	Runs    []*sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool              *sarifTool              `json:"tool"`
+	AutomationDetails *sarifAutomationDetails `json:"automationDetails,omitzero"`
+	ColumnKind        string                  `json:"columnKind"`
+	Results           []*sarifResult          `json:"results"`
+}
+
+type sarifTool struct {
+	Driver *sarifToolComponent `json:"driver"`
+}
+
+type sarifToolComponent struct {
+	Name           string `json:"name"`
+	Version        string `json:"version"`
+	InformationURI string `json:"informationUri"`
+}
+
+type sarifAutomationDetails struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID           string           `json:"ruleId"`
+	Level            string           `json:"level"`
+	Message          *sarifMessage    `json:"message"`
+	Locations        []*sarifLocation `json:"locations,omitzero"`
+	RelatedLocations []*sarifLocation `json:"relatedLocations,omitzero"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation"`
+	Message          *sarifMessage          `json:"message,omitzero"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation *sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// sarifRegion uses one-based lines and columns; columns are counted in UTF-16 code units (see columnKind).
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine"`
+	EndColumn   int `json:"endColumn"`
+}
+
+// WriteSARIFDiagnostics writes diagnostics as a SARIF 2.1.0 log. Diagnostics that do not belong to a project
+// are written as one run; the diagnostics of each project of a build are written as a separate run,
+// identified by the project's config file name.
+func WriteSARIFDiagnostics(output io.Writer, diags []Diagnostic, projects []ProjectDiagnostics, formatOpts *FormattingOptions) error {
+	log := &sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+	}
+	if len(projects) == 0 || len(diags) > 0 {
+		log.Runs = append(log.Runs, newSARIFRun("", diags, formatOpts))
+	}
+	for _, project := range projects {
+		log.Runs = append(log.Runs, newSARIFRun(project.Project, project.Diagnostics, formatOpts))
+	}
+	if err := json.MarshalIndentWrite(output, log, "", "  "); err != nil {
+		return err
+	}
+	_, err := io.WriteString(output, formatOpts.NewLine)
+	return err
+}
+
+func newSARIFRun(project string, diags []Diagnostic, formatOpts *FormattingOptions) *sarifRun {
+	run := &sarifRun{
+		Tool: &sarifTool{Driver: &sarifToolComponent{
+			Name:           "tsc",
+			Version:        core.Version(),
+			InformationURI: "https://www.typescriptlang.org/",
+		}},
+		ColumnKind: "utf16CodeUnits",
+		Results:    make([]*sarifResult, 0, len(diags)),
+	}
+	if project != "" {
+		run.AutomationDetails = &sarifAutomationDetails{ID: project + "/"}
+	}
+	for _, d := range diags {
+		run.Results = append(run.Results, newSARIFResult(d, formatOpts))
+	}
+	return run
+}
+
+func newSARIFResult(d Diagnostic, formatOpts *FormattingOptions) *sarifResult {
+	result := &sarifResult{
+		RuleID:  diagnosticPrefix(d) + strconv.Itoa(int(d.Code())),
+		Level:   sarifLevel(d.Category()),
+		Message: &sarifMessage{Text: FlattenDiagnosticMessage(d, "\n", formatOpts.Locale)},
+	}
+	if file := d.File(); file != nil {
+		result.Locations = []*sarifLocation{{PhysicalLocation: newSARIFPhysicalLocation(file, d.Pos(), d.End(), formatOpts)}}
+	}
+	for _, related := range d.RelatedInformation() {
+		file := related.File()
+		if file == nil {
+			continue
+		}
+		result.RelatedLocations = append(result.RelatedLocations, &sarifLocation{
+			PhysicalLocation: newSARIFPhysicalLocation(file, related.Pos(), related.End(), formatOpts),
+			Message:          &sarifMessage{Text: FlattenDiagnosticMessage(related, "\n", formatOpts.Locale)},
+		})
+	}
+	return result
+}
+
+func newSARIFPhysicalLocation(file FileLike, pos int, end int, formatOpts *FormattingOptions) *sarifPhysicalLocation {
+	start := toJSONPosition(file, pos)
+	finish := toJSONPosition(file, end)
+	return &sarifPhysicalLocation{
+		ArtifactLocation: &sarifArtifactLocation{URI: sarifURI(file.FileName(), formatOpts)},
+		Region: &sarifRegion{
+			StartLine:   start.Line,
+			StartColumn: start.Column,
+			EndLine:     finish.Line,
+			EndColumn:   finish.Column,
+		},
+	}
+}
+
+// sarifURI returns the file's path relative to the current directory as a URI reference, or an absolute
+// file URI when the file cannot be expressed relative to it.
+func sarifURI(fileName string, formatOpts *FormattingOptions) string {
+	relativeFileName := tspath.ConvertToRelativePath(fileName, formatOpts.ComparePathsOptions)
+	if !tspath.IsRootedDiskPath(relativeFileName) {
+		return (&url.URL{Path: relativeFileName}).String()
+	}
+	path := relativeFileName
+	if path[0] != '/' {
+		path = "/" + path
+	}
+	return (&url.URL{Scheme: "file", Path: path}).String()
+}
+
+func sarifLevel(category diagnostics.Category) string {
+	switch category {
+	case diagnostics.CategoryError:
+		return "error"
+	case diagnostics.CategoryWarning:
+		return "warning"
+	default:
+		return "note"
+	}
+}
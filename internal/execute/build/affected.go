@@ -0,0 +1,154 @@
+package build
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/collections"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/diagnostics"
+	"github.com/microsoft/typescript-go/internal/execute/incremental"
+	"github.com/microsoft/typescript-go/internal/outputpaths"
+	"github.com/microsoft/typescript-go/internal/tspath"
+)
+
+// readBuildInfoProgram returns the state recorded in the project's build info,
+// or nil if the project has not been built incrementally.
+func (t *BuildTask) readBuildInfoProgram(orchestrator *Orchestrator) *incremental.Program {
+	if t.resolved == nil {
+		return nil
+	}
+	contentMapperProject, err := t.getContentMapperProject(orchestrator)
+	if err != nil {
+		return nil
+	}
+	return incremental.ReadBuildInfoProgram(t.resolved, orchestrator.host, &compilerHost{
+		host:                 orchestrator.host,
+		contentMapperProject: contentMapperProject,
+	})
+}
+
+// computeAffectedProjects maps the files given to --affectedBy to the projects
+// that contain them, and reports the files affected in those projects and in
+// every project that references them, directly or transitively. Only the
+// affected projects and the projects they reference are built.
+func (o *Orchestrator) computeAffectedProjects() {
+	reportStatus := o.createBuilderStatusReporter(nil)
+	changedFileNames := core.Map(o.opts.Command.BuildOptions.AffectedBy, func(fileName string) string {
+		return tspath.GetNormalizedAbsolutePath(fileName, o.comparePathsOptions.CurrentDirectory)
+	})
+	changed := core.Map(changedFileNames, o.toPath)
+
+	// Find the projects that contain the changed files, either as root files or
+	// as files their programs included when they were last built.
+	programs := make(map[tspath.Path]*incremental.Program, len(o.order))
+	var owned collections.Set[tspath.Path]
+	var queue []string
+	for _, config := range o.order {
+		path := o.toPath(config)
+		task := o.getTask(path)
+		if task.resolved == nil {
+			continue
+		}
+		program := task.readBuildInfoProgram(o)
+		programs[path] = program
+		var files collections.Set[tspath.Path]
+		if program != nil {
+			for _, file := range program.GetFilesAffectedBy(changed) {
+				files.Add(file)
+			}
+		}
+		fileNamesByPath := task.resolved.FileNamesByPath()
+		owns := false
+		for _, file := range changed {
+			if _, isRoot := fileNamesByPath[file]; isRoot || files.Has(file) {
+				owned.Add(file)
+				owns = true
+			}
+		}
+		if owns {
+			queue = append(queue, config)
+		}
+	}
+	for index, file := range changed {
+		if !owned.Has(file) {
+			reportStatus(ast.NewCompilerDiagnostic(diagnostics.File_0_is_not_part_of_any_project_in_this_build, o.relativeFileName(changedFileNames[index])))
+		}
+	}
+
+	var affected collections.Set[tspath.Path]
+	for len(queue) > 0 {
+		config := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+		if affected.AddIfAbsent(o.toPath(config)) {
+			queue = append(queue, o.Downstream(config)...)
+		}
+	}
+
+	// Report the affected files of each project in build order, so that the
+	// declaration files emitted for the affected files of a project can be
+	// followed into the projects that reference it.
+	libraryDirectory := o.host.DefaultLibraryPath()
+	var upstreamOutputs collections.Set[tspath.Path]
+	for _, config := range o.order {
+		path := o.toPath(config)
+		task := o.getTask(path)
+		if !affected.Has(path) || task.resolved == nil || len(task.resolved.FileNames()) == 0 {
+			continue
+		}
+		var files []string
+		if program := programs[path]; program != nil {
+			fileNamesByPath := task.resolved.FileNamesByPath()
+			for _, file := range program.GetFilesAffectedBy(changed) {
+				if upstreamOutputs.Has(file) || tspath.StartsWithDirectory(string(file), libraryDirectory, o.comparePathsOptions.UseCaseSensitiveFileNames) {
+					continue
+				}
+				files = append(files, core.IfElse(fileNamesByPath[file] != "", fileNamesByPath[file], string(file)))
+			}
+		} else {
+			files = task.resolved.FileNames()
+		}
+		if len(files) == 0 {
+			reportStatus(ast.NewCompilerDiagnostic(diagnostics.Project_0_is_affected_because_it_references_an_affected_project, o.relativeFileName(config)))
+			continue
+		}
+		reportStatus(ast.NewCompilerDiagnostic(
+			diagnostics.Files_affected_in_project_0_Colon_1,
+			o.relativeFileName(config),
+			strings.Join(core.Map(files, func(f string) string {
+				return "\r\n    * " + o.relativeFileName(f)
+			}), ""),
+		))
+		if task.resolved.CompilerOptions().GetEmitDeclarations() {
+			for _, file := range files {
+				if tspath.IsDeclarationFileName(file) || tspath.FileExtensionIs(file, tspath.ExtensionJson) {
+					continue
+				}
+				if output := outputpaths.GetOutputDeclarationFileNameWorker(file, task.resolved.CompilerOptions(), task.resolved); output != "" {
+					outputPath := o.toPath(output)
+					upstreamOutputs.Add(outputPath)
+					changed = append(changed, outputPath)
+				}
+			}
+		}
+	}
+
+	// Affected projects can only be built once the projects they reference are.
+	// Solutions build nothing themselves, so their references are not needed.
+	o.affected = &collections.Set[tspath.Path]{}
+	for _, config := range slices.Backward(o.order) {
+		path := o.toPath(config)
+		if !affected.Has(path) && !o.affected.Has(path) {
+			continue
+		}
+		o.affected.Add(path)
+		task := o.getTask(path)
+		if task.resolved == nil || len(task.resolved.FileNames()) == 0 {
+			continue
+		}
+		for _, upstream := range task.upStream {
+			o.affected.Add(o.toPath(upstream.task.config))
+		}
+	}
+}
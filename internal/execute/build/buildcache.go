@@ -0,0 +1,374 @@
+package build
+
+import (
+	"encoding/hex"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/compiler"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/diagnostics"
+	"github.com/microsoft/typescript-go/internal/execute/incremental"
+	"github.com/microsoft/typescript-go/internal/json"
+	"github.com/microsoft/typescript-go/internal/module"
+	"github.com/microsoft/typescript-go/internal/tspath"
+	"github.com/microsoft/typescript-go/internal/vfs"
+	"github.com/zeebo/xxh3"
+)
+
+// CacheStore holds the entries of a build cache. Projects are built
+// concurrently, so implementations must be safe for concurrent use.
+type CacheStore interface {
+	// Get returns the entry stored under key, if any.
+	Get(key string) (data []byte, ok bool)
+	// Put stores data under key, replacing any existing entry.
+	Put(key string, data []byte) error
+}
+
+type directoryCacheStore struct {
+	fs  vfs.FS
+	dir string
+}
+
+// NewDirectoryCacheStore returns a CacheStore that keeps each entry in a file
+// in dir.
+func NewDirectoryCacheStore(fs vfs.FS, dir string) CacheStore {
+	return &directoryCacheStore{fs: fs, dir: dir}
+}
+
+func (s *directoryCacheStore) Get(key string) ([]byte, bool) {
+	data, ok := s.fs.ReadFile(s.entryFileName(key))
+	return []byte(data), ok
+}
+
+func (s *directoryCacheStore) Put(key string, data []byte) error {
+	return s.fs.WriteFile(s.entryFileName(key), string(data))
+}
+
+func (s *directoryCacheStore) entryFileName(key string) string {
+	return tspath.CombinePaths(s.dir, key+".json")
+}
+
+// buildCacheEntry holds the outputs of a project built from the inputs
+// described by the key the entry is stored under. File names are relative to
+// the project's config file directory so that entries can be shared between
+// checkouts in different locations.
+type buildCacheEntry struct {
+	// Inputs maps the files the program read besides its root files and
+	// default libraries, which are already part of the key, to hashes of their
+	// text. A file that did not exist maps to an empty string.
+	Inputs map[string]string `json:"inputs,omitzero"`
+	// Resolutions maps the files of the project to the module names and type
+	// reference directives resolved for them, so that files created in the
+	// locations that were looked up invalidate the entry.
+	Resolutions map[string][]buildCacheResolution `json:"resolutions,omitzero"`
+	// TypeDirectives lists the type reference directives included
+	// automatically, which change as packages are added to the type roots.
+	TypeDirectives []string `json:"typeDirectives,omitzero"`
+	// Outputs maps each output file, including the .tsbuildinfo file, to its
+	// text.
+	Outputs map[string]string `json:"outputs"`
+}
+
+type buildCacheResolution struct {
+	Name          string              `json:"name"`
+	Mode          core.ResolutionMode `json:"mode,omitzero"`
+	TypeReference bool                `json:"typeReference,omitzero"`
+	// ResolvedFileName is empty if the name did not resolve.
+	ResolvedFileName string `json:"resolvedFileName,omitzero"`
+}
+
+func hashText(text string) string {
+	sum := xxh3.HashString128(text).Bytes()
+	return hex.EncodeToString(sum[:])
+}
+
+// canUseBuildCache reports whether the outputs of the task can be stored in and
+// restored from the build cache. The output of content mappers depends on
+// external processes the cache key does not capture.
+func (t *BuildTask) canUseBuildCache(orchestrator *Orchestrator) bool {
+	return orchestrator.buildCache != nil && t.resolved != nil && len(t.resolved.ContentMappers()) == 0
+}
+
+// buildCacheKey computes the key of the task's cache entry from the compiler
+// version, the build options, the text of the project's config files and root
+// files, and the declaration outputs of its upstream projects.
+func (t *BuildTask) buildCacheKey(orchestrator *Orchestrator) (string, bool) {
+	fs := orchestrator.host.FS()
+	configDir := tspath.GetDirectoryPath(t.config)
+	h := xxh3.New()
+	write := func(s string) {
+		_, _ = h.WriteString(strconv.Itoa(len(s)))
+		_, _ = h.WriteString(":")
+		_, _ = h.WriteString(s)
+	}
+	writeFile := func(fileName string) bool {
+		text, ok := fs.ReadFile(fileName)
+		write(tspath.GetRelativePathFromDirectory(configDir, fileName, orchestrator.comparePathsOptions))
+		write(text)
+		return ok
+	}
+
+	write(core.Version())
+	commandLineOptions, err := json.Marshal(orchestrator.opts.Command.CompilerOptions, json.Deterministic(true))
+	if err != nil {
+		return "", false
+	}
+	write(string(commandLineOptions))
+	for _, configFileName := range slices.Concat([]string{t.config}, t.resolved.ExtendedSourceFiles()) {
+		if !writeFile(configFileName) {
+			return "", false
+		}
+	}
+	for _, fileName := range t.resolved.FileNames() {
+		if !writeFile(fileName) {
+			return "", false
+		}
+	}
+	for _, upstream := range t.upStream {
+		if upstream.task.resolved == nil {
+			return "", false
+		}
+		for outputFileName := range upstream.task.resolved.GetOutputFileNames() {
+			if tspath.IsDeclarationFileName(outputFileName) && !writeFile(outputFileName) {
+				return "", false
+			}
+		}
+	}
+	sum := h.Sum128().Bytes()
+	return hex.EncodeToString(sum[:]), true
+}
+
+// restoreFromBuildCache writes the outputs of the task's cache entry, if there
+// is one for its current inputs, and reports whether the project was restored.
+func (t *BuildTask) restoreFromBuildCache(orchestrator *Orchestrator) bool {
+	if !t.canUseBuildCache(orchestrator) || orchestrator.opts.Command.BuildOptions.Force.IsTrue() {
+		return false
+	}
+	key, ok := t.buildCacheKey(orchestrator)
+	if !ok {
+		return false
+	}
+	data, ok := orchestrator.buildCache.Get(key)
+	if !ok {
+		return false
+	}
+	var entry buildCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || len(entry.Outputs) == 0 {
+		return false
+	}
+
+	fs := orchestrator.host.FS()
+	configDir := tspath.GetDirectoryPath(t.config)
+	if !t.isOutputOfProject(orchestrator, configDir, &entry) {
+		return false
+	}
+	var packageJsons []string
+	for fileName, hash := range entry.Inputs {
+		fileName = tspath.GetNormalizedAbsolutePath(fileName, configDir)
+		text, ok := fs.ReadFile(fileName)
+		if hash != core.IfElse(ok, hashText(text), "") {
+			return false
+		}
+		if tspath.GetBaseFileName(fileName) == "package.json" {
+			packageJsons = append(packageJsons, fileName)
+		}
+	}
+	if !t.resolutionsUnchanged(orchestrator, &entry) {
+		return false
+	}
+
+	if orchestrator.opts.Command.BuildOptions.Verbose.IsTrue() {
+		t.result.reportStatus(ast.NewCompilerDiagnostic(diagnostics.Restoring_outputs_of_project_0_from_the_build_cache, orchestrator.relativeFileName(t.config)))
+	}
+	buildInfoPath := orchestrator.toPath(t.resolved.GetBuildInfoFileName())
+	var oldestOutputFileName string
+	for _, relativeFileName := range slices.Sorted(maps.Keys(entry.Outputs)) {
+		fileName := tspath.GetNormalizedAbsolutePath(relativeFileName, configDir)
+		text := entry.Outputs[relativeFileName]
+		if err := fs.WriteFile(fileName, text); err != nil {
+			// Building the project overwrites whatever was restored.
+			return false
+		}
+		if orchestrator.toPath(fileName) == buildInfoPath {
+			var buildInfo incremental.BuildInfo
+			if err := json.Unmarshal([]byte(text), &buildInfo); err != nil {
+				return false
+			}
+			t.onBuildInfoEmit(orchestrator, fileName, &buildInfo, true /*hasChangedDtsFile*/)
+			continue
+		}
+		if oldestOutputFileName == "" {
+			oldestOutputFileName = fileName
+		}
+		if t.storeOutputTimeStamp(orchestrator) {
+			orchestrator.host.storeMTime(fileName, orchestrator.opts.Sys.Now())
+		}
+	}
+
+	t.packageJsons = packageJsons
+	t.result.buildKind = buildKindRestored
+	t.status = &upToDateStatus{kind: upToDateStatusTypeUpToDate, data: core.IfElse(oldestOutputFileName != "", oldestOutputFileName, t.resolved.GetBuildInfoFileName())}
+	return true
+}
+
+// isOutputOfProject reports whether every file in the outputs of entry is an
+// output of the project. Entries may come from a store shared between
+// machines, so an entry that would write anywhere else is ignored.
+func (t *BuildTask) isOutputOfProject(orchestrator *Orchestrator, configDir string, entry *buildCacheEntry) bool {
+	outputs := make(map[tspath.Path]struct{})
+	for outputFileName := range t.resolved.GetOutputFileNames() {
+		outputs[orchestrator.toPath(outputFileName)] = struct{}{}
+	}
+	if buildInfoFileName := t.resolved.GetBuildInfoFileName(); buildInfoFileName != "" {
+		outputs[orchestrator.toPath(buildInfoFileName)] = struct{}{}
+	}
+	for relativeFileName := range entry.Outputs {
+		if _, ok := outputs[orchestrator.toPath(tspath.GetNormalizedAbsolutePath(relativeFileName, configDir))]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// storeInBuildCache stores the outputs of a project that built without errors
+// in the build cache.
+func (t *BuildTask) storeInBuildCache(orchestrator *Orchestrator) {
+	if !t.canUseBuildCache(orchestrator) || t.result.program == nil || t.status.kind != upToDateStatusTypeUpToDate {
+		return
+	}
+	key, ok := t.buildCacheKey(orchestrator)
+	if !ok {
+		return
+	}
+
+	fs := orchestrator.host.FS()
+	configDir := tspath.GetDirectoryPath(t.config)
+	toRelative := func(fileName string) string {
+		return tspath.GetRelativePathFromDirectory(configDir, fileName, orchestrator.comparePathsOptions)
+	}
+	entry := buildCacheEntry{
+		Inputs:  make(map[string]string),
+		Outputs: make(map[string]string),
+	}
+	program := t.result.program.GetProgram()
+	roots := make(map[tspath.Path]struct{}, len(t.resolved.FileNames()))
+	for _, fileName := range t.resolved.FileNames() {
+		roots[orchestrator.toPath(fileName)] = struct{}{}
+	}
+	for _, file := range program.GetSourceFiles() {
+		if _, ok := roots[file.Path()]; ok || program.IsSourceFileDefaultLibrary(file.Path()) {
+			continue
+		}
+		entry.Inputs[toRelative(file.FileName())] = hashText(file.Text())
+	}
+	entry.Resolutions = t.buildCacheResolutions(orchestrator, program, toRelative)
+	entry.TypeDirectives = module.NewResolver(orchestrator.host, t.resolved.CompilerOptions(), "", "", nil).GetAutomaticTypeDirectiveNames(t.resolved.CompilerOptions())
+	for _, packageJson := range t.packageJsons {
+		text, ok := fs.ReadFile(packageJson)
+		entry.Inputs[toRelative(packageJson)] = core.IfElse(ok, hashText(text), "")
+	}
+	for outputFileName := range t.resolved.GetOutputFileNames() {
+		if text, ok := fs.ReadFile(outputFileName); ok {
+			entry.Outputs[toRelative(outputFileName)] = text
+		}
+	}
+	if buildInfoFileName := t.resolved.GetBuildInfoFileName(); buildInfoFileName != "" {
+		if text, ok := fs.ReadFile(buildInfoFileName); ok {
+			entry.Outputs[toRelative(buildInfoFileName)] = text
+		}
+	}
+	if len(entry.Outputs) == 0 {
+		return
+	}
+
+	data, err := json.Marshal(&entry, json.Deterministic(true))
+	if err == nil {
+		err = orchestrator.buildCache.Put(key, data)
+	}
+	if err != nil {
+		t.result.reportStatus(ast.NewCompilerDiagnostic(diagnostics.Failed_to_store_outputs_of_project_0_in_the_build_cache_Colon_1, orchestrator.relativeFileName(t.config), err.Error()))
+	}
+}
+
+// buildCacheResolutions returns the resolutions the program made for the files
+// of the project, including the automatic type reference directives. Files of
+// upstream projects are resolved with their own options, and their outputs are
+// already part of the key.
+func (t *BuildTask) buildCacheResolutions(orchestrator *Orchestrator, program *compiler.Program, toRelative func(string) string) map[string][]buildCacheResolution {
+	inferredTypesContainingFile := tspath.CombinePaths(tspath.GetDirectoryPath(t.config), module.InferredTypesContainingFile)
+	containingFileName := func(path tspath.Path) string {
+		if path == orchestrator.toPath(inferredTypesContainingFile) {
+			return inferredTypesContainingFile
+		}
+		file := program.GetSourceFileByPath(path)
+		if file == nil || program.IsSourceFileDefaultLibrary(path) || program.GetRedirectForResolution(file) != nil {
+			return ""
+		}
+		return file.FileName()
+	}
+	resolutions := make(map[string][]buildCacheResolution)
+	add := func(path tspath.Path, resolution buildCacheResolution, resolvedFileName string) {
+		if fileName := containingFileName(path); fileName != "" {
+			if resolvedFileName != "" {
+				resolution.ResolvedFileName = toRelative(resolvedFileName)
+			}
+			resolutions[toRelative(fileName)] = append(resolutions[toRelative(fileName)], resolution)
+		}
+	}
+	for path, resolutionsInFile := range program.GetResolvedModules() {
+		for key, resolved := range resolutionsInFile {
+			add(path, buildCacheResolution{Name: key.Name, Mode: key.Mode}, core.IfElse(resolved.IsResolved(), resolved.ResolvedFileName, ""))
+		}
+	}
+	for path, resolutionsInFile := range program.GetResolvedTypeReferenceDirectives() {
+		for key, resolved := range resolutionsInFile {
+			add(path, buildCacheResolution{Name: key.Name, Mode: key.Mode, TypeReference: true}, resolved.ResolvedFileName)
+		}
+	}
+	for _, resolutionsInFile := range resolutions {
+		slices.SortFunc(resolutionsInFile, func(a, b buildCacheResolution) int {
+			if a.TypeReference != b.TypeReference {
+				return core.IfElse(a.TypeReference, 1, -1)
+			}
+			if c := strings.Compare(a.Name, b.Name); c != 0 {
+				return c
+			}
+			return int(a.Mode) - int(b.Mode)
+		})
+	}
+	return resolutions
+}
+
+// resolutionsUnchanged reports whether the automatic type reference directives
+// and the resolutions recorded in entry are the same for the current files.
+func (t *BuildTask) resolutionsUnchanged(orchestrator *Orchestrator, entry *buildCacheEntry) bool {
+	options := t.resolved.CompilerOptions()
+	resolver := module.NewResolver(orchestrator.host, options, "", "", nil)
+	if !slices.Equal(resolver.GetAutomaticTypeDirectiveNames(options), entry.TypeDirectives) {
+		return false
+	}
+	configDir := tspath.GetDirectoryPath(t.config)
+	for containingFileName, resolutions := range entry.Resolutions {
+		containingFileName = tspath.GetNormalizedAbsolutePath(containingFileName, configDir)
+		for _, resolution := range resolutions {
+			var resolvedFileName string
+			if resolution.TypeReference {
+				resolved, _ := resolver.ResolveTypeReferenceDirective(resolution.Name, containingFileName, resolution.Mode, nil)
+				resolvedFileName = resolved.ResolvedFileName
+			} else if resolved, _ := resolver.ResolveModuleName(resolution.Name, containingFileName, resolution.Mode, nil); resolved.IsResolved() {
+				resolvedFileName = resolved.ResolvedFileName
+			}
+			if resolvedFileName != "" {
+				resolvedFileName = tspath.GetRelativePathFromDirectory(configDir, resolvedFileName, orchestrator.comparePathsOptions)
+			}
+			if resolvedFileName != resolution.ResolvedFileName {
+				return false
+			}
+		}
+	}
+	return true
+}
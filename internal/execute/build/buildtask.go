@@ -28,6 +28,7 @@ const (
 	buildKindNone buildKind = iota
 	buildKindPseudo
 	buildKindProgram
+	buildKindRestored
 )
 
 type upstreamTask struct {
@@ -45,6 +46,7 @@ type taskResult struct {
 	builder            strings.Builder
 	reportStatus       tsc.DiagnosticReporter
 	diagnosticReporter tsc.DiagnosticReporter
+	diagnostics        []*ast.Diagnostic // only collected for --diagnosticFormat
 	exitStatus         tsc.ExitStatus
 	statistics         *tsc.Statistics
 	program            *incremental.Program
@@ -56,7 +58,7 @@ type BuildTask struct {
 	config     string
 	resolved   *tsoptions.ParsedCommandLine
 	upStream   []*upstreamTask
-	downStream []*BuildTask // Only set and used in watch mode
+	downStream []*BuildTask // Only set in watch mode and with --affectedBy
 	status     *upToDateStatus
 	done       chan struct{}
 
@@ -124,7 +126,17 @@ func (t *BuildTask) report(orchestrator *Orchestrator, configPath tspath.Path, b
 	if len(t.errors) > 0 {
 		buildResult.errors = append(core.IfElse(buildResult.errors != nil, buildResult.errors, []*ast.Diagnostic{}), t.errors...)
 	}
-	fmt.Fprint(orchestrator.opts.Sys.Writer(), t.result.builder.String())
+	writer := orchestrator.opts.Sys.Writer()
+	if orchestrator.structuredReporter != nil {
+		// Text requested by a project's own config, such as listFiles, goes to stderr so that stdout
+		// holds only the structured document.
+		writer = orchestrator.opts.Sys.ErrorWriter()
+	}
+	fmt.Fprint(writer, t.result.builder.String())
+	if orchestrator.structuredReporter != nil {
+		orchestrator.structuredReporter.ReportProjectDiagnostics(orchestrator.relativeFileName(t.config), t.result.diagnostics)
+	}
+	orchestrator.statusServer.ProjectBuildFinished(orchestrator.relativeFileName(t.config), t.errors)
 	if t.result.exitStatus > buildResult.result.Status {
 		buildResult.result.Status = t.result.exitStatus
 	}
@@ -141,6 +153,8 @@ func (t *BuildTask) report(orchestrator *Orchestrator, configPath tspath.Path, b
 		buildResult.statistics.ProjectsBuilt++
 	case buildKindPseudo:
 		buildResult.statistics.TimestampUpdates++
+	case buildKindRestored:
+		buildResult.statistics.ProjectsRestored++
 	}
 	buildResult.filesToDelete = append(buildResult.filesToDelete, t.result.filesToDelete...)
 	t.result = nil
@@ -154,7 +168,11 @@ func (t *BuildTask) buildProject(orchestrator *Orchestrator, path tspath.Path) {
 		t.status = t.getUpToDateStatus(orchestrator, path)
 		t.reportUpToDateStatus(orchestrator)
 		if !t.handleStatusThatDoesntRequireBuild(orchestrator) {
-			t.compileAndEmit(orchestrator, path)
+			orchestrator.statusServer.ProjectBuildStarted(orchestrator.relativeFileName(t.config))
+			if !t.restoreFromBuildCache(orchestrator) {
+				t.compileAndEmit(orchestrator, path)
+				t.storeInBuildCache(orchestrator)
+			}
 			t.updateDownstream(orchestrator, path)
 		} else {
 			if t.resolved != nil {
@@ -178,6 +196,16 @@ func (t *BuildTask) buildProject(orchestrator *Orchestrator, path tspath.Path) {
 	t.unblockDownstream()
 }
 
+// skipUnaffectedProject completes the task without building the project when
+// it is neither affected by the files given to --affectedBy nor referenced by a
+// project that is.
+func (t *BuildTask) skipUnaffectedProject(orchestrator *Orchestrator) {
+	if orchestrator.opts.Command.BuildOptions.Verbose.IsTrue() {
+		t.result.reportStatus(ast.NewCompilerDiagnostic(diagnostics.Skipping_build_of_project_0_because_it_is_not_affected_by_the_changed_files, orchestrator.relativeFileName(t.config)))
+	}
+	t.unblockDownstream()
+}
+
 func (t *BuildTask) updateDownstream(orchestrator *Orchestrator, path tspath.Path) {
 	if t.isInitialCycle {
 		return
@@ -253,8 +281,9 @@ func (t *BuildTask) compileAndEmit(orchestrator *Orchestrator, path tspath.Path)
 	compileTimes.BuildInfoReadTime = orchestrator.opts.Sys.Now().Sub(buildInfoReadStart)
 	parseStart := orchestrator.opts.Sys.Now()
 	program := compiler.NewProgram(compiler.ProgramOptions{
-		Config: t.resolved,
-		Host:   compilerHost,
+		Config:      t.resolved,
+		Host:        compilerHost,
+		CheckReport: tsc.NewCheckReport(t.resolved.CompilerOptions(), orchestrator.opts.Testing),
 	})
 	compileTimes.ParseTime = orchestrator.opts.Sys.Now().Sub(parseStart)
 	changesComputeStart := orchestrator.opts.Sys.Now()
@@ -643,15 +672,23 @@ func (t *BuildTask) reportUpToDateStatus(orchestrator *Orchestrator) {
 	if !orchestrator.opts.Command.BuildOptions.Verbose.IsTrue() {
 		return
 	}
+	if diagnostic := t.upToDateStatusDiagnostic(orchestrator); diagnostic != nil {
+		t.result.reportStatus(diagnostic)
+	}
+}
+
+// upToDateStatusDiagnostic returns the message explaining the up-to-date status
+// of the task, or nil if the status needs no explanation.
+func (t *BuildTask) upToDateStatusDiagnostic(orchestrator *Orchestrator) *ast.Diagnostic {
 	switch t.status.kind {
 	case upToDateStatusTypeConfigFileNotFound:
-		t.result.reportStatus(ast.NewCompilerDiagnostic(
+		return ast.NewCompilerDiagnostic(
 			diagnostics.Project_0_is_out_of_date_because_config_file_does_not_exist,
 			orchestrator.relativeFileName(t.config),
-		))
+		)
 	case upToDateStatusTypeUpstreamErrors:
 		upstreamStatus := t.status.upstreamErrors()
-		t.result.reportStatus(ast.NewCompilerDiagnostic(
+		return ast.NewCompilerDiagnostic(
 			core.IfElse(
 				upstreamStatus.refHasUpstreamErrors,
 				diagnostics.Project_0_can_t_be_built_because_its_dependency_1_was_not_built,
@@ -659,96 +696,97 @@ func (t *BuildTask) reportUpToDateStatus(orchestrator *Orchestrator) {
 			),
 			orchestrator.relativeFileName(t.config),
 			orchestrator.relativeFileName(upstreamStatus.ref),
-		))
+		)
 	case upToDateStatusTypeBuildErrors:
-		t.result.reportStatus(ast.NewCompilerDiagnostic(
+		return ast.NewCompilerDiagnostic(
 			diagnostics.Project_0_is_out_of_date_because_it_has_errors,
 			orchestrator.relativeFileName(t.config),
-		))
+		)
 	case upToDateStatusTypeUpToDate:
 		// This is to ensure skipping verbose log for projects that were built,
 		// and then some other package changed but this package doesnt need update
 		if inputOutputFileAndTime := t.status.inputOutputFileAndTime(); inputOutputFileAndTime != nil {
-			t.result.reportStatus(ast.NewCompilerDiagnostic(
+			return ast.NewCompilerDiagnostic(
 				diagnostics.Project_0_is_up_to_date_because_newest_input_1_is_older_than_output_2,
 				orchestrator.relativeFileName(t.config),
 				orchestrator.relativeFileName(inputOutputFileAndTime.input.file),
 				orchestrator.relativeFileName(inputOutputFileAndTime.output.file),
-			))
+			)
 		}
 	case upToDateStatusTypeUpToDateWithUpstreamTypes:
-		t.result.reportStatus(ast.NewCompilerDiagnostic(
+		return ast.NewCompilerDiagnostic(
 			diagnostics.Project_0_is_up_to_date_with_d_ts_files_from_its_dependencies,
 			orchestrator.relativeFileName(t.config),
-		))
+		)
 	case upToDateStatusTypeUpToDateWithInputFileText:
-		t.result.reportStatus(ast.NewCompilerDiagnostic(
+		return ast.NewCompilerDiagnostic(
 			diagnostics.Project_0_is_up_to_date_but_needs_to_update_timestamps_of_output_files_that_are_older_than_input_files,
 			orchestrator.relativeFileName(t.config),
-		))
+		)
 	case upToDateStatusTypeInputFileMissing:
-		t.result.reportStatus(ast.NewCompilerDiagnostic(
+		return ast.NewCompilerDiagnostic(
 			diagnostics.Project_0_is_out_of_date_because_input_1_does_not_exist,
 			orchestrator.relativeFileName(t.config),
 			orchestrator.relativeFileName(t.status.data.(string)),
-		))
+		)
 	case upToDateStatusTypeOutputMissing:
-		t.result.reportStatus(ast.NewCompilerDiagnostic(
+		return ast.NewCompilerDiagnostic(
 			diagnostics.Project_0_is_out_of_date_because_output_file_1_does_not_exist,
 			orchestrator.relativeFileName(t.config),
 			orchestrator.relativeFileName(t.status.data.(string)),
-		))
+		)
 	case upToDateStatusTypeInputFileNewer:
 		inputOutput := t.status.inputOutputName()
-		t.result.reportStatus(ast.NewCompilerDiagnostic(
+		return ast.NewCompilerDiagnostic(
 			diagnostics.Project_0_is_out_of_date_because_output_1_is_older_than_input_2,
 			orchestrator.relativeFileName(t.config),
 			orchestrator.relativeFileName(inputOutput.output),
 			orchestrator.relativeFileName(inputOutput.input),
-		))
+		)
 	case upToDateStatusTypeOutOfDateBuildInfoWithPendingEmit:
-		t.result.reportStatus(ast.NewCompilerDiagnostic(
+		return ast.NewCompilerDiagnostic(
 			diagnostics.Project_0_is_out_of_date_because_buildinfo_file_1_indicates_that_some_of_the_changes_were_not_emitted,
 			orchestrator.relativeFileName(t.config),
 			orchestrator.relativeFileName(t.status.data.(string)),
-		))
+		)
 	case upToDateStatusTypeOutOfDateBuildInfoWithErrors:
-		t.result.reportStatus(ast.NewCompilerDiagnostic(
+		return ast.NewCompilerDiagnostic(
 			diagnostics.Project_0_is_out_of_date_because_buildinfo_file_1_indicates_that_program_needs_to_report_errors,
 			orchestrator.relativeFileName(t.config),
 			orchestrator.relativeFileName(t.status.data.(string)),
-		))
+		)
 	case upToDateStatusTypeOutOfDateOptions:
-		t.result.reportStatus(ast.NewCompilerDiagnostic(
+		return ast.NewCompilerDiagnostic(
 			diagnostics.Project_0_is_out_of_date_because_buildinfo_file_1_indicates_there_is_change_in_compilerOptions,
 			orchestrator.relativeFileName(t.config),
 			orchestrator.relativeFileName(t.status.data.(string)),
-		))
+		)
 	case upToDateStatusTypeOutOfDateRoots:
 		inputOutput := t.status.inputOutputName()
-		t.result.reportStatus(ast.NewCompilerDiagnostic(
+		return ast.NewCompilerDiagnostic(
 			diagnostics.Project_0_is_out_of_date_because_buildinfo_file_1_indicates_that_file_2_was_root_file_of_compilation_but_not_any_more,
 			orchestrator.relativeFileName(t.config),
 			orchestrator.relativeFileName(inputOutput.output),
 			orchestrator.relativeFileName(inputOutput.input),
-		))
+		)
 	case upToDateStatusTypeTsVersionOutputOfDate:
-		t.result.reportStatus(ast.NewCompilerDiagnostic(
+		return ast.NewCompilerDiagnostic(
 			diagnostics.Project_0_is_out_of_date_because_output_for_it_was_generated_with_version_1_that_differs_with_current_version_2,
 			orchestrator.relativeFileName(t.config),
 			orchestrator.relativeFileName(t.status.data.(string)),
 			core.Version(),
-		))
+		)
 	case upToDateStatusTypeForceBuild:
-		t.result.reportStatus(ast.NewCompilerDiagnostic(
+		return ast.NewCompilerDiagnostic(
 			diagnostics.Project_0_is_being_forcibly_rebuilt,
 			orchestrator.relativeFileName(t.config),
-		))
+		)
 	case upToDateStatusTypeSolution:
 		// Does not need to report status
 	default:
 		panic(fmt.Sprintf("Unknown up to date status kind: %v", t.status.kind))
 	}
+	return nil
 }
 
 func (t *BuildTask) canUpdateJsDtsOutputTimestamps() bool {
@@ -28,6 +28,10 @@ type Options struct {
 	Sys     tsc.System
 	Command *tsoptions.ParsedBuildCommandLine
 	Testing tsc.CommandLineTesting
+	// BuildCache, when set, is used to restore the outputs of projects whose
+	// inputs are unchanged instead of building them. When nil, --buildCacheDir
+	// selects a directory-backed cache.
+	BuildCache CacheStore
 }
 
 type orchestratorResult struct {
@@ -70,6 +74,12 @@ type Orchestrator struct {
 	// the session context is cancelled (see contentmapper.New).
 	contentMapperHost contentmapper.Host
 
+	buildCache CacheStore
+
+	// affected holds the projects to build when --affectedBy is specified: the
+	// projects affected by the given files and the projects they reference.
+	affected *collections.Set[tspath.Path]
+
 	// order generation result
 	tasks  *collections.SyncMap[tspath.Path, *BuildTask]
 	order  []string
@@ -78,6 +88,12 @@ type Orchestrator struct {
 	errorSummaryReporter tsc.DiagnosticsReporter
 	watchStatusReporter  tsc.DiagnosticReporter
 
+	// structuredReporter collects diagnostics grouped by project when --diagnosticFormat is specified.
+	structuredReporter *tsc.StructuredDiagnosticsReporter
+
+	// statusServer serves the watch status over the pipe given by --watchStatusPipe.
+	statusServer *tsc.WatchStatusServer
+
 	// fswatch event-based watching
 	wm *watchmanager.WatchManager
 }
@@ -203,7 +219,7 @@ func (o *Orchestrator) setupBuildTask(
 		task.done = make(chan struct{})
 		o.order = append(o.order, configName)
 	}
-	if o.opts.Command.CompilerOptions.Watch.IsTrue() && downStream != nil {
+	if (o.opts.Command.CompilerOptions.Watch.IsTrue() || len(o.opts.Command.BuildOptions.AffectedBy) > 0) && downStream != nil {
 		task.downStream = append(task.downStream, downStream)
 	}
 	return task
@@ -253,8 +269,22 @@ func (o *Orchestrator) Start(ctx context.Context) tsc.CommandLineResult {
 		o.watchStatusReporter(ast.NewCompilerDiagnostic(diagnostics.Starting_compilation_in_watch_mode))
 	}
 	o.GenerateGraph(nil)
+	if format := o.opts.Command.BuildOptions.Graph; format != core.BuildGraphFormatNone {
+		return o.reportGraph(format)
+	}
+	if len(o.opts.Command.BuildOptions.AffectedBy) > 0 && len(o.errors) == 0 {
+		o.computeAffectedProjects()
+	}
+	if o.opts.Command.BuildOptions.ListAffected.IsTrue() {
+		return o.reportGraphErrors()
+	}
+	if err := o.statusServer.Listen(ctx); err != nil {
+		fmt.Fprintf(o.opts.Sys.Writer(), "%v\n", err)
+	}
 	result := o.buildOrClean()
 	if o.opts.Command.CompilerOptions.Watch.IsTrue() {
+		// --affectedBy narrows only the initial build; changes seen by the watcher decide what is built next.
+		o.affected = nil
 		o.Watch(ctx)
 		result.Watcher = o
 	}
@@ -666,6 +696,7 @@ func (o *Orchestrator) buildOrClean() tsc.CommandLineResult {
 			}), ""),
 		))
 	}
+	o.statusServer.BuildStarted()
 	var buildResult orchestratorResult
 	if len(o.errors) == 0 {
 		buildResult.statistics.Projects = len(o.Order())
@@ -681,6 +712,7 @@ func (o *Orchestrator) buildOrClean() tsc.CommandLineResult {
 		}
 		buildResult.errors = o.errors
 	}
+	o.statusServer.BuildFinished(o.errors)
 	buildResult.report(o)
 	return buildResult.result
 }
@@ -725,10 +757,12 @@ func (o *Orchestrator) buildOrCleanProject(task *BuildTask, path tspath.Path, bu
 	task.result = &taskResult{}
 	task.result.reportStatus = o.createBuilderStatusReporter(task)
 	task.result.diagnosticReporter = o.createDiagnosticReporter(task)
-	if !o.opts.Command.BuildOptions.Clean.IsTrue() {
-		task.buildProject(o, path)
-	} else {
+	if o.opts.Command.BuildOptions.Clean.IsTrue() {
 		task.cleanProject(o, path)
+	} else if o.affected != nil && !o.affected.Has(path) {
+		task.skipUnaffectedProject(o)
+	} else {
+		task.buildProject(o, path)
 	}
 	task.report(o, path, buildResult)
 }
@@ -745,6 +779,15 @@ func (o *Orchestrator) createBuilderStatusReporter(task *BuildTask) tsc.Diagnost
 }
 
 func (o *Orchestrator) createDiagnosticReporter(task *BuildTask) tsc.DiagnosticReporter {
+	if o.structuredReporter != nil {
+		if task == nil {
+			return o.structuredReporter.ReportDiagnostic
+		}
+		// Collected per task and handed to the structured reporter in build order, see BuildTask.report.
+		return func(diagnostic *ast.Diagnostic) {
+			task.result.diagnostics = append(task.result.diagnostics, diagnostic)
+		}
+	}
 	return tsc.CreateDiagnosticReporter(o.opts.Sys, o.getWriter(task), o.opts.Command.Locale(), o.opts.Command.CompilerOptions)
 }
 
@@ -756,21 +799,33 @@ func NewOrchestrator(opts Options) *Orchestrator {
 			CurrentDirectory:          opts.Sys.GetCurrentDirectory(),
 			UseCaseSensitiveFileNames: opts.Sys.FS().UseCaseSensitiveFileNames(),
 		},
-		tasks: &collections.SyncMap[tspath.Path, *BuildTask]{},
-		wm:    wm,
+		tasks:              &collections.SyncMap[tspath.Path, *BuildTask]{},
+		wm:                 wm,
+		structuredReporter: tsc.NewStructuredDiagnosticsReporter(opts.Sys, opts.Sys.Writer(), opts.Command.Locale(), opts.Command.CompilerOptions),
+		statusServer:       tsc.NewWatchStatusServer(opts.Sys, opts.Command.Locale(), opts.Command.CompilerOptions),
+	}
+	// The cache of parsed declaration files selected by --astCacheDir sits
+	// beneath the host's own cache of source files, which all projects share.
+	var astCache *compiler.ASTCache
+	if dir := opts.Command.CompilerOptions.AstCacheDir; dir != "" {
+		astCache = compiler.NewASTCache(opts.Sys.FS(), tspath.GetNormalizedAbsolutePath(dir, opts.Sys.GetCurrentDirectory()), compiler.DefaultASTCacheMaxSize, opts.Sys.Now)
 	}
 	orchestrator.host = &host{
 		orchestrator: orchestrator,
-		host: compiler.NewCachedFSCompilerHost(
+		host: compiler.NewASTCacheCompilerHost(compiler.NewCachedFSCompilerHost(
 			orchestrator.opts.Sys.GetCurrentDirectory(),
 			orchestrator.opts.Sys.FS(),
 			orchestrator.opts.Sys.DefaultLibraryPath(),
 			nil,
 			nil,
 			nil,
-		),
+		), astCache),
 		mTimes: &collections.SyncMap[tspath.Path, time.Time]{},
 	}
+	orchestrator.buildCache = opts.BuildCache
+	if dir := opts.Command.BuildOptions.BuildCacheDir; orchestrator.buildCache == nil && dir != "" {
+		orchestrator.buildCache = NewDirectoryCacheStore(opts.Sys.FS(), tspath.GetNormalizedAbsolutePath(dir, opts.Sys.GetCurrentDirectory()))
+	}
 	if opts.Command.CompilerOptions.Watch.IsTrue() {
 		orchestrator.watchStatusReporter = tsc.CreateWatchStatusReporter(opts.Sys, opts.Command.Locale(), opts.Command.CompilerOptions, opts.Testing)
 		if t, ok := opts.Testing.(watchmanager.CommandLineTestingWithWatchBackend); ok {
@@ -779,5 +834,9 @@ func NewOrchestrator(opts Options) *Orchestrator {
 	} else {
 		orchestrator.errorSummaryReporter = tsc.CreateReportErrorSummary(opts.Sys, opts.Command.Locale(), opts.Command.CompilerOptions)
 	}
+	if orchestrator.structuredReporter != nil {
+		orchestrator.watchStatusReporter = orchestrator.structuredReporter.ReportWatchStatus
+		orchestrator.errorSummaryReporter = orchestrator.structuredReporter.ReportErrorSummary
+	}
 	return orchestrator
 }
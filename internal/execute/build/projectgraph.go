@@ -0,0 +1,139 @@
+package build
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/execute/tsc"
+	"github.com/microsoft/typescript-go/internal/json"
+)
+
+type projectGraph struct {
+	Projects []*projectGraphNode `json:"projects"`
+}
+
+type projectGraphNode struct {
+	Config string `json:"config"`
+	// Status is one of "upToDate", "updateTimestamps", "outOfDate", "error" or
+	// "solution".
+	Status     string   `json:"status"`
+	Reason     string   `json:"reason,omitzero"`
+	References []string `json:"references,omitzero"`
+}
+
+func (s *upToDateStatus) graphStatus() string {
+	switch {
+	case s.isError():
+		return "error"
+	case s.isPseudoBuild():
+		return "updateTimestamps"
+	case s.kind == upToDateStatusTypeUpToDate:
+		return "upToDate"
+	case s.kind == upToDateStatusTypeSolution:
+		return "solution"
+	default:
+		return "outOfDate"
+	}
+}
+
+// projectGraph computes the up-to-date status of each project in build order
+// without building any of them. Statuses reflect the outputs on disk, so a
+// project that is up to date may still be rebuilt if an upstream project is.
+func (o *Orchestrator) projectGraph() *projectGraph {
+	graph := &projectGraph{}
+	locale := o.opts.Command.Locale()
+	for _, config := range o.order {
+		path := o.toPath(config)
+		task := o.getTask(path)
+		task.status = task.getUpToDateStatus(o, path)
+		node := &projectGraphNode{
+			Config: o.relativeFileName(config),
+			Status: task.status.graphStatus(),
+			References: core.Map(task.upStream, func(upstream *upstreamTask) string {
+				return o.relativeFileName(upstream.task.config)
+			}),
+		}
+		if diagnostic := task.upToDateStatusDiagnostic(o); diagnostic != nil {
+			node.Reason = diagnostic.Localize(locale)
+		}
+		graph.Projects = append(graph.Projects, node)
+	}
+	return graph
+}
+
+// reportGraph prints the project reference graph in the given format instead of
+// building the projects.
+func (o *Orchestrator) reportGraph(format core.BuildGraphFormat) tsc.CommandLineResult {
+	graph := o.projectGraph()
+	switch format {
+	case core.BuildGraphFormatDot:
+		graph.writeDot(o.opts.Sys.Writer())
+	case core.BuildGraphFormatJSON:
+		graph.writeJSON(o.opts.Sys.Writer())
+	}
+	return o.reportGraphErrors()
+}
+
+// reportGraphErrors reports the errors found while building the project
+// reference graph, for modes that do not build.
+func (o *Orchestrator) reportGraphErrors() tsc.CommandLineResult {
+	if len(o.errors) > 0 {
+		reportDiagnostic := o.createDiagnosticReporter(nil)
+		for _, err := range o.errors {
+			reportDiagnostic(err)
+		}
+		return tsc.CommandLineResult{Status: tsc.ExitStatusProjectReferenceCycle_OutputsSkipped}
+	}
+	return tsc.CommandLineResult{Status: tsc.ExitStatusSuccess}
+}
+
+func (g *projectGraph) writeJSON(w io.Writer) {
+	data, err := json.MarshalIndent(g, "", "    ")
+	if err != nil {
+		panic("failed to marshal project graph: " + err.Error())
+	}
+	fmt.Fprintf(w, "%s\n", data)
+}
+
+// writeDot writes the graph in the Graphviz DOT language, with an edge from
+// each project to the projects it references.
+func (g *projectGraph) writeDot(w io.Writer) {
+	var b strings.Builder
+	b.WriteString("digraph projects {\n")
+	for _, node := range g.Projects {
+		fmt.Fprintf(&b, "    %s [label=%s, color=%s", dotString(node.Config), dotString(node.Config+"\n"+node.Status), dotStatusColor(node.Status))
+		if node.Reason != "" {
+			fmt.Fprintf(&b, ", tooltip=%s", dotString(node.Reason))
+		}
+		b.WriteString("];\n")
+	}
+	for _, node := range g.Projects {
+		for _, reference := range node.References {
+			fmt.Fprintf(&b, "    %s -> %s;\n", dotString(node.Config), dotString(reference))
+		}
+	}
+	b.WriteString("}\n")
+	fmt.Fprint(w, b.String())
+}
+
+func dotString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+func dotStatusColor(status string) string {
+	switch status {
+	case "upToDate", "solution":
+		return "green"
+	case "updateTimestamps":
+		return "yellow"
+	case "error":
+		return "red"
+	default:
+		return "orange"
+	}
+}
@@ -3,6 +3,7 @@ package incremental
 import (
 	"context"
 	"fmt"
+	"maps"
 	"slices"
 	"strings"
 	"sync"
@@ -503,3 +504,31 @@ func (p *Program) PackageJsonLookupPaths() []string {
 	slices.Sort(packageJsons)
 	return core.Deduplicate(packageJsons)
 }
+
+// GetFilesAffectedBy returns the paths of the files in the program that may be
+// affected by changes to the given files: the given files that are part of the
+// program and the files that reference them, directly or transitively. A change
+// to a file that affects the global scope affects every file. Only the recorded
+// state is used, so this works on a program read from a build info file.
+func (p *Program) GetFilesAffectedBy(paths []tspath.Path) []tspath.Path {
+	var queue []tspath.Path
+	for _, path := range paths {
+		info, ok := p.snapshot.fileInfos.Load(path)
+		if !ok {
+			continue
+		}
+		if info.affectsGlobalScope {
+			return slices.Sorted(p.snapshot.fileInfos.Keys())
+		}
+		queue = append(queue, path)
+	}
+	var affected collections.Set[tspath.Path]
+	for len(queue) > 0 {
+		path := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+		if affected.AddIfAbsent(path) {
+			queue = slices.AppendSeq(queue, p.snapshot.referencedMap.getReferencedBy(path))
+		}
+	}
+	return slices.Sorted(maps.Keys(affected.Keys()))
+}
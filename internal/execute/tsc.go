@@ -93,6 +93,10 @@ func tscBuildCompilation(ctx context.Context, sys tsc.System, buildCommand *tsop
 	reportDiagnostic := tsc.CreateDiagnosticReporter(sys, sys.Writer(), locale, buildCommand.CompilerOptions)
 
 	if len(buildCommand.Errors) > 0 {
+		if structuredReporter := tsc.NewStructuredDiagnosticsReporter(sys, sys.Writer(), locale, buildCommand.CompilerOptions); structuredReporter != nil {
+			reportDiagnostic = structuredReporter.ReportDiagnostic
+			defer structuredReporter.Flush()
+		}
 		for _, err := range buildCommand.Errors {
 			reportDiagnostic(err)
 		}
@@ -123,6 +127,13 @@ func tscCompilation(ctx context.Context, sys tsc.System, commandLine *tsoptions.
 	configFileName := ""
 	locale := commandLine.Locale()
 	reportDiagnostic := tsc.CreateDiagnosticReporter(sys, sys.Writer(), locale, commandLine.CompilerOptions())
+	structuredReporter := tsc.NewStructuredDiagnosticsReporter(sys, sys.Writer(), locale, commandLine.CompilerOptions())
+	if structuredReporter != nil {
+		reportDiagnostic = structuredReporter.ReportDiagnostic
+		// Writes diagnostics reported before compilation started; a completed compilation has already
+		// written its document.
+		defer structuredReporter.Flush()
+	}
 
 	if len(commandLine.Errors) > 0 {
 		for _, e := range commandLine.Errors {
@@ -221,15 +232,33 @@ func tscCompilation(ctx context.Context, sys tsc.System, commandLine *tsoptions.
 			return tsc.CommandLineResult{Status: tsc.ExitStatusDiagnosticsPresent_OutputsGenerated}
 		}
 		configForCompilation = configParseResult
-		// Updater to reflect pretty
-		reportDiagnostic = tsc.CreateDiagnosticReporter(sys, sys.Writer(), locale, commandLine.CompilerOptions())
+		if structuredReporter == nil {
+			// Updater to reflect pretty
+			reportDiagnostic = tsc.CreateDiagnosticReporter(sys, sys.Writer(), locale, commandLine.CompilerOptions())
+		}
 	}
 
 	reportErrorSummary := tsc.CreateReportErrorSummary(sys, locale, configForCompilation.CompilerOptions())
+	if structuredReporter != nil {
+		reportErrorSummary = structuredReporter.ReportErrorSummary
+	}
 	if compilerOptionsFromCommandLine.ShowConfig.IsTrue() {
 		showConfig(sys, configForCompilation, configFileName)
 		return tsc.CommandLineResult{Status: tsc.ExitStatusSuccess}
 	}
+	if structuredReporter != nil {
+		// Checked once the config file is parsed, since it may set these options too.
+		if names := tsoptions.TextOutputOptions(configForCompilation.CompilerOptions()); len(names) != 0 {
+			for _, name := range names {
+				reportDiagnostic(ast.NewCompilerDiagnostic(diagnostics.Options_0_and_1_cannot_be_combined, "diagnosticFormat", name))
+			}
+			return tsc.CommandLineResult{Status: tsc.ExitStatusDiagnosticsPresent_OutputsSkipped}
+		}
+	}
+	if configForCompilation.CompilerOptions().Watch.IsTrue() && configForCompilation.CompilerOptions().CheckPerformanceReport.IsTrue() {
+		reportDiagnostic(ast.NewCompilerDiagnostic(diagnostics.Options_0_and_1_cannot_be_combined, "watch", "checkPerformanceReport"))
+		return tsc.CommandLineResult{Status: tsc.ExitStatusDiagnosticsPresent_OutputsSkipped}
+	}
 	if configForCompilation.CompilerOptions().Watch.IsTrue() {
 		watcher := createWatcher(
 			sys,
@@ -238,6 +267,7 @@ func tscCompilation(ctx context.Context, sys tsc.System, commandLine *tsoptions.
 			commandLineRaw,
 			reportDiagnostic,
 			reportErrorSummary,
+			structuredReporter,
 			testing,
 		)
 		watcher.start(ctx)
@@ -284,6 +314,15 @@ func getTraceFromSys(sys tsc.System, locale locale.Locale, testing tsc.CommandLi
 	return tsc.GetTraceWithWriterFromSys(sys.Writer(), locale, testing)
 }
 
+// newASTCache returns the cache of parsed declaration files selected by
+// --astCacheDir, or nil if the option is not set.
+func newASTCache(sys tsc.System, options *core.CompilerOptions) *compiler.ASTCache {
+	if options.AstCacheDir == "" {
+		return nil
+	}
+	return compiler.NewASTCache(sys.FS(), tspath.GetNormalizedAbsolutePath(options.AstCacheDir, sys.GetCurrentDirectory()), compiler.DefaultASTCacheMaxSize, sys.Now)
+}
+
 func performIncrementalCompilation(
 	ctx context.Context,
 	sys tsc.System,
@@ -299,7 +338,10 @@ func performIncrementalCompilation(
 	if contentMapperProject != nil {
 		defer contentMapperProject.Close()
 	}
-	host := compiler.NewCachedFSCompilerHost(sys.GetCurrentDirectory(), sys.FS(), sys.DefaultLibraryPath(), extendedConfigCache, getTraceFromSys(sys, config.Locale(), testing), contentMapperProject)
+	host := compiler.NewASTCacheCompilerHost(
+		compiler.NewCachedFSCompilerHost(sys.GetCurrentDirectory(), sys.FS(), sys.DefaultLibraryPath(), extendedConfigCache, getTraceFromSys(sys, config.Locale(), testing), contentMapperProject),
+		newASTCache(sys, config.CompilerOptions()),
+	)
 	buildInfoReadStart := sys.Now()
 	oldProgram := incremental.ReadBuildInfoProgram(config, incremental.NewBuildInfoReader(host), host)
 	compileTimes.BuildInfoReadTime = sys.Now().Sub(buildInfoReadStart)
@@ -308,9 +350,10 @@ func performIncrementalCompilation(
 
 	parseStart := sys.Now()
 	program := compiler.NewProgram(compiler.ProgramOptions{
-		Config:  config,
-		Host:    host,
-		Tracing: tr,
+		Config:      config,
+		Host:        host,
+		Tracing:     tr,
+		CheckReport: tsc.NewCheckReport(config.CompilerOptions(), testing),
 	})
 	compileTimes.ParseTime = sys.Now().Sub(parseStart)
 	changesComputeStart := sys.Now()
@@ -357,15 +400,19 @@ func performCompilation(
 	if contentMapperProject != nil {
 		defer contentMapperProject.Close()
 	}
-	host := compiler.NewCachedFSCompilerHost(sys.GetCurrentDirectory(), sys.FS(), sys.DefaultLibraryPath(), extendedConfigCache, getTraceFromSys(sys, config.Locale(), testing), contentMapperProject)
+	host := compiler.NewASTCacheCompilerHost(
+		compiler.NewCachedFSCompilerHost(sys.GetCurrentDirectory(), sys.FS(), sys.DefaultLibraryPath(), extendedConfigCache, getTraceFromSys(sys, config.Locale(), testing), contentMapperProject),
+		newASTCache(sys, config.CompilerOptions()),
+	)
 
 	tr := startTracingIfNeeded(sys, config, testing)
 
 	parseStart := sys.Now()
 	program := compiler.NewProgram(compiler.ProgramOptions{
-		Config:  config,
-		Host:    host,
-		Tracing: tr,
+		Config:      config,
+		Host:        host,
+		Tracing:     tr,
+		CheckReport: tsc.NewCheckReport(config.CompilerOptions(), testing),
 	})
 	compileTimes.ParseTime = sys.Now().Sub(parseStart)
 	if contentMapperHost != nil {
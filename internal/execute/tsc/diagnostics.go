@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync"
 
 	"github.com/microsoft/typescript-go/internal/ast"
 	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/diagnostics"
 	"github.com/microsoft/typescript-go/internal/diagnosticwriter"
 	"github.com/microsoft/typescript-go/internal/locale"
 	"github.com/microsoft/typescript-go/internal/tspath"
@@ -132,6 +134,9 @@ type DiagnosticsReporter = func(diagnostics []*ast.Diagnostic)
 func QuietDiagnosticsReporter(diagnostics []*ast.Diagnostic) {}
 
 func CreateReportErrorSummary(sys System, locale locale.Locale, options *core.CompilerOptions) DiagnosticsReporter {
+	if options.DiagnosticFormat != core.DiagnosticFormatNone {
+		return QuietDiagnosticsReporter
+	}
 	if shouldBePretty(sys, options) {
 		formatOpts := getFormatOptsOfSys(sys, locale)
 		return func(diagnostics []*ast.Diagnostic) {
@@ -142,7 +147,7 @@ func CreateReportErrorSummary(sys System, locale locale.Locale, options *core.Co
 }
 
 func CreateBuilderStatusReporter(sys System, w io.Writer, locale locale.Locale, options *core.CompilerOptions, testing CommandLineTesting) DiagnosticReporter {
-	if options.Quiet.IsTrue() {
+	if options.Quiet.IsTrue() || options.DiagnosticFormat != core.DiagnosticFormatNone {
 		return QuietDiagnosticReporter
 	}
 
@@ -174,3 +179,87 @@ func CreateWatchStatusReporter(sys System, locale locale.Locale, options *core.C
 		fmt.Fprint(writer, formatOpts.NewLine, formatOpts.NewLine)
 	}
 }
+
+// StructuredDiagnosticsReporter collects diagnostics and writes them as a single JSON or SARIF document
+// (see --diagnosticFormat). The document is written at the end of each compilation, or once per cycle in
+// watch mode. Options that would write other text to the same stream, such as --listFiles, are rejected
+// alongside --diagnosticFormat; text that projects of a build request in their own configs goes to stderr.
+type StructuredDiagnosticsReporter struct {
+	w          io.Writer
+	format     core.DiagnosticFormat
+	formatOpts *diagnosticwriter.FormattingOptions
+
+	mu          sync.Mutex
+	pending     bool
+	diagnostics []*ast.Diagnostic
+	projects    []diagnosticwriter.ProjectDiagnostics
+}
+
+// NewStructuredDiagnosticsReporter returns nil when no structured diagnostic format was requested.
+func NewStructuredDiagnosticsReporter(sys System, w io.Writer, locale locale.Locale, options *core.CompilerOptions) *StructuredDiagnosticsReporter {
+	if options == nil || options.DiagnosticFormat == core.DiagnosticFormatNone {
+		return nil
+	}
+	return &StructuredDiagnosticsReporter{
+		w:          w,
+		format:     options.DiagnosticFormat,
+		formatOpts: getFormatOptsOfSys(sys, locale),
+	}
+}
+
+// ReportDiagnostic collects a diagnostic that does not belong to a particular project.
+func (r *StructuredDiagnosticsReporter) ReportDiagnostic(diagnostic *ast.Diagnostic) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pending = true
+	r.diagnostics = append(r.diagnostics, diagnostic)
+}
+
+// ReportProjectDiagnostics collects the diagnostics of one project of a build. Projects are written in
+// the order in which they are reported.
+func (r *StructuredDiagnosticsReporter) ReportProjectDiagnostics(project string, diags []*ast.Diagnostic) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pending = true
+	r.projects = append(r.projects, diagnosticwriter.ProjectDiagnostics{
+		Project:     project,
+		Diagnostics: diagnosticwriter.FromASTDiagnostics(diags),
+	})
+}
+
+// ReportErrorSummary marks the end of a compilation and writes the document, even if no diagnostics
+// were reported.
+func (r *StructuredDiagnosticsReporter) ReportErrorSummary(diags []*ast.Diagnostic) {
+	r.mu.Lock()
+	r.pending = true
+	r.mu.Unlock()
+	r.Flush()
+}
+
+// ReportWatchStatus writes the document at the end of each watch cycle; other watch status messages are
+// not reported.
+func (r *StructuredDiagnosticsReporter) ReportWatchStatus(diagnostic *ast.Diagnostic) {
+	switch diagnostic.Code() {
+	case diagnostics.Found_1_error_Watching_for_file_changes.Code(), diagnostics.Found_0_errors_Watching_for_file_changes.Code():
+		r.Flush()
+	}
+}
+
+// Flush writes the collected diagnostics, if any were reported since the last document was written.
+func (r *StructuredDiagnosticsReporter) Flush() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.pending {
+		return
+	}
+	diags := diagnosticwriter.FromASTDiagnostics(r.diagnostics)
+	switch r.format {
+	case core.DiagnosticFormatJSON:
+		_ = diagnosticwriter.WriteJSONDiagnostics(r.w, diags, r.projects, r.formatOpts)
+	case core.DiagnosticFormatSARIF:
+		_ = diagnosticwriter.WriteSARIFDiagnostics(r.w, diags, r.projects, r.formatOpts)
+	}
+	r.pending = false
+	r.diagnostics = nil
+	r.projects = nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/microsoft/typescript-go/internal/ast"
 	"github.com/microsoft/typescript-go/internal/collections"
 	"github.com/microsoft/typescript-go/internal/compiler"
+	"github.com/microsoft/typescript-go/internal/core"
 	"github.com/microsoft/typescript-go/internal/diagnostics"
 	"github.com/microsoft/typescript-go/internal/execute/incremental"
 	"github.com/microsoft/typescript-go/internal/locale"
@@ -43,6 +44,16 @@ type EmitInput struct {
 	Tracing            *tracing.Tracing
 }
 
+// NewCheckReport returns the report of checking performance requested with
+// --checkPerformanceReport, or nil if the option is not set. Timings are left
+// out of the report when testing.
+func NewCheckReport(options *core.CompilerOptions, testing CommandLineTesting) *tracing.CheckReport {
+	if !options.CheckPerformanceReport.IsTrue() {
+		return nil
+	}
+	return tracing.NewCheckReport(testing != nil)
+}
+
 func EmitAndReportStatistics(input EmitInput) (CompileAndEmitResult, *Statistics) {
 	var statistics *Statistics
 	result := EmitFilesAndReportErrors(input)
@@ -63,6 +74,13 @@ func EmitAndReportStatistics(input EmitInput) (CompileAndEmitResult, *Statistics
 		statistics.Report(input.Writer, input.Testing)
 	}
 
+	if report := input.Program.CheckReport(); report != nil {
+		report.Write(input.Writer, tspath.ComparePathsOptions{
+			CurrentDirectory:          input.Program.GetCurrentDirectory(),
+			UseCaseSensitiveFileNames: input.Program.UseCaseSensitiveFileNames(),
+		})
+	}
+
 	if result.EmitResult.EmitSkipped && len(result.Diagnostics) > 0 {
 		result.Status = ExitStatusDiagnosticsPresent_OutputsSkipped
 	} else if len(result.Diagnostics) > 0 {
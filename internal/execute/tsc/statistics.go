@@ -57,6 +57,7 @@ type Statistics struct {
 	isAggregate      bool
 	Projects         int
 	ProjectsBuilt    int
+	ProjectsRestored int
 	TimestampUpdates int
 	files            int
 	lines            int
@@ -95,6 +96,9 @@ func (s *Statistics) Report(w io.Writer, testing CommandLineTesting) {
 		prefix = "Aggregate "
 		table.add("Projects in scope", s.Projects)
 		table.add("Projects built", s.ProjectsBuilt)
+		if s.ProjectsRestored != 0 {
+			table.add("Projects restored from cache", s.ProjectsRestored)
+		}
 		table.add("Timestamps only updates", s.TimestampUpdates)
 	}
 	table.add(prefix+"Files", s.files)
@@ -0,0 +1,232 @@
+package tsc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/diagnosticwriter"
+	"github.com/microsoft/typescript-go/internal/ipc"
+	"github.com/microsoft/typescript-go/internal/json"
+	"github.com/microsoft/typescript-go/internal/locale"
+)
+
+// Methods served by the watch status server (see --watchStatusPipe).
+const (
+	// WatchStatusMethodGetStatus returns a WatchStatus.
+	WatchStatusMethodGetStatus = "getStatus"
+	// WatchStatusMethodGetDiagnostics returns the diagnostics of the last build, in the shape of
+	// --diagnosticFormat json.
+	WatchStatusMethodGetDiagnostics = "getDiagnostics"
+
+	// WatchStatusNotificationBuildStarted is sent when a build starts.
+	WatchStatusNotificationBuildStarted = "buildStarted"
+	// WatchStatusNotificationProjectBuildStarted is sent with a WatchProjectEvent when a project of
+	// a --build starts building.
+	WatchStatusNotificationProjectBuildStarted = "projectBuildStarted"
+	// WatchStatusNotificationProjectBuildFinished is sent with a WatchProjectEvent when a project of
+	// a --build has been built.
+	WatchStatusNotificationProjectBuildFinished = "projectBuildFinished"
+	// WatchStatusNotificationBuildFinished is sent with a WatchBuildResult when a build finishes.
+	WatchStatusNotificationBuildFinished = "buildFinished"
+)
+
+// WatchStatus is the result of a getStatus request.
+type WatchStatus struct {
+	Building           bool              `json:"building"`
+	RebuildingProjects []string          `json:"rebuildingProjects"`
+	LastBuild          *WatchBuildResult `json:"lastBuild"`
+}
+
+// WatchBuildResult describes a finished build.
+type WatchBuildResult struct {
+	DurationMs float64 `json:"durationMs"`
+	ErrorCount int     `json:"errorCount"`
+}
+
+// WatchProjectEvent describes a project of a --build that started or finished building. The error
+// count is only set when the project finished.
+type WatchProjectEvent struct {
+	Project    string `json:"project"`
+	ErrorCount int    `json:"errorCount,omitzero"`
+}
+
+// WatchStatusServer serves the state of a watch session over JSON-RPC, so tools can query the
+// latest diagnostics and follow rebuilds without parsing the console output. A nil server ignores
+// all reports, so callers need not check whether --watchStatusPipe was specified.
+type WatchStatusServer struct {
+	pipe       string
+	now        func() time.Time
+	formatOpts *diagnosticwriter.FormattingOptions
+
+	mu                 sync.Mutex
+	building           bool
+	buildStart         time.Time
+	rebuildingProjects []string
+	lastBuild          *WatchBuildResult
+	diagnostics        []*ast.Diagnostic
+	projects           []diagnosticwriter.ProjectDiagnostics
+	pendingProjects    []diagnosticwriter.ProjectDiagnostics
+	projectErrorCount  int
+	conns              []*ipc.AsyncConn
+}
+
+// NewWatchStatusServer returns nil unless --watchStatusPipe was specified in watch mode.
+func NewWatchStatusServer(sys System, locale locale.Locale, options *core.CompilerOptions) *WatchStatusServer {
+	if options == nil || options.WatchStatusPipe == "" || !options.Watch.IsTrue() {
+		return nil
+	}
+	return &WatchStatusServer{
+		pipe:       options.WatchStatusPipe,
+		now:        sys.Now,
+		formatOpts: getFormatOptsOfSys(sys, locale),
+	}
+}
+
+// Listen starts accepting connections on the pipe given by --watchStatusPipe. The pipe is closed
+// when ctx is cancelled.
+func (s *WatchStatusServer) Listen(ctx context.Context) error {
+	if s == nil {
+		return nil
+	}
+	transport, err := ipc.NewPipeTransport(s.pipe)
+	if err != nil {
+		return fmt.Errorf("failed to create watch status pipe: %w", err)
+	}
+	go func() {
+		<-ctx.Done()
+		_ = transport.Close()
+	}()
+	go func() {
+		for {
+			rwc, err := transport.Accept()
+			if err != nil {
+				return
+			}
+			go s.serveConn(ctx, rwc)
+		}
+	}()
+	return nil
+}
+
+func (s *WatchStatusServer) serveConn(ctx context.Context, rwc io.ReadWriteCloser) {
+	defer rwc.Close()
+	conn := ipc.NewAsyncConn(rwc, s)
+	s.mu.Lock()
+	s.conns = append(s.conns, conn)
+	s.mu.Unlock()
+	_ = conn.Run(ctx)
+	s.mu.Lock()
+	s.conns = slices.DeleteFunc(s.conns, func(c *ipc.AsyncConn) bool { return c == conn })
+	s.mu.Unlock()
+}
+
+// HandleRequest implements ipc.Handler.
+func (s *WatchStatusServer) HandleRequest(ctx context.Context, method string, params json.Value) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch method {
+	case WatchStatusMethodGetStatus:
+		return &WatchStatus{
+			Building:           s.building,
+			RebuildingProjects: append([]string{}, s.rebuildingProjects...),
+			LastBuild:          s.lastBuild,
+		}, nil
+	case WatchStatusMethodGetDiagnostics:
+		return diagnosticwriter.MarshalJSONDiagnostics(diagnosticwriter.FromASTDiagnostics(s.diagnostics), s.projects, s.formatOpts)
+	default:
+		return nil, fmt.Errorf("unknown method: %s", method)
+	}
+}
+
+// HandleNotification implements ipc.Handler.
+func (s *WatchStatusServer) HandleNotification(ctx context.Context, method string, params json.Value) error {
+	return nil
+}
+
+// BuildStarted marks the start of a build.
+func (s *WatchStatusServer) BuildStarted() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.building = true
+	s.buildStart = s.now()
+	s.pendingProjects = nil
+	s.projectErrorCount = 0
+	conns := slices.Clone(s.conns)
+	s.mu.Unlock()
+	notify(conns, WatchStatusNotificationBuildStarted, nil)
+}
+
+// ProjectBuildStarted marks a project of a --build as rebuilding.
+func (s *WatchStatusServer) ProjectBuildStarted(project string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.rebuildingProjects = append(s.rebuildingProjects, project)
+	conns := slices.Clone(s.conns)
+	s.mu.Unlock()
+	notify(conns, WatchStatusNotificationProjectBuildStarted, &WatchProjectEvent{Project: project})
+}
+
+// ProjectBuildFinished records the diagnostics of a project of a --build. Projects are listed in the
+// order in which they are reported; a notification is only sent for projects that were rebuilding.
+func (s *WatchStatusServer) ProjectBuildFinished(project string, diags []*ast.Diagnostic) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.pendingProjects = append(s.pendingProjects, diagnosticwriter.ProjectDiagnostics{
+		Project:     project,
+		Diagnostics: diagnosticwriter.FromASTDiagnostics(diags),
+	})
+	s.projectErrorCount += len(diags)
+	index := slices.Index(s.rebuildingProjects, project)
+	if index < 0 {
+		s.mu.Unlock()
+		return
+	}
+	s.rebuildingProjects = slices.Delete(s.rebuildingProjects, index, index+1)
+	conns := slices.Clone(s.conns)
+	s.mu.Unlock()
+	notify(conns, WatchStatusNotificationProjectBuildFinished, &WatchProjectEvent{Project: project, ErrorCount: len(diags)})
+}
+
+// BuildFinished marks the end of a build, with the diagnostics that do not belong to a particular
+// project. These and the project diagnostics reported since BuildStarted replace the diagnostics of
+// the previous build.
+func (s *WatchStatusServer) BuildFinished(diags []*ast.Diagnostic) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.building = false
+	s.rebuildingProjects = nil
+	s.diagnostics = diags
+	s.projects = s.pendingProjects
+	s.pendingProjects = nil
+	s.lastBuild = &WatchBuildResult{
+		DurationMs: float64(s.now().Sub(s.buildStart)) / float64(time.Millisecond),
+		ErrorCount: len(diags) + s.projectErrorCount,
+	}
+	lastBuild := s.lastBuild
+	conns := slices.Clone(s.conns)
+	s.mu.Unlock()
+	notify(conns, WatchStatusNotificationBuildFinished, lastBuild)
+}
+
+// notify sends a notification to the given clients. Callers copy the clients
+// under s.mu and notify them after releasing it, so that a client that is slow
+// to read does not block requests or the reports of other projects.
+func notify(conns []*ipc.AsyncConn, method string, params any) {
+	for _, conn := range conns {
+		_ = conn.Notify(context.Background(), method, params)
+	}
+}
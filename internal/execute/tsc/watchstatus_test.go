@@ -0,0 +1,95 @@
+package tsc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/diagnostics"
+	"github.com/microsoft/typescript-go/internal/diagnosticwriter"
+	"github.com/microsoft/typescript-go/internal/ipc"
+	"github.com/microsoft/typescript-go/internal/json"
+	"github.com/microsoft/typescript-go/internal/tspath"
+	"gotest.tools/v3/assert"
+)
+
+type watchStatusNotification struct {
+	method string
+	params string
+}
+
+type watchStatusClient struct {
+	notifications chan watchStatusNotification
+}
+
+func (c *watchStatusClient) HandleRequest(context.Context, string, json.Value) (any, error) {
+	return nil, nil
+}
+
+func (c *watchStatusClient) HandleNotification(ctx context.Context, method string, params json.Value) error {
+	c.notifications <- watchStatusNotification{method: method, params: string(params)}
+	return nil
+}
+
+func TestWatchStatusServer(t *testing.T) {
+	t.Parallel()
+	now := time.Unix(0, 0)
+	server := &WatchStatusServer{
+		now: func() time.Time {
+			now = now.Add(250 * time.Millisecond)
+			return now
+		},
+		formatOpts: &diagnosticwriter.FormattingOptions{
+			NewLine:             "\n",
+			ComparePathsOptions: tspath.ComparePathsOptions{CurrentDirectory: "/home/src/workspaces/project"},
+		},
+	}
+
+	serverSide, clientSide := net.Pipe()
+	defer clientSide.Close()
+	go server.serveConn(t.Context(), serverSide)
+	client := &watchStatusClient{notifications: make(chan watchStatusNotification, 8)}
+	conn := ipc.NewAsyncConn(clientSide, client)
+	go func() { _ = conn.Run(t.Context()) }()
+
+	call := func(method string) string {
+		result, err := conn.Call(t.Context(), method, nil)
+		assert.NilError(t, err)
+		return string(result)
+	}
+	expectNotification := func(method string, params string) {
+		select {
+		case n := <-client.notifications:
+			assert.Equal(t, n.method, method)
+			assert.Equal(t, n.params, params)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for %s", method)
+		}
+	}
+
+	// The first response also guarantees that the connection is registered for notifications.
+	assert.Equal(t, call(WatchStatusMethodGetStatus), `{"building":false,"rebuildingProjects":[],"lastBuild":null}`)
+
+	server.BuildStarted()
+	expectNotification(WatchStatusNotificationBuildStarted, "")
+	server.ProjectBuildStarted("packages/a/tsconfig.json")
+	expectNotification(WatchStatusNotificationProjectBuildStarted, `{"project":"packages/a/tsconfig.json"}`)
+	assert.Equal(t, call(WatchStatusMethodGetStatus), `{"building":true,"rebuildingProjects":["packages/a/tsconfig.json"],"lastBuild":null}`)
+
+	server.ProjectBuildFinished("packages/a/tsconfig.json", []*ast.Diagnostic{
+		ast.NewCompilerDiagnostic(diagnostics.Cannot_find_name_0, "x"),
+	})
+	expectNotification(WatchStatusNotificationProjectBuildFinished, `{"project":"packages/a/tsconfig.json","errorCount":1}`)
+	// Projects that were up to date are not announced, but their diagnostics are kept.
+	server.ProjectBuildFinished("packages/b/tsconfig.json", nil)
+	server.BuildFinished(nil)
+	expectNotification(WatchStatusNotificationBuildFinished, `{"durationMs":250,"errorCount":1}`)
+
+	assert.Equal(t, call(WatchStatusMethodGetStatus), `{"building":false,"rebuildingProjects":[],"lastBuild":{"durationMs":250,"errorCount":1}}`)
+	assert.Equal(t, call(WatchStatusMethodGetDiagnostics), `{"diagnostics":[],"projects":[{"project":"packages/a/tsconfig.json","diagnostics":[{"code":2304,"category":"error","message":"Cannot find name 'x'."}]},{"project":"packages/b/tsconfig.json","diagnostics":[]}]}`)
+
+	_, err := conn.Call(t.Context(), "unknown", nil)
+	assert.ErrorContains(t, err, "unknown method: unknown")
+}
@@ -4805,6 +4805,54 @@ func TestGenerateTrace(t *testing.T) {
 	}
 }
 
+func TestCheckPerformanceReport(t *testing.T) {
+	t.Parallel()
+	(&tscInput{
+		subScenario: "reports files declarations and relations",
+		files: FileMap{
+			"/home/src/workspaces/project/tsconfig.json": stringtestutil.Dedent(`
+			{
+				"compilerOptions": {
+					"strict": true,
+					"noEmit": true
+				}
+			}`),
+			"/home/src/workspaces/project/types.ts": stringtestutil.Dedent(`
+			export interface Box<T> {
+				value: T;
+				map<U>(fn: (x: T) => U): Box<U>;
+			}
+			export type Pair<A, B> = { first: A; second: B };
+			`),
+			"/home/src/workspaces/project/main.ts": stringtestutil.Dedent(`
+			import { Box, Pair } from "./types";
+			function box<T>(value: T): Box<T> {
+				return { value, map: fn => box(fn(value)) };
+			}
+			class Store {
+				pairs: Pair<string, Box<number>>[] = [];
+				add(key: string, value: number) {
+					this.pairs[this.pairs.length] = { first: key, second: box(value) };
+				}
+			}
+			const labels: Box<string> = box(1).map(n => "#" + n);
+			`),
+		},
+		commandLineArgs: []string{"--checkPerformanceReport", "--singleThreaded"},
+		edits: []*tscEdit{
+			noChange,
+		},
+	}).run(t, "checkPerformanceReport")
+	(&tscInput{
+		subScenario: "cannot be combined with watch",
+		files: FileMap{
+			"/home/src/workspaces/project/tsconfig.json": `{ "compilerOptions": { "noEmit": true } }`,
+			"/home/src/workspaces/project/main.ts":       `export const x = 1;`,
+		},
+		commandLineArgs: []string{"--checkPerformanceReport", "--watch"},
+	}).run(t, "checkPerformanceReport")
+}
+
 func TestTscContentMapperEmit(t *testing.T) {
 	t.Parallel()
 	(&tscInput{
@@ -4990,3 +5038,69 @@ func TestTscContentMapperSynthesized(t *testing.T) {
 		commandLineArgs: []string{"--runExternalCode"},
 	}).run(t, "contentMapperSynthesized")
 }
+
+func TestTscDiagnosticFormat(t *testing.T) {
+	t.Parallel()
+	files := FileMap{
+		"/home/src/workspaces/project/tsconfig.json": `{}`,
+		"/home/src/workspaces/project/index.ts": stringtestutil.Dedent(`
+			interface Options { nested: { value: string } }
+			const fromNumber = { nested: { value: 1 } };
+			const options: Options = fromNumber;
+			const literal: Options = { nested: { value: 2 } };
+			import "./missing";`),
+	}
+	testCases := []*tscInput{
+		{
+			subScenario:     "json",
+			files:           files,
+			commandLineArgs: []string{"--diagnosticFormat", "json"},
+		},
+		{
+			subScenario:     "sarif",
+			files:           files,
+			commandLineArgs: []string{"--diagnosticFormat", "sarif"},
+		},
+		{
+			subScenario: "json without errors",
+			files: FileMap{
+				"/home/src/workspaces/project/tsconfig.json": `{}`,
+				"/home/src/workspaces/project/index.ts":      `export const x = 1;`,
+			},
+			commandLineArgs: []string{"--diagnosticFormat", "json"},
+		},
+		{
+			subScenario: "json with config file errors",
+			files: FileMap{
+				"/home/src/workspaces/project/tsconfig.json": `{ "compilerOptions": { "target": "es2099" } }`,
+				"/home/src/workspaces/project/index.ts":      `export const x = 1;`,
+			},
+			commandLineArgs: []string{"--diagnosticFormat", "json"},
+		},
+		{
+			subScenario: "diagnosticFormat cannot be specified in tsconfig",
+			files: FileMap{
+				"/home/src/workspaces/project/tsconfig.json": `{ "compilerOptions": { "diagnosticFormat": "json" } }`,
+				"/home/src/workspaces/project/index.ts":      `export const x = 1;`,
+			},
+			commandLineArgs: []string{"--pretty", "false"},
+		},
+		{
+			subScenario:     "json with extendedDiagnostics and checkPerformanceReport",
+			files:           files,
+			commandLineArgs: []string{"--diagnosticFormat", "json", "--extendedDiagnostics", "--checkPerformanceReport"},
+		},
+		{
+			subScenario: "json with listFiles in tsconfig",
+			files: FileMap{
+				"/home/src/workspaces/project/tsconfig.json": `{ "compilerOptions": { "listFiles": true } }`,
+				"/home/src/workspaces/project/index.ts":      `export const x = 1;`,
+			},
+			commandLineArgs: []string{"--diagnosticFormat", "json"},
+		},
+	}
+
+	for _, test := range testCases {
+		test.run(t, "diagnosticFormat")
+	}
+}
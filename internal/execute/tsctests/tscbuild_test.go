@@ -2,6 +2,7 @@ package tsctests
 
 import (
 	"fmt"
+	"maps"
 	"slices"
 	"strconv"
 	"strings"
@@ -4788,3 +4789,310 @@ func TestBuildProjectReferenceRedirectWithMultipleSubProjects(t *testing.T) {
 		test.run(t, "projectReferenceRedirect")
 	}
 }
+
+func TestBuildDiagnosticFormat(t *testing.T) {
+	t.Parallel()
+	files := FileMap{
+		"/home/src/workspaces/solution/shared/tsconfig.json": `{ "compilerOptions": { "composite": true } }`,
+		"/home/src/workspaces/solution/shared/index.ts":      `export const shared: string = 1;`,
+		"/home/src/workspaces/solution/app/tsconfig.json": stringtestutil.Dedent(`
+		{
+			"compilerOptions": { "composite": true },
+			"references": [{ "path": "../shared" }]
+		}`),
+		"/home/src/workspaces/solution/app/index.ts": `import { shared } from "../shared"; export const app: number = shared;`,
+		"/home/src/workspaces/solution/tsconfig.json": stringtestutil.Dedent(`
+		{
+			"files": [],
+			"references": [{ "path": "./shared" }, { "path": "./app" }]
+		}`),
+	}
+	testCases := []*tscInput{
+		{
+			subScenario:     "json groups diagnostics by project",
+			files:           files,
+			cwd:             "/home/src/workspaces/solution",
+			commandLineArgs: []string{"--build", "--verbose", "--diagnosticFormat", "json"},
+		},
+		{
+			subScenario:     "sarif writes a run per project",
+			files:           files,
+			cwd:             "/home/src/workspaces/solution",
+			commandLineArgs: []string{"--build", "--diagnosticFormat", "sarif"},
+		},
+		{
+			subScenario:     "json with extendedDiagnostics",
+			files:           files,
+			cwd:             "/home/src/workspaces/solution",
+			commandLineArgs: []string{"--build", "--diagnosticFormat", "json", "--extendedDiagnostics"},
+		},
+		{
+			subScenario: "json with listFiles in project config",
+			files: func() FileMap {
+				withListFiles := maps.Clone(files)
+				withListFiles["/home/src/workspaces/solution/shared/tsconfig.json"] = stringtestutil.Dedent(`
+				{
+					"compilerOptions": { "composite": true, "listFiles": true }
+				}`)
+				return withListFiles
+			}(),
+			cwd:             "/home/src/workspaces/solution",
+			commandLineArgs: []string{"--build", "--diagnosticFormat", "json"},
+		},
+		{
+			subScenario:     "json in watch mode",
+			files:           files,
+			cwd:             "/home/src/workspaces/solution",
+			commandLineArgs: []string{"--build", "--watch", "--diagnosticFormat", "json"},
+			edits: []*tscEdit{
+				newTscEdit("fix error in shared", func(sys *TestSys) {
+					sys.writeFileNoError("/home/src/workspaces/solution/shared/index.ts", `export const shared: number = 1;`)
+				}),
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		test.run(t, "diagnosticFormat")
+	}
+}
+
+func TestBuildCache(t *testing.T) {
+	t.Parallel()
+	files := FileMap{
+		"/home/src/workspaces/solution/shared/tsconfig.json": stringtestutil.Dedent(`
+		{
+			"compilerOptions": { "composite": true, "outDir": "out" }
+		}`),
+		"/home/src/workspaces/solution/shared/index.ts": `export const shared = 1;`,
+		"/home/src/workspaces/solution/app/tsconfig.json": stringtestutil.Dedent(`
+		{
+			"compilerOptions": { "composite": true, "outDir": "out" },
+			"references": [{ "path": "../shared" }]
+		}`),
+		"/home/src/workspaces/solution/app/index.ts": `import { shared } from "../shared"; export const app = shared + 1;`,
+	}
+	removeOutputs := func(sys *TestSys) {
+		sys.removeNoError("/home/src/workspaces/solution/shared/out")
+		sys.removeNoError("/home/src/workspaces/solution/app/out")
+	}
+	testCases := []*tscInput{
+		{
+			subScenario:     "restores outputs of unchanged projects",
+			files:           files,
+			cwd:             "/home/src/workspaces/solution",
+			commandLineArgs: []string{"--build", "app", "--verbose", "--buildCacheDir", "../cache"},
+			edits: []*tscEdit{
+				newTscEdit("remove outputs", removeOutputs),
+				newTscEdit("change declarations of upstream project", func(sys *TestSys) {
+					sys.writeFileNoError("/home/src/workspaces/solution/shared/index.ts", `export const shared = "1";`)
+				}),
+				newTscEdit("revert change and remove outputs", func(sys *TestSys) {
+					sys.writeFileNoError("/home/src/workspaces/solution/shared/index.ts", `export const shared = 1;`)
+					removeOutputs(sys)
+				}),
+				{
+					caption:         "force ignores cache",
+					commandLineArgs: []string{"--build", "app", "--verbose", "--buildCacheDir", "../cache", "--force"},
+				},
+			},
+		},
+		{
+			subScenario: "rebuilds when resolutions or type roots change",
+			files: FileMap{
+				"/home/src/workspaces/project/tsconfig.json": stringtestutil.Dedent(`
+				{
+					"compilerOptions": { "composite": true, "outDir": "out", "types": ["*"] }
+				}`),
+				"/home/src/workspaces/project/index.ts":            `import { value } from "pkg"; export const copy = value;`,
+				"/home/src/workspaces/node_modules/pkg/index.d.ts": `export declare const value: number;`,
+			},
+			cwd:             "/home/src/workspaces/project",
+			commandLineArgs: []string{"--build", "--verbose", "--buildCacheDir", "../cache"},
+			edits: []*tscEdit{
+				newTscEdit("remove outputs", func(sys *TestSys) {
+					sys.removeNoError("/home/src/workspaces/project/out")
+				}),
+				newTscEdit("add package in a location that was looked up and remove outputs", func(sys *TestSys) {
+					sys.writeFileNoError("/home/src/workspaces/project/node_modules/pkg/index.d.ts", `export declare const value: string;`)
+					sys.removeNoError("/home/src/workspaces/project/out")
+				}),
+				newTscEdit("add types package and remove outputs", func(sys *TestSys) {
+					sys.writeFileNoError("/home/src/workspaces/project/node_modules/@types/extra/index.d.ts", `declare const extra: number;`)
+					sys.removeNoError("/home/src/workspaces/project/out")
+				}),
+			},
+		},
+		{
+			subScenario: "ignores entries with outputs outside the project",
+			files: FileMap{
+				"/home/src/workspaces/project/tsconfig.json": stringtestutil.Dedent(`
+				{
+					"compilerOptions": { "composite": true, "outDir": "out" }
+				}`),
+				"/home/src/workspaces/project/index.ts": `export const value = 1;`,
+			},
+			cwd:             "/home/src/workspaces/project",
+			commandLineArgs: []string{"--build", "--verbose", "--buildCacheDir", "../cache"},
+			edits: []*tscEdit{
+				newTscEdit("add output that escapes the project to cache entries and remove outputs", func(sys *TestSys) {
+					for _, entry := range sys.FS().GetAccessibleEntries("/home/src/workspaces/cache").Files {
+						sys.replaceFileText("/home/src/workspaces/cache/"+entry, `"outputs":{`, `"outputs":{"../../escaped.js":"escaped",`)
+					}
+					sys.removeNoError("/home/src/workspaces/project/out")
+				}),
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		test.run(t, "buildCache")
+	}
+}
+
+func TestBuildGraph(t *testing.T) {
+	t.Parallel()
+	files := FileMap{
+		"/home/src/workspaces/solution/shared/tsconfig.json": `{ "compilerOptions": { "composite": true } }`,
+		"/home/src/workspaces/solution/shared/index.ts":      `export const shared = 1;`,
+		"/home/src/workspaces/solution/app/tsconfig.json": stringtestutil.Dedent(`
+		{
+			"compilerOptions": { "composite": true },
+			"references": [{ "path": "../shared" }]
+		}`),
+		"/home/src/workspaces/solution/app/index.ts": `import { shared } from "../shared"; export const app = shared;`,
+		"/home/src/workspaces/solution/tsconfig.json": stringtestutil.Dedent(`
+		{
+			"files": [],
+			"references": [{ "path": "./shared" }, { "path": "./app" }]
+		}`),
+	}
+	testCases := []*tscInput{
+		{
+			subScenario:     "reports status of each project",
+			files:           files,
+			cwd:             "/home/src/workspaces/solution",
+			commandLineArgs: []string{"--build", "--graph", "json"},
+			edits: []*tscEdit{
+				{
+					caption:         "build",
+					commandLineArgs: []string{"--build"},
+				},
+				{
+					caption:         "dot after build",
+					commandLineArgs: []string{"--build", "--graph", "dot"},
+					expectedDiff:    "graph reports the status of outputs on disk, which a clean build does not have",
+				},
+				{
+					caption:         "change upstream project",
+					commandLineArgs: []string{"--build", "--graph", "json"},
+					edit: func(sys *TestSys) {
+						sys.appendFile("/home/src/workspaces/solution/shared/index.ts", `export const other = 2;`)
+					},
+					expectedDiff: "graph reports the status of outputs on disk, which a clean build does not have",
+				},
+				{
+					caption:         "graph without format",
+					commandLineArgs: []string{"--build", "--graph"},
+					expectedDiff:    "graph reports the status of outputs on disk, which a clean build does not have",
+				},
+				{
+					caption:         "graph without format followed by project",
+					commandLineArgs: []string{"--build", "--graph", "app"},
+					expectedDiff:    "graph reports the status of outputs on disk, which a clean build does not have",
+				},
+			},
+		},
+		{
+			subScenario:     "reports error with watch",
+			files:           files,
+			cwd:             "/home/src/workspaces/solution",
+			commandLineArgs: []string{"--build", "--watch", "--graph"},
+		},
+	}
+
+	for _, test := range testCases {
+		test.run(t, "graph")
+	}
+}
+
+func TestBuildAffectedBy(t *testing.T) {
+	t.Parallel()
+	testCases := []*tscInput{
+		{
+			subScenario: "builds projects affected by changed files",
+			files: FileMap{
+				"/home/src/workspaces/solution/shared/tsconfig.json": `{ "compilerOptions": { "composite": true } }`,
+				"/home/src/workspaces/solution/shared/index.ts":      `export const shared = 1;`,
+				"/home/src/workspaces/solution/shared/util.ts":       `export const util = 2;`,
+				"/home/src/workspaces/solution/app/tsconfig.json": stringtestutil.Dedent(`
+				{
+					"compilerOptions": { "composite": true },
+					"references": [{ "path": "../shared" }]
+				}`),
+				"/home/src/workspaces/solution/app/index.ts":        `import { shared } from "../shared"; export const app = shared;`,
+				"/home/src/workspaces/solution/app/other.ts":        `export const other = 3;`,
+				"/home/src/workspaces/solution/tools/tsconfig.json": `{ "compilerOptions": { "composite": true } }`,
+				"/home/src/workspaces/solution/tools/index.ts":      `export const tools = 4;`,
+				"/home/src/workspaces/solution/tsconfig.json": stringtestutil.Dedent(`
+				{
+					"files": [],
+					"references": [{ "path": "./shared" }, { "path": "./app" }, { "path": "./tools" }]
+				}`),
+			},
+			cwd:             "/home/src/workspaces/solution",
+			commandLineArgs: []string{"--build"},
+			edits: []*tscEdit{
+				{
+					caption:         "change upstream project",
+					commandLineArgs: []string{"--build", "--verbose", "--affectedBy", "shared/index.ts"},
+					edit: func(sys *TestSys) {
+						sys.replaceFileText("/home/src/workspaces/solution/shared/index.ts", "1", "10")
+					},
+				},
+				{
+					caption:         "change file of unrelated project",
+					commandLineArgs: []string{"--build", "--affectedBy", "tools/index.ts,missing.ts"},
+					edit: func(sys *TestSys) {
+						sys.replaceFileText("/home/src/workspaces/solution/tools/index.ts", "4", "40")
+					},
+				},
+				{
+					caption:         "list affected files without building",
+					commandLineArgs: []string{"--build", "--affectedBy", "shared/util.ts", "--listAffected"},
+					edit: func(sys *TestSys) {
+						sys.replaceFileText("/home/src/workspaces/solution/shared/util.ts", "2", "20")
+					},
+				},
+			},
+		},
+		{
+			subScenario: "watch builds unaffected projects when they change",
+			files: FileMap{
+				"/home/src/workspaces/solution/shared/tsconfig.json": `{ "compilerOptions": { "composite": true } }`,
+				"/home/src/workspaces/solution/shared/index.ts":      `export const shared = 1;`,
+				"/home/src/workspaces/solution/tools/tsconfig.json":  `{ "compilerOptions": { "composite": true } }`,
+				"/home/src/workspaces/solution/tools/index.ts":       `export const tools = 4;`,
+				"/home/src/workspaces/solution/tsconfig.json": stringtestutil.Dedent(`
+				{
+					"files": [],
+					"references": [{ "path": "./shared" }, { "path": "./tools" }]
+				}`),
+			},
+			cwd:             "/home/src/workspaces/solution",
+			commandLineArgs: []string{"--build", "--watch", "--verbose", "--affectedBy", "shared/index.ts"},
+			edits: []*tscEdit{
+				{
+					caption: "change file of unaffected project",
+					edit: func(sys *TestSys) {
+						sys.replaceFileText("/home/src/workspaces/solution/tools/index.ts", "4", "40")
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		test.run(t, "affectedBy")
+	}
+}
@@ -705,3 +705,23 @@ func TestTscNoEmitWatch(t *testing.T) {
 		test.run(t, "noEmit")
 	}
 }
+
+func TestWatchDiagnosticFormat(t *testing.T) {
+	t.Parallel()
+	(&tscInput{
+		subScenario: "json is written once per cycle",
+		files: FileMap{
+			"/home/src/workspaces/project/index.ts":      `const x: number = "one";`,
+			"/home/src/workspaces/project/tsconfig.json": "{}",
+		},
+		commandLineArgs: []string{"--watch", "--diagnosticFormat", "json"},
+		edits: []*tscEdit{
+			newTscEdit("fix error", func(sys *TestSys) {
+				sys.writeFileNoError("/home/src/workspaces/project/index.ts", `const x: number = 1;`)
+			}),
+			newTscEdit("break config", func(sys *TestSys) {
+				sys.writeFileNoError("/home/src/workspaces/project/tsconfig.json", `{ "compilerOptions": { "target": "es2099" } }`)
+			}),
+		},
+	}).run(t, "diagnosticFormat")
+}
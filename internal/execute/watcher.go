@@ -65,6 +65,7 @@ type Watcher struct {
 	reportDiagnostic               tsc.DiagnosticReporter
 	reportErrorSummary             tsc.DiagnosticsReporter
 	reportWatchStatus              tsc.DiagnosticReporter
+	statusServer                   *tsc.WatchStatusServer
 	testing                        tsc.CommandLineTesting
 
 	// contentMapperHost transforms content-mapped files; it is created once per watch session (when
@@ -80,6 +81,7 @@ type Watcher struct {
 	configFilePaths     []string
 
 	sourceFileCache *collections.SyncMap[tspath.Path, *cachedSourceFile]
+	astCache        *compiler.ASTCache
 
 	wm            *watchmanager.WatchManager
 	seenFiles     *collections.Set[tspath.Path] // all build dependencies (for event filtering)
@@ -109,6 +111,7 @@ func createWatcher(
 	commandLineRaw *collections.OrderedMap[string, any],
 	reportDiagnostic tsc.DiagnosticReporter,
 	reportErrorSummary tsc.DiagnosticsReporter,
+	structuredReporter *tsc.StructuredDiagnosticsReporter,
 	testing tsc.CommandLineTesting,
 ) *Watcher {
 	wm := watchmanager.NewWatchManager(sys.Writer(), sys.FS().DirectoryExists)
@@ -123,10 +126,15 @@ func createWatcher(
 		reportDiagnostic:               reportDiagnostic,
 		reportErrorSummary:             reportErrorSummary,
 		reportWatchStatus:              tsc.CreateWatchStatusReporter(sys, configParseResult.Locale(), configParseResult.CompilerOptions(), testing),
+		statusServer:                   tsc.NewWatchStatusServer(sys, configParseResult.Locale(), configParseResult.CompilerOptions()),
 		testing:                        testing,
 		sourceFileCache:                &collections.SyncMap[tspath.Path, *cachedSourceFile]{},
+		astCache:                       newASTCache(sys, configParseResult.CompilerOptions()),
 		wm:                             wm,
 	}
+	if structuredReporter != nil {
+		w.reportWatchStatus = structuredReporter.ReportWatchStatus
+	}
 	if configParseResult.ConfigFile != nil {
 		w.configFileName = configParseResult.ConfigFile.SourceFile.FileName()
 	}
@@ -155,9 +163,13 @@ func (w *Watcher) start(ctx context.Context) {
 	if w.testing == nil {
 		w.wm.EnsureDefaultBackend()
 	}
+	if err := w.statusServer.Listen(ctx); err != nil {
+		fmt.Fprintf(w.sys.Writer(), "%v\n", err)
+	}
 
 	w.reportWatchStatus(ast.NewCompilerDiagnostic(diagnostics.Starting_compilation_in_watch_mode))
 	w.watchSetDirty = true
+	w.statusServer.BuildStarted()
 	if err := w.doBuild(); err != nil {
 		w.wm.ForceOverflow()
 	}
@@ -369,6 +381,7 @@ func (w *Watcher) DoCycle() {
 	}
 
 	w.reportWatchStatus(ast.NewCompilerDiagnostic(diagnostics.File_change_detected_Starting_incremental_compilation))
+	w.statusServer.BuildStarted()
 	if err := w.doBuild(); err != nil {
 		// Mid-cycle watch failure; force a full rebuild on the next event
 		w.wm.ForceOverflow()
@@ -430,7 +443,7 @@ func (w *Watcher) doBuild() error {
 	if w.program != nil && w.programReady && !w.configModified && !w.watchSetDirty && !w.forceFullRebuild {
 		cached := cachedvfs.From(w.sys.FS())
 		innerHost := compiler.NewCompilerHost(w.sys.GetCurrentDirectory(), cached, w.sys.DefaultLibraryPath(), w.extendedConfigCache, getTraceFromSys(w.sys, w.config.Locale(), w.testing), w.contentMapperProject)
-		host := &watchCompilerHost{CompilerHost: innerHost, cache: w.sourceFileCache}
+		host := &watchCompilerHost{CompilerHost: compiler.NewASTCacheCompilerHost(innerHost, w.astCache), cache: w.sourceFileCache}
 
 		if w.tryUpdateProgram(host) {
 			w.fastPathBuilds++
@@ -445,6 +458,7 @@ func (w *Watcher) doBuild() error {
 			}
 			w.configModified = false
 
+			w.statusServer.BuildFinished(result.Diagnostics)
 			errorCount := len(result.Diagnostics)
 			if errorCount == 1 {
 				w.reportWatchStatus(ast.NewCompilerDiagnostic(diagnostics.Found_1_error_Watching_for_file_changes))
@@ -462,7 +476,7 @@ func (w *Watcher) doBuild() error {
 	cached := cachedvfs.From(w.sys.FS())
 	tfs := &trackingvfs.FS{Inner: cached}
 	innerHost := compiler.NewCompilerHost(w.sys.GetCurrentDirectory(), tfs, w.sys.DefaultLibraryPath(), w.extendedConfigCache, getTraceFromSys(w.sys, w.config.Locale(), w.testing), w.contentMapperProject)
-	host := &watchCompilerHost{CompilerHost: innerHost, cache: w.sourceFileCache}
+	host := &watchCompilerHost{CompilerHost: compiler.NewASTCacheCompilerHost(innerHost, w.astCache), cache: w.sourceFileCache}
 
 	if w.config.ConfigFile != nil {
 		for dir := range w.config.WildcardDirectories() {
@@ -506,6 +520,7 @@ func (w *Watcher) doBuild() error {
 
 	if err := w.reconcileWatches(seenSlice); err != nil {
 		fmt.Fprintf(w.sys.Writer(), "%v\n", err)
+		w.statusServer.BuildFinished(result.Diagnostics)
 		return err
 	}
 	w.watchSetDirty = false
@@ -520,6 +535,7 @@ func (w *Watcher) doBuild() error {
 		return true
 	})
 
+	w.statusServer.BuildFinished(result.Diagnostics)
 	errorCount := len(result.Diagnostics)
 	if errorCount == 1 {
 		w.reportWatchStatus(ast.NewCompilerDiagnostic(diagnostics.Found_1_error_Watching_for_file_changes))
@@ -42,6 +42,7 @@ const (
 	smartSelectionCmd           baselineCommand = "Smart Selection"
 	codeLensesCmd               baselineCommand = "Code Lenses"
 	documentSymbolsCmd          baselineCommand = "Document Symbols"
+	typeHierarchyCmd            baselineCommand = "Type Hierarchy"
 )
 
 type baselineCommand string
@@ -85,6 +86,8 @@ func getBaselineExtension(command baselineCommand) string {
 		return "baseline"
 	case callHierarchyCmd:
 		return "callHierarchy.txt"
+	case typeHierarchyCmd:
+		return "typeHierarchy.txt"
 	case autoImportsCmd:
 		return "baseline.md"
 	case linkedEditingCmd:
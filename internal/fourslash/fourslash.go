@@ -9,6 +9,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"unicode/utf8"
 
@@ -71,6 +72,15 @@ type FourslashTest struct {
 	// Semantic token configuration
 	semanticTokenTypes     []string
 	semanticTokenModifiers []string
+
+	// Edits the server asked the client to apply with workspace/applyEdit, not yet applied.
+	pendingWorkspaceEditsMu sync.Mutex
+	pendingWorkspaceEdits   []*lsproto.WorkspaceEdit
+
+	// chooseMessageAction, if set, picks the action with which window/showMessageRequest is answered, or
+	// returns nil to dismiss the message.
+	chooseMessageActionMu sync.Mutex
+	chooseMessageAction   func(actions []*lsproto.MessageActionItem) *lsproto.MessageActionItem
 }
 
 type scriptInfo struct {
@@ -313,6 +323,37 @@ func (f *FourslashTest) handleServerRequest(_ context.Context, req *lsproto.Requ
 			Result:  results,
 		}
 
+	case lsproto.MethodWorkspaceApplyEdit:
+		// Record the edit; it is applied once the request that caused it completes.
+		params, err := lsproto.UnmarshalParams[*lsproto.ApplyWorkspaceEditParams](req)
+		if err == nil && params != nil {
+			f.pendingWorkspaceEditsMu.Lock()
+			f.pendingWorkspaceEdits = append(f.pendingWorkspaceEdits, params.Edit)
+			f.pendingWorkspaceEditsMu.Unlock()
+		}
+		return &lsproto.ResponseMessage{
+			ID:      req.ID,
+			JSONRPC: req.JSONRPC,
+			Result:  &lsproto.ApplyWorkspaceEditResult{Applied: err == nil},
+		}
+
+	case lsproto.MethodWindowShowMessageRequest:
+		var result any = lsproto.Null{}
+		params, err := lsproto.UnmarshalParams[*lsproto.ShowMessageRequestParams](req)
+		f.chooseMessageActionMu.Lock()
+		choose := f.chooseMessageAction
+		f.chooseMessageActionMu.Unlock()
+		if err == nil && params != nil && params.Actions != nil && choose != nil {
+			if action := choose(*params.Actions); action != nil {
+				result = action
+			}
+		}
+		return &lsproto.ResponseMessage{
+			ID:      req.ID,
+			JSONRPC: req.JSONRPC,
+			Result:  result,
+		}
+
 	case lsproto.MethodClientRegisterCapability:
 		// Accept all capability registrations
 		return &lsproto.ResponseMessage{
@@ -517,6 +558,12 @@ var (
 			},
 		},
 	}
+	defaultCodeActionCapabilities = &lsproto.CodeActionClientCapabilities{
+		DataSupport: ptrTrue,
+		ResolveSupport: &lsproto.ClientCodeActionResolveOptions{
+			Properties: []string{"edit"},
+		},
+	}
 	defaultWorkspaceEditCapabilities = &lsproto.WorkspaceEditClientCapabilities{
 		DocumentChanges: ptrTrue,
 		ResourceOperations: &[]lsproto.ResourceOperationKind{
@@ -547,6 +594,12 @@ func GetDefaultCapabilities() *lsproto.ClientCapabilities {
 					ItemDefaults: &[]string{"commitCharacters", "editRange"},
 				},
 			},
+			CodeAction: &lsproto.CodeActionClientCapabilities{
+				DataSupport: ptrTrue,
+				ResolveSupport: &lsproto.ClientCodeActionResolveOptions{
+					Properties: []string{"edit"},
+				},
+			},
 			Diagnostic: &lsproto.DiagnosticClientCapabilities{
 				RelatedInformation: ptrTrue,
 				TagSupport: &lsproto.ClientDiagnosticsTagOptions{
@@ -682,6 +735,9 @@ func getCapabilitiesWithDefaults(capabilities *lsproto.ClientCapabilities) *lspr
 	if capabilitiesWithDefaults.TextDocument.Completion == nil {
 		capabilitiesWithDefaults.TextDocument.Completion = defaultCompletionCapabilities
 	}
+	if capabilitiesWithDefaults.TextDocument.CodeAction == nil {
+		capabilitiesWithDefaults.TextDocument.CodeAction = defaultCodeActionCapabilities
+	}
 	if capabilitiesWithDefaults.TextDocument.Diagnostic == nil {
 		capabilitiesWithDefaults.TextDocument.Diagnostic = defaultDiagnosticCapabilities
 	}
@@ -1922,7 +1978,8 @@ func (f *FourslashTest) VerifyCodeFixAll(t *testing.T, options VerifyCodeFixAllO
 
 	// Find fix-all actions. The server returns these as quickfix entries with titles like
 	// "Add all missing imports" when multiple diagnostics match the same provider.
-	// We look for actions that are NOT single-diagnostic fixes (i.e., have no Diagnostics attached).
+	// We look for actions that are NOT single-diagnostic fixes (i.e., have no Diagnostics attached)
+	// and that were produced for the requested fix ID.
 	var fixAllCandidates []*lsproto.CodeAction
 	for _, action := range actions {
 		if action.Diagnostics == nil || len(*action.Diagnostics) == 0 {
@@ -1934,11 +1991,16 @@ func (f *FourslashTest) VerifyCodeFixAll(t *testing.T, options VerifyCodeFixAllO
 	if len(fixAllCandidates) == 1 {
 		fixAllAction = fixAllCandidates[0]
 	} else {
-		// If there are multiple fix-all candidates, match by FixID in the title.
-		for _, action := range fixAllCandidates {
-			if strings.Contains(strings.ToLower(action.Title), strings.ToLower(options.FixID)) {
-				fixAllAction = action
-				break
+		// If there are multiple fix-all candidates, match by the FixID the action was produced for, then by FixID in the title.
+		fixAllAction = core.Find(fixAllCandidates, func(action *lsproto.CodeAction) bool {
+			return action.Data != nil && action.Data.FixId == options.FixID
+		})
+		if fixAllAction == nil {
+			for _, action := range fixAllCandidates {
+				if strings.Contains(strings.ToLower(action.Title), strings.ToLower(options.FixID)) {
+					fixAllAction = action
+					break
+				}
 			}
 		}
 	}
@@ -2016,6 +2078,249 @@ func (f *FourslashTest) VerifySourceFixAll(t *testing.T, expectedContent string)
 	assert.Equal(t, expectedContent, actual, "File content after source.fixAll did not match expected content.")
 }
 
+// VerifyApplyRefactorOptions are the options for VerifyApplyRefactor.
+type VerifyApplyRefactorOptions struct {
+	Description    string
+	NewFileContent string
+	// Expected contents of other files changed or created by the refactoring, by file name.
+	OtherFileContents map[string]string
+}
+
+// VerifyApplyRefactor verifies that applying the refactoring with the given description to the current
+// selection produces the expected file content.
+func (f *FourslashTest) VerifyApplyRefactor(t *testing.T, options VerifyApplyRefactorOptions) {
+	t.Helper()
+
+	matchingAction := f.getRefactorAction(t, options.Description)
+	if matchingAction.Edit != nil {
+		expectedURI := lsconv.FileNameToDocumentURI(f.activeFilename)
+		if options.OtherFileContents == nil && matchingAction.Edit.Changes != nil {
+			for uri := range *matchingAction.Edit.Changes {
+				if uri != expectedURI {
+					t.Fatalf("Refactoring returned edits for unexpected URI %q (expected %q)", uri, expectedURI)
+				}
+			}
+		}
+		f.applyWorkspaceEdit(t, matchingAction.Edit)
+	}
+
+	actual := f.getScriptInfo(f.activeFilename).content
+	assert.Equal(t, options.NewFileContent, actual, "File content after applying refactoring did not match expected content.")
+	f.verifyFileContents(t, options.OtherFileContents)
+}
+
+// VerifyMoveToFile verifies that moving the current selection to targetFileName with the "Move to file"
+// refactoring produces the expected file contents. Like a client that lets the user pick the target, it passes
+// the target file to the command.
+func (f *FourslashTest) VerifyMoveToFile(t *testing.T, targetFileName string, expectedFileContents map[string]string) {
+	t.Helper()
+
+	action := f.getRefactorAction(t, diagnostics.Move_to_file.String())
+	if action.Command == nil || action.Command.Arguments == nil {
+		t.Fatalf("Refactoring %q has no command", action.Title)
+	}
+	arguments := append(slices.Clone(*action.Command.Arguments), lsconv.FileNameToDocumentURI(targetFileName))
+	f.executeMoveToFile(t, action.Command.Command, arguments, func(actions []*lsproto.MessageActionItem) *lsproto.MessageActionItem {
+		t.Errorf("Moving to a given file asked for the target file")
+		return nil
+	}, expectedFileContents)
+}
+
+// VerifyMoveToFilePrompt verifies that the "Move to file" refactoring asks for the target file offering exactly
+// the expected choices, named relative to the active file, and that picking choice produces the expected file
+// contents.
+func (f *FourslashTest) VerifyMoveToFilePrompt(t *testing.T, expectedChoices []string, choice string, expectedFileContents map[string]string) {
+	t.Helper()
+
+	action := f.getRefactorAction(t, diagnostics.Move_to_file.String())
+	if action.Command == nil || action.Command.Arguments == nil {
+		t.Fatalf("Refactoring %q has no command", action.Title)
+	}
+	var offered []string
+	f.executeMoveToFile(t, action.Command.Command, *action.Command.Arguments, func(actions []*lsproto.MessageActionItem) *lsproto.MessageActionItem {
+		var chosen *lsproto.MessageActionItem
+		for _, messageAction := range actions {
+			offered = append(offered, messageAction.Title)
+			if messageAction.Title == choice {
+				chosen = messageAction
+			}
+		}
+		return chosen
+	}, expectedFileContents)
+	assert.DeepEqual(t, offered, expectedChoices)
+}
+
+func (f *FourslashTest) executeMoveToFile(t *testing.T, command string, arguments []any, chooseMessageAction func(actions []*lsproto.MessageActionItem) *lsproto.MessageActionItem, expectedFileContents map[string]string) {
+	t.Helper()
+
+	f.chooseMessageActionMu.Lock()
+	f.chooseMessageAction = chooseMessageAction
+	f.chooseMessageActionMu.Unlock()
+	defer func() {
+		f.chooseMessageActionMu.Lock()
+		f.chooseMessageAction = nil
+		f.chooseMessageActionMu.Unlock()
+	}()
+	sendRequest(t, f, lsproto.WorkspaceExecuteCommandInfo, &lsproto.ExecuteCommandParams{
+		Command:   command,
+		Arguments: &arguments,
+	})
+
+	f.pendingWorkspaceEditsMu.Lock()
+	edits := f.pendingWorkspaceEdits
+	f.pendingWorkspaceEdits = nil
+	f.pendingWorkspaceEditsMu.Unlock()
+	if len(edits) == 0 {
+		t.Fatalf("Moving to file did not produce any edits")
+	}
+	for _, edit := range edits {
+		f.applyWorkspaceEdit(t, edit)
+	}
+	f.verifyFileContents(t, expectedFileContents)
+}
+
+func (f *FourslashTest) getRefactorAction(t *testing.T, description string) *lsproto.CodeAction {
+	t.Helper()
+
+	actions := f.getRefactorActions(t)
+	for _, action := range actions {
+		if action.Title == description {
+			if action.Edit == nil && action.Data != nil {
+				action = sendRequest(t, f, lsproto.CodeActionResolveInfo, action)
+			}
+			return action
+		}
+	}
+	t.Fatalf("No refactoring with description %q found. Available refactorings: %v", description, core.Map(actions, func(a *lsproto.CodeAction) string { return a.Title }))
+	return nil
+}
+
+func (f *FourslashTest) verifyFileContents(t *testing.T, expectedFileContents map[string]string) {
+	t.Helper()
+
+	for _, fileName := range slices.Sorted(maps.Keys(expectedFileContents)) {
+		script := f.getScriptInfo(fileName)
+		if script == nil {
+			t.Fatalf("Expected script info for %s, but got nil", fileName)
+		}
+		assert.Equal(t, expectedFileContents[fileName], script.content, "File content of %s did not match expected content.", fileName)
+	}
+}
+
+// applyWorkspaceEdit applies the text edits of a workspace edit and creates the files it creates.
+func (f *FourslashTest) applyWorkspaceEdit(t *testing.T, edit *lsproto.WorkspaceEdit) {
+	t.Helper()
+
+	applyEdits := func(fileName string, edits []*lsproto.TextEdit) {
+		script := f.getOrLoadScriptInfo(fileName)
+		if script == nil {
+			t.Fatalf("Workspace edit changes unknown file %s", fileName)
+		}
+		changes := core.Map(edits, func(edit *lsproto.TextEdit) core.TextChange {
+			return core.TextChange{
+				TextRange: f.fromLSPRange(script, edit.Range),
+				NewText:   edit.NewText,
+			}
+		})
+		f.editScriptAndUpdateMarkersWorker(t, fileName, changes)
+	}
+
+	if edit.Changes != nil {
+		for uri, edits := range *edit.Changes {
+			applyEdits(uri.FileName(), edits)
+		}
+	}
+	if edit.DocumentChanges != nil {
+		for _, docChange := range *edit.DocumentChanges {
+			switch {
+			case docChange.CreateFile != nil:
+				fileName := docChange.CreateFile.Uri.FileName()
+				if err := f.vfs.WriteFile(fileName, ""); err != nil {
+					t.Fatalf("failed to create %s: %v", fileName, err)
+				}
+				f.scriptInfos[fileName] = newScriptInfo(fileName, "")
+				sendNotification(t, f, lsproto.WorkspaceDidChangeWatchedFilesInfo, &lsproto.DidChangeWatchedFilesParams{
+					Changes: []*lsproto.FileEvent{{Uri: docChange.CreateFile.Uri, Type: lsproto.FileChangeTypeCreated}},
+				})
+			case docChange.TextDocumentEdit != nil:
+				applyEdits(docChange.TextDocumentEdit.TextDocument.Uri.FileName(), core.Map(docChange.TextDocumentEdit.Edits, func(edit lsproto.TextEditOrAnnotatedTextEditOrSnippetTextEdit) *lsproto.TextEdit {
+					return edit.TextEdit
+				}))
+			}
+		}
+	}
+}
+
+// VerifyRefactorsAvailable verifies the titles of the refactorings offered for the current selection.
+func (f *FourslashTest) VerifyRefactorsAvailable(t *testing.T, expected []string) {
+	t.Helper()
+
+	actions := f.getRefactorActions(t)
+	assert.DeepEqual(t, expected, core.Map(actions, func(a *lsproto.CodeAction) string { return a.Title }))
+}
+
+// VerifyRefactorResolveContentModified verifies that once edit has changed the document so that the refactoring
+// with the given description is no longer offered for the current selection, resolving the code action offered
+// before the edit fails with ContentModified.
+func (f *FourslashTest) VerifyRefactorResolveContentModified(t *testing.T, description string, edit func()) {
+	t.Helper()
+
+	actions := f.getRefactorActions(t)
+	index := slices.IndexFunc(actions, func(action *lsproto.CodeAction) bool { return action.Title == description })
+	if index < 0 {
+		t.Fatalf("No refactoring with description %q found", description)
+	}
+	action := actions[index]
+	if action.Edit != nil || action.Data == nil {
+		t.Fatalf("Refactoring %q was not deferred to codeAction/resolve", description)
+	}
+	edit()
+	resMsg, _, _ := lsptestutil.SendRequest(t, f.client, lsproto.CodeActionResolveInfo, action)
+	if resMsg == nil {
+		t.Fatalf("Nil response received for %s request", lsproto.MethodCodeActionResolve)
+	}
+	resp := resMsg.AsResponse()
+	if resp.Error == nil {
+		t.Fatalf("Resolving %q after the edit succeeded; expected ContentModified", description)
+	}
+	assert.Equal(t, resp.Error.Code, int32(lsproto.ErrorCodeContentModified))
+}
+
+// getRefactorActions gets the refactor code actions for the current selection.
+func (f *FourslashTest) getRefactorActions(t *testing.T) []*lsproto.CodeAction {
+	t.Helper()
+
+	only := []lsproto.CodeActionKind{lsproto.CodeActionKindRefactor}
+	end := f.currentCaretPosition
+	if f.selectionEnd != nil {
+		end = *f.selectionEnd
+	}
+	params := &lsproto.CodeActionParams{
+		TextDocument: lsproto.TextDocumentIdentifier{
+			Uri: lsconv.FileNameToDocumentURI(f.activeFilename),
+		},
+		Range: lsproto.Range{
+			Start: f.currentCaretPosition,
+			End:   end,
+		},
+		Context: &lsproto.CodeActionContext{
+			Diagnostics: []*lsproto.Diagnostic{},
+			Only:        &only,
+		},
+	}
+	result := sendRequest(t, f, lsproto.TextDocumentCodeActionInfo, params)
+
+	var actions []*lsproto.CodeAction
+	if result.CommandOrCodeActionArray != nil {
+		for _, item := range *result.CommandOrCodeActionArray {
+			if item.CodeAction != nil {
+				actions = append(actions, item.CodeAction)
+			}
+		}
+	}
+	return actions
+}
+
 // getCodeFixActions gets per-diagnostic quick fix code actions, excluding fix-all entries.
 func (f *FourslashTest) getCodeFixActions(t *testing.T, errorCode ...int) []*lsproto.CodeAction {
 	t.Helper()
@@ -2314,13 +2619,15 @@ func (f *FourslashTest) VerifyImportFixAtPosition(t *testing.T, expectedTexts []
 	}
 	result := sendRequest(t, f, lsproto.TextDocumentCodeActionInfo, params)
 
-	// Find all auto-import code actions (fixes with fixId/fixName related to imports)
+	// Find all auto-import code actions (fixes with fixId/fixName related to imports), like the
+	// TypeScript harness, which keeps the fixes named "import" among the fixes for every diagnostic.
 	// Skip fix-all entries (those without diagnostics attached)
 	var importActions []*lsproto.CodeAction
 	if result.CommandOrCodeActionArray != nil {
 		for _, item := range *result.CommandOrCodeActionArray {
 			if item.CodeAction != nil && item.CodeAction.Kind != nil && *item.CodeAction.Kind == lsproto.CodeActionKindQuickFix {
-				if item.CodeAction.Diagnostics != nil && len(*item.CodeAction.Diagnostics) > 0 {
+				if item.CodeAction.Diagnostics != nil && len(*item.CodeAction.Diagnostics) > 0 &&
+					item.CodeAction.Data != nil && slices.Contains(ls.ImportFixProvider.FixIds, item.CodeAction.Data.FixId) {
 					importActions = append(importActions, item.CodeAction)
 				}
 			}
@@ -2904,6 +3211,36 @@ func (f *FourslashTest) VerifyFoldingRangeLines(t *testing.T, expected []Folding
 	}
 }
 
+// VerifyDocumentLinks checks that the document links of the active file cover exactly the
+// ranges of that file, in order, and that each link targets the corresponding expected file.
+func (f *FourslashTest) VerifyDocumentLinks(t *testing.T, expectedTargets []string) {
+	params := &lsproto.DocumentLinkParams{
+		TextDocument: lsproto.TextDocumentIdentifier{
+			Uri: lsconv.FileNameToDocumentURI(f.activeFilename),
+		},
+	}
+	result := sendRequest(t, f, lsproto.TextDocumentDocumentLinkInfo, params)
+	if result.DocumentLinks == nil {
+		t.Fatalf("Nil response received for document link request")
+	}
+
+	actualLinks := *result.DocumentLinks
+	expectedRanges := f.getRangesInFile(f.activeFilename)
+	if len(expectedRanges) != len(expectedTargets) {
+		t.Fatalf("verifyDocumentLinks failed - %d ranges in file but %d expected targets", len(expectedRanges), len(expectedTargets))
+	}
+	if len(actualLinks) != len(expectedTargets) {
+		t.Fatalf("verifyDocumentLinks failed - expected %d links, got %d", len(expectedTargets), len(actualLinks))
+	}
+	for i, link := range actualLinks {
+		assertDeepEqual(t, link.Range, expectedRanges[i].LSRange, fmt.Sprintf("verifyDocumentLinks failed - range of link %d", i))
+		expectedTarget := lsproto.URI(lsconv.FileNameToDocumentURI(expectedTargets[i]))
+		if link.Target == nil || *link.Target != expectedTarget {
+			t.Errorf("verifyDocumentLinks failed - link %d: expected target %s, got %v", i, expectedTarget, link.Target)
+		}
+	}
+}
+
 func (f *FourslashTest) VerifyBaselineHover(t *testing.T) {
 	markersAndItems := core.MapFiltered(f.Markers(), func(marker *Marker) (markerAndItem[*lsproto.Hover], bool) {
 		if marker.Name == nil {
@@ -3422,6 +3759,76 @@ func (f *FourslashTest) VerifyBaselineCallHierarchy(t *testing.T) {
 	f.addResultToBaseline(t, callHierarchyCmd, strings.TrimSuffix(result.String(), "\n"))
 }
 
+func (f *FourslashTest) VerifyBaselineTypeHierarchy(t *testing.T) {
+	fileName := f.activeFilename
+	position := f.currentCaretPosition
+
+	params := &lsproto.TypeHierarchyPrepareParams{
+		TextDocument: lsproto.TextDocumentIdentifier{
+			Uri: lsconv.FileNameToDocumentURI(fileName),
+		},
+		Position: position,
+	}
+
+	prepareResult := sendRequest(t, f, lsproto.TextDocumentPrepareTypeHierarchyInfo, params)
+	if prepareResult.TypeHierarchyItems == nil || len(*prepareResult.TypeHierarchyItems) == 0 {
+		f.addResultToBaseline(t, typeHierarchyCmd, "No type hierarchy items available")
+		return
+	}
+
+	var result strings.Builder
+	for _, item := range *prepareResult.TypeHierarchyItems {
+		formatTypeHierarchyItem(f, &result, item, "", "")
+		formatTypeHierarchyRelatives(t, f, &result, item, "supertypes", "", false /*last*/, make(map[lsproto.Location]bool))
+		formatTypeHierarchyRelatives(t, f, &result, item, "subtypes", "", true /*last*/, make(map[lsproto.Location]bool))
+	}
+	f.addResultToBaseline(t, typeHierarchyCmd, strings.TrimSuffix(result.String(), "\n"))
+}
+
+func formatTypeHierarchyItem(f *FourslashTest, result *strings.Builder, item *lsproto.TypeHierarchyItem, firstPrefix string, prefix string) {
+	file := f.getOrLoadScriptInfo(item.Uri.FileName())
+	result.WriteString(fmt.Sprintf("%s╭ name: %s\n", firstPrefix, item.Name))
+	result.WriteString(fmt.Sprintf("%s├ kind: %s\n", prefix, symbolKindToLowercase(item.Kind)))
+	if item.Detail != nil && *item.Detail != "" {
+		result.WriteString(fmt.Sprintf("%s├ containerName: %s\n", prefix, *item.Detail))
+	}
+	result.WriteString(fmt.Sprintf("%s├ file: %s\n", prefix, item.Uri.FileName()))
+	result.WriteString(prefix)
+	result.WriteString("├ selectionSpan:\n")
+	formatCallHierarchyItemSpan(f, file, result, item.SelectionRange, prefix+"│ ", prefix+"│ ")
+}
+
+// Writes the supertypes or subtypes of an item, recursing in the same direction until an item repeats.
+func formatTypeHierarchyRelatives(t *testing.T, f *FourslashTest, result *strings.Builder, item *lsproto.TypeHierarchyItem, direction string, prefix string, last bool, seen map[lsproto.Location]bool) {
+	marker := "├"
+	if last {
+		marker = "╰"
+	}
+	location := lsproto.Location{Uri: item.Uri, Range: item.SelectionRange}
+	if seen[location] {
+		result.WriteString(fmt.Sprintf("%s%s %s: ...\n", prefix, marker, direction))
+		return
+	}
+	seen[location] = true
+	defer delete(seen, location)
+
+	var response lsproto.TypeHierarchyItemsOrNull
+	if direction == "supertypes" {
+		response = sendRequest(t, f, lsproto.TypeHierarchySupertypesInfo, &lsproto.TypeHierarchySupertypesParams{Item: item})
+	} else {
+		response = sendRequest(t, f, lsproto.TypeHierarchySubtypesInfo, &lsproto.TypeHierarchySubtypesParams{Item: item})
+	}
+	if response.TypeHierarchyItems == nil || len(*response.TypeHierarchyItems) == 0 {
+		result.WriteString(fmt.Sprintf("%s%s %s: none\n", prefix, marker, direction))
+		return
+	}
+	result.WriteString(fmt.Sprintf("%s├ %s:\n", prefix, direction))
+	for _, relative := range *response.TypeHierarchyItems {
+		formatTypeHierarchyItem(f, result, relative, prefix+"│ ", prefix+"│ │ ")
+		formatTypeHierarchyRelatives(t, f, result, relative, direction, prefix+"│ │ ", true /*last*/, seen)
+	}
+}
+
 type callHierarchyItemDirection int
 
 const (
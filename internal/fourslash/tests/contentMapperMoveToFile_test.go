@@ -0,0 +1,22 @@
+package fourslash_test
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/internal/testutil"
+	"github.com/microsoft/typescript-go/internal/testutil/contentmappertest"
+)
+
+func TestContentMapperMoveToFileNotAvailable(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	f, done := newContentMapperFourslash(t, `// @Filename: /app.box
+/*a*/export const x = 1;/*b*/
+export const y = x + 1;
+`, contentmappertest.VerbatimMapper, ".box")
+	defer done()
+
+	f.GoToFile(t, "/app.box")
+	f.GoToSelect(t, "a", "b")
+	f.VerifyRefactorsAvailable(t, nil)
+}
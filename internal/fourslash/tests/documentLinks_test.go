@@ -0,0 +1,71 @@
+package fourslash_test
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/internal/fourslash"
+	"github.com/microsoft/typescript-go/internal/testutil"
+)
+
+func TestDocumentLinks(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `// @Filename: /tsconfig.json
+{ "compilerOptions": { "allowJs": true, "checkJs": true }, "files": ["/a.ts", "/b.js"] }
+// @Filename: /a.ts
+/// <reference path="[|./globals.d.ts|]" />
+/// <reference types="[|pkg|]" />
+import { util } from "[|./util|]";
+import { missing } from "./missing";
+export { util as u2 } from "[|./util|]";
+const lazy = import("[|./util|]");
+// @Filename: /b.js
+const { util } = require("[|./util|]");
+// @Filename: /globals.d.ts
+declare var g: number;
+// @Filename: /util.ts
+export const util = 1;
+// @Filename: /node_modules/@types/pkg/index.d.ts
+declare var pkg: number;`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.GoToFile(t, "/a.ts")
+	f.VerifyDocumentLinks(t, []string{
+		"/globals.d.ts",
+		"/node_modules/@types/pkg/index.d.ts",
+		"/util.ts",
+		"/util.ts",
+		"/util.ts",
+	})
+	f.GoToFile(t, "/b.js")
+	f.VerifyDocumentLinks(t, []string{"/util.ts"})
+}
+
+func TestDocumentLinksTsConfig(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `// @Filename: /project/tsconfig.json
+{
+    "extends": ["[|../tsconfig.base.json|]", "[|@scope/config|]", "./missing.json"],
+    "references": [{ "path": "[|../lib|]" }, { "path": "[|../other/tsconfig.other.json|]" }, { "path": "../missing" }]
+}
+// @Filename: /tsconfig.base.json
+{ "compilerOptions": { "strict": true } }
+// @Filename: /node_modules/@scope/config/tsconfig.json
+{ "compilerOptions": {} }
+// @Filename: /lib/tsconfig.json
+{ "compilerOptions": { "composite": true } }
+// @Filename: /other/tsconfig.other.json
+{ "compilerOptions": { "composite": true } }
+// @Filename: /project/index.ts
+export {};`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.GoToFile(t, "/project/tsconfig.json")
+	f.VerifyDocumentLinks(t, []string{
+		"/tsconfig.base.json",
+		"/node_modules/@scope/config/tsconfig.json",
+		"/lib/tsconfig.json",
+		"/other/tsconfig.other.json",
+	})
+}
@@ -0,0 +1,65 @@
+package fourslash_test
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/internal/fourslash"
+	"github.com/microsoft/typescript-go/internal/testutil"
+)
+
+func TestExtractConstantEnclosingScope(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `function area(radius: number) {
+    const scale = 2;
+    return /*a*/Math.PI * radius/*b*/ * scale;
+}`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.GoToSelect(t, "a", "b")
+	f.VerifyRefactorsAvailable(t, []string{
+		"Extract to inner function in function 'area'",
+		"Extract to function in global scope",
+		"Extract to constant in enclosing scope",
+	})
+	f.VerifyApplyRefactor(t, fourslash.VerifyApplyRefactorOptions{
+		Description: "Extract to constant in enclosing scope",
+		NewFileContent: `function area(radius: number) {
+    const scale = 2;
+    const newLocal = Math.PI * radius;
+    return newLocal * scale;
+}`,
+	})
+}
+
+func TestExtractConstantModuleScope(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `export function greet(name: string) {
+    return name + /*a*/"!!!".repeat(3)/*b*/;
+}`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.GoToSelect(t, "a", "b")
+	f.VerifyApplyRefactor(t, fourslash.VerifyApplyRefactorOptions{
+		Description: "Extract to constant in module scope",
+		NewFileContent: `const newLocal = "!!!".repeat(3);
+export function greet(name: string) {
+    return name + newLocal;
+}`,
+	})
+}
+
+func TestExtractConstantResolveAfterEdit(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `export function greet(name: string) {
+    return name + /*a*/"!!!".repeat(3)/*b*/;
+}`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.GoToSelect(t, "a", "b")
+	f.VerifyRefactorResolveContentModified(t, "Extract to constant in module scope", func() {
+		f.ReplaceLine(t, 1, `    return name + "!!!" /* no longer an expression */;`)
+	})
+}
@@ -0,0 +1,117 @@
+package fourslash_test
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/internal/fourslash"
+	"github.com/microsoft/typescript-go/internal/testutil"
+)
+
+func TestExtractFunctionCapturedParameters(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `function outer(x: number, s: string) {
+    const y = 1;
+    /*a*/const z = x + y;
+    console.log(s, z);/*b*/
+}`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.GoToSelect(t, "a", "b")
+	f.VerifyRefactorsAvailable(t, []string{
+		"Extract to inner function in function 'outer'",
+		"Extract to function in global scope",
+	})
+	f.VerifyApplyRefactor(t, fourslash.VerifyApplyRefactorOptions{
+		Description: "Extract to function in global scope",
+		NewFileContent: `function outer(x: number, s: string) {
+    const y = 1;
+    newFunction(x, y, s);
+}
+
+function newFunction(x: number, y: number, s: string) {
+    const z = x + y;
+    console.log(s, z);
+}
+`,
+	})
+}
+
+func TestExtractFunctionInnerScope(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `export function outer(x: number) {
+    const y = /*a*/x * 2/*b*/;
+    return y;
+}`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.GoToSelect(t, "a", "b")
+	f.VerifyApplyRefactor(t, fourslash.VerifyApplyRefactorOptions{
+		Description: "Extract to inner function in function 'outer'",
+		NewFileContent: `export function outer(x: number) {
+    const y = newFunction();
+    return y;
+
+    function newFunction(): number {
+        return x * 2;
+    }
+}`,
+	})
+}
+
+func TestExtractFunctionReturnsDeclaration(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `export async function load(url: string) {
+    /*a*/const response = await fetch(url);
+    let text = await response.text();/*b*/
+    return text.length;
+}
+
+function unrelated() {}`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.GoToSelect(t, "a", "b")
+	f.VerifyApplyRefactor(t, fourslash.VerifyApplyRefactorOptions{
+		Description: "Extract to function in module scope",
+		NewFileContent: `export async function load(url: string) {
+    let text = await newFunction(url);
+    return text.length;
+}
+
+async function newFunction(url: string): Promise<string> {
+    const response = await fetch(url);
+    let text = await response.text();
+    return text;
+}
+
+function unrelated() {}`,
+	})
+}
+
+func TestExtractFunctionNotAvailable(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `class C {
+    x = 1;
+    m(n: number) {
+        /*a*/this.x = n;/*b*/
+        for (const i of [1, 2]) {
+            /*c*/if (i > n) break;/*d*/
+        }
+        let counter = 0;
+        /*e*/counter++;/*f*/
+        return counter;
+    }
+}`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.GoToSelect(t, "a", "b")
+	f.VerifyRefactorsAvailable(t, nil)
+	f.GoToSelect(t, "c", "d")
+	f.VerifyRefactorsAvailable(t, nil)
+	// A local that is written can only be captured by an inner function.
+	f.GoToSelect(t, "e", "f")
+	f.VerifyRefactorsAvailable(t, []string{"Extract to inner function in method 'm'"})
+}
@@ -0,0 +1,61 @@
+package fourslash_test
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/internal/fourslash"
+	"github.com/microsoft/typescript-go/internal/testutil"
+)
+
+func TestExtractTypeAlias(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `let point: /*a*/{ x: number; y: number }/*b*/ = { x: 0, y: 0 };`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.GoToSelect(t, "a", "b")
+	f.VerifyApplyRefactor(t, fourslash.VerifyApplyRefactorOptions{
+		Description: "Extract to type alias",
+		NewFileContent: `type NewType = {
+    x: number;
+    y: number;
+};
+
+let point: NewType = { x: 0, y: 0 };`,
+	})
+}
+
+func TestExtractTypeAliasWithTypeParameters(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `function pair<T, U extends string>(t: T, u: U): /*a*/[T, U]/*b*/ {
+    return [t, u];
+}`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.GoToSelect(t, "a", "b")
+	f.VerifyApplyRefactor(t, fourslash.VerifyApplyRefactorOptions{
+		Description: "Extract to type alias",
+		NewFileContent: `type NewType<T, U extends string> = [
+    T,
+    U
+];
+
+function pair<T, U extends string>(t: T, u: U): NewType<T, U> {
+    return [t, u];
+}`,
+	})
+}
+
+func TestExtractTypeAliasNotAvailable(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `type ElementOf<A> = A extends (infer E)[] ? /*a*/E[]/*b*/ : never;
+let x: /*c*/number | /*d*/string;`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.GoToSelect(t, "a", "b")
+	f.VerifyRefactorsAvailable(t, nil)
+	f.GoToSelect(t, "c", "d")
+	f.VerifyRefactorsAvailable(t, nil)
+}
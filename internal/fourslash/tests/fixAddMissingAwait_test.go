@@ -0,0 +1,49 @@
+package fourslash_test
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/internal/fourslash"
+	"github.com/microsoft/typescript-go/internal/testutil"
+)
+
+func TestFixAddMissingAwait(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `async function f(p: Promise<number>) {
+    return p.toFixed();
+}`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.VerifyCodeFix(t, fourslash.VerifyCodeFixOptions{
+		Description: "Add 'await'",
+		NewFileContent: `async function f(p: Promise<number>) {
+    return (await p).toFixed();
+}`,
+	})
+}
+
+func TestFixAddMissingAwaitAll(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `// @target: esnext
+async function f(p: Promise<number>, items: Promise<string[]>) {
+    const doubled = p * 2;
+    for (const item of items) {
+        item.length;
+    }
+    return doubled;
+}`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.VerifyCodeFixAll(t, fourslash.VerifyCodeFixAllOptions{
+		FixID: "addMissingAwait",
+		NewFileContent: `async function f(p: Promise<number>, items: Promise<string[]>) {
+    const doubled = await p * 2;
+    for await (const item of items) {
+        item.length;
+    }
+    return doubled;
+}`,
+	})
+}
@@ -0,0 +1,78 @@
+package fourslash_test
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/internal/fourslash"
+	"github.com/microsoft/typescript-go/internal/testutil"
+)
+
+func TestFixAddOverrideModifier(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `// @noImplicitOverride: true
+// @experimentalDecorators: true
+declare function dec(...args: any[]): any;
+abstract class Base {
+    name = "";
+    kind = "";
+    abstract run(): void;
+    static create() {}
+    protected readonly size = 0;
+}
+abstract class Derived extends Base {
+    @dec name = "derived";
+    abstract run(): void;
+    public static create() {}
+    protected readonly size = 1;
+    constructor(public kind: string) {
+        super();
+    }
+}`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.VerifyCodeFixAll(t, fourslash.VerifyCodeFixAllOptions{
+		FixID: "fixAddOverrideModifier",
+		NewFileContent: `declare function dec(...args: any[]): any;
+abstract class Base {
+    name = "";
+    kind = "";
+    abstract run(): void;
+    static create() {}
+    protected readonly size = 0;
+}
+abstract class Derived extends Base {
+    @dec override name = "derived";
+    abstract override run(): void;
+    public static override create() {}
+    protected override readonly size = 1;
+    constructor(public override kind: string) {
+        super();
+    }
+}`,
+	})
+}
+
+func TestSourceFixAllSkipsSpelling(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `// @noImplicitOverride: true
+class Base {
+    run() {}
+}
+export class Derived extends Base {
+    run() {
+        this.rn();
+    }
+}`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.VerifySourceFixAll(t, `class Base {
+    run() {}
+}
+export class Derived extends Base {
+    override run() {
+        this.rn();
+    }
+}`)
+}
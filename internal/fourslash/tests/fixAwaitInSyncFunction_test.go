@@ -0,0 +1,62 @@
+package fourslash_test
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/internal/fourslash"
+	"github.com/microsoft/typescript-go/internal/testutil"
+)
+
+func TestFixAwaitInSyncFunction(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `// @target: esnext
+function load(): number {
+    await Promise.resolve();
+    return 1;
+}`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.VerifyCodeFix(t, fourslash.VerifyCodeFixOptions{
+		Description: "Add async modifier to containing function",
+		NewFileContent: `async function load(): Promise<number> {
+    await Promise.resolve();
+    return 1;
+}`,
+	})
+}
+
+func TestFixAwaitInSyncFunctionAll(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `// @target: esnext
+export const f = x => {
+    await x;
+    await x;
+};
+export class C {
+    m(items: AsyncIterable<number>): Promise<void> {
+        for await (const item of items) {}
+    }
+}
+export const g = function () {
+    await 1;
+};`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.VerifyCodeFixAll(t, fourslash.VerifyCodeFixAllOptions{
+		FixID: "fixAwaitInSyncFunction",
+		NewFileContent: `export const f = async x => {
+    await x;
+    await x;
+};
+export class C {
+    async m(items: AsyncIterable<number>): Promise<void> {
+        for await (const item of items) {}
+    }
+}
+export const g = async function () {
+    await 1;
+};`,
+	})
+}
@@ -0,0 +1,48 @@
+package fourslash_test
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/internal/fourslash"
+	"github.com/microsoft/typescript-go/internal/testutil"
+)
+
+func TestFixSpelling(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `const options = { verbose: true, "dry-run": false };
+options.verbos;
+options.dryrun;`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.VerifyCodeFix(t, fourslash.VerifyCodeFixOptions{
+		Description: "Change spelling to 'verbose'",
+		NewFileContent: `const options = { verbose: true, "dry-run": false };
+options.verbose;
+options.dryrun;`,
+	})
+	f.VerifyCodeFix(t, fourslash.VerifyCodeFixOptions{
+		Description: "Change spelling to 'dry-run'",
+		NewFileContent: `const options = { verbose: true, "dry-run": false };
+options.verbos;
+options["dry-run"];`,
+	})
+}
+
+func TestFixSpellingAll(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `export const counter = 1;
+export function increment() {}
+increment(countr);
+incremnt();`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.VerifyCodeFixAll(t, fourslash.VerifyCodeFixAllOptions{
+		FixID: "fixSpelling",
+		NewFileContent: `export const counter = 1;
+export function increment() {}
+increment(counter);
+increment();`,
+	})
+}
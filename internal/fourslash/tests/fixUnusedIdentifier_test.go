@@ -0,0 +1,166 @@
+package fourslash_test
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/internal/fourslash"
+	"github.com/microsoft/typescript-go/internal/testutil"
+)
+
+func TestFixUnusedIdentifier(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `// @noUnusedLocals: true
+// @noUnusedParameters: true
+export function f(a: number, b: number) {
+    return b;
+}`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.VerifyCodeFixAvailableExact(t, []string{"Remove unused declaration for: 'a'", "Prefix 'a' with an underscore"})
+	f.VerifyCodeFix(t, fourslash.VerifyCodeFixOptions{
+		Description: "Remove unused declaration for: 'a'",
+		NewFileContent: `export function f(b: number) {
+    return b;
+}`,
+	})
+}
+
+func TestFixUnusedIdentifierParameterPassedByCallers(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `// @noUnusedLocals: true
+// @noUnusedParameters: true
+function f(a: number, b: number) {
+    return b;
+}
+f(1, 2);`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.VerifyCodeFixAvailableExact(t, []string{"Prefix 'a' with an underscore"})
+}
+
+func TestFixUnusedIdentifierLastParameterPassedByCallers(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `// @noUnusedLocals: true
+// @noUnusedParameters: true
+class C {
+    m(a: number, b: number) {
+        return a;
+    }
+}
+new C().m(1, 2);`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.VerifyCodeFixAvailableExact(t, []string{"Prefix 'b' with an underscore"})
+}
+
+func TestFixUnusedIdentifierSetterParameter(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `// @noUnusedLocals: true
+// @noUnusedParameters: true
+export class C {
+    set x(value: number) {}
+}`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.VerifyCodeFixAvailableExact(t, []string{"Prefix 'value' with an underscore"})
+}
+
+func TestFixUnusedIdentifierDeletesWrites(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `// @noUnusedLocals: true
+export function f() {
+    let x = 0;
+    x = 1;
+    if (x) {}
+}
+export function g() {
+    let y = 0;
+    y = 1;
+    y = 2;
+}`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.VerifyCodeFix(t, fourslash.VerifyCodeFixOptions{
+		Description: "Remove unused declaration for: 'y'",
+		NewFileContent: `export function f() {
+    let x = 0;
+    x = 1;
+    if (x) {}
+}
+export function g() {
+}`,
+	})
+}
+
+func TestFixUnusedIdentifierDeletesMemberWrites(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `// @noUnusedLocals: true
+export class C {
+    private p = 0;
+    #q = 0;
+    m() {
+        this.p = 1;
+        this.#q = 2;
+    }
+}`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.VerifyCodeFixAll(t, fourslash.VerifyCodeFixAllOptions{
+		FixID: "unusedIdentifier_delete",
+		NewFileContent: `export class C {
+    m() {
+    }
+}`,
+	})
+}
+
+func TestFixUnusedIdentifierNotOfferedForOtherWrites(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `// @noUnusedLocals: true
+declare function use(value: number): void;
+export function f() {
+    let x = 0;
+    use(x = 1);
+}`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.VerifyCodeFixAvailableExact(t, nil)
+}
+
+func TestFixUnusedIdentifierAll(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `// @noUnusedLocals: true
+// @noUnusedParameters: true
+// @Filename: /m.ts
+export const a = 1, b = 2, c = 3;
+// @Filename: /index.ts
+import { a, b } from "./m";
+import { c } from "./m";
+export function f<T>(x: number, y: number) {
+    const { p, q } = { p: 1, q: 2 };
+    const [first, second] = [1, 2];
+    let s1, s2;
+    return x + second + c;
+}
+class Unused {}`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.GoToFile(t, "/index.ts")
+	f.VerifyCodeFixAll(t, fourslash.VerifyCodeFixAllOptions{
+		FixID: "unusedIdentifier_delete",
+		NewFileContent: `import { c } from "./m";
+export function f(x: number) {
+    const [, second] = [1, 2];
+    return x + second + c;
+}
+`,
+	})
+}
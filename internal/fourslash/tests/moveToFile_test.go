@@ -0,0 +1,121 @@
+package fourslash_test
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/internal/fourslash"
+	"github.com/microsoft/typescript-go/internal/testutil"
+)
+
+func TestMoveToNewFile(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `// @Filename: /a.ts
+import { helper } from "./util";
+const local = 1;
+/*a*/export function foo() {
+    return helper(local);
+}/*b*/
+foo();
+// @Filename: /util.ts
+export function helper(n: number) { return n; }
+// @Filename: /b.ts
+import { foo } from "./a";
+foo();`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.GoToFile(t, "/a.ts")
+	f.GoToSelect(t, "a", "b")
+	f.VerifyApplyRefactor(t, fourslash.VerifyApplyRefactorOptions{
+		Description: "Move to a new file",
+		NewFileContent: `import { foo } from "./foo";
+export const local = 1;
+foo();`,
+		OtherFileContents: map[string]string{
+			"/foo.ts": `import { helper } from "./util";
+import { local } from "./a";
+
+export function foo() {
+    return helper(local);
+}
+`,
+			"/b.ts": `import { foo } from "./foo";
+foo();`,
+		},
+	})
+}
+
+func TestMoveToExistingFile(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `// @Filename: /a.ts
+/*a*/export const x = 1;/*b*/
+export const y = x + 1;
+// @Filename: /target.ts
+export const z = 0;
+// @Filename: /user.ts
+import { x, y } from "./a";
+console.log(x, y);`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.GoToFile(t, "/a.ts")
+	f.GoToSelect(t, "a", "b")
+	f.VerifyMoveToFile(t, "/target.ts", map[string]string{
+		"/a.ts": `import { x } from "./target";
+
+export const y = x + 1;`,
+		"/target.ts": `export const z = 0;
+
+export const x = 1;
+`,
+		"/user.ts": `import { y } from "./a";
+import { x } from "./target";
+console.log(x, y);`,
+	})
+}
+
+func TestMoveToFilePrompt(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `// @Filename: /src/a.ts
+/*a*/export const x = 1;/*b*/
+/*c*/export const y = 2;/*d*/
+// @Filename: /src/c.ts
+export const c = 0;
+// @Filename: /src/b.ts
+export const b = 0;
+// @Filename: /src/types.d.ts
+declare const t: number;
+// @Filename: /lib/d.ts
+export const d = 0;`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.GoToFile(t, "/src/a.ts")
+	f.GoToSelect(t, "a", "b")
+	f.VerifyMoveToFile(t, "/lib/d.ts", map[string]string{
+		"/lib/d.ts": `export const d = 0;
+
+export const x = 1;
+`,
+	})
+	// Files moved to recently come first, followed by other files in the same directory.
+	f.GoToSelect(t, "c", "d")
+	f.VerifyMoveToFilePrompt(t, []string{"../lib/d.ts", "./b.ts", "./c.ts", "Move to a new file"}, "Move to a new file", map[string]string{
+		"/src/a.ts": ``,
+		"/src/y.ts": `export const y = 2;
+`,
+	})
+}
+
+func TestMoveToFileNotAvailable(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `/*a*/import { x } from "./b";/*b*/
+export const y = x;
+// @Filename: /b.ts
+export const x = 1;`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.GoToSelect(t, "a", "b")
+	f.VerifyRefactorsAvailable(t, nil)
+}
@@ -0,0 +1,80 @@
+package fourslash_test
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/internal/fourslash"
+	"github.com/microsoft/typescript-go/internal/testutil"
+)
+
+func TestTypeHierarchy(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `// @Filename: /shapes.ts
+export interface Named {
+    name: string;
+}
+export interface /*shape*/Shape extends Named {
+    area(): number;
+}
+export abstract class Base implements Shape {
+    abstract name: string;
+    abstract area(): number;
+}
+// @Filename: /square.ts
+import { Base, Shape } from "./shapes";
+import * as shapes from "./shapes";
+export class Square extends Base {
+    name = "square";
+    area() { return 1; }
+}
+export interface Polygon extends shapes.Shape {
+    sides: number;
+}
+const Circle = class extends Base implements Shape {
+    name = "circle";
+    area() { return 3; }
+};`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.GoToMarker(t, "shape")
+	f.VerifyBaselineTypeHierarchy(t)
+}
+
+func TestTypeHierarchyAcrossProject(t *testing.T) {
+	t.Parallel()
+	defer testutil.RecoverAndFail(t, "Panic on fourslash test")
+	const content = `// @Filename: /projects/container/lib/tsconfig.json
+{
+	"compilerOptions": {
+		"composite": true,
+	},
+	files: ["index.ts"],
+}
+// @Filename: /projects/container/lib/index.ts
+export class /*base*/Base {}
+export class Derived extends Base {}
+// @Filename: /projects/container/exec/tsconfig.json
+{
+	"files": ["./index.ts"],
+	"references": [
+		{ "path": "../lib" },
+	],
+}
+// @Filename: /projects/container/exec/index.ts
+import { Base } from "../lib";
+class Downstream extends Base {}
+// @Filename: /projects/container/tsconfig.json
+{
+	"files": [],
+	"include": [],
+	"references": [
+		{ "path": "./lib" },
+		{ "path": "./exec" },
+	],
+}`
+	f, done := fourslash.NewFourslash(t, nil /*capabilities*/, content)
+	defer done()
+	f.GoToMarker(t, "base")
+	f.VerifyBaselineTypeHierarchy(t)
+}
@@ -0,0 +1,134 @@
+package ipc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/microsoft/typescript-go/internal/json"
+	"github.com/microsoft/typescript-go/internal/jsonrpc"
+)
+
+// The helpers in this file are shared by hosts that drive a child process over a connection, such as
+// content mappers and language service plugins.
+
+// LoggingProtocol wraps a Protocol and logs every message read or written, prefixed with Prefix.
+type LoggingProtocol struct {
+	Protocol
+	Prefix string
+	Logger func(message string)
+}
+
+func (p *LoggingProtocol) log(direction string, message any) {
+	data, err := json.Marshal(message)
+	if err != nil {
+		p.Logger(fmt.Sprintf("%s %s: <failed to serialize: %v>", p.Prefix, direction, err))
+		return
+	}
+	p.Logger(fmt.Sprintf("%s %s: %s", p.Prefix, direction, data))
+}
+
+func (p *LoggingProtocol) ReadMessage() (*Message, error) {
+	message, err := p.Protocol.ReadMessage()
+	if err == nil {
+		p.log("receive", message)
+	}
+	return message, err
+}
+
+func (p *LoggingProtocol) WriteRequest(id *jsonrpc.ID, method string, params any) error {
+	p.log("send", jsonrpc.RequestMessage{ID: id, Method: method, Params: params})
+	return p.Protocol.WriteRequest(id, method, params)
+}
+
+func (p *LoggingProtocol) WriteNotification(method string, params any) error {
+	p.log("send", jsonrpc.RequestMessage{Method: method, Params: params})
+	return p.Protocol.WriteNotification(method, params)
+}
+
+func (p *LoggingProtocol) WriteResponse(id *jsonrpc.ID, result any) error {
+	p.log("send", jsonrpc.ResponseMessage{ID: id, Result: result})
+	return p.Protocol.WriteResponse(id, result)
+}
+
+func (p *LoggingProtocol) WriteError(id *jsonrpc.ID, responseError *jsonrpc.ResponseError) error {
+	p.log("send", jsonrpc.ResponseMessage{ID: id, Error: responseError})
+	return p.Protocol.WriteError(id, responseError)
+}
+
+// StderrLogger is an io.Writer for a child process's stderr that logs each complete line, prefixed with
+// Prefix.
+type StderrLogger struct {
+	Prefix string
+	Logger func(message string)
+
+	mu      sync.Mutex
+	pending string
+}
+
+func (w *StderrLogger) Write(data []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.pending += string(data)
+	for {
+		index := strings.IndexByte(w.pending, '\n')
+		if index < 0 {
+			break
+		}
+		w.log(strings.TrimSuffix(w.pending[:index], "\r"))
+		w.pending = w.pending[index+1:]
+	}
+	return len(data), nil
+}
+
+// Flush logs the last line written, if it was not terminated.
+func (w *StderrLogger) Flush() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.pending != "" {
+		w.log(strings.TrimSuffix(w.pending, "\r"))
+		w.pending = ""
+	}
+}
+
+func (w *StderrLogger) log(message string) {
+	w.Logger(fmt.Sprintf("%s stderr: %s", w.Prefix, message))
+}
+
+// CloseOnceReadWriteCloser closes the wrapped io.ReadWriteCloser at most once, returning the same error
+// from every call to Close.
+type CloseOnceReadWriteCloser struct {
+	io.ReadWriteCloser
+	once sync.Once
+	err  error
+}
+
+func (c *CloseOnceReadWriteCloser) Close() error {
+	c.once.Do(func() { c.err = c.ReadWriteCloser.Close() })
+	return c.err
+}
+
+// ExitCode reports the exit code of the wrapped process, if it reports one and has exited.
+func (c *CloseOnceReadWriteCloser) ExitCode() (int, bool) {
+	if state, ok := c.ReadWriteCloser.(interface{ ExitCode() (int, bool) }); ok {
+		return state.ExitCode()
+	}
+	return 0, false
+}
+
+// RejectHandler rejects every request initiated by a child process, for protocols that are driven by the
+// parent only; a request from the child is a protocol violation. Notifications are ignored.
+type RejectHandler struct {
+	// Peer describes the child process in error messages.
+	Peer string
+}
+
+func (h RejectHandler) HandleRequest(ctx context.Context, method string, params json.Value) (any, error) {
+	return nil, fmt.Errorf("%s sent an unexpected request: %s", h.Peer, method)
+}
+
+func (RejectHandler) HandleNotification(ctx context.Context, method string, params json.Value) error {
+	return nil
+}
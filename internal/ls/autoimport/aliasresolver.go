@@ -8,6 +8,7 @@ import (
 	"github.com/microsoft/typescript-go/internal/core"
 	"github.com/microsoft/typescript-go/internal/module"
 	"github.com/microsoft/typescript-go/internal/packagejson"
+	"github.com/microsoft/typescript-go/internal/pnp"
 	"github.com/microsoft/typescript-go/internal/symlinks"
 	"github.com/microsoft/typescript-go/internal/tsoptions"
 	"github.com/microsoft/typescript-go/internal/tspath"
@@ -189,6 +190,11 @@ func (r *aliasResolver) GetPackageJsonInfo(pkgJsonPath string) *packagejson.Info
 	panic("unimplemented")
 }
 
+// GetPnpManifest implements checker.Program.
+func (r *aliasResolver) GetPnpManifest(directory string) *pnp.Manifest {
+	panic("unimplemented")
+}
+
 // GetProjectReferenceFromOutputDts implements checker.Program.
 func (r *aliasResolver) GetProjectReferenceFromOutputDts(path tspath.Path) *tsoptions.SourceOutputAndProjectReference {
 	panic("unimplemented")
@@ -25,6 +25,7 @@ type ImportAdder interface {
 	HasFixes() bool
 	AddImportFromExportedSymbol(symbol *ast.Symbol, isValidTypeOnlyUseSite bool)
 	AddImportFix(fix *Fix)
+	WriteFixes(tracker *change.Tracker)
 	Edits() []*lsproto.TextEdit
 }
 
@@ -116,8 +117,19 @@ func (adder *importAdder) AddImportFromExportedSymbol(exportedSymbol *ast.Symbol
 }
 
 func (adder *importAdder) Edits() []*lsproto.TextEdit {
-	// !!! organize imports?
 	tracker := change.NewTracker(adder.ctx, adder.view.program.Options(), adder.formatOptions, adder.converters)
+	adder.WriteFixes(tracker)
+
+	// Unmappable files are dropped by GetChanges, so a content-mapped importing file that cannot be
+	// faithfully rewritten yields no edits rather than a corrupting one.
+	changes, _ := tracker.GetChanges()
+	return changes[adder.view.importingFile.OriginalFileName()]
+}
+
+// WriteFixes records the accumulated import changes for the importing file in the given tracker, so they can
+// be combined with other edits to the same file.
+func (adder *importAdder) WriteFixes(tracker *change.Tracker) {
+	// !!! organize imports?
 	quotePreference := lsutil.GetQuotePreference(adder.view.importingFile, adder.preferences)
 	for _, fix := range adder.addToNamespace {
 		addNamespaceQualifier(fix, tracker, adder.view.importingFile, locale.Default)
@@ -166,13 +178,8 @@ func (adder *importAdder) Edits() []*lsproto.TextEdit {
 	}
 
 	if len(newDeclarations) > 0 {
-		insertImports(tracker, adder.view.importingFile, newDeclarations, true /*blankLineBetween*/, adder.preferences)
+		InsertImports(tracker, adder.view.importingFile, newDeclarations, true /*blankLineBetween*/, adder.preferences)
 	}
-
-	// Unmappable files are dropped by GetChanges, so a content-mapped importing file that cannot be
-	// faithfully rewritten yields no edits rather than a corrupting one.
-	changes, _ := tracker.GetChanges()
-	return changes[adder.view.importingFile.OriginalFileName()]
 }
 
 func sortedNamedImports(m map[string]*newImportBinding) []*newImportBinding {
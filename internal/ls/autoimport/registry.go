@@ -20,6 +20,7 @@ import (
 	"github.com/microsoft/typescript-go/internal/lsp/lsproto"
 	"github.com/microsoft/typescript-go/internal/module"
 	"github.com/microsoft/typescript-go/internal/packagejson"
+	"github.com/microsoft/typescript-go/internal/pnp"
 	"github.com/microsoft/typescript-go/internal/project/dirty"
 	"github.com/microsoft/typescript-go/internal/project/logging"
 	"github.com/microsoft/typescript-go/internal/symlinks"
@@ -310,6 +311,10 @@ type directory struct {
 	name           string
 	packageJson    *packagejson.InfoCacheEntry
 	hasNodeModules bool
+	// pnpManifest is the Yarn Plug'n'Play manifest of the directory, if it is the root
+	// of a Plug'n'Play project. Such directories get a node_modules bucket holding the
+	// packages of the manifest.
+	pnpManifest *pnp.Manifest
 }
 
 func (d *directory) Clone() *directory {
@@ -317,6 +322,7 @@ func (d *directory) Clone() *directory {
 		name:           d.name,
 		packageJson:    d.packageJson,
 		hasNodeModules: d.hasNodeModules,
+		pnpManifest:    d.pnpManifest,
 	}
 }
 
@@ -615,27 +621,38 @@ func (b *registryBuilder) updateBucketAndDirectoryExistence(change RegistryChang
 		}
 	}
 
-	updateDirectory := func(dirPath tspath.Path, dirName string, packageJsonChanged bool) {
+	updateDirectory := func(dirPath tspath.Path, dirName string, packageJsonChanged bool, pnpManifestChanged bool) {
 		packageJsonFileName := tspath.CombinePaths(dirName, "package.json")
 		hasNodeModules := b.host.FS().DirectoryExists(tspath.CombinePaths(dirName, "node_modules"))
+		var pnpManifest *pnp.Manifest
 		if entry, ok := b.directories.Get(dirPath); ok {
+			pnpManifest = entry.Value().pnpManifest
+			if pnpManifestChanged {
+				pnpManifest = b.loadPnpManifest(dirName)
+			}
 			entry.ChangeIf(func(dir *directory) bool {
-				return packageJsonChanged || dir.hasNodeModules != hasNodeModules
+				return packageJsonChanged || pnpManifestChanged || dir.hasNodeModules != hasNodeModules
 			}, func(dir *directory) {
 				dir.packageJson = b.host.GetPackageJson(packageJsonFileName)
 				dir.hasNodeModules = hasNodeModules
+				dir.pnpManifest = pnpManifest
 			})
 		} else {
+			pnpManifest = b.loadPnpManifest(dirName)
 			b.directories.Add(dirPath, &directory{
 				name:           dirName,
 				packageJson:    b.host.GetPackageJson(packageJsonFileName),
 				hasNodeModules: hasNodeModules,
+				pnpManifest:    pnpManifest,
 			})
 		}
 
-		if hasNodeModules {
-			if _, ok := b.nodeModules.Get(dirPath); !ok {
+		if hasNodeModules || pnpManifest != nil {
+			if entry, ok := b.nodeModules.Get(dirPath); !ok {
 				b.nodeModules.Add(dirPath, newRegistryBucket())
+			} else if pnpManifestChanged {
+				// A new install can move any package, so the whole bucket is rebuilt.
+				entry.Change(func(bucket *RegistryBucket) { bucket.markNodeModulesDirty("") })
 			}
 		} else {
 			b.nodeModules.TryDelete(dirPath)
@@ -643,20 +660,26 @@ func (b *registryBuilder) updateBucketAndDirectoryExistence(change RegistryChang
 	}
 
 	var addedNodeModulesDirs, removedNodeModulesDirs []tspath.Path
-	packageJsonChanged := func(dirName string) bool {
-		uri := lsconv.FileNameToDocumentURI(tspath.CombinePaths(dirName, "package.json"))
+	fileChanged := func(fileName string) bool {
+		uri := lsconv.FileNameToDocumentURI(fileName)
 		return change.Changed.Has(uri) || change.Deleted.Has(uri) || change.Created.Has(uri)
 	}
+	packageJsonChanged := func(dirName string) bool {
+		return fileChanged(tspath.CombinePaths(dirName, "package.json"))
+	}
+	pnpManifestChanged := func(dirName string) bool {
+		return fileChanged(tspath.CombinePaths(dirName, pnp.ManifestFileName)) || fileChanged(tspath.CombinePaths(dirName, pnp.DataFileName))
+	}
 	core.DiffMapsFunc(
 		b.base.directories,
 		neededDirectories,
 		func(dir *directory, dirName string) bool {
-			return !packageJsonChanged(dirName) && dir.hasNodeModules == b.host.FS().DirectoryExists(tspath.CombinePaths(dirName, "node_modules"))
+			return !packageJsonChanged(dirName) && !pnpManifestChanged(dirName) && dir.hasNodeModules == b.host.FS().DirectoryExists(tspath.CombinePaths(dirName, "node_modules"))
 		},
 		func(dirPath tspath.Path, dirName string) {
 			// Need and don't have
 			hadNodeModules := b.base.nodeModules[dirPath] != nil
-			updateDirectory(dirPath, dirName, false)
+			updateDirectory(dirPath, dirName, false, false)
 			if logger != nil {
 				logger.Logf("Added directory: %s", dirPath)
 			}
@@ -677,7 +700,7 @@ func (b *registryBuilder) updateBucketAndDirectoryExistence(change RegistryChang
 			}
 		},
 		func(dirPath tspath.Path, dir *directory, dirName string) {
-			updateDirectory(dirPath, dirName, packageJsonChanged(dirName))
+			updateDirectory(dirPath, dirName, packageJsonChanged(dirName), pnpManifestChanged(dirName))
 			if logger != nil {
 				logger.Logf("Changed directory: %s", dirPath)
 			}
@@ -785,6 +808,7 @@ func (b *registryBuilder) updateIndexes(ctx context.Context, change RegistryChan
 		dependencyNames *collections.Set[string]
 		dirName         string
 		dirPath         tspath.Path
+		pnpManifest     *pnp.Manifest
 
 		// For granular updates.
 		isUpdate       bool
@@ -841,7 +865,8 @@ func (b *registryBuilder) updateIndexes(ctx context.Context, change RegistryChan
 	var nodeModulesTasks []*nodeModulesBucketTask
 	tspath.ForEachAncestorDirectoryPath(change.RequestedFile, func(dirPath tspath.Path) (any, bool) {
 		if nodeModulesBucket, ok := b.nodeModules.Get(dirPath); ok {
-			dirName := core.FirstResult(b.directories.Get(dirPath)).Value().name
+			dir := core.FirstResult(b.directories.Get(dirPath)).Value()
+			dirName := dir.name
 			dependencies := b.computeDependenciesForNodeModulesDirectory(change, allResolvedPackageNames, dirName, dirPath)
 			bucketState := nodeModulesBucket.Value().state
 			// !!! Optimization: handle different dependency set via granular updates
@@ -858,6 +883,7 @@ func (b *registryBuilder) updateIndexes(ctx context.Context, change RegistryChan
 					dependencyNames: dependencies,
 					dirName:         dirName,
 					dirPath:         dirPath,
+					pnpManifest:     dir.pnpManifest,
 				})
 			} else if canDoGranularUpdate {
 				nodeModulesTasks = append(nodeModulesTasks, &nodeModulesBucketTask{
@@ -865,6 +891,7 @@ func (b *registryBuilder) updateIndexes(ctx context.Context, change RegistryChan
 					dependencyNames: dependencies,
 					dirName:         dirName,
 					dirPath:         dirPath,
+					pnpManifest:     dir.pnpManifest,
 					isUpdate:        true,
 					existingBucket:  nodeModulesBucket.Value(),
 					dirtyPackages:   dirtyPackages,
@@ -886,11 +913,14 @@ func (b *registryBuilder) updateIndexes(ctx context.Context, change RegistryChan
 		wg.Go(func() {
 			if task.isUpdate {
 				task.packageNames = task.dirtyPackages
+			} else if task.pnpManifest != nil {
+				task.directoryPackageNames = getPackageNamesInPnpManifest(task.pnpManifest)
+				task.packageNames = core.Coalesce(task.dependencyNames, task.directoryPackageNames)
 			} else {
 				task.directoryPackageNames = getPackageNamesInNodeModules(tspath.CombinePaths(task.dirName, "node_modules"), b.host.FS())
 				task.packageNames = core.Coalesce(task.dependencyNames, task.directoryPackageNames)
 			}
-			task.discovered = b.discoverBucketPackages(task.packageNames, task.dirName, task.dirPath)
+			task.discovered = b.discoverBucketPackages(task.packageNames, task.dirName, task.dirPath, task.pnpManifest)
 		})
 	}
 	wg.Wait()
@@ -1376,14 +1406,25 @@ func (b *registryBuilder) discoverBucketPackages(
 	packageNames *collections.Set[string],
 	dirName string,
 	dirPath tspath.Path,
+	pnpManifest *pnp.Manifest,
 ) []*discoveredPackage {
+	packageDirectory := func(packageName string) string {
+		if pnpManifest != nil {
+			// Packages of a Plug'n'Play project are wherever its manifest places them. Unknown
+			// names map to a directory that does not exist, like missing node_modules packages.
+			if pkg, ok := pnpManifest.ResolveDependency(pnpManifest.TopLevel(), packageName); ok {
+				return pkg.Location
+			}
+		}
+		return tspath.CombinePaths(dirName, "node_modules", packageName)
+	}
 	result := make([]*discoveredPackage, 0, packageNames.Len())
 	for packageName := range packageNames.Keys() {
 		typesPackageName := module.GetTypesPackageName(packageName)
-		packageJson := b.host.GetPackageJson(tspath.CombinePaths(dirName, "node_modules", packageName, "package.json"))
+		packageJson := b.host.GetPackageJson(tspath.CombinePaths(packageDirectory(packageName), "package.json"))
 		var typesPackageJson *packagejson.InfoCacheEntry
 		if packageName != typesPackageName {
-			typesJson := b.host.GetPackageJson(tspath.CombinePaths(dirName, "node_modules", typesPackageName, "package.json"))
+			typesJson := b.host.GetPackageJson(tspath.CombinePaths(packageDirectory(typesPackageName), "package.json"))
 			if typesJson.DirectoryExists {
 				typesPackageJson = typesJson
 			}
@@ -1807,6 +1848,17 @@ func (b *registryBuilder) updateNodeModulesBucket(
 	result.err = ctx.Err()
 }
 
+// loadPnpManifest returns the Plug'n'Play manifest of dirName, or nil if dirName is not the root of a
+// Plug'n'Play project.
+func (b *registryBuilder) loadPnpManifest(dirName string) *pnp.Manifest {
+	manifestPath := tspath.CombinePaths(dirName, pnp.ManifestFileName)
+	if !b.host.FS().FileExists(manifestPath) {
+		return nil
+	}
+	manifest, _ := pnp.Load(b.host.FS(), manifestPath)
+	return manifest
+}
+
 func (b *registryBuilder) getNearestAncestorDirectoryWithPackageJson(filePath tspath.Path) *directory {
 	return core.FirstResult(tspath.ForEachAncestorDirectoryPath(filePath.GetDirectoryPath(), func(dirPath tspath.Path) (result *directory, stop bool) {
 		if dirEntry, ok := b.directories.Get(dirPath); ok && dirEntry.Value().packageJson.Exists() {
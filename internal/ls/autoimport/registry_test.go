@@ -1087,6 +1087,49 @@ func TestHiddenDirectoriesInNodeModules(t *testing.T) {
 	})
 }
 
+func TestPnpProjectNodeModulesBucket(t *testing.T) {
+	t.Parallel()
+	projectRoot := "/home/src/pnp-project"
+	lodashDir := projectRoot + "/.yarn/unplugged/lodash-npm-4.17.21/node_modules/lodash"
+	typesNodeDir := projectRoot + "/.yarn/unplugged/@types-node-npm-20.0.0/node_modules/@types/node"
+	files := map[string]any{
+		projectRoot + "/tsconfig.json": `{ "compilerOptions": { "module": "nodenext" } }`,
+		projectRoot + "/package.json":  `{ "name": "app", "dependencies": { "lodash": "*" }, "devDependencies": { "@types/node": "*" } }`,
+		projectRoot + "/index.ts":      `import { chunk } from "lodash";`,
+		projectRoot + "/.pnp.cjs":      "",
+		projectRoot + "/.pnp.data.json": `{
+			"enableTopLevelFallback": true,
+			"fallbackExclusionList": [],
+			"fallbackPool": [],
+			"ignorePatternData": null,
+			"packageRegistryData": [
+				[null, [[null, {"packageLocation": "./", "packageDependencies": [["lodash", "npm:4.17.21"], ["@types/node", "npm:20.0.0"]], "linkType": "SOFT"}]]],
+				["lodash", [["npm:4.17.21", {"packageLocation": "./.yarn/unplugged/lodash-npm-4.17.21/node_modules/lodash/", "packageDependencies": [], "linkType": "HARD"}]]],
+				["@types/node", [["npm:20.0.0", {"packageLocation": "./.yarn/unplugged/@types-node-npm-20.0.0/node_modules/@types/node/", "packageDependencies": [], "linkType": "HARD"}]]]
+			]
+		}`,
+		lodashDir + "/package.json":    `{ "name": "lodash", "version": "4.17.21", "types": "index.d.ts" }`,
+		lodashDir + "/index.d.ts":      "export declare function chunk<T>(array: T[], size: number): T[][];\n",
+		typesNodeDir + "/package.json": `{ "name": "@types/node", "version": "20.0.0", "types": "index.d.ts" }`,
+		typesNodeDir + "/index.d.ts":   "declare module \"fs\" { export function readFileSync(path: string): string; }\n",
+	}
+
+	session, _ := projecttestutil.Setup(files)
+	t.Cleanup(session.Close)
+
+	ctx := context.Background()
+	indexURI := lsproto.DocumentUri("file://" + projectRoot + "/index.ts")
+	session.DidOpenFile(ctx, indexURI, 1, files[projectRoot+"/index.ts"].(string), lsproto.LanguageKindTypeScript)
+
+	_, err := session.GetCurrentLanguageServiceWithAutoImports(ctx, indexURI)
+	assert.NilError(t, err)
+
+	nodeModulesBucket := singleBucket(t, autoImportStats(t, session).NodeModulesBuckets)
+	assert.Equal(t, string(nodeModulesBucket.Path), projectRoot)
+	assert.Assert(t, nodeModulesBucket.PackageNames.Has("lodash"))
+	assert.Assert(t, nodeModulesBucket.ExportCount > 0)
+}
+
 func TestAutoImportEntrypointDirectorySearch(t *testing.T) {
 	t.Parallel()
 
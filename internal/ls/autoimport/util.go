@@ -16,6 +16,7 @@ import (
 	"github.com/microsoft/typescript-go/internal/module"
 	"github.com/microsoft/typescript-go/internal/modulespecifiers"
 	"github.com/microsoft/typescript-go/internal/packagejson"
+	"github.com/microsoft/typescript-go/internal/pnp"
 	"github.com/microsoft/typescript-go/internal/tspath"
 	"github.com/microsoft/typescript-go/internal/vfs"
 	"github.com/microsoft/typescript-go/internal/vfs/wrapvfs"
@@ -115,6 +116,16 @@ func getPackageNamesInNodeModules(nodeModulesDir string, fs vfs.FS) *collections
 	return packageNames
 }
 
+// getPackageNamesInPnpManifest returns the names of the packages that the top-level package of a Yarn
+// Plug'n'Play project depends on, the equivalent of the packages in its node_modules directory.
+func getPackageNamesInPnpManifest(manifest *pnp.Manifest) *collections.Set[string] {
+	packageNames := &collections.Set[string]{}
+	for _, name := range manifest.TopLevel().DependencyNames() {
+		packageNames.Add(module.GetPackageNameFromTypesPackageName(name))
+	}
+	return packageNames
+}
+
 func getDefaultLikeExportNameFromDeclaration(symbol *ast.Symbol) string {
 	for _, d := range symbol.Declarations {
 		// "export default" in this case. See `ExportAssignment`for more details.
@@ -3,6 +3,7 @@ package change
 import (
 	"context"
 	"slices"
+	"strings"
 
 	"github.com/microsoft/typescript-go/internal/ast"
 	"github.com/microsoft/typescript-go/internal/astnav"
@@ -91,6 +92,7 @@ type Tracker struct {
 	*ast.NodeFactory
 
 	changes                    *collections.MultiMap[*ast.SourceFile, *trackerEdit]
+	newFiles                   []newFile
 	deletedNodes               []deletedNode
 	nodesWithInsertionsAtStart map[*ast.Node]*nodesInsertedAtStartState
 
@@ -104,6 +106,12 @@ type Tracker struct {
 	// printer
 }
 
+type newFile struct {
+	oldFile    *ast.SourceFile
+	fileName   string
+	statements []*ast.Node
+}
+
 type deletedNode struct {
 	sourceFile *ast.SourceFile
 	node       *ast.Node
@@ -135,7 +143,9 @@ func (t *Tracker) GetChanges() (map[string][]*lsproto.TextEdit, []string) {
 	t.finishDeleteDeclarations()
 	t.finishNodesWithInsertionsAtStart()
 	changes := t.getTextChangesFromChanges()
-	// !!! changes for new files
+	for _, file := range t.newFiles {
+		changes[file.fileName] = []*lsproto.TextEdit{{NewText: t.getNewFileText(file)}}
+	}
 	if t.unmappableFiles.Len() == 0 {
 		return changes, nil
 	}
@@ -241,6 +251,39 @@ func (t *Tracker) InsertNodeBefore(sourceFile *ast.SourceFile, before *ast.Node,
 	t.InsertNodeAt(sourceFile, core.TextPos(t.getAdjustedStartPosition(sourceFile, before, leadingTriviaOption, false)), newNode, t.getOptionsForInsertNodeBefore(before, newNode, blankLineBetween))
 }
 
+// InsertNodeAtEndOfScope inserts a statement at the end of a block or source file, on its own line before the
+// closing brace and separated from the preceding statements by a blank line.
+func (t *Tracker) InsertNodeAtEndOfScope(sourceFile *ast.SourceFile, scope *ast.Node, newNode *ast.Node) {
+	text := sourceFile.Text()
+	closePos := len(text)
+	if !ast.IsSourceFile(scope) {
+		closePos = scope.End() - 1
+	}
+	// The full start of the closing token is the end of the last statement, or just after the opening brace.
+	fullStart := scope.Pos()
+	if statements := scope.Statements(); len(statements) > 0 {
+		fullStart = statements[len(statements)-1].End()
+	} else if !ast.IsSourceFile(scope) {
+		fullStart = scanner.GetTokenPosOfNode(scope, sourceFile, false /*includeJSDoc*/) + 1
+	}
+	pos := closePos
+	if lineStart := format.GetLineStartPositionForPosition(closePos, sourceFile); lineStart > fullStart {
+		pos = lineStart
+	}
+	prefix := t.newLine
+	if fullStart >= len(text) || !stringutil.IsLineBreak(rune(text[fullStart])) {
+		prefix += t.newLine
+	}
+	t.InsertNodeAt(sourceFile, core.TextPos(pos), newNode, NodeOptions{Prefix: prefix, Suffix: t.newLine})
+}
+
+// CreateNewFile records a file to be created with the given statements, which are printed relative to oldFile.
+// A nil statement stands for a blank line. GetChanges reports the content of the new file as a single insertion
+// at its start.
+func (t *Tracker) CreateNewFile(oldFile *ast.SourceFile, fileName string, statements []*ast.Node) {
+	t.newFiles = append(t.newFiles, newFile{oldFile: oldFile, fileName: fileName, statements: statements})
+}
+
 // TryInsertTypeAnnotation inserts a type annotation after the appropriate position on a node
 // (after the close paren for function-like, after the name/exclamation/question for variable-like).
 // Returns true if successful.
@@ -333,6 +376,17 @@ func (t *Tracker) DeleteNodeRange(sourceFile *ast.SourceFile, startNode *ast.Nod
 	t.ReplaceRangeWithText(sourceFile, t.toLSPEditRange(sourceFile, core.NewTextRange(startPosition, endPosition)), "")
 }
 
+// DeleteNodeRangeExcludingEnd deletes from the start of startNode, including its leading comments, up to the
+// start of afterEndNode, or to the end of the file if afterEndNode is nil.
+func (t *Tracker) DeleteNodeRangeExcludingEnd(sourceFile *ast.SourceFile, startNode *ast.Node, afterEndNode *ast.Node) {
+	startPosition := t.getAdjustedStartPosition(sourceFile, startNode, LeadingTriviaOptionIncludeAll, false)
+	endPosition := len(sourceFile.Text())
+	if afterEndNode != nil {
+		endPosition = t.getAdjustedStartPosition(sourceFile, afterEndNode, LeadingTriviaOptionIncludeAll, false)
+	}
+	t.ReplaceRangeWithText(sourceFile, t.toLSPEditRange(sourceFile, core.NewTextRange(startPosition, endPosition)), "")
+}
+
 // finishDeleteDeclarations processes all queued deletions with smart handling for lists and trailing commas.
 func (t *Tracker) finishDeleteDeclarations() {
 	deletedNodesInLists := make(map[*ast.Node]bool)
@@ -563,6 +617,28 @@ func (t *Tracker) InsertAtTopOfFile(sourceFile *ast.SourceFile, insert []*ast.St
 	}
 }
 
+// InsertStatementsAtEndOfFile appends statements taken from oldFile to the end of a source file, separated from
+// its existing content and from each other by a blank line.
+func (t *Tracker) InsertStatementsAtEndOfFile(sourceFile *ast.SourceFile, oldFile *ast.SourceFile, statements []*ast.Statement) {
+	if len(statements) == 0 {
+		return
+	}
+	parts := make([]string, 0, len(statements))
+	for _, statement := range statements {
+		text, _ := t.getNonformattedText(statement, oldFile)
+		parts = append(parts, text)
+	}
+	text := sourceFile.Text()
+	prefix := ""
+	if len(strings.TrimSpace(text)) != 0 {
+		prefix = t.newLine
+		if !stringutil.IsLineBreak(rune(text[len(text)-1])) {
+			prefix += t.newLine
+		}
+	}
+	t.InsertText(sourceFile, t.toLSPEditPos(sourceFile, core.TextPos(len(text))), prefix+strings.Join(parts, t.newLine+t.newLine)+t.newLine)
+}
+
 func (t *Tracker) InsertMemberAtStart(sourceFile *ast.SourceFile, node *ast.Node, newElement *ast.Node) {
 	t.insertNodeAtStartWorker(sourceFile, node, newElement)
 }
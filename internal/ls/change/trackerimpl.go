@@ -18,6 +18,7 @@ import (
 	"github.com/microsoft/typescript-go/internal/scanner"
 	"github.com/microsoft/typescript-go/internal/spanmap"
 	"github.com/microsoft/typescript-go/internal/stringutil"
+	"github.com/microsoft/typescript-go/internal/tspath"
 )
 
 func (t *Tracker) getTextChangesFromChanges() map[string][]*lsproto.TextEdit {
@@ -63,6 +64,25 @@ func (t *Tracker) getTextChangesFromChanges() map[string][]*lsproto.TextEdit {
 	return changes
 }
 
+func (t *Tracker) getNewFileText(file newFile) string {
+	parts := make([]string, 0, len(file.statements))
+	for _, statement := range file.statements {
+		if statement == nil {
+			parts = append(parts, "")
+			continue
+		}
+		text, _ := t.getNonformattedText(statement, file.oldFile)
+		parts = append(parts, text)
+	}
+	text := strings.Join(parts, t.newLine)
+	sourceFile := parser.ParseSourceFile(
+		ast.SourceFileParseOptions{FileName: file.fileName, Path: tspath.Path(file.fileName)},
+		text,
+		core.GetScriptKindFromFileName(file.fileName),
+	)
+	return core.ApplyBulkEdits(text, format.FormatDocument(t.ctx, sourceFile)) + t.newLine
+}
+
 func (t *Tracker) computeNewText(change *trackerEdit, targetSourceFile *ast.SourceFile, sourceFile *ast.SourceFile) string {
 	switch change.kind {
 	case trackerEditKindRemove:
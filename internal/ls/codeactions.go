@@ -3,11 +3,11 @@ package ls
 import (
 	"cmp"
 	"context"
+	"fmt"
 	"slices"
 	"strings"
 
 	"github.com/microsoft/typescript-go/internal/ast"
-	"github.com/microsoft/typescript-go/internal/collections"
 	"github.com/microsoft/typescript-go/internal/compiler"
 	"github.com/microsoft/typescript-go/internal/core"
 	"github.com/microsoft/typescript-go/internal/diagnostics"
@@ -23,6 +23,9 @@ type CodeFixProvider struct {
 	GetCodeActions    func(ctx context.Context, fixContext *CodeFixContext) ([]*CodeAction, error)
 	FixIds            []string
 	GetAllCodeActions func(ctx context.Context, fixContext *CodeFixContext) (*CombinedCodeActions, error)
+	// SkipSourceFixAll excludes the provider from source.fixAll, for fixes that guess at the
+	// intended code or remove code, which should not be applied without the user picking them.
+	SkipSourceFixAll bool
 }
 
 // CodeFixContext contains the context needed to generate code fixes
@@ -67,11 +70,56 @@ type CombinedCodeActions struct {
 	Changes     []*lsproto.TextEdit
 }
 
+// RefactorProvider represents a provider for a family of refactorings
+type RefactorProvider struct {
+	Kinds              []lsproto.CodeActionKind
+	GetRefactorActions func(ctx context.Context, refactorContext *RefactorContext) ([]*RefactorAction, error)
+}
+
+// RefactorContext contains the context needed to compute refactorings for a selection
+type RefactorContext struct {
+	SourceFile *ast.SourceFile
+	Span       core.TextRange
+	Program    *compiler.Program
+	LS         *LanguageService
+	Params     *lsproto.CodeActionParams
+}
+
+// RefactorAction represents a single refactoring
+type RefactorAction struct {
+	Description string
+	Kind        lsproto.CodeActionKind
+	// GetEdits computes the edits of the refactoring, or returns nil if it has none. Computing the edits can be
+	// much more expensive than finding the refactoring, so it is deferred until the edits are needed.
+	GetEdits func() *RefactorEdits
+	// Command, if set, is executed instead of applying the edits, for refactorings that need more input from the user.
+	Command *lsproto.Command
+}
+
+// RefactorEdits represents the edits of a refactoring, grouped by file name
+type RefactorEdits struct {
+	Changes map[string][]*lsproto.TextEdit
+	// NewFiles lists the files in Changes that do not exist yet and are created by the refactoring.
+	NewFiles []string
+}
+
+// refactorProviders is the list of all registered refactor providers
+var refactorProviders = []*RefactorProvider{
+	ExtractSymbolRefactorProvider,
+	ExtractTypeRefactorProvider,
+	MoveToFileRefactorProvider,
+}
+
 // codeFixProviders is the list of all registered code fix providers
 var codeFixProviders = []*CodeFixProvider{
 	ImportFixProvider,
 	IsolatedDeclarationsFixProvider,
 	FixClassIncorrectlyImplementsInterfaceProvider,
+	FixSpellingProvider,
+	FixAddMissingAwaitProvider,
+	FixUnusedIdentifierProvider,
+	FixAddOverrideModifierProvider,
+	FixAwaitInSyncFunctionProvider,
 	// Add more code fix providers here as they are implemented
 }
 
@@ -155,9 +203,143 @@ func (l *LanguageService) ProvideCodeActions(ctx context.Context, params *lsprot
 		actions = append(actions, fixAllActions...)
 	}
 
+	if params.Context == nil || wantsRefactors(params.Context.Only) {
+		refactorActions, err := l.getRefactorActions(ctx, program, file, params)
+		if err != nil {
+			return lsproto.CodeActionResponse{}, err
+		}
+		actions = append(actions, refactorActions...)
+	}
+
 	return lsproto.CommandOrCodeActionArrayOrNull{CommandOrCodeActionArray: &actions}, nil
 }
 
+// getRefactorActions returns the refactorings available for the selected range. If the client can resolve
+// the edits of code actions, the edits are left to ResolveCodeAction.
+func (l *LanguageService) getRefactorActions(
+	ctx context.Context,
+	program *compiler.Program,
+	file *ast.SourceFile,
+	params *lsproto.CodeActionParams,
+) ([]lsproto.CommandOrCodeAction, error) {
+	refactors, err := l.getRefactors(ctx, program, file, params)
+	if err != nil {
+		return nil, err
+	}
+	var data *lsproto.CodeActionData
+	if slices.Contains(lsproto.GetClientCapabilities(ctx).TextDocument.CodeAction.ResolveSupport.Properties, "edit") {
+		data = &lsproto.CodeActionData{Uri: params.TextDocument.Uri, Range: &params.Range}
+	}
+	var actions []lsproto.CommandOrCodeAction
+	for _, refactor := range refactors {
+		if action := convertRefactorToLSPCodeAction(refactor, data); action != nil {
+			actions = append(actions, lsproto.CommandOrCodeAction{CodeAction: action})
+		}
+	}
+	return actions, nil
+}
+
+// getRefactors returns the refactorings the providers offer for the selected range.
+func (l *LanguageService) getRefactors(
+	ctx context.Context,
+	program *compiler.Program,
+	file *ast.SourceFile,
+	params *lsproto.CodeActionParams,
+) ([]*RefactorAction, error) {
+	var refactors []*RefactorAction
+	for _, mapped := range lsconv.FromLSPRangeForSourceFile(l.converters, file, params.Range, spanmap.FeatureCodeActions) {
+		for _, provider := range refactorProviders {
+			if params.Context != nil && !refactorProviderMatchesKinds(provider, params.Context.Only) {
+				continue
+			}
+			refactorContext := &RefactorContext{
+				SourceFile: mapped.Script,
+				Span:       mapped.Span,
+				Program:    program,
+				LS:         l,
+				Params:     params,
+			}
+			providerRefactors, err := provider.GetRefactorActions(ctx, refactorContext)
+			if err != nil {
+				return nil, err
+			}
+			refactors = append(refactors, providerRefactors...)
+		}
+	}
+	return refactors, nil
+}
+
+// ResolveCodeAction computes the edit of a refactoring that ProvideCodeActions returned without one, by finding
+// the refactoring again for the range recorded in the code action's data. When the document has changed so that
+// the refactoring is no longer offered there, it fails with ContentModified so the client can ask again.
+func (l *LanguageService) ResolveCodeAction(ctx context.Context, action *lsproto.CodeAction) (*lsproto.CodeAction, error) {
+	if action.Edit != nil || action.Kind == nil || action.Data == nil || action.Data.Uri == "" || action.Data.Range == nil {
+		return action, nil
+	}
+	program, file := l.tryGetProgramAndFile(action.Data.Uri.FileName())
+	if file == nil {
+		return nil, fmt.Errorf("%w: %s is no longer in the project", lsproto.ErrorCodeContentModified, action.Data.Uri)
+	}
+	params := &lsproto.CodeActionParams{
+		TextDocument: lsproto.TextDocumentIdentifier{Uri: action.Data.Uri},
+		Range:        *action.Data.Range,
+		Context: &lsproto.CodeActionContext{
+			Diagnostics: []*lsproto.Diagnostic{},
+			Only:        &[]lsproto.CodeActionKind{*action.Kind},
+		},
+	}
+	refactors, err := l.getRefactors(ctx, program, file, params)
+	if err != nil {
+		return nil, err
+	}
+	for _, refactor := range refactors {
+		if refactor.Description != action.Title || refactor.Kind != *action.Kind || refactor.GetEdits == nil {
+			continue
+		}
+		if edits := refactor.GetEdits(); edits != nil {
+			resolved := *action
+			resolved.Edit = createWorkspaceEdit(edits.Changes, edits.NewFiles)
+			return &resolved, nil
+		}
+		break
+	}
+	return nil, fmt.Errorf("%w: %q is no longer available for the selected range", lsproto.ErrorCodeContentModified, action.Title)
+}
+
+// wantsRefactors returns true if the Only filter is nil/empty (meaning all kinds are wanted)
+// or includes a refactor kind.
+func wantsRefactors(only *[]lsproto.CodeActionKind) bool {
+	if only == nil || len(*only) == 0 {
+		return true
+	}
+	for _, kind := range *only {
+		if codeActionKindContains(kind, lsproto.CodeActionKindRefactor) || codeActionKindContains(lsproto.CodeActionKindRefactor, kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// refactorProviderMatchesKinds returns true if any kind produced by the provider is requested by the Only filter.
+func refactorProviderMatchesKinds(provider *RefactorProvider, only *[]lsproto.CodeActionKind) bool {
+	if only == nil || len(*only) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(provider.Kinds, func(providerKind lsproto.CodeActionKind) bool {
+		return isRefactorKindRequested(providerKind, only)
+	})
+}
+
+// isRefactorKindRequested returns true if the Only filter is nil/empty or includes the given refactor kind.
+func isRefactorKindRequested(kind lsproto.CodeActionKind, only *[]lsproto.CodeActionKind) bool {
+	if only == nil || len(*only) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(*only, func(requested lsproto.CodeActionKind) bool {
+		return codeActionKindContains(requested, kind)
+	})
+}
+
 // getFixAllQuickFixes returns per-provider "Fix all in file" quickfix entries for providers
 // that matched at least 2 diagnostics in the full file.
 func (l *LanguageService) getFixAllQuickFixes(
@@ -169,15 +351,12 @@ func (l *LanguageService) getFixAllQuickFixes(
 ) ([]lsproto.CommandOrCodeAction, error) {
 	var actions []lsproto.CommandOrCodeAction
 
-	// Deduplicate providers; multiple fixIds may map to the same provider.
-	var seen collections.Set[*CodeFixProvider]
-	for _, provider := range fixIdSeen {
-		if seen.Has(provider) {
-			continue
-		}
-		seen.Add(provider)
-
-		if provider.GetAllCodeActions == nil {
+	// Visit providers in registration order so that the fix-all entries are listed deterministically.
+	for _, provider := range codeFixProviders {
+		fixID := core.Find(provider.FixIds, func(fixID string) bool {
+			return fixIdSeen[fixID] == provider
+		})
+		if fixID == "" || provider.GetAllCodeActions == nil {
 			continue
 		}
 
@@ -204,6 +383,7 @@ func (l *LanguageService) getFixAllQuickFixes(
 					Title: combined.Description,
 					Kind:  &kind,
 					Edit:  &lsproto.WorkspaceEdit{Changes: &changes},
+					Data:  &lsproto.CodeActionData{FixId: fixID},
 				},
 			})
 		}
@@ -280,7 +460,7 @@ func (l *LanguageService) createFixAllAction(
 	lspChanges := make(map[lsproto.DocumentUri][]*lsproto.TextEdit)
 
 	for _, provider := range codeFixProviders {
-		if provider.GetAllCodeActions == nil {
+		if provider.GetAllCodeActions == nil || provider.SkipSourceFixAll {
 			continue
 		}
 
@@ -392,6 +572,29 @@ func containsErrorCode(codes []int32, code int32) bool {
 	return slices.Contains(codes, code)
 }
 
+// convertRefactorToLSPCodeAction converts an internal RefactorAction to an LSP CodeAction. If data is set, the
+// edits are left to be resolved; otherwise they are computed now, and nil is returned if there are none.
+func convertRefactorToLSPCodeAction(refactor *RefactorAction, data *lsproto.CodeActionData) *lsproto.CodeAction {
+	kind := refactor.Kind
+	action := &lsproto.CodeAction{
+		Title: refactor.Description,
+		Kind:  &kind,
+	}
+	switch {
+	case refactor.Command != nil:
+		action.Command = refactor.Command
+	case data != nil:
+		action.Data = data
+	default:
+		edits := refactor.GetEdits()
+		if edits == nil {
+			return nil
+		}
+		action.Edit = createWorkspaceEdit(edits.Changes, edits.NewFiles)
+	}
+	return action
+}
+
 // convertToLSPCodeAction converts an internal CodeAction to an LSP CodeAction
 func convertToLSPCodeAction(action *CodeAction, diag *lsproto.Diagnostic, uri lsproto.DocumentUri) lsproto.CommandOrCodeAction {
 	kind := lsproto.CodeActionKindQuickFix
@@ -399,6 +602,10 @@ func convertToLSPCodeAction(action *CodeAction, diag *lsproto.Diagnostic, uri ls
 		uri: action.Changes,
 	}
 	diagnostics := []*lsproto.Diagnostic{diag}
+	var data *lsproto.CodeActionData
+	if action.FixID != "" {
+		data = &lsproto.CodeActionData{FixId: action.FixID}
+	}
 
 	return lsproto.CommandOrCodeAction{
 		CodeAction: &lsproto.CodeAction{
@@ -406,6 +613,7 @@ func convertToLSPCodeAction(action *CodeAction, diag *lsproto.Diagnostic, uri ls
 			Kind:        &kind,
 			Edit:        &lsproto.WorkspaceEdit{Changes: &changes},
 			Diagnostics: &diagnostics,
+			Data:        data,
 		},
 	}
 }
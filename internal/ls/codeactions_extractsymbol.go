@@ -0,0 +1,682 @@
+package ls
+
+import (
+	"context"
+	"slices"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/astnav"
+	"github.com/microsoft/typescript-go/internal/checker"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/diagnostics"
+	"github.com/microsoft/typescript-go/internal/locale"
+	"github.com/microsoft/typescript-go/internal/ls/change"
+	"github.com/microsoft/typescript-go/internal/lsp/lsproto"
+	"github.com/microsoft/typescript-go/internal/nodebuilder"
+	"github.com/microsoft/typescript-go/internal/printer"
+	"github.com/microsoft/typescript-go/internal/scanner"
+	"github.com/microsoft/typescript-go/internal/stringutil"
+)
+
+const (
+	CodeActionKindRefactorExtractFunction lsproto.CodeActionKind = "refactor.extract.function"
+	CodeActionKindRefactorExtractConstant lsproto.CodeActionKind = "refactor.extract.constant"
+)
+
+// ExtractSymbolRefactorProvider offers to extract the selected expression or statements to a new function,
+// or the selected expression to a new constant.
+var ExtractSymbolRefactorProvider = &RefactorProvider{
+	Kinds:              []lsproto.CodeActionKind{CodeActionKindRefactorExtractFunction, CodeActionKindRefactorExtractConstant},
+	GetRefactorActions: getExtractSymbolActions,
+}
+
+// extractRange is a selection that can be extracted: either a single expression, or a run of statements from
+// one statement list.
+type extractRange struct {
+	expression *ast.Node
+	statements []*ast.Node
+}
+
+func (r *extractRange) first() *ast.Node {
+	if r.expression != nil {
+		return r.expression
+	}
+	return r.statements[0]
+}
+
+func (r *extractRange) last() *ast.Node {
+	if r.expression != nil {
+		return r.expression
+	}
+	return r.statements[len(r.statements)-1]
+}
+
+func (r *extractRange) contains(node *ast.Node) bool {
+	return node.Pos() >= r.first().Pos() && node.End() <= r.last().End()
+}
+
+// extractReference is a symbol declared outside of the extracted range and referenced within it.
+type extractReference struct {
+	node        *ast.Node // the first reference
+	symbol      *ast.Symbol
+	declaration *ast.Node
+	isWrite     bool
+	isType      bool
+}
+
+// extractUsages summarizes how the extracted range depends on the code around it.
+type extractUsages struct {
+	references []*extractReference
+	// The range uses `this`, `super` or `arguments` of its enclosing function.
+	usesThis  bool
+	hasAwait  bool
+	hasYield  bool
+	hasReturn bool
+	// The range contains a `break` or `continue` that jumps out of it.
+	hasJump bool
+}
+
+// extractScope is a place the extracted code can be moved to.
+type extractScope struct {
+	// The node whose statements receive the new declaration: a function-like declaration or the source file.
+	node *ast.Node
+	// The location at which visibility of referenced declarations is decided.
+	location    *ast.Node
+	description string
+}
+
+type symbolExtractor struct {
+	ctx        context.Context
+	sourceFile *ast.SourceFile
+	checker    *checker.Checker
+	ls         *LanguageService
+	locale     locale.Locale
+	isJS       bool
+	rng        *extractRange
+	usages     *extractUsages
+}
+
+func getExtractSymbolActions(ctx context.Context, refactorContext *RefactorContext) ([]*RefactorAction, error) {
+	rng := getExtractRange(refactorContext.SourceFile, refactorContext.Span)
+	if rng == nil {
+		return nil, nil
+	}
+
+	c, done := refactorContext.Program.GetTypeCheckerForFile(ctx, refactorContext.SourceFile)
+	defer done()
+
+	e := &symbolExtractor{
+		ctx:        ctx,
+		sourceFile: refactorContext.SourceFile,
+		checker:    c,
+		ls:         refactorContext.LS,
+		locale:     locale.FromContext(ctx),
+		isJS:       ast.IsInJSFile(refactorContext.SourceFile.AsNode()),
+		rng:        rng,
+	}
+	e.usages = e.collectUsages()
+
+	var only *[]lsproto.CodeActionKind
+	if refactorContext.Params.Context != nil {
+		only = refactorContext.Params.Context.Only
+	}
+	var actions []*RefactorAction
+	if isRefactorKindRequested(CodeActionKindRefactorExtractFunction, only) {
+		actions = append(actions, e.getExtractFunctionActions()...)
+	}
+	if rng.expression != nil && isRefactorKindRequested(CodeActionKindRefactorExtractConstant, only) {
+		actions = append(actions, e.getExtractConstantActions()...)
+	}
+	return actions, nil
+}
+
+// getExtractRange finds the expression or statements exactly covered by the span, ignoring surrounding trivia.
+func getExtractRange(file *ast.SourceFile, span core.TextRange) *extractRange {
+	text := file.Text()
+	start := scanner.SkipTrivia(text, span.Pos())
+	end := span.End()
+	for end > start && stringutil.IsWhiteSpaceLike(rune(text[end-1])) {
+		end--
+	}
+	if start >= end {
+		return nil
+	}
+
+	node := astnav.GetTokenAtPosition(file, start)
+	if node == nil || ast.IsSourceFile(node) || scanner.GetTokenPosOfNode(node, file, false /*includeJSDoc*/) != start {
+		return nil
+	}
+	// Take the outermost node that begins at the selection and does not extend past it.
+	for node.Parent != nil && !ast.IsSourceFile(node.Parent) && scanner.GetTokenPosOfNode(node.Parent, file, false /*includeJSDoc*/) == start && node.Parent.End() <= end {
+		node = node.Parent
+	}
+
+	if !isStatementInList(node) {
+		if node.End() == end && isExtractableExpression(node) {
+			return &extractRange{expression: node}
+		}
+		return nil
+	}
+	statements := node.Parent.Statements()
+	index := slices.Index(statements, node)
+	for i := index; i < len(statements) && statements[i].End() <= end; i++ {
+		if statements[i].End() == end {
+			return &extractRange{statements: statements[index : i+1]}
+		}
+	}
+	return nil
+}
+
+func isStatementInList(node *ast.Node) bool {
+	return ast.IsStatement(node) && node.Parent != nil && node.Parent.CanHaveStatements()
+}
+
+func isExtractableExpression(node *ast.Node) bool {
+	if !ast.IsExpressionNode(node) || ast.IsPartOfTypeNode(node) || ast.IsAssignmentTarget(node) {
+		return false
+	}
+	switch node.Kind {
+	case ast.KindSpreadElement, ast.KindOmittedExpression:
+		return false
+	}
+	return ast.FindAncestor(node, func(n *ast.Node) bool {
+		return ast.IsImportDeclaration(n) || ast.IsExportDeclaration(n) || ast.IsImportEqualsDeclaration(n) || ast.IsDecorator(n)
+	}) == nil
+}
+
+// collectUsages walks the extracted range, recording references to outside declarations and constructs that
+// depend on the enclosing function.
+func (e *symbolExtractor) collectUsages() *extractUsages {
+	usages := &extractUsages{}
+	referencesBySymbol := make(map[*ast.Symbol]*extractReference)
+
+	var visit func(node *ast.Node, inFunction bool, inNonArrowFunction bool, inBreakable bool)
+	visit = func(node *ast.Node, inFunction bool, inNonArrowFunction bool, inBreakable bool) {
+		switch node.Kind {
+		case ast.KindThisKeyword, ast.KindSuperKeyword, ast.KindThisType:
+			usages.usesThis = usages.usesThis || !inNonArrowFunction
+		case ast.KindReturnStatement:
+			usages.hasReturn = usages.hasReturn || !inFunction
+		case ast.KindAwaitExpression:
+			usages.hasAwait = usages.hasAwait || !inFunction
+		case ast.KindForOfStatement:
+			usages.hasAwait = usages.hasAwait || !inFunction && node.AsForInOrOfStatement().AwaitModifier != nil
+		case ast.KindYieldExpression:
+			usages.hasYield = usages.hasYield || !inFunction
+		case ast.KindBreakStatement, ast.KindContinueStatement:
+			usages.hasJump = usages.hasJump || !inFunction && (node.Label() != nil || !inBreakable)
+		case ast.KindIdentifier:
+			if node.Text() == "arguments" && ast.IsExpressionNode(node) {
+				usages.usesThis = usages.usesThis || !inNonArrowFunction
+			}
+			e.addReference(usages, referencesBySymbol, node)
+		}
+
+		switch {
+		case ast.IsFunctionLike(node):
+			inFunction = true
+			inNonArrowFunction = inNonArrowFunction || !ast.IsArrowFunction(node)
+		case ast.IsClassLike(node):
+			inNonArrowFunction = true
+		case ast.IsIterationStatement(node, false /*lookInLabeledStatements*/) || ast.IsSwitchStatement(node):
+			inBreakable = true
+		}
+		node.ForEachChild(func(child *ast.Node) bool {
+			visit(child, inFunction, inNonArrowFunction, inBreakable)
+			return false
+		})
+	}
+
+	if e.rng.expression != nil {
+		visit(e.rng.expression, false, false, false)
+	} else {
+		for _, statement := range e.rng.statements {
+			visit(statement, false, false, false)
+		}
+	}
+	return usages
+}
+
+func (e *symbolExtractor) addReference(usages *extractUsages, referencesBySymbol map[*ast.Symbol]*extractReference, node *ast.Node) {
+	parent := node.Parent
+	if (ast.IsPropertyAccessExpression(parent) || ast.IsQualifiedName(parent)) && parent.Name() == node {
+		return
+	}
+
+	var symbol *ast.Symbol
+	if ast.IsShorthandPropertyAssignment(parent) && parent.Name() == node {
+		symbol = e.checker.GetShorthandAssignmentValueSymbol(parent)
+	} else {
+		symbol = e.checker.GetSymbolAtLocation(node)
+	}
+	if symbol == nil || symbol.Flags&(ast.SymbolFlagsVariable|ast.SymbolFlagsFunction|ast.SymbolFlagsClass|ast.SymbolFlagsEnum|ast.SymbolFlagsModule|ast.SymbolFlagsType|ast.SymbolFlagsAlias) == 0 {
+		return
+	}
+	// Declarations in other files are always in scope, and declarations in the range move with it.
+	declaration := core.Find(symbol.Declarations, func(d *ast.Node) bool { return ast.GetSourceFileOfNode(d) == e.sourceFile })
+	if declaration == nil || e.rng.contains(declaration) {
+		return
+	}
+
+	reference := referencesBySymbol[symbol]
+	if reference == nil {
+		reference = &extractReference{node: node, symbol: symbol, declaration: declaration}
+		referencesBySymbol[symbol] = reference
+		usages.references = append(usages.references, reference)
+	}
+	reference.isWrite = reference.isWrite || ast.IsWriteAccess(node)
+	reference.isType = reference.isType || ast.IsPartOfTypeNode(node)
+}
+
+// getDeclarationScope gets the node throughout which a declaration is visible.
+func getDeclarationScope(declaration *ast.Node) *ast.Node {
+	declaration = ast.GetRootDeclaration(declaration)
+	switch {
+	case ast.IsParameterDeclaration(declaration) || ast.IsTypeParameterDeclaration(declaration) || ast.IsCatchClause(declaration.Parent):
+		return declaration.Parent
+	case ast.IsFunctionExpression(declaration) || ast.IsClassExpression(declaration):
+		return declaration
+	case ast.IsVariableDeclaration(declaration) && ast.GetCombinedNodeFlags(declaration)&ast.NodeFlagsBlockScoped == 0:
+		return ast.FindAncestor(declaration.Parent, func(n *ast.Node) bool {
+			return ast.IsFunctionLike(n) || ast.IsSourceFile(n) || ast.IsModuleBlock(n)
+		})
+	}
+	return ast.FindAncestor(declaration.Parent, func(n *ast.Node) bool {
+		return ast.IsBlock(n) || ast.IsSourceFile(n) || ast.IsModuleBlock(n) || ast.IsCaseBlock(n) ||
+			ast.IsForStatement(n) || ast.IsForInOrOfStatement(n) || ast.IsFunctionLike(n) || ast.IsClassLike(n)
+	})
+}
+
+// isDeclarationVisibleAt reports whether a declaration is in scope at the given location.
+func isDeclarationVisibleAt(declaration *ast.Node, location *ast.Node) bool {
+	scope := getDeclarationScope(declaration)
+	return scope == nil || ast.IsSourceFile(scope) || ast.FindAncestor(location, func(n *ast.Node) bool { return n == scope }) != nil
+}
+
+func (e *symbolExtractor) moduleScope() *extractScope {
+	scopeName := "global"
+	if ast.IsExternalModule(e.sourceFile) {
+		scopeName = "module"
+	}
+	return &extractScope{
+		node:        e.sourceFile.AsNode(),
+		location:    e.sourceFile.AsNode(),
+		description: scopeName,
+	}
+}
+
+// getFunctionDescription describes a function-like declaration the way it is named in refactoring titles.
+func getFunctionDescription(node *ast.Node) string {
+	name := ""
+	if node.Name() != nil {
+		name = scanner.DeclarationNameToString(node.Name())
+	}
+	switch node.Kind {
+	case ast.KindFunctionDeclaration, ast.KindFunctionExpression:
+		if name == "" {
+			return "anonymous function"
+		}
+		return "function '" + name + "'"
+	case ast.KindArrowFunction:
+		return "arrow function"
+	case ast.KindMethodDeclaration:
+		return "method '" + name + "'"
+	case ast.KindGetAccessor:
+		return "'get " + name + "'"
+	case ast.KindSetAccessor:
+		return "'set " + name + "'"
+	case ast.KindConstructor:
+		return "constructor"
+	}
+	return "function"
+}
+
+// getExtractFunctionActions offers to extract the range to a function in the innermost enclosing function
+// and in the module scope.
+func (e *symbolExtractor) getExtractFunctionActions() []*RefactorAction {
+	usages := e.usages
+	if usages.hasReturn || usages.hasJump || usages.hasYield || usages.usesThis {
+		return nil
+	}
+
+	var returnedDeclaration *ast.Node
+	if e.rng.statements != nil {
+		if slices.ContainsFunc(e.rng.statements, func(s *ast.Node) bool {
+			return ast.IsImportDeclaration(s) || ast.IsImportEqualsDeclaration(s) || ast.IsExportDeclaration(s) || ast.IsExportAssignment(s) ||
+				ast.HasSyntacticModifier(s, ast.ModifierFlagsExport)
+		}) {
+			return nil
+		}
+		usedOutside := e.getDeclarationsUsedOutside()
+		switch {
+		case len(usedOutside) > 1:
+			return nil
+		case len(usedOutside) == 1:
+			returnedDeclaration = usedOutside[0]
+			if !ast.IsVariableDeclaration(returnedDeclaration) || !ast.IsIdentifier(returnedDeclaration.Name()) {
+				return nil
+			}
+		}
+	}
+
+	var scopes []*extractScope
+	if fn := ast.FindAncestor(e.rng.first().Parent, ast.IsFunctionLike); fn != nil {
+		if body := fn.Body(); body != nil && ast.IsBlock(body) && e.rng.first().Pos() >= body.Pos() {
+			scopes = append(scopes, &extractScope{
+				node:        fn,
+				location:    body,
+				description: diagnostics.Extract_to_0_in_1.Localize(e.locale, "inner function", getFunctionDescription(fn)),
+			})
+		}
+	}
+	moduleScope := e.moduleScope()
+	moduleScope.description = diagnostics.Extract_to_0_in_1_scope.Localize(e.locale, "function", moduleScope.description)
+	scopes = append(scopes, moduleScope)
+
+	var actions []*RefactorAction
+	for _, scope := range scopes {
+		if action := e.extractFunction(scope, returnedDeclaration); action != nil {
+			actions = append(actions, action)
+		}
+	}
+	return actions
+}
+
+// getDeclarationsUsedOutside gets the declarations made by the extracted statements that are referenced
+// elsewhere in the enclosing function or file.
+func (e *symbolExtractor) getDeclarationsUsedOutside() []*ast.Node {
+	declarationsBySymbol := make(map[*ast.Symbol]*ast.Node)
+	for _, statement := range e.rng.statements {
+		var names []*ast.Node
+		if ast.IsVariableStatement(statement) {
+			for _, declaration := range statement.AsVariableStatement().DeclarationList.AsVariableDeclarationList().Declarations.Nodes {
+				names = append(names, declaration.Name())
+			}
+		} else if ast.IsDeclarationStatement(statement) && statement.Name() != nil {
+			names = append(names, statement.Name())
+		}
+		for _, name := range names {
+			for _, identifier := range collectBindingIdentifiers(name) {
+				if symbol := e.checker.GetSymbolAtLocation(identifier); symbol != nil {
+					declarationsBySymbol[symbol] = ast.GetRootDeclaration(identifier.Parent)
+				}
+			}
+		}
+	}
+	if len(declarationsBySymbol) == 0 {
+		return nil
+	}
+
+	container := ast.FindAncestor(e.rng.first().Parent, func(n *ast.Node) bool { return ast.IsFunctionLike(n) || ast.IsSourceFile(n) })
+	var used []*ast.Node
+	var visit func(node *ast.Node) bool
+	visit = func(node *ast.Node) bool {
+		if e.rng.contains(node) && node.Pos() < node.End() {
+			return false
+		}
+		if ast.IsIdentifier(node) {
+			if declaration := declarationsBySymbol[e.checker.GetSymbolAtLocation(node)]; declaration != nil && !slices.Contains(used, declaration) {
+				used = append(used, declaration)
+			}
+		}
+		return node.ForEachChild(visit)
+	}
+	container.ForEachChild(visit)
+	return used
+}
+
+func collectBindingIdentifiers(name *ast.Node) []*ast.Node {
+	if ast.IsIdentifier(name) {
+		return []*ast.Node{name}
+	}
+	var identifiers []*ast.Node
+	if ast.IsBindingPattern(name) {
+		for _, element := range name.Elements() {
+			if element.Name() != nil {
+				identifiers = append(identifiers, collectBindingIdentifiers(element.Name())...)
+			}
+		}
+	}
+	return identifiers
+}
+
+// getCapturedReferences gets the references that are not in scope at the new location and must be passed in.
+// It returns false if a reference cannot be passed as a parameter.
+func (e *symbolExtractor) getCapturedReferences(scope *extractScope) ([]*extractReference, bool) {
+	var captured []*extractReference
+	for _, reference := range e.usages.references {
+		if isDeclarationVisibleAt(reference.declaration, scope.location) {
+			continue
+		}
+		if reference.isWrite || reference.isType || reference.symbol.Flags&ast.SymbolFlagsVariable == 0 {
+			return nil, false
+		}
+		captured = append(captured, reference)
+	}
+	return captured, true
+}
+
+// typeToTypeNode converts a type to a type node usable in the given scope. Type parameters that are not in scope
+// there are added to typeParameters; any other declaration out of scope makes the conversion fail.
+func (e *symbolExtractor) typeToTypeNode(t *checker.Type, scope *extractScope, typeParameters *[]*ast.Node) (*ast.TypeNode, bool) {
+	idToSymbol := make(map[*ast.IdentifierNode]*ast.Symbol)
+	typeNode := e.checker.TypeToTypeNode(t, scope.node, nodebuilder.FlagsNoTruncation, idToSymbol)
+	if typeNode == nil {
+		return nil, false
+	}
+	for _, symbol := range idToSymbol {
+		declaration := core.Find(symbol.Declarations, func(d *ast.Node) bool { return ast.GetSourceFileOfNode(d) == e.sourceFile })
+		if declaration == nil || isDeclarationVisibleAt(declaration, scope.location) {
+			continue
+		}
+		if !ast.IsTypeParameterDeclaration(declaration) {
+			return nil, false
+		}
+		if !slices.Contains(*typeParameters, declaration) {
+			*typeParameters = append(*typeParameters, declaration)
+		}
+	}
+	return typeNode, true
+}
+
+func (e *symbolExtractor) extractFunction(scope *extractScope, returnedDeclaration *ast.Node) *RefactorAction {
+	captured, ok := e.getCapturedReferences(scope)
+	if !ok {
+		return nil
+	}
+
+	// Converting the types decides whether the function can be declared in the scope, so it is done up front;
+	// the edits are built only when requested.
+	var typeParameterDeclarations []*ast.Node
+	parameterTypes := make([]*ast.TypeNode, len(captured))
+	var returnType *ast.TypeNode
+	if !e.isJS {
+		for i, reference := range captured {
+			t := e.checker.GetBaseTypeOfLiteralType(e.checker.GetTypeOfSymbolAtLocation(reference.symbol, reference.node))
+			if parameterTypes[i], ok = e.typeToTypeNode(t, scope, &typeParameterDeclarations); !ok {
+				return nil
+			}
+		}
+		var t *checker.Type
+		if e.rng.expression != nil {
+			t = e.checker.GetBaseTypeOfLiteralType(e.checker.GetTypeAtLocation(e.rng.expression))
+		} else if returnedDeclaration != nil {
+			t = e.checker.GetBaseTypeOfLiteralType(e.checker.GetTypeAtLocation(returnedDeclaration.Name()))
+		}
+		if t != nil {
+			if returnType, ok = e.typeToTypeNode(t, scope, &typeParameterDeclarations); !ok {
+				return nil
+			}
+		}
+	}
+
+	return e.createAction(scope.description, CodeActionKindRefactorExtractFunction, func(tracker *change.Tracker) {
+		factory := tracker.NodeFactory
+
+		var parameters []*ast.Node
+		var arguments []*ast.Node
+		for i, reference := range captured {
+			parameters = append(parameters, factory.NewParameterDeclaration(nil, nil, factory.NewIdentifier(reference.symbol.Name), nil, parameterTypes[i], nil))
+			arguments = append(arguments, factory.NewIdentifier(reference.symbol.Name))
+		}
+
+		returnType := returnType
+		if returnType != nil && e.usages.hasAwait {
+			returnType = factory.NewTypeReferenceNode(factory.NewIdentifier("Promise"), factory.NewNodeList([]*ast.Node{returnType}))
+		}
+
+		var body []*ast.Node
+		if e.rng.expression != nil {
+			body = append(body, factory.NewReturnStatement(factory.DeepCloneNode(e.rng.expression)))
+		} else {
+			for _, statement := range e.rng.statements {
+				body = append(body, factory.DeepCloneNode(statement))
+			}
+			if returnedDeclaration != nil {
+				body = append(body, factory.NewReturnStatement(factory.NewIdentifier(returnedDeclaration.Name().Text())))
+			}
+		}
+
+		var modifiers *ast.ModifierList
+		if e.usages.hasAwait {
+			modifiers = factory.NewModifierList([]*ast.Node{factory.NewModifier(ast.KindAsyncKeyword)})
+		}
+		var typeParameters *ast.NodeList
+		if len(typeParameterDeclarations) > 0 {
+			typeParameters = factory.NewNodeList(core.Map(typeParameterDeclarations, factory.DeepCloneNode))
+		}
+		functionName := tracker.EmitContext.Factory.NewUniqueNameEx("newFunction", printer.AutoGenerateOptions{Flags: printer.GeneratedIdentifierFlagsOptimistic})
+		newFunction := factory.NewFunctionDeclaration(
+			modifiers,
+			nil, /*asteriskToken*/
+			functionName.AsNode(),
+			typeParameters,
+			factory.NewNodeList(parameters),
+			returnType,
+			nil, /*fullSignature*/
+			factory.NewBlock(factory.NewNodeList(body), true /*multiLine*/),
+		)
+
+		call := factory.NewCallExpression(functionName.AsNode(), nil, nil, factory.NewNodeList(arguments), ast.NodeFlagsNone)
+		if e.usages.hasAwait {
+			call = factory.NewAwaitExpression(call)
+		}
+		if e.rng.expression != nil {
+			tracker.ReplaceNode(e.sourceFile, e.rng.expression, call, nil)
+		} else {
+			var replacement *ast.Node
+			if returnedDeclaration != nil {
+				name := factory.NewIdentifier(returnedDeclaration.Name().Text())
+				declaration := factory.NewVariableDeclaration(name, nil, nil, call)
+				flags := returnedDeclaration.Parent.Flags & ast.NodeFlagsBlockScoped
+				replacement = factory.NewVariableStatement(nil, factory.NewVariableDeclarationList(factory.NewNodeList([]*ast.Node{declaration}), flags))
+			} else {
+				replacement = factory.NewExpressionStatement(call)
+			}
+			tracker.ReplaceRange(
+				e.sourceFile,
+				tracker.GetAdjustedRange(e.sourceFile, e.rng.first(), e.rng.last(), change.LeadingTriviaOptionExclude, change.TrailingTriviaOptionExclude),
+				replacement,
+				change.NodeOptions{},
+			)
+		}
+		e.insertFunction(tracker, scope, newFunction)
+	})
+}
+
+// insertFunction inserts the new function before the first function declared after the range in the scope,
+// or at the end of the scope.
+func (e *symbolExtractor) insertFunction(tracker *change.Tracker, scope *extractScope, newFunction *ast.Node) {
+	var statements []*ast.Node
+	if ast.IsSourceFile(scope.node) {
+		statements = scope.node.Statements()
+	} else {
+		statements = scope.node.Body().Statements()
+	}
+	end := e.rng.last().End()
+	if before := core.Find(statements, func(s *ast.Node) bool { return s.Pos() >= end && ast.IsFunctionLikeDeclaration(s) }); before != nil {
+		tracker.InsertNodeBefore(e.sourceFile, before, newFunction, true /*blankLineBetween*/, change.LeadingTriviaOptionNone)
+		return
+	}
+	if ast.IsSourceFile(scope.node) {
+		tracker.InsertNodeAtEndOfScope(e.sourceFile, scope.node, newFunction)
+	} else {
+		tracker.InsertNodeAtEndOfScope(e.sourceFile, scope.node.Body(), newFunction)
+	}
+}
+
+// getExtractConstantActions offers to extract the expression to a constant before the statement containing it,
+// and in the module scope.
+func (e *symbolExtractor) getExtractConstantActions() []*RefactorAction {
+	expression := e.rng.expression
+	topLevelStatement := ast.FindAncestor(expression, func(n *ast.Node) bool { return n.Parent != nil && ast.IsSourceFile(n.Parent) })
+	if topLevelStatement == nil {
+		return nil
+	}
+
+	var actions []*RefactorAction
+	enclosingStatement := ast.FindAncestor(expression, ast.IsStatement)
+	if enclosingStatement != nil && enclosingStatement != topLevelStatement && isStatementInList(enclosingStatement) && !ast.IsIterationStatement(enclosingStatement, false /*lookInLabeledStatements*/) {
+		crossesFunction := ast.FindAncestor(expression.Parent, func(n *ast.Node) bool {
+			return n == enclosingStatement || ast.IsFunctionLike(n)
+		}) != enclosingStatement
+		if !crossesFunction || !(e.usages.usesThis || e.usages.hasAwait || e.usages.hasYield) {
+			scope := &extractScope{
+				location:    enclosingStatement,
+				description: diagnostics.Extract_to_0_in_enclosing_scope.Localize(e.locale, "constant"),
+			}
+			if action := e.extractConstant(scope, enclosingStatement); action != nil {
+				actions = append(actions, action)
+			}
+		}
+	}
+
+	inFunctionOrClass := ast.FindAncestor(expression.Parent, func(n *ast.Node) bool { return ast.IsFunctionLike(n) || ast.IsClassLike(n) }) != nil
+	if !inFunctionOrClass || !(e.usages.usesThis || e.usages.hasAwait || e.usages.hasYield) {
+		scope := e.moduleScope()
+		scope.description = diagnostics.Extract_to_0_in_1_scope.Localize(e.locale, "constant", scope.description)
+		if !isStatementInList(topLevelStatement) || ast.IsIterationStatement(topLevelStatement, false /*lookInLabeledStatements*/) && topLevelStatement == enclosingStatement {
+			return actions
+		}
+		if action := e.extractConstant(scope, topLevelStatement); action != nil {
+			actions = append(actions, action)
+		}
+	}
+	return actions
+}
+
+func (e *symbolExtractor) extractConstant(scope *extractScope, before *ast.Node) *RefactorAction {
+	for _, reference := range e.usages.references {
+		if !isDeclarationVisibleAt(reference.declaration, scope.location) {
+			return nil
+		}
+	}
+
+	return e.createAction(scope.description, CodeActionKindRefactorExtractConstant, func(tracker *change.Tracker) {
+		factory := tracker.NodeFactory
+
+		constantName := tracker.EmitContext.Factory.NewUniqueNameEx("newLocal", printer.AutoGenerateOptions{Flags: printer.GeneratedIdentifierFlagsOptimistic})
+		declaration := factory.NewVariableDeclaration(constantName.AsNode(), nil, nil, factory.DeepCloneNode(e.rng.expression))
+		statement := factory.NewVariableStatement(nil, factory.NewVariableDeclarationList(factory.NewNodeList([]*ast.Node{declaration}), ast.NodeFlagsConst))
+
+		tracker.InsertNodeBefore(e.sourceFile, before, statement, false /*blankLineBetween*/, change.LeadingTriviaOptionNone)
+		tracker.ReplaceNode(e.sourceFile, e.rng.expression, constantName.AsNode(), nil)
+	})
+}
+
+// createAction creates a refactoring whose edits are recorded by makeChanges when they are requested.
+func (e *symbolExtractor) createAction(description string, kind lsproto.CodeActionKind, makeChanges func(tracker *change.Tracker)) *RefactorAction {
+	return &RefactorAction{
+		Description: description,
+		Kind:        kind,
+		GetEdits: func() *RefactorEdits {
+			tracker := change.NewTracker(e.ctx, e.ls.GetProgram().Options(), e.ls.FormatOptions(), e.ls.converters)
+			makeChanges(tracker)
+			changes, _ := tracker.GetChanges()
+			if len(changes) == 0 {
+				return nil
+			}
+			return &RefactorEdits{Changes: changes}
+		},
+	}
+}
@@ -0,0 +1,194 @@
+package ls
+
+import (
+	"context"
+	"slices"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/astnav"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/diagnostics"
+	"github.com/microsoft/typescript-go/internal/locale"
+	"github.com/microsoft/typescript-go/internal/ls/change"
+	"github.com/microsoft/typescript-go/internal/lsp/lsproto"
+	"github.com/microsoft/typescript-go/internal/printer"
+	"github.com/microsoft/typescript-go/internal/scanner"
+	"github.com/microsoft/typescript-go/internal/stringutil"
+)
+
+const CodeActionKindRefactorExtractType lsproto.CodeActionKind = "refactor.extract.type"
+
+// ExtractTypeRefactorProvider offers to extract the selected type node to a type alias.
+var ExtractTypeRefactorProvider = &RefactorProvider{
+	Kinds:              []lsproto.CodeActionKind{CodeActionKindRefactorExtractType},
+	GetRefactorActions: getExtractTypeActions,
+}
+
+func getExtractTypeActions(ctx context.Context, refactorContext *RefactorContext) ([]*RefactorAction, error) {
+	file := refactorContext.SourceFile
+	if ast.IsInJSFile(file.AsNode()) {
+		return nil, nil
+	}
+	selection := getExtractTypeNode(file, refactorContext.Span)
+	if selection == nil {
+		return nil, nil
+	}
+	statement := ast.FindAncestor(selection, isStatementInList)
+	if statement == nil {
+		return nil, nil
+	}
+	typeParameters, ok := collectExtractedTypeParameters(selection, statement)
+	if !ok {
+		return nil, nil
+	}
+
+	ls := refactorContext.LS
+	return []*RefactorAction{{
+		Description: diagnostics.Extract_to_type_alias.Localize(locale.FromContext(ctx)),
+		Kind:        CodeActionKindRefactorExtractType,
+		GetEdits: func() *RefactorEdits {
+			tracker := change.NewTracker(ctx, refactorContext.Program.Options(), ls.FormatOptions(), ls.converters)
+			factory := tracker.NodeFactory
+
+			aliasName := tracker.EmitContext.Factory.NewUniqueNameEx("NewType", printer.AutoGenerateOptions{Flags: printer.GeneratedIdentifierFlagsOptimistic})
+			var aliasTypeParameters *ast.NodeList
+			var typeArguments *ast.NodeList
+			if len(typeParameters) > 0 {
+				aliasTypeParameters = factory.NewNodeList(core.Map(typeParameters, func(typeParameter *ast.Node) *ast.Node {
+					tp := typeParameter.AsTypeParameterDeclaration()
+					return factory.NewTypeParameterDeclaration(
+						nil, /*modifiers*/
+						factory.NewIdentifier(tp.Name().Text()),
+						factory.DeepCloneNode(tp.Constraint),
+						nil, /*expression*/
+						nil, /*defaultType*/
+					)
+				}))
+				typeArguments = factory.NewNodeList(core.Map(typeParameters, func(typeParameter *ast.Node) *ast.Node {
+					return factory.NewTypeReferenceNode(factory.NewIdentifier(typeParameter.Name().Text()), nil)
+				}))
+			}
+			alias := factory.NewTypeAliasDeclaration(nil /*modifiers*/, aliasName.AsNode(), aliasTypeParameters, factory.DeepCloneNode(selection))
+
+			tracker.InsertNodeBefore(file, statement, alias, true /*blankLineBetween*/, change.LeadingTriviaOptionNone)
+			tracker.ReplaceNode(file, selection, factory.NewTypeReferenceNode(aliasName.AsNode(), typeArguments), nil)
+
+			changes, _ := tracker.GetChanges()
+			return &RefactorEdits{Changes: changes}
+		},
+	}}, nil
+}
+
+// getExtractTypeNode finds the outermost type node exactly covered by the span, ignoring surrounding trivia.
+func getExtractTypeNode(file *ast.SourceFile, span core.TextRange) *ast.Node {
+	text := file.Text()
+	start := scanner.SkipTrivia(text, span.Pos())
+	end := span.End()
+	for end > start && stringutil.IsWhiteSpaceLike(rune(text[end-1])) {
+		end--
+	}
+	if start >= end {
+		return nil
+	}
+
+	node := astnav.GetTokenAtPosition(file, start)
+	if node == nil || scanner.GetTokenPosOfNode(node, file, false /*includeJSDoc*/) != start {
+		return nil
+	}
+	var selection *ast.Node
+	for ; node != nil && !ast.IsSourceFile(node) && scanner.GetTokenPosOfNode(node, file, false /*includeJSDoc*/) == start && node.End() <= end; node = node.Parent {
+		if ast.IsTypeNode(node) && node.End() == end {
+			selection = node
+		}
+	}
+	if selection == nil || ast.IsTypePredicateNode(selection) || selection.Kind == ast.KindThisType || ast.IsExpressionWithTypeArguments(selection) {
+		return nil
+	}
+	return selection
+}
+
+// collectExtractedTypeParameters gets the type parameters referenced in the selected type that are declared by
+// the statement that receives the alias, and so are not in scope before it. It returns false if the selection refers to something that cannot
+// be carried over to the alias.
+func collectExtractedTypeParameters(selection *ast.Node, statement *ast.Node) ([]*ast.Node, bool) {
+	var typeParameters []*ast.Node
+	var visit func(node *ast.Node) bool
+	visit = func(node *ast.Node) bool {
+		switch {
+		case node.Kind == ast.KindThisType:
+			return true
+		case ast.IsTypeReferenceNode(node) && ast.IsIdentifier(node.AsTypeReferenceNode().TypeName):
+			typeName := node.AsTypeReferenceNode().TypeName
+			if declaration := findTypeParameterInScope(typeName); declaration != nil {
+				if ast.IsInferTypeNode(declaration.Parent) {
+					if !isNodeWithin(declaration, selection) {
+						return true
+					}
+				} else if !isNodeWithin(declaration, selection) && isNodeWithin(declaration, statement) && !slices.Contains(typeParameters, declaration) {
+					typeParameters = append(typeParameters, declaration)
+				}
+			}
+		case ast.IsTypeQueryNode(node):
+			// `typeof x` refers to a value that may not be in scope at the alias.
+			entityName := node.AsTypeQueryNode().ExprName
+			for ast.IsQualifiedName(entityName) {
+				entityName = entityName.AsQualifiedName().Left
+			}
+			for n := selection.Parent; n != nil && n != statement.Parent; n = n.Parent {
+				if ast.IsFunctionLike(n) && core.Some(n.Parameters(), func(p *ast.Node) bool {
+					return ast.IsIdentifier(p.Name()) && p.Name().Text() == entityName.Text()
+				}) {
+					return true
+				}
+			}
+		}
+		return node.ForEachChild(visit)
+	}
+	if visit(selection) {
+		return nil, false
+	}
+	return typeParameters, true
+}
+
+// findTypeParameterInScope finds the type parameter declaration named by the identifier, looking outward from
+// the identifier through declarations with type parameters, mapped types and `infer` types.
+func findTypeParameterInScope(name *ast.Node) *ast.Node {
+	for n := name.Parent; n != nil; n = n.Parent {
+		if ast.IsConditionalTypeNode(n) && isNodeWithin(name, n.AsConditionalTypeNode().TrueType) {
+			var found *ast.Node
+			var findInfer func(node *ast.Node) bool
+			findInfer = func(node *ast.Node) bool {
+				if ast.IsInferTypeNode(node) {
+					typeParameter := node.AsInferTypeNode().TypeParameter
+					if typeParameter.Name().Text() == name.Text() {
+						found = typeParameter
+						return true
+					}
+				}
+				return node.ForEachChild(findInfer)
+			}
+			findInfer(n.AsConditionalTypeNode().ExtendsType)
+			if found != nil {
+				return found
+			}
+		}
+		if ast.IsMappedTypeNode(n) {
+			if typeParameter := n.AsMappedTypeNode().TypeParameter; typeParameter.Name().Text() == name.Text() {
+				return typeParameter
+			}
+			continue
+		}
+		if ast.IsFunctionLike(n) || ast.IsClassLike(n) || ast.IsInterfaceDeclaration(n) || ast.IsTypeAliasDeclaration(n) {
+			for _, typeParameter := range n.TypeParameters() {
+				if typeParameter.Name().Text() == name.Text() {
+					return typeParameter
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func isNodeWithin(node *ast.Node, container *ast.Node) bool {
+	return node.Pos() >= container.Pos() && node.End() <= container.End()
+}
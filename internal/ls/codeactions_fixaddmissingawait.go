@@ -0,0 +1,181 @@
+package ls
+
+import (
+	"context"
+	"slices"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/astnav"
+	"github.com/microsoft/typescript-go/internal/checker"
+	"github.com/microsoft/typescript-go/internal/collections"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/diagnostics"
+	"github.com/microsoft/typescript-go/internal/locale"
+	"github.com/microsoft/typescript-go/internal/ls/change"
+	"github.com/microsoft/typescript-go/internal/scanner"
+)
+
+const fixAddMissingAwaitFixID = "addMissingAwait"
+
+var fixAddMissingAwaitCallableErrorCodes = []int32{
+	diagnostics.This_expression_is_not_callable.Code(),
+	diagnostics.This_expression_is_not_constructable.Code(),
+}
+
+var fixAddMissingAwaitErrorCodes = append([]int32{
+	diagnostics.An_arithmetic_operand_must_be_of_type_any_number_bigint_or_an_enum_type.Code(),
+	diagnostics.The_left_hand_side_of_an_arithmetic_operation_must_be_of_type_any_number_bigint_or_an_enum_type.Code(),
+	diagnostics.The_right_hand_side_of_an_arithmetic_operation_must_be_of_type_any_number_bigint_or_an_enum_type.Code(),
+	diagnostics.Operator_0_cannot_be_applied_to_type_1.Code(),
+	diagnostics.Operator_0_cannot_be_applied_to_types_1_and_2.Code(),
+	diagnostics.This_comparison_appears_to_be_unintentional_because_the_types_0_and_1_have_no_overlap.Code(),
+	diagnostics.This_condition_will_always_return_true_since_this_0_is_always_defined.Code(),
+	diagnostics.Type_0_is_not_an_array_type.Code(),
+	diagnostics.Type_0_is_not_an_array_type_or_a_string_type.Code(),
+	diagnostics.Type_0_can_only_be_iterated_through_when_using_the_downlevelIteration_flag_or_with_a_target_of_es2015_or_higher.Code(),
+	diagnostics.Type_0_is_not_an_array_type_or_a_string_type_or_does_not_have_a_Symbol_iterator_method_that_returns_an_iterator.Code(),
+	diagnostics.Type_0_is_not_an_array_type_or_does_not_have_a_Symbol_iterator_method_that_returns_an_iterator.Code(),
+	diagnostics.Type_0_must_have_a_Symbol_iterator_method_that_returns_an_iterator.Code(),
+	diagnostics.Type_0_must_have_a_Symbol_asyncIterator_method_that_returns_an_async_iterator.Code(),
+	diagnostics.Argument_of_type_0_is_not_assignable_to_parameter_of_type_1.Code(),
+	diagnostics.Property_0_does_not_exist_on_type_1.Code(),
+}, fixAddMissingAwaitCallableErrorCodes...)
+
+var FixAddMissingAwaitProvider = &CodeFixProvider{
+	ErrorCodes:        fixAddMissingAwaitErrorCodes,
+	GetCodeActions:    getCodeActionsToAddMissingAwait,
+	FixIds:            []string{fixAddMissingAwaitFixID},
+	GetAllCodeActions: getAllCodeActionsToAddMissingAwait,
+	SkipSourceFixAll:  true,
+}
+
+func getCodeActionsToAddMissingAwait(ctx context.Context, fixContext *CodeFixContext) ([]*CodeAction, error) {
+	diag := core.Find(fixContext.Program.GetSemanticDiagnostics(ctx, fixContext.SourceFile), func(d *ast.Diagnostic) bool {
+		return d.Code() == fixContext.ErrorCode && d.Loc() == fixContext.Span
+	})
+	if diag == nil {
+		return nil, nil
+	}
+	expression := getAwaitErrorSpanExpression(fixContext.SourceFile, diag)
+	if expression == nil {
+		return nil, nil
+	}
+
+	typeChecker, done := fixContext.Program.GetTypeCheckerForFile(ctx, fixContext.SourceFile)
+	defer done()
+
+	changeTracker := change.NewTracker(ctx, fixContext.Program.Options(), fixContext.LS.FormatOptions(), fixContext.LS.converters)
+	addMissingAwait(changeTracker, typeChecker, fixContext.SourceFile, fixContext.ErrorCode, expression)
+	changes := getChanges(changeTracker, nil /*importAdder*/, fixContext.SourceFile)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	locale := locale.FromContext(ctx)
+	return []*CodeAction{{
+		Description:       diagnostics.Add_await.Localize(locale),
+		Changes:           changes,
+		FixID:             fixAddMissingAwaitFixID,
+		FixAllDescription: diagnostics.Fix_all_expressions_possibly_missing_await.Localize(locale),
+	}}, nil
+}
+
+func getAllCodeActionsToAddMissingAwait(ctx context.Context, fixContext *CodeFixContext) (*CombinedCodeActions, error) {
+	typeChecker, done := fixContext.Program.GetTypeCheckerForFile(ctx, fixContext.SourceFile)
+	defer done()
+
+	changeTracker := change.NewTracker(ctx, fixContext.Program.Options(), fixContext.LS.FormatOptions(), fixContext.LS.converters)
+	var seenExpressions collections.Set[*ast.Node]
+	for _, diag := range getAllDiagnostics(ctx, fixContext.Program, fixContext.SourceFile) {
+		if diag.File() != fixContext.SourceFile || !isFixableDiagnostic(diag, fixAddMissingAwaitErrorCodes) {
+			continue
+		}
+		expression := getAwaitErrorSpanExpression(fixContext.SourceFile, diag)
+		if expression != nil && seenExpressions.AddIfAbsent(expression) {
+			addMissingAwait(changeTracker, typeChecker, fixContext.SourceFile, diag.Code(), expression)
+		}
+	}
+
+	changes := getChanges(changeTracker, nil /*importAdder*/, fixContext.SourceFile)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	return &CombinedCodeActions{
+		Description: diagnostics.Fix_all_expressions_possibly_missing_await.Localize(locale.FromContext(ctx)),
+		Changes:     changes,
+	}, nil
+}
+
+// getAwaitErrorSpanExpression returns the expression a diagnostic was reported on, if the checker
+// suggested that it is missing an 'await' and an 'await' could be added there.
+func getAwaitErrorSpanExpression(sourceFile *ast.SourceFile, diag *ast.Diagnostic) *ast.Node {
+	if !slices.ContainsFunc(diag.RelatedInformation(), func(related *ast.Diagnostic) bool {
+		return related.Code() == diagnostics.Did_you_forget_to_use_await.Code()
+	}) {
+		return nil
+	}
+	// The checker has already determined that 'await' might be missing and attached related info,
+	// so find the expression that exactly matches the diagnostic range.
+	token := astnav.GetTokenAtPosition(sourceFile, diag.Pos())
+	expression := ast.FindAncestorOrQuit(token, func(node *ast.Node) ast.FindAncestorResult {
+		if scanner.GetTokenPosOfNode(node, sourceFile, false) < diag.Pos() || node.End() > diag.End() {
+			return ast.FindAncestorQuit
+		}
+		return ast.ToFindAncestorResult(ast.IsExpression(node) && scanner.GetTokenPosOfNode(node, sourceFile, false) == diag.Pos() && node.End() == diag.End())
+	})
+	if expression == nil || !isInsideAwaitableBody(expression) {
+		return nil
+	}
+	return expression
+}
+
+func isInsideAwaitableBody(node *ast.Node) bool {
+	return node.Flags&ast.NodeFlagsAwaitContext != 0 || ast.FindAncestor(node, func(ancestor *ast.Node) bool {
+		if ancestor.Parent == nil {
+			return false
+		}
+		if ast.IsArrowFunction(ancestor.Parent) && ancestor.Parent.Body() == ancestor {
+			return true
+		}
+		return ast.IsBlock(ancestor) && (ancestor.Parent.Kind == ast.KindFunctionDeclaration ||
+			ancestor.Parent.Kind == ast.KindFunctionExpression ||
+			ancestor.Parent.Kind == ast.KindArrowFunction ||
+			ancestor.Parent.Kind == ast.KindMethodDeclaration)
+	}) != nil
+}
+
+func addMissingAwait(changeTracker *change.Tracker, typeChecker *checker.Checker, sourceFile *ast.SourceFile, errorCode int32, insertionSite *ast.Node) {
+	parent := insertionSite.Parent
+	switch {
+	case ast.IsForOfStatement(parent) && parent.AsForInOrOfStatement().AwaitModifier == nil:
+		// for (const x of promise) -> for await (const x of promise)
+		if openParen := astnav.FindChildOfKind(parent, ast.KindOpenParenToken, sourceFile); openParen != nil {
+			insertTextBefore(changeTracker, sourceFile, openParen, "await ")
+		}
+	case ast.IsBinaryExpression(insertionSite):
+		binary := insertionSite.AsBinaryExpression()
+		for _, side := range []*ast.Node{binary.Left, binary.Right} {
+			if typeChecker.GetPromisedTypeOfPromise(typeChecker.GetTypeAtLocation(side)) != nil {
+				insertTextBefore(changeTracker, sourceFile, side, "await ")
+			}
+		}
+	case errorCode == diagnostics.Property_0_does_not_exist_on_type_1.Code() && ast.IsPropertyAccessExpression(parent) && parent.Name() == insertionSite:
+		// promise.toFixed -> (await promise).toFixed
+		insertParenthesizedAwait(changeTracker, sourceFile, parent.Expression())
+	case containsErrorCode(fixAddMissingAwaitCallableErrorCodes, errorCode) && ast.IsCallOrNewExpression(parent) && parent.Expression() == insertionSite:
+		// promise() -> (await promise)()
+		insertParenthesizedAwait(changeTracker, sourceFile, insertionSite)
+	default:
+		insertTextBefore(changeTracker, sourceFile, insertionSite, "await ")
+	}
+}
+
+func insertParenthesizedAwait(changeTracker *change.Tracker, sourceFile *ast.SourceFile, expression *ast.Node) {
+	insertTextBefore(changeTracker, sourceFile, expression, "(await ")
+	changeTracker.ReplaceTextRangeWithText(sourceFile, core.NewTextRange(expression.End(), expression.End()), ")")
+}
+
+func insertTextBefore(changeTracker *change.Tracker, sourceFile *ast.SourceFile, node *ast.Node, text string) {
+	pos := scanner.GetTokenPosOfNode(node, sourceFile, false)
+	changeTracker.ReplaceTextRangeWithText(sourceFile, core.NewTextRange(pos, pos), text)
+}
@@ -0,0 +1,112 @@
+package ls
+
+import (
+	"context"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/astnav"
+	"github.com/microsoft/typescript-go/internal/collections"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/diagnostics"
+	"github.com/microsoft/typescript-go/internal/locale"
+	"github.com/microsoft/typescript-go/internal/ls/change"
+	"github.com/microsoft/typescript-go/internal/scanner"
+)
+
+const fixAddOverrideModifierFixID = "fixAddOverrideModifier"
+
+var fixAddOverrideModifierErrorCodes = []int32{
+	diagnostics.This_member_must_have_an_override_modifier_because_it_overrides_a_member_in_the_base_class_0.Code(),
+	diagnostics.This_member_must_have_an_override_modifier_because_it_overrides_an_abstract_method_that_is_declared_in_the_base_class_0.Code(),
+	diagnostics.This_parameter_property_must_have_an_override_modifier_because_it_overrides_a_member_in_base_class_0.Code(),
+}
+
+var FixAddOverrideModifierProvider = &CodeFixProvider{
+	ErrorCodes:        fixAddOverrideModifierErrorCodes,
+	GetCodeActions:    getCodeActionsToAddOverrideModifier,
+	FixIds:            []string{fixAddOverrideModifierFixID},
+	GetAllCodeActions: getAllCodeActionsToAddOverrideModifier,
+}
+
+func getCodeActionsToAddOverrideModifier(ctx context.Context, fixContext *CodeFixContext) ([]*CodeAction, error) {
+	member := findContainerClassElementLike(fixContext.SourceFile, fixContext.Span.Pos())
+	if member == nil {
+		return nil, nil
+	}
+
+	changeTracker := change.NewTracker(ctx, fixContext.Program.Options(), fixContext.LS.FormatOptions(), fixContext.LS.converters)
+	addOverrideModifier(changeTracker, fixContext.SourceFile, member)
+	changes := getChanges(changeTracker, nil /*importAdder*/, fixContext.SourceFile)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	locale := locale.FromContext(ctx)
+	return []*CodeAction{{
+		Description:       diagnostics.Add_override_modifier.Localize(locale),
+		Changes:           changes,
+		FixID:             fixAddOverrideModifierFixID,
+		FixAllDescription: diagnostics.Add_all_missing_override_modifiers.Localize(locale),
+	}}, nil
+}
+
+func getAllCodeActionsToAddOverrideModifier(ctx context.Context, fixContext *CodeFixContext) (*CombinedCodeActions, error) {
+	changeTracker := change.NewTracker(ctx, fixContext.Program.Options(), fixContext.LS.FormatOptions(), fixContext.LS.converters)
+	var seenMembers collections.Set[*ast.Node]
+	for _, diag := range getAllDiagnostics(ctx, fixContext.Program, fixContext.SourceFile) {
+		if diag.File() != fixContext.SourceFile || !isFixableDiagnostic(diag, fixAddOverrideModifierErrorCodes) {
+			continue
+		}
+		member := findContainerClassElementLike(fixContext.SourceFile, diag.Pos())
+		if member != nil && seenMembers.AddIfAbsent(member) {
+			addOverrideModifier(changeTracker, fixContext.SourceFile, member)
+		}
+	}
+
+	changes := getChanges(changeTracker, nil /*importAdder*/, fixContext.SourceFile)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	return &CombinedCodeActions{
+		Description: diagnostics.Add_all_missing_override_modifiers.Localize(locale.FromContext(ctx)),
+		Changes:     changes,
+	}, nil
+}
+
+// findContainerClassElementLike returns the class member or parameter property containing pos.
+func findContainerClassElementLike(sourceFile *ast.SourceFile, pos int) *ast.Node {
+	token := astnav.GetTokenAtPosition(sourceFile, pos)
+	return ast.FindAncestor(token, func(node *ast.Node) bool {
+		return ast.IsClassElement(node) || node.Parent != nil && ast.IsParameterPropertyDeclaration(node, node.Parent)
+	})
+}
+
+// addOverrideModifier inserts 'override' after any accessibility, 'static' or 'abstract' modifier,
+// which must precede it, or otherwise at the start of the member after its decorators.
+func addOverrideModifier(changeTracker *change.Tracker, sourceFile *ast.SourceFile, member *ast.Node) {
+	var modifiers []*ast.Node
+	if modifierList := member.Modifiers(); modifierList != nil {
+		modifiers = modifierList.Nodes
+	}
+	staticModifier := core.Find(modifiers, func(modifier *ast.Node) bool { return modifier.Kind == ast.KindStaticKeyword })
+	abstractModifier := core.Find(modifiers, func(modifier *ast.Node) bool { return modifier.Kind == ast.KindAbstractKeyword })
+	accessibilityModifier := core.Find(modifiers, func(modifier *ast.Node) bool {
+		return ast.ModifierToFlag(modifier.Kind)&ast.ModifierFlagsAccessibilityModifier != 0
+	})
+
+	precedingModifier := core.OrElse(abstractModifier, core.OrElse(staticModifier, accessibilityModifier))
+
+	var pos int
+	var text string
+	if precedingModifier != nil {
+		pos = precedingModifier.End()
+		text = " override"
+	} else {
+		pos = scanner.GetTokenPosOfNode(member, sourceFile, false)
+		if lastDecorator := core.FindLast(modifiers, ast.IsDecorator); lastDecorator != nil {
+			pos = scanner.SkipTrivia(sourceFile.Text(), lastDecorator.End())
+		}
+		text = "override "
+	}
+	changeTracker.ReplaceTextRangeWithText(sourceFile, core.NewTextRange(pos, pos), text)
+}
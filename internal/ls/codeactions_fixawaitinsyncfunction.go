@@ -0,0 +1,122 @@
+package ls
+
+import (
+	"context"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/astnav"
+	"github.com/microsoft/typescript-go/internal/collections"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/diagnostics"
+	"github.com/microsoft/typescript-go/internal/locale"
+	"github.com/microsoft/typescript-go/internal/ls/change"
+	"github.com/microsoft/typescript-go/internal/scanner"
+)
+
+const fixAwaitInSyncFunctionFixID = "fixAwaitInSyncFunction"
+
+var fixAwaitInSyncFunctionErrorCodes = []int32{
+	diagnostics.X_await_expressions_are_only_allowed_within_async_functions_and_at_the_top_levels_of_modules.Code(),
+	diagnostics.X_await_using_statements_are_only_allowed_within_async_functions_and_at_the_top_levels_of_modules.Code(),
+	diagnostics.X_for_await_loops_are_only_allowed_within_async_functions_and_at_the_top_levels_of_modules.Code(),
+	diagnostics.Cannot_find_name_0_Did_you_mean_to_write_this_in_an_async_function.Code(),
+}
+
+var FixAwaitInSyncFunctionProvider = &CodeFixProvider{
+	ErrorCodes:        fixAwaitInSyncFunctionErrorCodes,
+	GetCodeActions:    getCodeActionsToFixAwaitInSyncFunction,
+	FixIds:            []string{fixAwaitInSyncFunctionFixID},
+	GetAllCodeActions: getAllCodeActionsToFixAwaitInSyncFunction,
+}
+
+func getCodeActionsToFixAwaitInSyncFunction(ctx context.Context, fixContext *CodeFixContext) ([]*CodeAction, error) {
+	function := getSyncContainingFunction(fixContext.SourceFile, fixContext.Span.Pos())
+	if function == nil {
+		return nil, nil
+	}
+
+	changeTracker := change.NewTracker(ctx, fixContext.Program.Options(), fixContext.LS.FormatOptions(), fixContext.LS.converters)
+	addAsyncModifier(changeTracker, fixContext.SourceFile, function)
+	changes := getChanges(changeTracker, nil /*importAdder*/, fixContext.SourceFile)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	locale := locale.FromContext(ctx)
+	return []*CodeAction{{
+		Description:       diagnostics.Add_async_modifier_to_containing_function.Localize(locale),
+		Changes:           changes,
+		FixID:             fixAwaitInSyncFunctionFixID,
+		FixAllDescription: diagnostics.Add_all_missing_async_modifiers.Localize(locale),
+	}}, nil
+}
+
+func getAllCodeActionsToFixAwaitInSyncFunction(ctx context.Context, fixContext *CodeFixContext) (*CombinedCodeActions, error) {
+	changeTracker := change.NewTracker(ctx, fixContext.Program.Options(), fixContext.LS.FormatOptions(), fixContext.LS.converters)
+	var seenFunctions collections.Set[*ast.Node]
+	for _, diag := range getAllDiagnostics(ctx, fixContext.Program, fixContext.SourceFile) {
+		if diag.File() != fixContext.SourceFile || !isFixableDiagnostic(diag, fixAwaitInSyncFunctionErrorCodes) {
+			continue
+		}
+		function := getSyncContainingFunction(fixContext.SourceFile, diag.Pos())
+		if function != nil && seenFunctions.AddIfAbsent(function) {
+			addAsyncModifier(changeTracker, fixContext.SourceFile, function)
+		}
+	}
+
+	changes := getChanges(changeTracker, nil /*importAdder*/, fixContext.SourceFile)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	return &CombinedCodeActions{
+		Description: diagnostics.Add_all_missing_async_modifiers.Localize(locale.FromContext(ctx)),
+		Changes:     changes,
+	}, nil
+}
+
+// getSyncContainingFunction returns the non-async function containing pos, if it can be made async.
+func getSyncContainingFunction(sourceFile *ast.SourceFile, pos int) *ast.Node {
+	token := astnav.GetTokenAtPosition(sourceFile, pos)
+	function := ast.GetContainingFunction(token)
+	if function == nil || ast.IsAsyncFunction(function) {
+		return nil
+	}
+	switch function.Kind {
+	case ast.KindFunctionDeclaration, ast.KindFunctionExpression, ast.KindArrowFunction, ast.KindMethodDeclaration:
+		return function
+	}
+	return nil
+}
+
+// addAsyncModifier marks a function as async, wrapping its declared return type in a Promise.
+func addAsyncModifier(changeTracker *change.Tracker, sourceFile *ast.SourceFile, function *ast.Node) {
+	var insertBefore *ast.Node
+	switch function.Kind {
+	case ast.KindMethodDeclaration:
+		insertBefore = function.Name()
+	case ast.KindFunctionDeclaration, ast.KindFunctionExpression:
+		insertBefore = astnav.FindChildOfKind(function, ast.KindFunctionKeyword, sourceFile)
+	case ast.KindArrowFunction:
+		insertBefore = astnav.FindChildOfKind(function, ast.KindOpenParenToken, sourceFile)
+		if insertBefore == nil && len(function.Parameters()) > 0 {
+			insertBefore = function.Parameters()[0]
+		}
+	}
+	if insertBefore == nil {
+		return
+	}
+
+	if returnType := function.Type(); returnType != nil && !isPromiseTypeReference(returnType) {
+		changeTracker.ReplaceTextRangeWithText(sourceFile, core.NewTextRange(scanner.GetTokenPosOfNode(returnType, sourceFile, false), scanner.GetTokenPosOfNode(returnType, sourceFile, false)), "Promise<")
+		changeTracker.ReplaceTextRangeWithText(sourceFile, core.NewTextRange(returnType.End(), returnType.End()), ">")
+	}
+	changeTracker.InsertModifierBefore(sourceFile, ast.KindAsyncKeyword, insertBefore)
+}
+
+func isPromiseTypeReference(typeNode *ast.Node) bool {
+	if !ast.IsTypeReferenceNode(typeNode) {
+		return false
+	}
+	typeName := typeNode.AsTypeReferenceNode().TypeName
+	return ast.IsIdentifier(typeName) && typeName.Text() == "Promise"
+}
@@ -0,0 +1,170 @@
+package ls
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/astnav"
+	"github.com/microsoft/typescript-go/internal/checker"
+	"github.com/microsoft/typescript-go/internal/collections"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/diagnostics"
+	"github.com/microsoft/typescript-go/internal/locale"
+	"github.com/microsoft/typescript-go/internal/ls/change"
+	"github.com/microsoft/typescript-go/internal/ls/lsutil"
+	"github.com/microsoft/typescript-go/internal/scanner"
+)
+
+const fixSpellingFixID = "fixSpelling"
+
+var fixSpellingErrorCodes = []int32{
+	diagnostics.Property_0_does_not_exist_on_type_1_Did_you_mean_2.Code(),
+	diagnostics.Property_0_may_not_exist_on_type_1_Did_you_mean_2.Code(),
+	diagnostics.Cannot_find_name_0_Did_you_mean_1.Code(),
+	diagnostics.Could_not_find_name_0_Did_you_mean_1.Code(),
+	diagnostics.Cannot_find_namespace_0_Did_you_mean_1.Code(),
+	diagnostics.X_0_has_no_exported_member_named_1_Did_you_mean_2.Code(),
+}
+
+var FixSpellingProvider = &CodeFixProvider{
+	ErrorCodes:        fixSpellingErrorCodes,
+	GetCodeActions:    getCodeActionsToFixSpelling,
+	FixIds:            []string{fixSpellingFixID},
+	GetAllCodeActions: getAllCodeActionsToFixSpelling,
+	SkipSourceFixAll:  true,
+}
+
+type spellingInfo struct {
+	node            *ast.Node
+	suggestedSymbol *ast.Symbol
+}
+
+func getCodeActionsToFixSpelling(ctx context.Context, fixContext *CodeFixContext) ([]*CodeAction, error) {
+	typeChecker, done := fixContext.Program.GetTypeCheckerForFile(ctx, fixContext.SourceFile)
+	defer done()
+
+	info := getSpellingInfo(typeChecker, fixContext.SourceFile, fixContext.Span.Pos())
+	if info == nil {
+		return nil, nil
+	}
+
+	changeTracker := change.NewTracker(ctx, fixContext.Program.Options(), fixContext.LS.FormatOptions(), fixContext.LS.converters)
+	doSpellingChange(changeTracker, fixContext.SourceFile, info, fixContext.LS.UserPreferences())
+	changes := getChanges(changeTracker, nil /*importAdder*/, fixContext.SourceFile)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	locale := locale.FromContext(ctx)
+	return []*CodeAction{{
+		Description:       diagnostics.Change_spelling_to_0.Localize(locale, info.suggestedSymbol.Name),
+		Changes:           changes,
+		FixID:             fixSpellingFixID,
+		FixAllDescription: diagnostics.Fix_all_detected_spelling_errors.Localize(locale),
+	}}, nil
+}
+
+func getAllCodeActionsToFixSpelling(ctx context.Context, fixContext *CodeFixContext) (*CombinedCodeActions, error) {
+	typeChecker, done := fixContext.Program.GetTypeCheckerForFile(ctx, fixContext.SourceFile)
+	defer done()
+
+	changeTracker := change.NewTracker(ctx, fixContext.Program.Options(), fixContext.LS.FormatOptions(), fixContext.LS.converters)
+	var seenNodes collections.Set[*ast.Node]
+	for _, diag := range getAllDiagnostics(ctx, fixContext.Program, fixContext.SourceFile) {
+		if diag.File() != fixContext.SourceFile || !isFixableDiagnostic(diag, fixSpellingErrorCodes) {
+			continue
+		}
+		info := getSpellingInfo(typeChecker, fixContext.SourceFile, diag.Pos())
+		if info != nil && seenNodes.AddIfAbsent(info.node) {
+			doSpellingChange(changeTracker, fixContext.SourceFile, info, fixContext.LS.UserPreferences())
+		}
+	}
+
+	changes := getChanges(changeTracker, nil /*importAdder*/, fixContext.SourceFile)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	return &CombinedCodeActions{
+		Description: diagnostics.Fix_all_detected_spelling_errors.Localize(locale.FromContext(ctx)),
+		Changes:     changes,
+	}, nil
+}
+
+func getSpellingInfo(typeChecker *checker.Checker, sourceFile *ast.SourceFile, pos int) *spellingInfo {
+	// This is the identifier of the misspelled word. eg:
+	// this.speling = 1;
+	//      ^^^^^^^
+	node := astnav.GetTokenAtPosition(sourceFile, pos)
+	if node == nil || node.Parent == nil {
+		return nil
+	}
+	parent := node.Parent
+
+	var suggestedSymbol *ast.Symbol
+	switch {
+	case ast.IsPropertyAccessExpression(parent) && parent.Name() == node:
+		containingType := typeChecker.GetTypeAtLocation(parent.Expression())
+		if parent.Flags&ast.NodeFlagsOptionalChain != 0 {
+			containingType = typeChecker.GetNonNullableType(containingType)
+		}
+		suggestedSymbol = typeChecker.GetSuggestedSymbolForNonexistentProperty(node, containingType)
+	case ast.IsQualifiedName(parent) && parent.AsQualifiedName().Right == node:
+		if symbol := typeChecker.GetSymbolAtLocation(parent.AsQualifiedName().Left); symbol != nil && symbol.Flags&ast.SymbolFlagsModule != 0 {
+			suggestedSymbol = typeChecker.GetSuggestedSymbolForNonexistentModule(node, symbol)
+		}
+	case ast.IsImportSpecifier(parent) && parent.Name() == node:
+		importDeclaration := ast.FindAncestorKind(node, ast.KindImportDeclaration)
+		if importDeclaration == nil {
+			return nil
+		}
+		if moduleSymbol := typeChecker.ResolveExternalModuleName(importDeclaration.ModuleSpecifier()); moduleSymbol != nil {
+			suggestedSymbol = typeChecker.GetSuggestedSymbolForNonexistentModule(node, moduleSymbol)
+		}
+	case ast.IsIdentifier(node):
+		meaning := convertSemanticMeaningToSymbolFlags(getMeaningFromLocation(node))
+		suggestedSymbol = typeChecker.GetSuggestedSymbolForNonexistentSymbol(node, node.Text(), meaning)
+	}
+
+	if suggestedSymbol == nil {
+		return nil
+	}
+	return &spellingInfo{node: node, suggestedSymbol: suggestedSymbol}
+}
+
+func doSpellingChange(changeTracker *change.Tracker, sourceFile *ast.SourceFile, info *spellingInfo, preferences lsutil.UserPreferences) {
+	node := info.node
+	suggestion := info.suggestedSymbol.Name
+	if !scanner.IsIdentifierText(suggestion, sourceFile.LanguageVariant) && ast.IsPropertyAccessExpression(node.Parent) {
+		valueDeclaration := info.suggestedSymbol.ValueDeclaration
+		if valueDeclaration == nil || valueDeclaration.Name() == nil || !ast.IsPrivateIdentifier(valueDeclaration.Name()) {
+			// foo.bar-baz does not parse, so rewrite the access as foo["bar-baz"].
+			quotedSuggestion := strconv.Quote(suggestion)
+			if lsutil.GetQuotePreference(sourceFile, preferences) == lsutil.QuotePreferenceSingle && !strings.ContainsAny(suggestion, "'\\") {
+				quotedSuggestion = "'" + quotedSuggestion[1:len(quotedSuggestion)-1] + "'"
+			}
+			accessText := "[" + quotedSuggestion + "]"
+			if node.Parent.AsPropertyAccessExpression().QuestionDotToken != nil {
+				accessText = "?." + accessText
+			}
+			changeTracker.ReplaceTextRangeWithText(sourceFile, core.NewTextRange(node.Parent.Expression().End(), node.End()), accessText)
+			return
+		}
+	}
+	changeTracker.ReplaceTextRangeWithText(sourceFile, core.NewTextRange(scanner.GetTokenPosOfNode(node, sourceFile, false), node.End()), suggestion)
+}
+
+func convertSemanticMeaningToSymbolFlags(meaning ast.SemanticMeaning) ast.SymbolFlags {
+	var flags ast.SymbolFlags
+	if meaning&ast.SemanticMeaningNamespace != 0 {
+		flags |= ast.SymbolFlagsNamespace
+	}
+	if meaning&ast.SemanticMeaningType != 0 {
+		flags |= ast.SymbolFlagsType
+	}
+	if meaning&ast.SemanticMeaningValue != 0 {
+		flags |= ast.SymbolFlagsValue
+	}
+	return flags
+}
@@ -0,0 +1,332 @@
+package ls
+
+import (
+	"context"
+	"slices"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/astnav"
+	"github.com/microsoft/typescript-go/internal/checker"
+	"github.com/microsoft/typescript-go/internal/collections"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/diagnostics"
+	"github.com/microsoft/typescript-go/internal/locale"
+	"github.com/microsoft/typescript-go/internal/ls/change"
+	"github.com/microsoft/typescript-go/internal/scanner"
+)
+
+const fixUnusedIdentifierDeleteFixID = "unusedIdentifier_delete"
+
+var fixUnusedIdentifierErrorCodes = []int32{
+	diagnostics.X_0_is_declared_but_its_value_is_never_read.Code(),
+	diagnostics.X_0_is_declared_but_never_used.Code(),
+	diagnostics.Property_0_is_declared_but_its_value_is_never_read.Code(),
+	diagnostics.All_imports_in_import_declaration_are_unused.Code(),
+	diagnostics.All_destructured_elements_are_unused.Code(),
+	diagnostics.All_variables_are_unused.Code(),
+	diagnostics.All_type_parameters_are_unused.Code(),
+}
+
+var FixUnusedIdentifierProvider = &CodeFixProvider{
+	ErrorCodes:        fixUnusedIdentifierErrorCodes,
+	GetCodeActions:    getCodeActionsToFixUnusedIdentifier,
+	FixIds:            []string{fixUnusedIdentifierDeleteFixID},
+	GetAllCodeActions: getAllCodeActionsToDeleteUnusedIdentifiers,
+	SkipSourceFixAll:  true,
+}
+
+// unusedDeclaration describes what to delete for an unused declaration diagnostic: either
+// a node, which is removed along with its list separators, or a plain text range.
+type unusedDeclaration struct {
+	node      *ast.Node
+	textRange core.TextRange
+	// writes are the statements that only assign to the declaration, which are removed along with it.
+	writes      []*ast.Node
+	description *diagnostics.Message
+	arg         string
+}
+
+func getCodeActionsToFixUnusedIdentifier(ctx context.Context, fixContext *CodeFixContext) ([]*CodeAction, error) {
+	locale := locale.FromContext(ctx)
+	var actions []*CodeAction
+
+	typeChecker, done := fixContext.Program.GetTypeCheckerForFile(ctx, fixContext.SourceFile)
+	defer done()
+
+	if unused := getUnusedDeclaration(ctx, fixContext, typeChecker, fixContext.ErrorCode, fixContext.Span); unused != nil {
+		changeTracker := change.NewTracker(ctx, fixContext.Program.Options(), fixContext.LS.FormatOptions(), fixContext.LS.converters)
+		deleteUnusedDeclaration(changeTracker, fixContext.SourceFile, unused)
+		if changes := getChanges(changeTracker, nil /*importAdder*/, fixContext.SourceFile); len(changes) > 0 {
+			var description string
+			if unused.arg != "" {
+				description = unused.description.Localize(locale, unused.arg)
+			} else {
+				description = unused.description.Localize(locale)
+			}
+			actions = append(actions, &CodeAction{
+				Description:       description,
+				Changes:           changes,
+				FixID:             fixUnusedIdentifierDeleteFixID,
+				FixAllDescription: diagnostics.Delete_all_unused_declarations.Localize(locale),
+			})
+		}
+	}
+
+	if name := getUnusedNameToPrefix(fixContext.SourceFile, fixContext.Span.Pos()); name != nil {
+		changeTracker := change.NewTracker(ctx, fixContext.Program.Options(), fixContext.LS.FormatOptions(), fixContext.LS.converters)
+		start := scanner.GetTokenPosOfNode(name, fixContext.SourceFile, false)
+		changeTracker.ReplaceTextRangeWithText(fixContext.SourceFile, core.NewTextRange(start, start), "_")
+		actions = append(actions, &CodeAction{
+			Description: diagnostics.Prefix_0_with_an_underscore.Localize(locale, name.Text()),
+			Changes:     getChanges(changeTracker, nil /*importAdder*/, fixContext.SourceFile),
+		})
+	}
+
+	return actions, nil
+}
+
+func getAllCodeActionsToDeleteUnusedIdentifiers(ctx context.Context, fixContext *CodeFixContext) (*CombinedCodeActions, error) {
+	typeChecker, done := fixContext.Program.GetTypeCheckerForFile(ctx, fixContext.SourceFile)
+	defer done()
+
+	changeTracker := change.NewTracker(ctx, fixContext.Program.Options(), fixContext.LS.FormatOptions(), fixContext.LS.converters)
+	var seenNodes collections.Set[*ast.Node]
+	for _, diag := range getAllDiagnostics(ctx, fixContext.Program, fixContext.SourceFile) {
+		if diag.File() != fixContext.SourceFile || !isFixableDiagnostic(diag, fixUnusedIdentifierErrorCodes) {
+			continue
+		}
+		unused := getUnusedDeclaration(ctx, fixContext, typeChecker, diag.Code(), diag.Loc())
+		if unused != nil && (unused.node == nil || seenNodes.AddIfAbsent(unused.node)) {
+			deleteUnusedDeclaration(changeTracker, fixContext.SourceFile, unused)
+		}
+	}
+
+	changes := getChanges(changeTracker, nil /*importAdder*/, fixContext.SourceFile)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	return &CombinedCodeActions{
+		Description: diagnostics.Delete_all_unused_declarations.Localize(locale.FromContext(ctx)),
+		Changes:     changes,
+	}, nil
+}
+
+func deleteUnusedDeclaration(changeTracker *change.Tracker, sourceFile *ast.SourceFile, unused *unusedDeclaration) {
+	if unused.node != nil {
+		changeTracker.Delete(sourceFile, unused.node)
+	} else {
+		changeTracker.DeleteRange(sourceFile, unused.textRange)
+	}
+	for _, write := range unused.writes {
+		changeTracker.Delete(sourceFile, write)
+	}
+}
+
+// getUnusedDeclaration returns the declaration reported by an unused diagnostic, or nil if it
+// cannot be deleted without changing the meaning of the surrounding code.
+func getUnusedDeclaration(ctx context.Context, fixContext *CodeFixContext, typeChecker *checker.Checker, errorCode int32, span core.TextRange) *unusedDeclaration {
+	sourceFile := fixContext.SourceFile
+	token := astnav.GetTokenAtPosition(sourceFile, span.Pos())
+	if token == nil || token.Parent == nil {
+		return nil
+	}
+
+	switch errorCode {
+	case diagnostics.All_imports_in_import_declaration_are_unused.Code():
+		importDeclaration := ast.FindAncestorKind(token, ast.KindImportDeclaration)
+		if importDeclaration == nil {
+			return nil
+		}
+		return &unusedDeclaration{node: importDeclaration, description: diagnostics.Remove_import_from_0, arg: importDeclaration.ModuleSpecifier().Text()}
+	case diagnostics.All_destructured_elements_are_unused.Code():
+		pattern := token.Parent
+		if !ast.IsBindingPattern(pattern) || !ast.IsVariableDeclaration(pattern.Parent) || ast.IsForInOrOfStatement(pattern.Parent.Parent.Parent) {
+			return nil
+		}
+		return &unusedDeclaration{node: pattern.Parent, description: diagnostics.Remove_unused_destructuring_declaration}
+	case diagnostics.All_variables_are_unused.Code():
+		if !ast.IsVariableDeclarationList(token.Parent) || !ast.IsVariableStatement(token.Parent.Parent) {
+			return nil
+		}
+		return &unusedDeclaration{node: token.Parent.Parent, description: diagnostics.Remove_variable_statement}
+	case diagnostics.All_type_parameters_are_unused.Code():
+		return &unusedDeclaration{textRange: span, description: diagnostics.Remove_type_parameters}
+	}
+
+	if !ast.IsIdentifier(token) && !ast.IsPrivateIdentifier(token) {
+		return nil
+	}
+	declaration := token.Parent
+	if declaration.Name() != token {
+		return nil
+	}
+	switch {
+	case ast.IsImportClause(declaration):
+		// Deleting the name of an import clause deletes only the default import.
+		declaration = token
+	case ast.IsImportSpecifier(declaration), ast.IsNamespaceImport(declaration):
+	case ast.IsParameterDeclaration(declaration):
+		if !mayDeleteParameter(ctx, fixContext, declaration) {
+			return nil
+		}
+	case ast.IsTypeParameterDeclaration(declaration):
+		if ast.IsInferTypeNode(declaration.Parent) {
+			return nil
+		}
+		if typeParameters := declaration.Parent.TypeParameterList(); len(typeParameters.Nodes) == 1 {
+			// Remove the angle brackets along with the only type parameter.
+			return &unusedDeclaration{
+				textRange:   core.NewTextRange(typeParameters.Pos()-1, typeParameters.End()+1),
+				description: diagnostics.Remove_unused_declaration_for_Colon_0,
+				arg:         token.Text(),
+			}
+		}
+	case ast.IsBindingElement(declaration):
+		pattern := declaration.Parent
+		if len(pattern.Elements()) == 1 {
+			// Removing the only element would leave an empty pattern behind; remove the whole declaration.
+			if !ast.IsVariableDeclaration(pattern.Parent) || ast.IsForInOrOfStatement(pattern.Parent.Parent.Parent) {
+				return nil
+			}
+			declaration = pattern.Parent
+		}
+	case ast.IsVariableDeclaration(declaration):
+		if ast.IsForInOrOfStatement(declaration.Parent.Parent) {
+			return nil
+		}
+	case ast.IsPropertyDeclaration(declaration), ast.IsMethodDeclaration(declaration), ast.IsAccessor(declaration),
+		ast.IsFunctionDeclaration(declaration), ast.IsClassDeclaration(declaration), ast.IsInterfaceDeclaration(declaration),
+		ast.IsTypeAliasDeclaration(declaration), ast.IsEnumDeclaration(declaration), ast.IsImportEqualsDeclaration(declaration):
+	default:
+		return nil
+	}
+	writes, ok := getDeletableWrites(typeChecker, sourceFile, token)
+	if !ok {
+		return nil
+	}
+	return &unusedDeclaration{node: declaration, writes: writes, description: diagnostics.Remove_unused_declaration_for_Colon_0, arg: token.Text()}
+}
+
+// getDeletableWrites returns the statements that only assign to the declaration with the given name.
+// It reports false if the declaration is referenced in any other way, since deleting it would leave
+// that reference behind.
+func getDeletableWrites(typeChecker *checker.Checker, sourceFile *ast.SourceFile, name *ast.Node) ([]*ast.Node, bool) {
+	symbol := typeChecker.GetSymbolAtLocation(name)
+	if symbol == nil {
+		return nil, true
+	}
+	var writes []*ast.Node
+	for _, reference := range getPossibleSymbolReferenceNodes(sourceFile, name.Text(), nil /*container*/) {
+		if reference == name || reference.Flags&ast.NodeFlagsJSDoc != 0 || typeChecker.GetSymbolAtLocation(reference) != symbol {
+			continue
+		}
+		statement := getWriteOnlyStatement(reference)
+		if statement == nil {
+			return nil, false
+		}
+		writes = append(writes, statement)
+	}
+	return writes, true
+}
+
+// getWriteOnlyStatement returns the expression statement consisting of an assignment, increment or
+// decrement of the reference, such as `x = 1;` or `this.p++;`, if the statement can be removed.
+func getWriteOnlyStatement(reference *ast.Node) *ast.Node {
+	expression := ast.ClimbPastPropertyAccess(reference)
+	if !ast.IsWriteOnlyAccess(expression) {
+		return nil
+	}
+	statement := expression.Parent.Parent
+	if statement == nil || !ast.IsExpressionStatement(statement) || statement.Expression() != expression.Parent {
+		return nil
+	}
+	switch statement.Parent.Kind {
+	case ast.KindBlock, ast.KindSourceFile, ast.KindModuleBlock, ast.KindCaseClause, ast.KindDefaultClause:
+		return statement
+	}
+	return nil
+}
+
+// mayDeleteParameter reports whether an unused parameter can be removed without shifting or
+// dropping an argument passed to its function.
+func mayDeleteParameter(ctx context.Context, fixContext *CodeFixContext, parameter *ast.Node) bool {
+	function := parameter.Parent
+	switch {
+	case ast.IsParameterPropertyDeclaration(parameter, function), ast.IsSetAccessorDeclaration(function):
+		// Parameter properties declare members, and a setter must have a parameter.
+		return false
+	case ast.IsGetAccessorDeclaration(function):
+		return true
+	}
+
+	parameters := function.Parameters()
+	index := slices.Index(parameters, parameter)
+	isLast := index == len(parameters)-1
+
+	var referent *ast.Node
+	switch {
+	case ast.IsFunctionDeclaration(function), ast.IsMethodDeclaration(function):
+		referent = function.Name()
+	case ast.IsConstructorDeclaration(function):
+		referent = function.Parent.Name()
+	}
+	if referent == nil || !ast.IsIdentifier(referent) {
+		// The callers of anonymous functions cannot be found, but they never depend on the last
+		// parameter being there.
+		return isLast
+	}
+
+	sourceFiles := fixContext.Program.GetSourceFiles()
+	entries := fixContext.LS.getReferencedSymbolsForNode(ctx, referent.Pos(), referent, fixContext.Program, sourceFiles, refOptions{use: referenceUseReferences})
+	for _, entry := range entries {
+		for _, reference := range entry.References() {
+			node := reference.Node()
+			if node == nil || node == referent || node.Flags&ast.NodeFlagsJSDoc != 0 || ast.IsPartOfTypeNode(node) {
+				continue
+			}
+			callee := ast.ClimbPastPropertyAccess(node)
+			switch {
+			case ast.IsCallOrNewExpression(callee.Parent) && callee.Parent.Expression() == callee:
+				if len(callee.Parent.Arguments()) > index {
+					return false
+				}
+			case (ast.IsMethodDeclaration(node.Parent) || ast.IsMethodSignatureDeclaration(node.Parent)) && node.Parent.Name() == node:
+				// An overriding or overridden method receives the same arguments.
+				if node.Parent != function && len(node.Parent.Parameters()) > index {
+					return false
+				}
+			case ast.IsConstructorDeclaration(function) && ast.IsExpressionWithTypeArgumentsInClassExtendsClause(node.Parent):
+				// Derived classes pass their arguments through super calls.
+				return false
+			case ast.IsDeclarationName(node):
+			default:
+				// The function is passed around, so its callers cannot be known.
+				return isLast
+			}
+		}
+	}
+	return true
+}
+
+// getUnusedNameToPrefix returns the name of an unused parameter or loop variable that can be
+// marked as intentionally unused by prefixing it with an underscore.
+func getUnusedNameToPrefix(sourceFile *ast.SourceFile, pos int) *ast.Node {
+	token := astnav.GetTokenAtPosition(sourceFile, pos)
+	if token == nil || !ast.IsIdentifier(token) || token.Text()[0] == '_' {
+		return nil
+	}
+	parent := token.Parent
+	switch {
+	case ast.IsParameterDeclaration(parent) && parent.Name() == token:
+		if ast.IsParameterPropertyDeclaration(parent, parent.Parent) {
+			return nil
+		}
+	case ast.IsVariableDeclaration(parent) && parent.Name() == token:
+		if !ast.IsForInOrOfStatement(parent.Parent.Parent) {
+			return nil
+		}
+	default:
+		return nil
+	}
+	return token
+}
@@ -0,0 +1,739 @@
+package ls
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/checker"
+	"github.com/microsoft/typescript-go/internal/compiler"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/diagnostics"
+	"github.com/microsoft/typescript-go/internal/locale"
+	"github.com/microsoft/typescript-go/internal/ls/autoimport"
+	"github.com/microsoft/typescript-go/internal/ls/change"
+	"github.com/microsoft/typescript-go/internal/ls/lsconv"
+	"github.com/microsoft/typescript-go/internal/ls/lsutil"
+	"github.com/microsoft/typescript-go/internal/lsp/lsproto"
+	"github.com/microsoft/typescript-go/internal/modulespecifiers"
+	"github.com/microsoft/typescript-go/internal/scanner"
+	"github.com/microsoft/typescript-go/internal/spanmap"
+	"github.com/microsoft/typescript-go/internal/tspath"
+)
+
+const (
+	CodeActionKindRefactorMoveNewFile lsproto.CodeActionKind = "refactor.move.newFile"
+	CodeActionKindRefactorMoveFile    lsproto.CodeActionKind = "refactor.move.file"
+)
+
+// CommandMoveToFile is the command behind the "Move to file" refactoring. Its arguments are the document URI, the
+// selected range and, optionally, the URI of the target file. Clients that let the user pick the target add it to
+// the arguments; otherwise the server asks for it with window/showMessageRequest, offering a few likely targets.
+// The server applies the resulting edit with workspace/applyEdit.
+const CommandMoveToFile = "typescript.moveToFile"
+
+// MoveToFileRefactorProvider offers to move the selected top-level statements to a new file or to an existing one.
+var MoveToFileRefactorProvider = &RefactorProvider{
+	Kinds:              []lsproto.CodeActionKind{CodeActionKindRefactorMoveNewFile, CodeActionKindRefactorMoveFile},
+	GetRefactorActions: getMoveToFileActions,
+}
+
+func getMoveToFileActions(ctx context.Context, refactorContext *RefactorContext) ([]*RefactorAction, error) {
+	file := refactorContext.SourceFile
+	// Statements of content-mapped files are generated from another language and cannot be moved as text.
+	if file.ContentMapper() != "" || getStatementsToMove(file, refactorContext.Span) == nil {
+		return nil, nil
+	}
+
+	var only *[]lsproto.CodeActionKind
+	if refactorContext.Params.Context != nil {
+		only = refactorContext.Params.Context.Only
+	}
+	var actions []*RefactorAction
+	if isRefactorKindRequested(CodeActionKindRefactorMoveNewFile, only) {
+		actions = append(actions, &RefactorAction{
+			Description: diagnostics.Move_to_a_new_file.Localize(locale.FromContext(ctx)),
+			Kind:        CodeActionKindRefactorMoveNewFile,
+			GetEdits: func() *RefactorEdits {
+				c, done := refactorContext.Program.GetTypeCheckerForFile(ctx, file)
+				defer done()
+				mover := refactorContext.LS.newStatementMover(ctx, refactorContext.Program, c, file, refactorContext.Span)
+				newFileName := mover.getNewFileName()
+				changes := mover.getChanges(newFileName)
+				if changes == nil {
+					return nil
+				}
+				return &RefactorEdits{Changes: changes, NewFiles: []string{newFileName}}
+			},
+		})
+	}
+	if isRefactorKindRequested(CodeActionKindRefactorMoveFile, only) && file == refactorContext.Program.GetSourceFile(file.FileName()) {
+		description := diagnostics.Move_to_file.Localize(locale.FromContext(ctx))
+		actions = append(actions, &RefactorAction{
+			Description: description,
+			Kind:        CodeActionKindRefactorMoveFile,
+			Command: &lsproto.Command{
+				Title:     description,
+				Command:   CommandMoveToFile,
+				Arguments: &[]any{refactorContext.Params.TextDocument.Uri, refactorContext.Params.Range},
+			},
+		})
+	}
+	return actions, nil
+}
+
+// GetMoveToFileEdit computes the edit that moves the top-level statements in the given range of a document to
+// targetFileName, which may name an existing file of the program or a file to be created. An empty targetFileName
+// moves the statements to a new file named after them. It returns nil if the statements cannot be moved there.
+func (l *LanguageService) GetMoveToFileEdit(ctx context.Context, documentURI lsproto.DocumentUri, documentRange lsproto.Range, targetFileName string) (*lsproto.WorkspaceEdit, error) {
+	program, file := l.getProgramAndFile(documentURI)
+	if file.ContentMapper() != "" || program.GetSourceFile(targetFileName) == file {
+		return nil, nil
+	}
+	mapped := lsconv.FromLSPRangeForSourceFile(l.converters, file, documentRange, spanmap.FeatureCodeActions)
+	if len(mapped) != 1 || mapped[0].Script != file {
+		return nil, nil
+	}
+	c, done := program.GetTypeCheckerForFile(ctx, file)
+	defer done()
+	mover := l.newStatementMover(ctx, program, c, file, mapped[0].Span)
+	if mover == nil {
+		return nil, nil
+	}
+	if targetFileName == "" {
+		targetFileName = mover.getNewFileName()
+	}
+	changes := mover.getChanges(targetFileName)
+	if changes == nil {
+		return nil, nil
+	}
+	var newFiles []string
+	if program.GetSourceFile(targetFileName) == nil {
+		newFiles = append(newFiles, targetFileName)
+	}
+	return createWorkspaceEdit(changes, newFiles), nil
+}
+
+// GetMoveToFileTargets returns up to limit files of the program that the "Move to file" refactoring suggests as
+// targets for statements of the given document. Targets are other files of the same language that are not
+// declaration files or library files. The files of recent, most recently used first, come before the other files
+// in the directory of the document; files in other directories are not suggested.
+func (l *LanguageService) GetMoveToFileTargets(documentURI lsproto.DocumentUri, recent []string, limit int) []string {
+	program, file := l.getProgramAndFile(documentURI)
+	isJS := ast.IsInJSFile(file.AsNode())
+	isTarget := func(target *ast.SourceFile) bool {
+		return target != nil && target != file && !target.IsDeclarationFile && target.ContentMapper() == "" &&
+			ast.IsInJSFile(target.AsNode()) == isJS && !program.IsSourceFileFromExternalLibrary(target)
+	}
+
+	var targets []string
+	for _, fileName := range recent {
+		if target := program.GetSourceFile(fileName); isTarget(target) && !slices.Contains(targets, target.FileName()) {
+			targets = append(targets, target.FileName())
+		}
+	}
+	directory := file.Path().GetDirectoryPath()
+	var sameDirectory []string
+	for _, target := range program.GetSourceFiles() {
+		if isTarget(target) && target.Path().GetDirectoryPath() == directory && !slices.Contains(targets, target.FileName()) {
+			sameDirectory = append(sameDirectory, target.FileName())
+		}
+	}
+	slices.Sort(sameDirectory)
+	targets = append(targets, sameDirectory...)
+	return targets[:min(len(targets), limit)]
+}
+
+// getStatementsToMove gets the top-level statements touched by the span, or nil if they cannot be moved.
+func getStatementsToMove(file *ast.SourceFile, span core.TextRange) []*ast.Node {
+	if span.Len() == 0 || file.IsDeclarationFile || !ast.IsExternalModule(file) {
+		return nil
+	}
+	statements := file.Statements.Nodes
+	start := slices.IndexFunc(statements, func(s *ast.Node) bool { return s.End() > span.Pos() })
+	if start < 0 {
+		return nil
+	}
+	end := start
+	for end < len(statements) && scanner.GetTokenPosOfNode(statements[end], file, false /*includeJSDoc*/) < span.End() {
+		end++
+	}
+	toMove := statements[start:end]
+	if len(toMove) == 0 || slices.ContainsFunc(toMove, func(s *ast.Node) bool { return !isMovableStatement(s) }) {
+		return nil
+	}
+	return toMove
+}
+
+func isMovableStatement(statement *ast.Node) bool {
+	switch statement.Kind {
+	case ast.KindImportDeclaration, ast.KindImportEqualsDeclaration, ast.KindExportDeclaration, ast.KindExportAssignment:
+		return false
+	case ast.KindModuleDeclaration:
+		if !ast.IsIdentifier(statement.Name()) {
+			return false
+		}
+	case ast.KindExpressionStatement:
+		return !ast.IsPrologueDirective(statement)
+	}
+	return !ast.HasSyntacticModifier(statement, ast.ModifierFlagsDefault)
+}
+
+// getTopLevelDeclarationStatement returns the statement of a declaration made in the top-level scope of a file,
+// or nil if the declaration is not a top-level one.
+func getTopLevelDeclarationStatement(declaration *ast.Node) *ast.Node {
+	root := ast.GetRootDeclaration(declaration)
+	if ast.IsVariableDeclaration(root) {
+		if statement := root.Parent.Parent; ast.IsVariableStatement(statement) && ast.IsSourceFile(statement.Parent) {
+			return statement
+		}
+		return nil
+	}
+	if ast.IsSourceFile(declaration.Parent) && ast.IsDeclarationStatement(declaration) {
+		return declaration
+	}
+	return nil
+}
+
+// getDeclaredNames gets the names declared by a top-level statement.
+func getDeclaredNames(statement *ast.Node) []*ast.Node {
+	if ast.IsVariableStatement(statement) {
+		var names []*ast.Node
+		for _, declaration := range statement.AsVariableStatement().DeclarationList.AsVariableDeclarationList().Declarations.Nodes {
+			names = append(names, collectBindingIdentifiers(declaration.Name())...)
+		}
+		return names
+	}
+	if name := statement.Name(); name != nil && ast.IsIdentifier(name) {
+		return []*ast.Node{name}
+	}
+	return nil
+}
+
+func isImportBinding(declaration *ast.Node) bool {
+	switch declaration.Kind {
+	case ast.KindImportClause, ast.KindNamespaceImport, ast.KindImportSpecifier:
+		return true
+	case ast.KindImportEqualsDeclaration:
+		return ast.IsExternalModuleImportEqualsDeclaration(declaration)
+	}
+	return false
+}
+
+func getImportStatementOfBinding(binding *ast.Node) *ast.Node {
+	return ast.FindAncestor(binding, func(n *ast.Node) bool { return ast.IsImportDeclaration(n) || ast.IsImportEqualsDeclaration(n) })
+}
+
+// statementMover computes the edits that move a run of top-level statements out of a file.
+type statementMover struct {
+	ctx     context.Context
+	ls      *LanguageService
+	program *compiler.Program
+	checker *checker.Checker
+	oldFile *ast.SourceFile
+	toMove  []*ast.Node
+
+	// Top-level declarations in the old file that stay there but are referenced by the moved statements.
+	usedFromOldFile []*ast.Node
+	// Moved top-level declarations that are referenced by the statements staying in the old file.
+	usedFromMoved []*ast.Node
+	// Import bindings of the old file that are referenced by the moved statements.
+	importsUsedByMoved []*ast.Node
+	// Import bindings of the old file that are referenced by the statements staying there.
+	importsUsedByRest []*ast.Node
+}
+
+func (l *LanguageService) newStatementMover(ctx context.Context, program *compiler.Program, c *checker.Checker, file *ast.SourceFile, span core.TextRange) *statementMover {
+	toMove := getStatementsToMove(file, span)
+	if toMove == nil {
+		return nil
+	}
+
+	m := &statementMover{
+		ctx:     ctx,
+		ls:      l,
+		program: program,
+		checker: c,
+		oldFile: file,
+		toMove:  toMove,
+	}
+	for _, statement := range file.Statements.Nodes {
+		if ast.IsImportDeclaration(statement) || ast.IsImportEqualsDeclaration(statement) {
+			continue
+		}
+		moved := m.isMoved(statement)
+		m.forEachReferencedDeclaration(statement, func(declaration *ast.Node) {
+			switch {
+			case isImportBinding(declaration):
+				if moved {
+					m.importsUsedByMoved = core.AppendIfUnique(m.importsUsedByMoved, declaration)
+				} else {
+					m.importsUsedByRest = core.AppendIfUnique(m.importsUsedByRest, declaration)
+				}
+			case m.isMoved(getTopLevelDeclarationStatement(declaration)) != moved:
+				if moved {
+					m.usedFromOldFile = core.AppendIfUnique(m.usedFromOldFile, declaration)
+				} else {
+					m.usedFromMoved = core.AppendIfUnique(m.usedFromMoved, declaration)
+				}
+			}
+		})
+	}
+	return m
+}
+
+func (m *statementMover) isMoved(statement *ast.Node) bool {
+	return statement != nil && slices.Contains(m.toMove, statement)
+}
+
+// forEachReferencedDeclaration calls cb with the top-level declaration or import binding of the old file that each
+// identifier in node refers to.
+func (m *statementMover) forEachReferencedDeclaration(node *ast.Node, cb func(declaration *ast.Node)) {
+	var visit func(node *ast.Node) bool
+	visit = func(node *ast.Node) bool {
+		if ast.IsIdentifier(node) {
+			var symbol *ast.Symbol
+			if ast.IsShorthandPropertyAssignment(node.Parent) && node.Parent.Name() == node {
+				symbol = m.checker.GetShorthandAssignmentValueSymbol(node.Parent)
+			} else {
+				symbol = m.checker.GetSymbolAtLocation(node)
+			}
+			if symbol != nil {
+				for _, declaration := range symbol.Declarations {
+					if ast.GetSourceFileOfNode(declaration) != m.oldFile || declaration.Name() == node {
+						continue
+					}
+					if isImportBinding(declaration) || getTopLevelDeclarationStatement(declaration) != nil {
+						cb(declaration)
+						break
+					}
+				}
+			}
+		}
+		return node.ForEachChild(visit)
+	}
+	visit(node)
+}
+
+// getNewFileName picks a file name next to the old file, named after the first declaration being moved.
+func (m *statementMover) getNewFileName() string {
+	name := "newFile"
+	for _, statement := range m.toMove {
+		if names := getDeclaredNames(statement); len(names) > 0 {
+			name = names[0].Text()
+			break
+		}
+	}
+	directory := tspath.GetDirectoryPath(m.oldFile.FileName())
+	extension := tspath.TryGetExtensionFromPath(m.oldFile.FileName())
+	fileName := tspath.CombinePaths(directory, name+extension)
+	for i := 1; m.program.FileExists(fileName); i++ {
+		fileName = tspath.CombinePaths(directory, name+"."+strconv.Itoa(i)+extension)
+	}
+	return fileName
+}
+
+// getChanges computes the edits for moving the statements to targetFileName, grouped by file name. If the target
+// is not a file of the program, the edits create it, and its full content is the only edit for that file name.
+func (m *statementMover) getChanges(targetFileName string) map[string][]*lsproto.TextEdit {
+	targetFile := m.program.GetSourceFile(targetFileName)
+	if targetFile == m.oldFile {
+		return nil
+	}
+	if targetFile != nil && (targetFile.IsDeclarationFile || len(targetFile.Statements.Nodes) > 0 && !ast.IsExternalModule(targetFile)) {
+		return nil
+	}
+
+	tracker := change.NewTracker(m.ctx, m.program.Options(), m.ls.FormatOptions(), m.ls.converters)
+	m.updateOldFile(tracker, targetFileName)
+	m.updateImportsInOtherFiles(tracker, targetFile, targetFileName)
+
+	var imports []*ast.Node
+	for _, binding := range m.importsUsedByMoved {
+		if statement := m.getImportForTarget(tracker, targetFile, targetFileName, binding); statement != nil {
+			imports = append(imports, statement)
+		}
+	}
+	body := core.Map(m.toMove, func(statement *ast.Node) *ast.Node {
+		if m.needsExport(statement) {
+			return addExportModifier(tracker.NodeFactory, statement)
+		}
+		return statement
+	})
+
+	if targetFile == nil {
+		if len(m.usedFromOldFile) > 0 {
+			names := core.Map(m.getNamesOf(m.usedFromOldFile), func(name string) *ast.Node {
+				return tracker.NodeFactory.NewImportSpecifier(false /*isTypeOnly*/, nil /*propertyName*/, tracker.NodeFactory.NewIdentifier(name))
+			})
+			imports = append(imports, tracker.NodeFactory.NewImportDeclaration(
+				nil, /*modifiers*/
+				tracker.NodeFactory.NewImportClause(ast.KindUnknown, nil /*name*/, tracker.NodeFactory.NewNamedImports(tracker.NodeFactory.NewNodeList(names))),
+				m.newModuleSpecifier(tracker, m.oldFile, targetFileName, m.oldFile.FileName()),
+				nil, /*attributes*/
+			))
+		}
+		statements := imports
+		if len(statements) > 0 {
+			statements = append(statements, nil)
+		}
+		tracker.CreateNewFile(m.oldFile, targetFileName, append(statements, body...))
+	} else {
+		if len(imports) > 0 {
+			autoimport.InsertImports(tracker, targetFile, imports, true /*blankLineBetween*/, m.ls.UserPreferences())
+		}
+		if len(m.usedFromOldFile) > 0 {
+			adder := autoimport.NewImportAdder(m.ctx, m.program, m.checker, targetFile, m.ls.getCurrentAutoImportView(targetFile), m.ls.FormatOptions(), m.ls.converters, m.ls.UserPreferences())
+			m.addNamedImports(adder, targetFile, targetFileName, m.getNamesOf(m.usedFromOldFile), m.oldFile.FileName())
+			adder.WriteFixes(tracker)
+		}
+		tracker.InsertStatementsAtEndOfFile(targetFile, m.oldFile, body)
+	}
+
+	changes, unmappable := tracker.GetChanges()
+	if len(unmappable) > 0 {
+		return nil
+	}
+	return changes
+}
+
+// updateOldFile removes the moved statements from the old file, exports the declarations they still use from it,
+// imports the moved declarations it still uses, and removes the imports only the moved statements used.
+func (m *statementMover) updateOldFile(tracker *change.Tracker, targetFileName string) {
+	var afterLast *ast.Node
+	statements := m.oldFile.Statements.Nodes
+	if index := slices.Index(statements, m.toMove[len(m.toMove)-1]); index+1 < len(statements) {
+		afterLast = statements[index+1]
+	}
+	tracker.DeleteNodeRangeExcludingEnd(m.oldFile, m.toMove[0], afterLast)
+
+	var exported []*ast.Node
+	for _, declaration := range m.usedFromOldFile {
+		statement := getTopLevelDeclarationStatement(declaration)
+		if !ast.HasSyntacticModifier(statement, ast.ModifierFlagsExport) && !slices.Contains(exported, statement) {
+			exported = append(exported, statement)
+			tracker.InsertModifierBefore(m.oldFile, ast.KindExportKeyword, statement)
+		}
+	}
+
+	for _, binding := range m.importsUsedByMoved {
+		if !slices.Contains(m.importsUsedByRest, binding) {
+			m.deleteImportBinding(tracker, m.oldFile, binding, func(other *ast.Node) bool {
+				return !slices.Contains(m.importsUsedByRest, other)
+			})
+		}
+	}
+
+	if len(m.usedFromMoved) > 0 {
+		adder := autoimport.NewImportAdder(m.ctx, m.program, m.checker, m.oldFile, m.ls.getCurrentAutoImportView(m.oldFile), m.ls.FormatOptions(), m.ls.converters, m.ls.UserPreferences())
+		m.addNamedImports(adder, m.oldFile, m.oldFile.FileName(), m.getNamesOf(m.usedFromMoved), targetFileName)
+		adder.WriteFixes(tracker)
+	}
+}
+
+// deleteImportBinding deletes an import binding, or its whole import statement if isUnused holds for every other
+// binding of the statement.
+func (m *statementMover) deleteImportBinding(tracker *change.Tracker, file *ast.SourceFile, binding *ast.Node, isUnused func(other *ast.Node) bool) {
+	statement := getImportStatementOfBinding(binding)
+	if core.Every(getImportBindings(statement), func(other *ast.Node) bool { return other == binding || isUnused(other) }) {
+		tracker.Delete(file, statement)
+		return
+	}
+	tracker.Delete(file, binding)
+}
+
+func getImportBindings(statement *ast.Node) []*ast.Node {
+	if ast.IsImportEqualsDeclaration(statement) {
+		return []*ast.Node{statement}
+	}
+	clause := statement.AsImportDeclaration().ImportClause
+	if clause == nil {
+		return nil
+	}
+	var bindings []*ast.Node
+	if clause.Name() != nil {
+		bindings = append(bindings, clause)
+	}
+	if namedBindings := clause.AsImportClause().NamedBindings; namedBindings != nil {
+		if ast.IsNamespaceImport(namedBindings) {
+			bindings = append(bindings, namedBindings)
+		} else {
+			bindings = append(bindings, namedBindings.Elements()...)
+		}
+	}
+	return bindings
+}
+
+// needsExport reports whether a moved statement must be exported from the target so that the old file can
+// import it.
+func (m *statementMover) needsExport(statement *ast.Node) bool {
+	if ast.HasSyntacticModifier(statement, ast.ModifierFlagsExport) {
+		return false
+	}
+	return slices.ContainsFunc(m.usedFromMoved, func(declaration *ast.Node) bool {
+		return getTopLevelDeclarationStatement(declaration) == statement
+	})
+}
+
+func addExportModifier(factory *ast.NodeFactory, statement *ast.Node) *ast.Node {
+	modifiers := []*ast.Node{factory.NewModifier(ast.KindExportKeyword)}
+	if statement.Modifiers() != nil {
+		modifiers = append(modifiers, statement.Modifiers().Nodes...)
+	}
+	return ast.ReplaceModifiers(factory, statement, factory.NewModifierList(modifiers))
+}
+
+func (m *statementMover) getNamesOf(declarations []*ast.Node) []string {
+	var names []string
+	for _, declaration := range declarations {
+		names = core.AppendIfUnique(names, declaration.Name().Text())
+	}
+	slices.Sort(names)
+	return names
+}
+
+// addNamedImports adds named imports of declarations from the file toFileName to the import adder of a file.
+func (m *statementMover) addNamedImports(adder autoimport.ImportAdder, importingFile *ast.SourceFile, importingFileName string, names []string, toFileName string) {
+	moduleSpecifier := m.getModuleSpecifier(importingFile, importingFileName, toFileName)
+	for _, name := range names {
+		adder.AddImportFix(&autoimport.Fix{
+			AutoImportFix: &lsproto.AutoImportFix{
+				Kind:            lsproto.AutoImportFixKindAddNew,
+				Name:            name,
+				ImportKind:      lsproto.ImportKindNamed,
+				AddAsTypeOnly:   lsproto.AddAsTypeOnlyAllowed,
+				ModuleSpecifier: moduleSpecifier,
+			},
+		})
+	}
+}
+
+// getModuleSpecifier gets the specifier with which a file imports toFileName. The importing file may not exist yet,
+// in which case importingFile is the file it is created from.
+func (m *statementMover) getModuleSpecifier(importingFile *ast.SourceFile, importingFileName string, toFileName string) string {
+	return modulespecifiers.UpdateModuleSpecifier(
+		m.program.Options(),
+		m.program,
+		importingFile,
+		importingFileName,
+		"", /*oldImportSpecifier*/
+		toFileName,
+		m.ls.UserPreferences().ModuleSpecifierPreferences(),
+		modulespecifiers.ModuleSpecifierOptions{},
+	)
+}
+
+func (m *statementMover) newModuleSpecifier(tracker *change.Tracker, importingFile *ast.SourceFile, importingFileName string, toFileName string) *ast.Node {
+	return m.newStringLiteral(tracker, m.getModuleSpecifier(importingFile, importingFileName, toFileName))
+}
+
+func (m *statementMover) newStringLiteral(tracker *change.Tracker, text string) *ast.Node {
+	quotePreference := lsutil.GetQuotePreference(m.oldFile, m.ls.UserPreferences())
+	return tracker.NodeFactory.NewStringLiteral(text, core.IfElse(quotePreference == lsutil.QuotePreferenceSingle, ast.TokenFlagsSingleQuote, ast.TokenFlagsNone))
+}
+
+// getImportForTarget creates the import of a binding used by the moved statements, as it is written in the target.
+// It returns nil if the target is the imported module, or if the target already has the same import.
+func (m *statementMover) getImportForTarget(tracker *change.Tracker, targetFile *ast.SourceFile, targetFileName string, binding *ast.Node) *ast.Node {
+	statement := getImportStatementOfBinding(binding)
+	var moduleSpecifier *ast.Node
+	if ast.IsImportEqualsDeclaration(statement) {
+		moduleSpecifier = statement.AsImportEqualsDeclaration().ModuleReference.Expression()
+	} else {
+		moduleSpecifier = statement.AsImportDeclaration().ModuleSpecifier
+	}
+
+	specifier := moduleSpecifier.Text()
+	if resolved := m.program.GetResolvedModuleFromModuleSpecifier(m.oldFile, moduleSpecifier); resolved != nil && resolved.ResolvedFileName != "" {
+		if tspath.ComparePaths(resolved.ResolvedFileName, targetFileName, tspath.ComparePathsOptions{UseCaseSensitiveFileNames: m.ls.UseCaseSensitiveFileNames()}) == 0 {
+			return nil
+		}
+		if tspath.IsExternalModuleNameRelative(specifier) {
+			importingFile := core.OrElse(targetFile, m.oldFile)
+			specifier = m.getModuleSpecifier(importingFile, targetFileName, resolved.ResolvedFileName)
+		}
+	}
+	if targetFile != nil && m.targetHasImport(targetFile, binding, specifier) {
+		return nil
+	}
+
+	factory := tracker.NodeFactory
+	newSpecifier := m.newStringLiteral(tracker, specifier)
+	if ast.IsImportEqualsDeclaration(statement) {
+		return factory.NewImportEqualsDeclaration(nil /*modifiers*/, statement.AsImportEqualsDeclaration().IsTypeOnly, factory.NewIdentifier(statement.Name().Text()), factory.NewExternalModuleReference(newSpecifier))
+	}
+	clause := statement.AsImportDeclaration().ImportClause.AsImportClause()
+	var name, namedBindings *ast.Node
+	switch binding.Kind {
+	case ast.KindImportClause:
+		name = factory.NewIdentifier(binding.Name().Text())
+	case ast.KindNamespaceImport:
+		namedBindings = factory.NewNamespaceImport(factory.NewIdentifier(binding.Name().Text()))
+	case ast.KindImportSpecifier:
+		namedBindings = factory.NewNamedImports(factory.NewNodeList([]*ast.Node{factory.DeepCloneNode(binding)}))
+	}
+	return factory.NewImportDeclaration(
+		nil, /*modifiers*/
+		factory.NewImportClause(clause.PhaseModifier, name, namedBindings),
+		newSpecifier,
+		factory.DeepCloneNode(statement.AsImportDeclaration().Attributes),
+	)
+}
+
+// targetHasImport reports whether the target file already imports the same name as binding from the module.
+func (m *statementMover) targetHasImport(targetFile *ast.SourceFile, binding *ast.Node, moduleSpecifier string) bool {
+	for _, statement := range targetFile.Statements.Nodes {
+		if !ast.IsImportDeclaration(statement) && !ast.IsImportEqualsDeclaration(statement) {
+			continue
+		}
+		for _, other := range getImportBindings(statement) {
+			if other.Kind == binding.Kind && other.Name().Text() == binding.Name().Text() && ast.GetExternalModuleName(statement).Text() == moduleSpecifier {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// updateImportsInOtherFiles points the imports and re-exports of moved declarations in other files at the target.
+func (m *statementMover) updateImportsInOtherFiles(tracker *change.Tracker, targetFile *ast.SourceFile, targetFileName string) {
+	// Specifiers of moved declarations, grouped by the import or export declaration containing them.
+	specifiersByDeclaration := make(map[*ast.Node][]*ast.Node)
+	var declarations []*ast.Node
+	sourceFiles := m.program.GetSourceFiles()
+	for _, statement := range m.toMove {
+		if !ast.HasSyntacticModifier(statement, ast.ModifierFlagsExport) {
+			continue
+		}
+		for _, name := range getDeclaredNames(statement) {
+			for _, entry := range m.ls.GetReferencedSymbolsForNode(m.ctx, name.Pos(), name, sourceFiles) {
+				for _, reference := range entry.References() {
+					if !reference.IsNodeEntry() {
+						continue
+					}
+					specifier := reference.Node().Parent
+					if !ast.IsImportSpecifier(specifier) && !ast.IsExportSpecifier(specifier) {
+						continue
+					}
+					declaration := ast.FindAncestor(specifier, func(n *ast.Node) bool { return ast.IsImportDeclaration(n) || ast.IsExportDeclaration(n) })
+					if declaration == nil || ast.GetSourceFileOfNode(declaration) == m.oldFile || !m.importsOldFile(declaration) {
+						continue
+					}
+					if _, ok := specifiersByDeclaration[declaration]; !ok {
+						declarations = append(declarations, declaration)
+					}
+					specifiersByDeclaration[declaration] = core.AppendIfUnique(specifiersByDeclaration[declaration], specifier)
+				}
+			}
+		}
+	}
+	// !!! namespace imports and `export *` of the old file
+
+	for _, declaration := range declarations {
+		file := ast.GetSourceFileOfNode(declaration)
+		specifiers := specifiersByDeclaration[declaration]
+		moduleSpecifier := ast.GetExternalModuleName(declaration)
+		if file == targetFile {
+			// The target declares the moved symbols itself now.
+			for _, specifier := range specifiers {
+				if ast.IsImportSpecifier(specifier) {
+					m.deleteImportBinding(tracker, file, specifier, func(other *ast.Node) bool { return slices.Contains(specifiers, other) })
+				}
+			}
+			continue
+		}
+		newSpecifier := m.getModuleSpecifier(file, file.FileName(), targetFileName)
+		allMoved := core.Every(getNamedSpecifiers(declaration), func(specifier *ast.Node) bool { return slices.Contains(specifiers, specifier) })
+		if allMoved && (ast.IsExportDeclaration(declaration) || declaration.AsImportDeclaration().ImportClause.Name() == nil) {
+			tracker.ReplaceNode(file, moduleSpecifier, m.newStringLiteralLike(tracker, moduleSpecifier, newSpecifier), nil)
+			continue
+		}
+		for _, specifier := range specifiers {
+			tracker.Delete(file, specifier)
+		}
+		tracker.InsertNodeAfter(file, declaration, m.updateDeclarationWithSpecifiers(tracker, declaration, specifiers, newSpecifier))
+	}
+}
+
+func (m *statementMover) importsOldFile(declaration *ast.Node) bool {
+	moduleSpecifier := ast.GetExternalModuleName(declaration)
+	if moduleSpecifier == nil || !ast.IsStringLiteralLike(moduleSpecifier) {
+		return false
+	}
+	resolved := m.program.GetResolvedModuleFromModuleSpecifier(ast.GetSourceFileOfNode(declaration), moduleSpecifier)
+	return resolved != nil && resolved.ResolvedFileName == m.oldFile.FileName()
+}
+
+func getNamedSpecifiers(declaration *ast.Node) []*ast.Node {
+	if ast.IsExportDeclaration(declaration) {
+		if exportClause := declaration.AsExportDeclaration().ExportClause; exportClause != nil && ast.IsNamedExports(exportClause) {
+			return exportClause.Elements()
+		}
+		return nil
+	}
+	if clause := declaration.AsImportDeclaration().ImportClause; clause != nil {
+		if namedBindings := clause.AsImportClause().NamedBindings; namedBindings != nil && ast.IsNamedImports(namedBindings) {
+			return namedBindings.Elements()
+		}
+	}
+	return nil
+}
+
+// newStringLiteralLike creates a string literal with the given text, quoted like the literal it replaces.
+func (m *statementMover) newStringLiteralLike(tracker *change.Tracker, literal *ast.Node, text string) *ast.Node {
+	return tracker.NodeFactory.NewStringLiteral(text, literal.AsStringLiteral().TokenFlags&ast.TokenFlagsSingleQuote)
+}
+
+// updateDeclarationWithSpecifiers creates a copy of an import or export declaration that has only the given
+// specifiers and refers to a different module.
+func (m *statementMover) updateDeclarationWithSpecifiers(tracker *change.Tracker, declaration *ast.Node, specifiers []*ast.Node, moduleSpecifier string) *ast.Node {
+	factory := tracker.NodeFactory
+	elements := factory.NewNodeList(core.Map(specifiers, factory.DeepCloneNode))
+	newSpecifier := m.newStringLiteralLike(tracker, ast.GetExternalModuleName(declaration), moduleSpecifier)
+	if ast.IsExportDeclaration(declaration) {
+		exportDeclaration := declaration.AsExportDeclaration()
+		return factory.NewExportDeclaration(nil /*modifiers*/, exportDeclaration.IsTypeOnly, factory.NewNamedExports(elements), newSpecifier, factory.DeepCloneNode(exportDeclaration.Attributes))
+	}
+	importDeclaration := declaration.AsImportDeclaration()
+	return factory.NewImportDeclaration(
+		nil, /*modifiers*/
+		factory.NewImportClause(importDeclaration.ImportClause.AsImportClause().PhaseModifier, nil /*name*/, factory.NewNamedImports(elements)),
+		newSpecifier,
+		factory.DeepCloneNode(importDeclaration.Attributes),
+	)
+}
+
+// createWorkspaceEdit creates a workspace edit from edits grouped by file name. Files in newFiles are created
+// before their content is inserted.
+func createWorkspaceEdit(changes map[string][]*lsproto.TextEdit, newFiles []string) *lsproto.WorkspaceEdit {
+	if len(newFiles) == 0 {
+		lspChanges := make(map[lsproto.DocumentUri][]*lsproto.TextEdit, len(changes))
+		for fileName, edits := range changes {
+			lspChanges[lsconv.FileNameToDocumentURI(fileName)] = edits
+		}
+		return &lsproto.WorkspaceEdit{Changes: &lspChanges}
+	}
+
+	var documentChanges []lsproto.TextDocumentEditOrCreateFileOrRenameFileOrDeleteFile
+	for _, fileName := range newFiles {
+		documentChanges = append(documentChanges, lsproto.TextDocumentEditOrCreateFileOrRenameFileOrDeleteFile{
+			CreateFile: &lsproto.CreateFile{Kind: lsproto.StringLiteralCreate{}, Uri: lsconv.FileNameToDocumentURI(fileName)},
+		})
+	}
+	fileNames := slices.Sorted(maps.Keys(changes))
+	for _, fileName := range fileNames {
+		edits := make([]lsproto.TextEditOrAnnotatedTextEditOrSnippetTextEdit, 0, len(changes[fileName]))
+		for _, edit := range changes[fileName] {
+			edits = append(edits, lsproto.TextEditOrAnnotatedTextEditOrSnippetTextEdit{TextEdit: edit})
+		}
+		documentChanges = append(documentChanges, lsproto.TextDocumentEditOrCreateFileOrRenameFileOrDeleteFile{
+			TextDocumentEdit: &lsproto.TextDocumentEdit{
+				TextDocument: lsproto.OptionalVersionedTextDocumentIdentifier{Uri: lsconv.FileNameToDocumentURI(fileName)},
+				Edits:        edits,
+			},
+		})
+	}
+	return &lsproto.WorkspaceEdit{DocumentChanges: &documentChanges}
+}
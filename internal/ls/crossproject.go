@@ -419,3 +419,21 @@ func combineIncomingCalls(results iter.Seq[lsproto.CallHierarchyIncomingCallsRes
 	}
 	return lsproto.CallHierarchyIncomingCallsResponse{CallHierarchyIncomingCalls: &combined}
 }
+
+func combineTypeHierarchyItems(results iter.Seq[lsproto.TypeHierarchyItemsOrNull]) lsproto.TypeHierarchyItemsOrNull {
+	var combined []*lsproto.TypeHierarchyItem
+	var seenItems collections.Set[lsproto.Location]
+	for resp := range results {
+		if resp.TypeHierarchyItems != nil {
+			for _, item := range *resp.TypeHierarchyItems {
+				if seenItems.AddIfAbsent(item.GetLocation()) {
+					combined = append(combined, item)
+				}
+			}
+		}
+	}
+	if len(combined) == 0 {
+		return lsproto.TypeHierarchyItemsOrNull{}
+	}
+	return lsproto.TypeHierarchyItemsOrNull{TypeHierarchyItems: &combined}
+}
@@ -0,0 +1,131 @@
+package ls
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/compiler"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/ls/lsconv"
+	"github.com/microsoft/typescript-go/internal/lsp/lsproto"
+	"github.com/microsoft/typescript-go/internal/tsoptions"
+	"github.com/microsoft/typescript-go/internal/tspath"
+)
+
+type documentLink struct {
+	textRange core.TextRange
+	target    string
+}
+
+func (l *LanguageService) ProvideDocumentLinks(ctx context.Context, documentURI lsproto.DocumentUri) (lsproto.DocumentLinkResponse, error) {
+	program, file := l.getProgramAndFile(documentURI)
+	var links []documentLink
+	if isTsConfigFile(program, file) {
+		links = getTsConfigDocumentLinks(program, file)
+	} else {
+		links = getSourceFileDocumentLinks(program, file)
+	}
+	slices.SortStableFunc(links, func(a, b documentLink) int {
+		return a.textRange.Pos() - b.textRange.Pos()
+	})
+
+	result := make([]*lsproto.DocumentLink, 0, len(links))
+	for _, link := range links {
+		lspRange, fidelity := l.converters.ToLSPRange(file, link.textRange)
+		if !fidelity.IsExact() {
+			continue
+		}
+		result = append(result, &lsproto.DocumentLink{
+			Range:  lspRange,
+			Target: new(lsproto.URI(lsconv.FileNameToDocumentURI(link.target))),
+		})
+	}
+	return lsproto.DocumentLinksOrNull{DocumentLinks: &result}, nil
+}
+
+// getSourceFileDocumentLinks returns links for the module specifiers and triple-slash
+// references of a source file that the program was able to resolve.
+func getSourceFileDocumentLinks(program *compiler.Program, file *ast.SourceFile) []documentLink {
+	var links []documentLink
+	for _, ref := range file.ReferencedFiles {
+		if target := program.GetSourceFileFromReference(file, ref); target != nil {
+			links = append(links, documentLink{textRange: ref.TextRange, target: target.FileName()})
+		}
+	}
+	for _, ref := range file.TypeReferenceDirectives {
+		if resolved := program.GetResolvedTypeReferenceDirectiveFromTypeReferenceDirective(ref, file); resolved != nil && resolved.IsResolved() {
+			links = append(links, documentLink{textRange: ref.TextRange, target: resolved.ResolvedFileName})
+		}
+	}
+	for _, moduleSpecifier := range file.Imports() {
+		if !ast.IsStringLiteralLike(moduleSpecifier) || ast.NodeIsSynthesized(moduleSpecifier) {
+			continue
+		}
+		if resolved := program.GetResolvedModuleFromModuleSpecifier(file, moduleSpecifier); resolved != nil && resolved.IsResolved() {
+			links = append(links, documentLink{textRange: createStringTextRange(file, moduleSpecifier), target: resolved.ResolvedFileName})
+		}
+	}
+	return links
+}
+
+// isTsConfigFile reports whether a JSON file should be treated as a tsconfig.json: either it is
+// the config file of the program, or its name follows the tsconfig/jsconfig naming convention.
+func isTsConfigFile(program *compiler.Program, file *ast.SourceFile) bool {
+	if file.ScriptKind != core.ScriptKindJSON {
+		return false
+	}
+	if configFile := program.CommandLine().ConfigFile; configFile != nil && configFile.SourceFile.Path() == file.Path() {
+		return true
+	}
+	baseName := strings.ToLower(tspath.GetBaseFileName(file.FileName()))
+	return strings.HasPrefix(baseName, "tsconfig") || strings.HasPrefix(baseName, "jsconfig")
+}
+
+// getTsConfigDocumentLinks returns links for the "extends" and "references" paths of a tsconfig.json.
+func getTsConfigDocumentLinks(program *compiler.Program, file *ast.SourceFile) []documentLink {
+	configFile := tsoptions.NewTsconfigSourceFileFromFilePath(file.FileName(), file.Path(), file.Text()).SourceFile
+	configDir := tspath.GetDirectoryPath(configFile.FileName())
+	var links []documentLink
+	addLink := func(element *ast.Node, resolve func(value string) string) {
+		if !ast.IsStringLiteral(element) || element.Text() == "" {
+			return
+		}
+		if target := resolve(element.Text()); target != "" && program.Host().FS().FileExists(target) {
+			links = append(links, documentLink{textRange: createStringTextRange(configFile, element), target: target})
+		}
+	}
+
+	tsoptions.ForEachTsConfigPropArray(configFile, "extends", func(property *ast.PropertyAssignment) *struct{} {
+		elements := []*ast.Node{property.Initializer}
+		if ast.IsArrayLiteralExpression(property.Initializer) {
+			elements = property.Initializer.Elements()
+		}
+		for _, element := range elements {
+			addLink(element, func(value string) string {
+				return tsoptions.ResolveExtendedConfigPath(value, program.Host(), configFile.FileName())
+			})
+		}
+		return nil
+	})
+
+	tsoptions.ForEachTsConfigPropArray(configFile, "references", func(property *ast.PropertyAssignment) *struct{} {
+		if !ast.IsArrayLiteralExpression(property.Initializer) {
+			return nil
+		}
+		for _, element := range property.Initializer.Elements() {
+			if !ast.IsObjectLiteralExpression(element) {
+				continue
+			}
+			tsoptions.ForEachPropertyAssignment(element.AsObjectLiteralExpression(), "path", func(pathProperty *ast.PropertyAssignment) *struct{} {
+				addLink(pathProperty.Initializer, func(value string) string {
+					return core.ResolveConfigFileNameOfProjectReference(tspath.GetNormalizedAbsolutePath(value, configDir))
+				})
+				return nil
+			})
+		}
+		return nil
+	})
+	return links
+}
@@ -0,0 +1,58 @@
+package ls
+
+import (
+	"context"
+
+	"github.com/microsoft/typescript-go/internal/checker"
+	"github.com/microsoft/typescript-go/internal/locale"
+	"github.com/microsoft/typescript-go/internal/lsp/lsproto"
+)
+
+// ProvideRelationErrorExplanation explains the relation error reported at the given range, such as an
+// assignability error, as a tree of the comparisons that failed. If code is nil, the first relation error at
+// the range is explained.
+func (l *LanguageService) ProvideRelationErrorExplanation(
+	ctx context.Context,
+	documentURI lsproto.DocumentUri,
+	rng lsproto.Range,
+	code *int32,
+) (lsproto.CustomExplainRelationErrorResponse, error) {
+	program, file := l.getProgramAndFile(documentURI)
+	// The checkers of the program do not record relation errors, so the file is checked again by a
+	// checker that does, once there is a diagnostic to explain.
+	var c *checker.Checker
+	for _, diagnostic := range program.GetSemanticDiagnostics(ctx, file) {
+		if diagnostic.File() != file || code != nil && diagnostic.Code() != *code {
+			continue
+		}
+		if diagnosticRange, _ := l.converters.ToLSPRange(file, diagnostic.Loc()); diagnosticRange != rng {
+			continue
+		}
+		if c == nil {
+			c = checker.NewRelationErrorChecker(program)
+		}
+		if explanation := c.ExplainRelationError(ctx, diagnostic); explanation != nil {
+			return lsproto.RelationExplanationOrNull{RelationExplanation: toLSPRelationExplanation(explanation, locale.FromContext(ctx))}, nil
+		}
+	}
+	return lsproto.RelationExplanationOrNull{}, nil
+}
+
+func toLSPRelationExplanation(explanation *checker.RelationExplanation, locale locale.Locale) *lsproto.RelationExplanation {
+	messages := make([]string, 0, len(explanation.Messages))
+	for _, message := range explanation.Messages {
+		messages = append(messages, message.Localize(locale))
+	}
+	children := make([]*lsproto.RelationExplanation, 0, len(explanation.Children))
+	for _, child := range explanation.Children {
+		children = append(children, toLSPRelationExplanation(child, locale))
+	}
+	return &lsproto.RelationExplanation{
+		Path:     explanation.Path,
+		Member:   explanation.Member,
+		Source:   explanation.Source,
+		Target:   explanation.Target,
+		Messages: messages,
+		Children: children,
+	}
+}
@@ -188,6 +188,10 @@ type UserPreferences struct {
 	// AutomaticTypeAcquisitionEnabled is the unified setting from tsserver.automaticTypeAcquisition.enabled under the js/ts section.
 	// When set, it takes precedence over DisableAutomaticTypeAcquisition.
 	AutomaticTypeAcquisitionEnabled core.Tristate `raw:"automaticTypeAcquisitionEnabled" config:"tsserver.automaticTypeAcquisition.enabled"`
+	// TypingsPackageManager selects the package manager that installs typings: "npm" (the default), "pnpm", "yarn", "bun",
+	// or "local" to install from the directory of tarballs given by TypingsLocalRegistryPath.
+	TypingsPackageManager    string `raw:"typingsPackageManager" config:"tsserver.automaticTypeAcquisition.packageManager"`
+	TypingsLocalRegistryPath string `raw:"typingsLocalRegistryPath" config:"tsserver.automaticTypeAcquisition.localRegistryPath"`
 	// TODO: add tsserver.web.typeAcquisition.enabled under the js/ts section for the web variant when web support is implemented.
 
 	// ------- Project Configuration -------
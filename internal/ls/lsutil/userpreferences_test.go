@@ -673,4 +673,20 @@ func TestUserPreferencesParseATA(t *testing.T) {
 		prefs := NewDefaultUserPreferences()
 		assert.Assert(t, !prefs.IsATADisabled())
 	})
+
+	t.Run("ParseUserPreferences with typings package manager", func(t *testing.T) {
+		t.Parallel()
+		prefs := ParseUserPreferences(map[string]any{
+			"js/ts": map[string]any{
+				"tsserver": map[string]any{
+					"automaticTypeAcquisition": map[string]any{
+						"packageManager":    "local",
+						"localRegistryPath": "/mirror/npm",
+					},
+				},
+			},
+		})
+		assert.Equal(t, prefs.TypingsPackageManager, "local")
+		assert.Equal(t, prefs.TypingsLocalRegistryPath, "/mirror/npm")
+	})
 }
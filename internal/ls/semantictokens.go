@@ -190,6 +190,31 @@ func (l *LanguageService) ProvideSemanticTokensRange(ctx context.Context, docume
 	}, nil
 }
 
+// SemanticTokensEdits returns the edits that turn previously encoded semantic tokens into the current ones.
+// Edits made while typing are usually local, so a single edit replacing everything between the common
+// prefix and suffix of the two arrays is close to minimal while avoiding a full diff of large files.
+func SemanticTokensEdits(previous []uint32, current []uint32) []*lsproto.SemanticTokensEdit {
+	prefix := 0
+	for prefix < len(previous) && prefix < len(current) && previous[prefix] == current[prefix] {
+		prefix++
+	}
+	if prefix == len(previous) && prefix == len(current) {
+		return []*lsproto.SemanticTokensEdit{}
+	}
+	suffix := 0
+	for suffix < len(previous)-prefix && suffix < len(current)-prefix && previous[len(previous)-1-suffix] == current[len(current)-1-suffix] {
+		suffix++
+	}
+	edit := &lsproto.SemanticTokensEdit{
+		Start:       uint32(prefix),
+		DeleteCount: uint32(len(previous) - prefix - suffix),
+	}
+	if inserted := current[prefix : len(current)-suffix]; len(inserted) > 0 {
+		edit.Data = new(slices.Clone(inserted))
+	}
+	return []*lsproto.SemanticTokensEdit{edit}
+}
+
 func sortSemanticTokens(tokens []semanticToken, converters *lsconv.Converters) {
 	slices.SortFunc(tokens, func(a, b semanticToken) int {
 		aRange, _ := semanticTokenLSPRange(a, converters)
@@ -0,0 +1,49 @@
+package ls
+
+import (
+	"context"
+
+	"github.com/microsoft/typescript-go/internal/astnav"
+	"github.com/microsoft/typescript-go/internal/checker"
+	"github.com/microsoft/typescript-go/internal/diagnosticwriter"
+	"github.com/microsoft/typescript-go/internal/locale"
+	"github.com/microsoft/typescript-go/internal/ls/lsconv"
+	"github.com/microsoft/typescript-go/internal/lsp/lsproto"
+	"github.com/microsoft/typescript-go/internal/spanmap"
+)
+
+// ProvideEvaluatedType evaluates a type expression as if it were written at the given position, and returns the
+// resulting type fully expanded. Named types nested in the result are expanded up to the given verbosity level,
+// as in hover.
+func (l *LanguageService) ProvideEvaluatedType(
+	ctx context.Context,
+	documentURI lsproto.DocumentUri,
+	position lsproto.Position,
+	expression string,
+	verbosityLevel int,
+) (lsproto.CustomEvaluateTypeResponse, error) {
+	program, file := l.getProgramAndFile(documentURI)
+	positions := lsconv.FromLSPPositionForSourceFile(l.converters, file, position, spanmap.FeatureHover)
+	if len(positions) == 0 || !positions[0].Fidelity.IsSingleSegment() {
+		return lsproto.EvaluatedTypeOrNull{}, nil
+	}
+	file = positions[0].Script
+	location := astnav.GetTokenAtPosition(file, int(positions[0].Position))
+	c, done := program.GetTypeCheckerForFile(ctx, file)
+	defer done()
+
+	expr := c.GetTypeOfTypeExpression(expression, location)
+	locale := locale.FromContext(ctx)
+	result := &lsproto.EvaluatedType{
+		Diagnostics: make([]string, 0, len(expr.Diagnostics)),
+	}
+	for _, diagnostic := range expr.Diagnostics {
+		result.Diagnostics = append(result.Diagnostics, diagnosticwriter.FlattenDiagnosticMessage(diagnosticwriter.WrapASTDiagnostic(diagnostic), "\n", locale))
+	}
+	if expr.Type != nil {
+		vc := &checker.VerbosityContext{Level: verbosityLevel}
+		result.Type = c.TypeToStringEx(expr.Type, location, typeFormatFlags|checker.TypeFormatFlagsInTypeAlias|checker.TypeFormatFlagsMultilineObjectLiterals|checker.TypeFormatFlagsNoTruncation, vc)
+		result.CanIncreaseVerbosity = vc.CanIncreaseVerbosity
+	}
+	return lsproto.EvaluatedTypeOrNull{EvaluatedType: result}, nil
+}
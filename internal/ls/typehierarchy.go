@@ -0,0 +1,202 @@
+package ls
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/astnav"
+	"github.com/microsoft/typescript-go/internal/checker"
+	"github.com/microsoft/typescript-go/internal/collections"
+	"github.com/microsoft/typescript-go/internal/compiler"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/ls/lsconv"
+	"github.com/microsoft/typescript-go/internal/lsp/lsproto"
+	"github.com/microsoft/typescript-go/internal/scanner"
+	"github.com/microsoft/typescript-go/internal/spanmap"
+)
+
+// Indicates whether a node is a type hierarchy declaration: a class or an interface.
+func isTypeHierarchyDeclaration(node *ast.Node) bool {
+	return node != nil && (ast.IsClassLike(node) || ast.IsInterfaceDeclaration(node))
+}
+
+// Resolves the type hierarchy declarations for a node: the class or interface it declares, or all
+// class and interface declarations of the symbol it refers to.
+func resolveTypeHierarchyDeclarations(c *checker.Checker, location *ast.Node) []*ast.Node {
+	if (location.Kind == ast.KindClassKeyword || location.Kind == ast.KindInterfaceKeyword) && isTypeHierarchyDeclaration(location.Parent) {
+		location = location.Parent
+	}
+	if isTypeHierarchyDeclaration(location) {
+		name := location.Name()
+		if name == nil {
+			return []*ast.Node{location}
+		}
+		location = name
+	}
+
+	symbol := c.GetSymbolAtLocation(location)
+	if symbol == nil {
+		return nil
+	}
+	if symbol.Flags&ast.SymbolFlagsAlias != 0 {
+		symbol = c.GetAliasedSymbol(symbol)
+	}
+	return core.Filter(symbol.Declarations, isTypeHierarchyDeclaration)
+}
+
+// Creates a `TypeHierarchyItem` for a class or interface declaration.
+func (l *LanguageService) createTypeHierarchyItem(program *compiler.Program, node *ast.Node) *lsproto.TypeHierarchyItem {
+	sourceFile := ast.GetSourceFileOfNode(node)
+	nameText, namePos, nameEnd := getCallHierarchyItemName(program, node)
+	containerName := getCallHierarchyItemContainerName(program, node)
+
+	// Type hierarchy navigates the same relationships as go-to-implementation.
+	fullStart := scanner.SkipTriviaEx(sourceFile.Text(), node.Pos(), &scanner.SkipTriviaOptions{StopAtComments: true})
+	span, spanFidelity := l.converters.ToLSPRangeForFeature(sourceFile, core.NewTextRange(fullStart, node.End()), spanmap.FeatureImplementation)
+	selectionSpan, selectionFidelity := l.converters.ToLSPRangeForFeature(sourceFile, core.NewTextRange(namePos, nameEnd), spanmap.FeatureImplementation)
+	if !selectionFidelity.IsSingleSegment() {
+		return nil
+	}
+	if spanFidelity.IsNone() || sourceFile.ContentMapper() != "" && !lspRangeContains(span, selectionSpan) {
+		span = selectionSpan
+	}
+
+	item := &lsproto.TypeHierarchyItem{
+		Name:           nameText,
+		Kind:           getSymbolKindFromNode(node),
+		Uri:            lsconv.FileNameToDocumentURI(sourceFile.OriginalFileName()),
+		Range:          span,
+		SelectionRange: selectionSpan,
+	}
+	if containerName != "" {
+		item.Detail = &containerName
+	}
+	return item
+}
+
+// Gets the type hierarchy declarations at a position in a file.
+func (l *LanguageService) typeHierarchyDeclarations(ctx context.Context, program *compiler.Program, file *ast.SourceFile, position lsproto.Position) []*ast.Node {
+	positions := lsconv.FromLSPPositionForSourceFile(l.converters, file, position, spanmap.FeatureImplementation)
+	var declarations []*ast.Node
+	var seen collections.Set[*ast.Node]
+	for _, mapped := range positions {
+		if !mapped.Fidelity.IsSingleSegment() {
+			continue
+		}
+		node := astnav.GetTouchingPropertyName(mapped.Script, int(mapped.Position))
+		if node == nil || ast.IsSourceFile(node) {
+			continue
+		}
+		c, done := program.GetTypeCheckerForFile(ctx, mapped.Script)
+		for _, declaration := range resolveTypeHierarchyDeclarations(c, node) {
+			if seen.AddIfAbsent(declaration) {
+				declarations = append(declarations, declaration)
+			}
+		}
+		done()
+	}
+	return declarations
+}
+
+func (l *LanguageService) createTypeHierarchyItems(program *compiler.Program, declarations []*ast.Node) lsproto.TypeHierarchyItemsOrNull {
+	var items []*lsproto.TypeHierarchyItem
+	var seen collections.Set[lsproto.Location]
+	for _, declaration := range declarations {
+		if item := l.createTypeHierarchyItem(program, declaration); item != nil {
+			if seen.AddIfAbsent(lsproto.Location{Uri: item.Uri, Range: item.SelectionRange}) {
+				items = append(items, item)
+			}
+		}
+	}
+	if items == nil {
+		return lsproto.TypeHierarchyItemsOrNull{}
+	}
+	return lsproto.TypeHierarchyItemsOrNull{TypeHierarchyItems: &items}
+}
+
+func (l *LanguageService) ProvidePrepareTypeHierarchy(
+	ctx context.Context,
+	documentURI lsproto.DocumentUri,
+	position lsproto.Position,
+) (lsproto.TypeHierarchyPrepareResponse, error) {
+	program, file := l.getProgramAndFile(documentURI)
+	return l.createTypeHierarchyItems(program, l.typeHierarchyDeclarations(ctx, program, file, position)), nil
+}
+
+// Gets the classes and interfaces named in the `extends` and `implements` clauses of the item.
+func (l *LanguageService) ProvideTypeHierarchySupertypes(
+	ctx context.Context,
+	item *lsproto.TypeHierarchyItem,
+) (lsproto.TypeHierarchySupertypesResponse, error) {
+	program := l.GetProgram()
+	file := program.GetSourceFile(item.Uri.FileName())
+	if file == nil {
+		return lsproto.TypeHierarchyItemsOrNull{}, nil
+	}
+
+	var supertypes []*ast.Node
+	var seen collections.Set[*ast.Node]
+	for _, declaration := range l.typeHierarchyDeclarations(ctx, program, file, item.SelectionRange.Start) {
+		c, done := program.GetTypeCheckerForFile(ctx, ast.GetSourceFileOfNode(declaration))
+		for _, element := range slices.Concat(ast.GetExtendsHeritageClauseElements(declaration), ast.GetImplementsHeritageClauseElements(declaration)) {
+			name := ast.GetHeritageClauseElementName(element)
+			if ast.IsPropertyAccessExpression(name) {
+				name = name.Name()
+			}
+			for _, supertype := range resolveTypeHierarchyDeclarations(c, name) {
+				if seen.AddIfAbsent(supertype) {
+					supertypes = append(supertypes, supertype)
+				}
+			}
+		}
+		done()
+	}
+	return l.createTypeHierarchyItems(program, supertypes), nil
+}
+
+// Gets the classes and interfaces that name the item in their `extends` or `implements` clauses, across
+// all projects that reference the item's declaration.
+func (l *LanguageService) ProvideTypeHierarchySubtypes(
+	ctx context.Context,
+	params *lsproto.TypeHierarchySubtypesParams,
+	orchestrator CrossProjectOrchestrator,
+) (lsproto.TypeHierarchySubtypesResponse, error) {
+	result, err := handleCrossProject(
+		l,
+		ctx,
+		params,
+		orchestrator,
+		(*LanguageService).symbolAndEntriesToTypeHierarchySubtypes,
+		combineTypeHierarchyItems,
+		false, /*isRename*/
+		false, /*implementations*/
+		symbolEntryTransformOptions{},
+	)
+	if result.TypeHierarchyItems != nil {
+		slices.SortFunc(*result.TypeHierarchyItems, func(a, b *lsproto.TypeHierarchyItem) int {
+			if uriComp := strings.Compare(string(a.Uri), string(b.Uri)); uriComp != 0 {
+				return uriComp
+			}
+			return lsproto.CompareRanges(a.SelectionRange, b.SelectionRange)
+		})
+	}
+	return result, err
+}
+
+func (l *LanguageService) symbolAndEntriesToTypeHierarchySubtypes(ctx context.Context, params *lsproto.TypeHierarchySubtypesParams, data SymbolAndEntriesData, options symbolEntryTransformOptions) (lsproto.TypeHierarchySubtypesResponse, error) {
+	var subtypes []*ast.Node
+	var seen collections.Set[*ast.Node]
+	for _, symbolAndEntries := range data.SymbolsAndEntries {
+		for _, entry := range symbolAndEntries.references {
+			if entry.kind != entryKindNode {
+				continue
+			}
+			if subtype := getContainingNodeIfInHeritageClause(entry.node); subtype != nil && seen.AddIfAbsent(subtype) {
+				subtypes = append(subtypes, subtype)
+			}
+		}
+	}
+	return l.createTypeHierarchyItems(l.GetProgram(), subtypes), nil
+}
@@ -195,6 +195,42 @@ const customStructures: Structure[] = [
             },
         ],
     },
+    {
+        name: "CodeActionData",
+        properties: [
+            {
+                name: "fixId",
+                type: { kind: "base", name: "string" },
+                documentation: "The ID of the code fix whose fixes in the whole file are combined by this code action.",
+                omitzeroValue: true,
+            },
+            {
+                name: "uri",
+                type: { kind: "base", name: "DocumentUri" },
+                documentation: "The document of the refactoring whose edit is computed when this code action is resolved.",
+                omitzeroValue: true,
+            },
+            {
+                name: "range",
+                type: { kind: "reference", name: "Range" },
+                optional: true,
+                documentation: "The range the refactoring was requested for.",
+            },
+            {
+                name: "plugin",
+                type: { kind: "base", name: "string" },
+                documentation: "The name of the language service plugin that contributed this code action.",
+                omitzeroValue: true,
+            },
+            {
+                name: "pluginData",
+                type: { kind: "reference", name: "any" },
+                optional: true,
+                documentation: "The data the plugin attached to this code action, passed through unchanged.",
+            },
+        ],
+        documentation: "CodeActionData is preserved on a CodeAction.",
+    },
     {
         name: "ExperimentalServerCapabilities",
         properties: [
@@ -210,6 +246,18 @@ const customStructures: Structure[] = [
                 optional: true,
                 documentation: "The server provides multi-document highlight support via custom/textDocument/multiDocumentHighlight.",
             },
+            {
+                name: "customExplainRelationErrorProvider",
+                type: { kind: "base", name: "boolean" },
+                optional: true,
+                documentation: "The server provides relation error explanations via custom/textDocument/explainRelationError.",
+            },
+            {
+                name: "customEvaluateTypeProvider",
+                type: { kind: "base", name: "boolean" },
+                optional: true,
+                documentation: "The server provides type expression evaluation via custom/textDocument/evaluateType.",
+            },
         ],
         documentation: "ExperimentalServerCapabilities contains experimental capabilities under development.",
     },
@@ -617,6 +665,116 @@ const customStructures: Structure[] = [
         ],
         documentation: "Parameters for the custom/textDocument/multiDocumentHighlight request.",
     },
+    {
+        name: "ExplainRelationErrorParams",
+        properties: [
+            {
+                name: "textDocument",
+                type: { kind: "reference", name: "TextDocumentIdentifier" },
+                documentation: "The text document.",
+            },
+            {
+                name: "range",
+                type: { kind: "reference", name: "Range" },
+                documentation: "The range of the diagnostic to explain.",
+            },
+            {
+                name: "code",
+                type: { kind: "base", name: "integer" },
+                optional: true,
+                documentation: "The code of the diagnostic to explain. If omitted, the first relation error at the range is explained.",
+            },
+        ],
+        documentation: "Parameters for the custom/textDocument/explainRelationError request.",
+    },
+    {
+        name: "RelationExplanation",
+        properties: [
+            {
+                name: "path",
+                type: { kind: "base", name: "string" },
+                omitzeroValue: true,
+                documentation: "The path of members from the root comparison to this one, such as `a.b[0]`.",
+            },
+            {
+                name: "member",
+                type: { kind: "base", name: "string" },
+                omitzeroValue: true,
+                documentation: "The member of the parent comparison's types compared here: a property name, a tuple element such as `[0]`, an index signature such as `[string]`, or a signature `()` or `new ()`.",
+            },
+            {
+                name: "source",
+                type: { kind: "base", name: "string" },
+                documentation: "The source type or signature.",
+            },
+            {
+                name: "target",
+                type: { kind: "base", name: "string" },
+                documentation: "The target type or signature.",
+            },
+            {
+                name: "messages",
+                type: { kind: "array", element: { kind: "base", name: "string" } },
+                documentation: "The elaborations reported for this comparison.",
+            },
+            {
+                name: "children",
+                type: { kind: "array", element: { kind: "reference", name: "RelationExplanation" } },
+                documentation: "The failed comparisons that caused this comparison to fail.",
+            },
+        ],
+        documentation: "A failed comparison of a source type with a target type in the explanation of a relation error.",
+    },
+    {
+        name: "EvaluateTypeParams",
+        properties: [
+            {
+                name: "textDocument",
+                type: { kind: "reference", name: "TextDocumentIdentifier" },
+                documentation: "The text document.",
+            },
+            {
+                name: "position",
+                type: { kind: "reference", name: "Position" },
+                documentation: "The position in whose scope the type expression is evaluated.",
+            },
+            {
+                name: "expression",
+                type: { kind: "base", name: "string" },
+                documentation: "The type expression to evaluate, such as `Pick<Options, \"a\" | \"b\">`.",
+            },
+            {
+                name: "verbosityLevel",
+                type: { kind: "base", name: "integer" },
+                optional: true,
+                documentation: "The level up to which named types in the evaluated type are expanded, as in hover. Defaults to 0.",
+            },
+        ],
+        documentation: "Parameters for the custom/textDocument/evaluateType request.",
+    },
+    {
+        name: "EvaluatedType",
+        properties: [
+            {
+                name: "type",
+                type: { kind: "base", name: "string" },
+                omitzeroValue: true,
+                documentation: "The evaluated type, printed structurally. Omitted if the expression could not be parsed.",
+            },
+            {
+                name: "diagnostics",
+                type: { kind: "array", element: { kind: "base", name: "string" } },
+                documentation: "The messages of the errors in the type expression.",
+            },
+            {
+                name: "canIncreaseVerbosity",
+                type: { kind: "base", name: "boolean" },
+                omitzeroValue: true,
+                documentation: "Whether evaluating with a higher verbosity level would expand more of the type.",
+            },
+        ],
+        documentation: "The result of the custom/textDocument/evaluateType request.",
+    },
     {
         name: "VSClassifiedTextRun",
         properties: [
@@ -953,6 +1111,34 @@ const customRequests: Request[] = [
         messageDirection: "clientToServer",
         documentation: "Request to get document highlights across multiple files.",
     },
+    {
+        method: "custom/textDocument/explainRelationError",
+        typeName: "CustomExplainRelationErrorRequest",
+        params: { kind: "reference", name: "ExplainRelationErrorParams" },
+        result: {
+            kind: "or",
+            items: [
+                { kind: "reference", name: "RelationExplanation" },
+                { kind: "base", name: "null" },
+            ],
+        },
+        messageDirection: "clientToServer",
+        documentation: "Request to explain a relation error, such as an assignability error, as a tree of failed comparisons.",
+    },
+    {
+        method: "custom/textDocument/evaluateType",
+        typeName: "CustomEvaluateTypeRequest",
+        params: { kind: "reference", name: "EvaluateTypeParams" },
+        result: {
+            kind: "or",
+            items: [
+                { kind: "reference", name: "EvaluatedType" },
+                { kind: "base", name: "null" },
+            ],
+        },
+        messageDirection: "clientToServer",
+        documentation: "Request to evaluate a type expression in the scope of a position and print the resulting type.",
+    },
     {
         method: "textDocument/_vs_onAutoInsert",
         typeName: "VSOnAutoInsertRequest",
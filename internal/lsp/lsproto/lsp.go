@@ -72,6 +72,15 @@ type HasTextDocumentPosition interface {
 	TextDocumentPosition() Position
 }
 
+// A subtypes request is a reference search anchored at the name of the item's declaration.
+func (s *TypeHierarchySubtypesParams) TextDocumentURI() DocumentUri {
+	return s.Item.Uri
+}
+
+func (s *TypeHierarchySubtypesParams) TextDocumentPosition() Position {
+	return s.Item.SelectionRange.Start
+}
+
 type HasLocations interface {
 	GetLocations() *[]Location
 }
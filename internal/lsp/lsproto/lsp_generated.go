@@ -8879,6 +8879,30 @@ func (s *CodeLensData) UnmarshalJSONFrom(dec *json.Decoder) error {
 	return unmarshalStruct(s, dec)
 }
 
+// CodeActionData is preserved on a CodeAction.
+type CodeActionData struct {
+	// The ID of the code fix whose fixes in the whole file are combined by this code action.
+	FixId string `json:"fixId,omitzero" lsp:"nullable"`
+
+	// The document of the refactoring whose edit is computed when this code action is resolved.
+	Uri DocumentUri `json:"uri,omitzero" lsp:"nullable"`
+
+	// The range the refactoring was requested for.
+	Range *Range `json:"range,omitzero"`
+
+	// The name of the language service plugin that contributed this code action.
+	Plugin string `json:"plugin,omitzero" lsp:"nullable"`
+
+	// The data the plugin attached to this code action, passed through unchanged.
+	PluginData *any `json:"pluginData,omitzero" lsp:"nullable"`
+}
+
+var _ json.UnmarshalerFrom = (*CodeActionData)(nil)
+
+func (s *CodeActionData) UnmarshalJSONFrom(dec *json.Decoder) error {
+	return unmarshalStruct(s, dec)
+}
+
 // ExperimentalServerCapabilities contains experimental capabilities under development.
 type ExperimentalServerCapabilities struct {
 	// The server provides source definition support via custom/textDocument/sourceDefinition.
@@ -8886,6 +8910,12 @@ type ExperimentalServerCapabilities struct {
 
 	// The server provides multi-document highlight support via custom/textDocument/multiDocumentHighlight.
 	CustomMultiDocumentHighlightProvider *bool `json:"customMultiDocumentHighlightProvider,omitzero"`
+
+	// The server provides relation error explanations via custom/textDocument/explainRelationError.
+	CustomExplainRelationErrorProvider *bool `json:"customExplainRelationErrorProvider,omitzero"`
+
+	// The server provides type expression evaluation via custom/textDocument/evaluateType.
+	CustomEvaluateTypeProvider *bool `json:"customEvaluateTypeProvider,omitzero"`
 }
 
 var _ json.UnmarshalerFrom = (*ExperimentalServerCapabilities)(nil)
@@ -9387,6 +9417,102 @@ func (s *MultiDocumentHighlightParams) UnmarshalJSONFrom(dec *json.Decoder) erro
 	return unmarshalStruct(s, dec)
 }
 
+// Parameters for the custom/textDocument/explainRelationError request.
+type ExplainRelationErrorParams struct {
+	// The text document.
+	TextDocument TextDocumentIdentifier `json:"textDocument" lsp:"required"`
+
+	// The range of the diagnostic to explain.
+	Range Range `json:"range" lsp:"required"`
+
+	// The code of the diagnostic to explain. If omitted, the first relation error at the range is explained.
+	Code *int32 `json:"code,omitzero"`
+}
+
+func (s *ExplainRelationErrorParams) TextDocumentURI() DocumentUri {
+	return s.TextDocument.Uri
+}
+
+var _ json.UnmarshalerFrom = (*ExplainRelationErrorParams)(nil)
+
+func (s *ExplainRelationErrorParams) UnmarshalJSONFrom(dec *json.Decoder) error {
+	return unmarshalStruct(s, dec)
+}
+
+// A failed comparison of a source type with a target type in the explanation of a relation error.
+type RelationExplanation struct {
+	// The path of members from the root comparison to this one, such as `a.b[0]`.
+	Path string `json:"path,omitzero" lsp:"nullable"`
+
+	// The member of the parent comparison's types compared here: a property name, a tuple element such as `[0]`, an index signature such as `[string]`, or a signature `()` or `new ()`.
+	Member string `json:"member,omitzero" lsp:"nullable"`
+
+	// The source type or signature.
+	Source string `json:"source" lsp:"required"`
+
+	// The target type or signature.
+	Target string `json:"target" lsp:"required"`
+
+	// The elaborations reported for this comparison.
+	Messages []string `json:"messages" lsp:"required"`
+
+	// The failed comparisons that caused this comparison to fail.
+	Children []*RelationExplanation `json:"children" lsp:"required"`
+}
+
+var _ json.UnmarshalerFrom = (*RelationExplanation)(nil)
+
+func (s *RelationExplanation) UnmarshalJSONFrom(dec *json.Decoder) error {
+	return unmarshalStruct(s, dec)
+}
+
+// Parameters for the custom/textDocument/evaluateType request.
+type EvaluateTypeParams struct {
+	// The text document.
+	TextDocument TextDocumentIdentifier `json:"textDocument" lsp:"required"`
+
+	// The position in whose scope the type expression is evaluated.
+	Position Position `json:"position" lsp:"required"`
+
+	// The type expression to evaluate, such as `Pick<Options, "a" | "b">`.
+	Expression string `json:"expression" lsp:"required"`
+
+	// The level up to which named types in the evaluated type are expanded, as in hover. Defaults to 0.
+	VerbosityLevel *int32 `json:"verbosityLevel,omitzero"`
+}
+
+func (s *EvaluateTypeParams) TextDocumentURI() DocumentUri {
+	return s.TextDocument.Uri
+}
+
+func (s *EvaluateTypeParams) TextDocumentPosition() Position {
+	return s.Position
+}
+
+var _ json.UnmarshalerFrom = (*EvaluateTypeParams)(nil)
+
+func (s *EvaluateTypeParams) UnmarshalJSONFrom(dec *json.Decoder) error {
+	return unmarshalStruct(s, dec)
+}
+
+// The result of the custom/textDocument/evaluateType request.
+type EvaluatedType struct {
+	// The evaluated type, printed structurally. Omitted if the expression could not be parsed.
+	Type string `json:"type,omitzero" lsp:"nullable"`
+
+	// The messages of the errors in the type expression.
+	Diagnostics []string `json:"diagnostics" lsp:"required"`
+
+	// Whether evaluating with a higher verbosity level would expand more of the type.
+	CanIncreaseVerbosity bool `json:"canIncreaseVerbosity,omitzero" lsp:"nullable"`
+}
+
+var _ json.UnmarshalerFrom = (*EvaluatedType)(nil)
+
+func (s *EvaluatedType) UnmarshalJSONFrom(dec *json.Decoder) error {
+	return unmarshalStruct(s, dec)
+}
+
 // A classified text run with text and classification type, used for colorized display in VS.
 type VSClassifiedTextRun struct {
 	// The classification type name (e.g. 'keyword', 'class name', 'parameter name').
@@ -9486,9 +9612,6 @@ type TypeHierarchyItemData struct{}
 // InlayHintData is a placeholder for custom data preserved on a InlayHint.
 type InlayHintData struct{}
 
-// CodeActionData is a placeholder for custom data preserved on a CodeAction.
-type CodeActionData struct{}
-
 // WorkspaceSymbolData is a placeholder for custom data preserved on a WorkspaceSymbol.
 type WorkspaceSymbolData struct{}
 
@@ -11110,6 +11233,10 @@ const (
 	MethodCustomTextDocumentSourceDefinition Method = "custom/textDocument/sourceDefinition"
 	// Request to get document highlights across multiple files.
 	MethodCustomTextDocumentMultiDocumentHighlight Method = "custom/textDocument/multiDocumentHighlight"
+	// Request to explain a relation error, such as an assignability error, as a tree of failed comparisons.
+	MethodCustomTextDocumentExplainRelationError Method = "custom/textDocument/explainRelationError"
+	// Request to evaluate a type expression in the scope of a position and print the resulting type.
+	MethodCustomTextDocumentEvaluateType Method = "custom/textDocument/evaluateType"
 	// Request for auto-insert when a trigger character is typed (VS-specific).
 	MethodTextDocumentVSOnAutoInsert Method = "textDocument/_vs_onAutoInsert"
 	// VS-specific request for Find All References with grouped reference items.
@@ -11673,6 +11800,18 @@ type CustomMultiDocumentHighlightResponse = MultiDocumentHighlightsOrNull
 // Type mapping info for `custom/textDocument/multiDocumentHighlight`
 var CustomTextDocumentMultiDocumentHighlightInfo = RequestInfo[*MultiDocumentHighlightParams, CustomMultiDocumentHighlightResponse]{Method: MethodCustomTextDocumentMultiDocumentHighlight}
 
+// Response type for `custom/textDocument/explainRelationError`
+type CustomExplainRelationErrorResponse = RelationExplanationOrNull
+
+// Type mapping info for `custom/textDocument/explainRelationError`
+var CustomTextDocumentExplainRelationErrorInfo = RequestInfo[*ExplainRelationErrorParams, CustomExplainRelationErrorResponse]{Method: MethodCustomTextDocumentExplainRelationError}
+
+// Response type for `custom/textDocument/evaluateType`
+type CustomEvaluateTypeResponse = EvaluatedTypeOrNull
+
+// Type mapping info for `custom/textDocument/evaluateType`
+var CustomTextDocumentEvaluateTypeInfo = RequestInfo[*EvaluateTypeParams, CustomEvaluateTypeResponse]{Method: MethodCustomTextDocumentEvaluateType}
+
 // Response type for `textDocument/_vs_onAutoInsert`
 type VSOnAutoInsertResponse = VSOnAutoInsertResponseItemOrNull
 
@@ -14625,6 +14764,60 @@ func (o *MultiDocumentHighlightsOrNull) UnmarshalJSONFrom(dec *json.Decoder) err
 	}
 }
 
+type RelationExplanationOrNull struct {
+	RelationExplanation *RelationExplanation
+}
+
+var _ json.MarshalerTo = (*RelationExplanationOrNull)(nil)
+
+func (o *RelationExplanationOrNull) MarshalJSONTo(enc *json.Encoder) error {
+	return marshalUnion(o, enc, "RelationExplanationOrNull", true)
+}
+
+var _ json.UnmarshalerFrom = (*RelationExplanationOrNull)(nil)
+
+func (o *RelationExplanationOrNull) UnmarshalJSONFrom(dec *json.Decoder) error {
+	*o = RelationExplanationOrNull{}
+
+	switch dec.PeekKind() {
+	case 'n':
+		_, err := dec.ReadToken()
+		return err
+	case '{':
+		o.RelationExplanation = new(RelationExplanation)
+		return json.UnmarshalDecode(dec, o.RelationExplanation)
+	default:
+		return errInvalidKind("RelationExplanationOrNull", dec.PeekKind())
+	}
+}
+
+type EvaluatedTypeOrNull struct {
+	EvaluatedType *EvaluatedType
+}
+
+var _ json.MarshalerTo = (*EvaluatedTypeOrNull)(nil)
+
+func (o *EvaluatedTypeOrNull) MarshalJSONTo(enc *json.Encoder) error {
+	return marshalUnion(o, enc, "EvaluatedTypeOrNull", true)
+}
+
+var _ json.UnmarshalerFrom = (*EvaluatedTypeOrNull)(nil)
+
+func (o *EvaluatedTypeOrNull) UnmarshalJSONFrom(dec *json.Decoder) error {
+	*o = EvaluatedTypeOrNull{}
+
+	switch dec.PeekKind() {
+	case 'n':
+		_, err := dec.ReadToken()
+		return err
+	case '{':
+		o.EvaluatedType = new(EvaluatedType)
+		return json.UnmarshalDecode(dec, o.EvaluatedType)
+	default:
+		return errInvalidKind("EvaluatedTypeOrNull", dec.PeekKind())
+	}
+}
+
 type VSOnAutoInsertResponseItemOrNull struct {
 	VSOnAutoInsertResponseItem *VSOnAutoInsertResponseItem
 }
@@ -215,3 +215,20 @@ func (p *projectLoadingProgress) beginOrReport(token, text string, begun bool) b
 	}
 	return true
 }
+
+// partialResultParams are the params of a $/progress notification that streams a partial result. Unlike work done
+// progress, the value has the partial result type of the request that supplied the token.
+type partialResultParams[T any] struct {
+	Token lsproto.IntegerOrString `json:"token"`
+	Value T                       `json:"value"`
+}
+
+// sendPartialResult sends a partial result of a request that was given a partialResultToken. Once a request has
+// streamed partial results, its final response must only contain what has not been streamed.
+func sendPartialResult[T any](s *Server, token lsproto.IntegerOrString, value T) error {
+	msg := &lsproto.RequestMessage{
+		Method: lsproto.MethodProgress,
+		Params: &partialResultParams[T]{Token: token, Value: value},
+	}
+	return s.send(msg.Message())
+}
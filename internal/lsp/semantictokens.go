@@ -0,0 +1,132 @@
+package lsp
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/microsoft/typescript-go/internal/ls"
+	"github.com/microsoft/typescript-go/internal/lsp/lsproto"
+	"github.com/microsoft/typescript-go/internal/project"
+	"github.com/microsoft/typescript-go/internal/tspath"
+)
+
+// semanticTokensCache holds the last semantic tokens sent for each open document, so that
+// textDocument/semanticTokens/full/delta can respond with the edits to them. The tokens of a document
+// are reused as long as neither the document nor the program of its project has changed.
+type semanticTokensCache struct {
+	mu           sync.Mutex
+	lastResultID uint64
+	entries      map[lsproto.DocumentUri]*semanticTokensCacheEntry
+}
+
+type semanticTokensCacheEntry struct {
+	resultID string
+	data     []uint32
+	version  semanticTokensVersion
+}
+
+// semanticTokensVersion identifies the state of the snapshot that semantic tokens were computed from.
+type semanticTokensVersion struct {
+	project           tspath.Path
+	programLastUpdate uint64
+	fileVersion       int32
+}
+
+func getSemanticTokensVersion(snapshot *project.Snapshot, uri lsproto.DocumentUri) (semanticTokensVersion, bool) {
+	p := snapshot.GetDefaultProject(uri)
+	file := snapshot.GetFile(uri.FileName())
+	if p == nil || file == nil {
+		return semanticTokensVersion{}, false
+	}
+	return semanticTokensVersion{
+		project:           p.Id(),
+		programLastUpdate: p.ProgramLastUpdate,
+		fileVersion:       file.Version(),
+	}, true
+}
+
+// get returns the tokens last sent for the document, and whether they are still valid for the given version.
+func (c *semanticTokensCache) get(uri lsproto.DocumentUri, version semanticTokensVersion, ok bool) (*semanticTokensCacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry := c.entries[uri]
+	return entry, entry != nil && ok && entry.version == version
+}
+
+// set records the tokens sent for the document and returns their result ID.
+func (c *semanticTokensCache) set(uri lsproto.DocumentUri, version semanticTokensVersion, data []uint32) *semanticTokensCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastResultID++
+	entry := &semanticTokensCacheEntry{
+		resultID: strconv.FormatUint(c.lastResultID, 10),
+		data:     data,
+		version:  version,
+	}
+	if c.entries == nil {
+		c.entries = make(map[lsproto.DocumentUri]*semanticTokensCacheEntry)
+	}
+	c.entries[uri] = entry
+	return entry
+}
+
+func (c *semanticTokensCache) delete(uri lsproto.DocumentUri) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, uri)
+}
+
+// provideSemanticTokens returns the current semantic tokens of the document, computing them only if the
+// cached tokens are out of date, along with the tokens that were sent before.
+func (s *Server) provideSemanticTokens(ctx context.Context, languageService *ls.LanguageService, snapshot *project.Snapshot, uri lsproto.DocumentUri) (current *semanticTokensCacheEntry, previous *semanticTokensCacheEntry, err error) {
+	version, ok := getSemanticTokensVersion(snapshot, uri)
+	previous, upToDate := s.semanticTokens.get(uri, version, ok)
+	if upToDate {
+		return previous, previous, nil
+	}
+	response, err := languageService.ProvideSemanticTokens(ctx, uri)
+	if err != nil {
+		return nil, nil, err
+	}
+	data := []uint32{}
+	if response.SemanticTokens != nil {
+		data = response.SemanticTokens.Data
+	}
+	if !ok {
+		return &semanticTokensCacheEntry{data: data}, previous, nil
+	}
+	return s.semanticTokens.set(uri, version, data), previous, nil
+}
+
+func (s *Server) handleSemanticTokensFull(ctx context.Context, languageService *ls.LanguageService, snapshot *project.Snapshot, params *lsproto.SemanticTokensParams) (lsproto.SemanticTokensResponse, error) {
+	current, _, err := s.provideSemanticTokens(ctx, languageService, snapshot, params.TextDocument.Uri)
+	if err != nil {
+		return lsproto.SemanticTokensOrNull{}, err
+	}
+	return lsproto.SemanticTokensOrNull{SemanticTokens: current.toSemanticTokens()}, nil
+}
+
+func (s *Server) handleSemanticTokensFullDelta(ctx context.Context, languageService *ls.LanguageService, snapshot *project.Snapshot, params *lsproto.SemanticTokensDeltaParams) (lsproto.SemanticTokensDeltaResponse, error) {
+	current, previous, err := s.provideSemanticTokens(ctx, languageService, snapshot, params.TextDocument.Uri)
+	if err != nil {
+		return lsproto.SemanticTokensOrSemanticTokensDeltaOrNull{}, err
+	}
+	if previous == nil || previous.resultID != params.PreviousResultId || current.resultID == "" {
+		return lsproto.SemanticTokensOrSemanticTokensDeltaOrNull{SemanticTokens: current.toSemanticTokens()}, nil
+	}
+	return lsproto.SemanticTokensOrSemanticTokensDeltaOrNull{
+		SemanticTokensDelta: &lsproto.SemanticTokensDelta{
+			ResultId: &current.resultID,
+			Edits:    ls.SemanticTokensEdits(previous.data, current.data),
+		},
+	}, nil
+}
+
+func (e *semanticTokensCacheEntry) toSemanticTokens() *lsproto.SemanticTokens {
+	tokens := &lsproto.SemanticTokens{Data: e.data}
+	if e.resultID != "" {
+		tokens.ResultId = &e.resultID
+	}
+	return tokens
+}
@@ -9,6 +9,7 @@ import (
 	"math/rand/v2"
 	"runtime/debug"
 	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -30,12 +31,14 @@ import (
 	"github.com/microsoft/typescript-go/internal/ls/lsutil"
 	"github.com/microsoft/typescript-go/internal/lsp/lsproto"
 	"github.com/microsoft/typescript-go/internal/lsp/lspwatcher"
+	"github.com/microsoft/typescript-go/internal/lsplugin"
 	"github.com/microsoft/typescript-go/internal/pprof"
 	"github.com/microsoft/typescript-go/internal/project"
 	"github.com/microsoft/typescript-go/internal/project/ata"
 	"github.com/microsoft/typescript-go/internal/tsoptions"
 	"github.com/microsoft/typescript-go/internal/tspath"
 	"github.com/microsoft/typescript-go/internal/vfs"
+	"github.com/zeebo/xxh3"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -50,6 +53,9 @@ type ServerOptions struct {
 	TypingsLocation    string
 	ParseCache         *project.ParseCache
 	NpmInstall         func(cwd string, args []string) ([]byte, error)
+	// RunPackageManager runs a package manager other than npm to install typings. It is nil when
+	// only npm is supported.
+	RunPackageManager func(cwd string, command string, args []string) ([]byte, error)
 	// Spawn launches a child process, returning its stdio as an io.ReadWriteCloser (Read is its stdout,
 	// Write is its stdin). It is nil when the host cannot spawn processes. Currently used for content mappers.
 	Spawn              func(command []string, dir string, stderr io.Writer) (io.ReadWriteCloser, error)
@@ -76,6 +82,7 @@ func NewServer(opts *ServerOptions) *Server {
 		typingsLocation:       opts.TypingsLocation,
 		parseCache:            opts.ParseCache,
 		npmInstall:            opts.NpmInstall,
+		runPackageManager:     opts.RunPackageManager,
 		spawn:                 opts.Spawn,
 		startWatchdog:         opts.SetParentProcessID,
 		initComplete:          make(chan struct{}),
@@ -223,10 +230,17 @@ type Server struct {
 
 	session *project.Session
 
+	// semanticTokens holds the semantic tokens last sent for each open document
+	semanticTokens semanticTokensCache
+
 	// apiSessions holds active API sessions keyed by their ID
 	apiSessions   map[string]*api.Session
 	apiSessionsMu sync.Mutex
 
+	// recentMoveToFileTargets holds the files statements were last moved to with "Move to file", most recent first
+	recentMoveToFileTargets   []string
+	recentMoveToFileTargetsMu sync.Mutex
+
 	// Test options for initializing session
 	client project.Client
 
@@ -239,8 +253,9 @@ type Server struct {
 	// parseCache can be passed in so separate tests can share ASTs
 	parseCache *project.ParseCache
 
-	npmInstall func(cwd string, args []string) ([]byte, error)
-	spawn      func(command []string, dir string, stderr io.Writer) (io.ReadWriteCloser, error)
+	npmInstall        func(cwd string, args []string) ([]byte, error)
+	runPackageManager func(cwd string, command string, args []string) ([]byte, error)
+	spawn             func(command []string, dir string, stderr io.Writer) (io.ReadWriteCloser, error)
 
 	cpuProfiler pprof.CPUProfiler
 
@@ -346,6 +361,7 @@ const (
 	contentMapperOnTypeFormattingRegistrationID  = "content-mapper-on-type-formatting"
 	contentMapperLinkedEditingRegistrationID     = "content-mapper-linked-editing"
 	contentMapperCallHierarchyRegistrationID     = "content-mapper-call-hierarchy"
+	contentMapperTypeHierarchyRegistrationID     = "content-mapper-type-hierarchy"
 	contentMapperWillRenameFilesRegistrationID   = "content-mapper-will-rename-files"
 )
 
@@ -397,6 +413,8 @@ func (s *Server) supportsContentMapperRegistration(id string) bool {
 		return s.clientCapabilities.TextDocument.LinkedEditingRange.DynamicRegistration
 	case contentMapperCallHierarchyRegistrationID:
 		return s.clientCapabilities.TextDocument.CallHierarchy.DynamicRegistration
+	case contentMapperTypeHierarchyRegistrationID:
+		return s.clientCapabilities.TextDocument.TypeHierarchy.DynamicRegistration
 	case contentMapperWillRenameFilesRegistrationID:
 		return s.clientCapabilities.Workspace.FileOperations.DynamicRegistration &&
 			s.clientCapabilities.Workspace.FileOperations.WillRename
@@ -444,6 +462,7 @@ func (s *Server) RegisterContentMapperExtensions(ctx context.Context, extensions
 			{Id: contentMapperOnTypeFormattingRegistrationID, Method: string(lsproto.MethodTextDocumentOnTypeFormatting)},
 			{Id: contentMapperLinkedEditingRegistrationID, Method: string(lsproto.MethodTextDocumentLinkedEditingRange)},
 			{Id: contentMapperCallHierarchyRegistrationID, Method: string(lsproto.MethodTextDocumentPrepareCallHierarchy)},
+			{Id: contentMapperTypeHierarchyRegistrationID, Method: string(lsproto.MethodTextDocumentPrepareTypeHierarchy)},
 			{Id: contentMapperWillRenameFilesRegistrationID, Method: string(lsproto.MethodWorkspaceWillRenameFiles)},
 		}
 		unregistrations = slices.DeleteFunc(unregistrations, func(registration *lsproto.Unregistration) bool {
@@ -587,7 +606,9 @@ func (s *Server) RegisterContentMapperExtensions(ctx context.Context, extensions
 					DocumentSelector: selector,
 					Legend:           ls.SemanticTokensLegend(s.clientCapabilities.TextDocument.SemanticTokens),
 					Full: &lsproto.BooleanOrSemanticTokensFullDelta{
-						Boolean: new(true),
+						SemanticTokensFullDelta: &lsproto.SemanticTokensFullDelta{
+							Delta: new(true),
+						},
 					},
 					Range: &lsproto.BooleanOrEmptyObject{
 						Boolean: new(true),
@@ -639,7 +660,9 @@ func (s *Server) RegisterContentMapperExtensions(ctx context.Context, extensions
 						lsproto.CodeActionKindSourceRemoveUnusedImports,
 						lsproto.CodeActionKindSourceSortImports,
 						lsproto.CodeActionKindSourceFixAll,
+						lsproto.CodeActionKindRefactor,
 					},
+					ResolveProvider: new(true),
 				},
 			},
 		},
@@ -677,6 +700,12 @@ func (s *Server) RegisterContentMapperExtensions(ctx context.Context, extensions
 				TextDocumentPrepareCallHierarchy: &lsproto.CallHierarchyRegistrationOptions{DocumentSelector: selector},
 			},
 		},
+		{
+			Id: contentMapperTypeHierarchyRegistrationID,
+			RegisterOptions: &lsproto.RegisterOptions{
+				TextDocumentPrepareTypeHierarchy: &lsproto.TypeHierarchyRegistrationOptions{DocumentSelector: selector},
+			},
+		},
 		{
 			Id: contentMapperWillRenameFilesRegistrationID,
 			RegisterOptions: &lsproto.RegisterOptions{
@@ -1231,6 +1260,7 @@ var handlers = sync.OnceValue(func() handlerMap {
 	registerNotificationHandler(handlers, lsproto.ExitInfo, (*Server).handleExit)
 
 	registerNotificationHandler(handlers, lsproto.WorkspaceDidChangeConfigurationInfo, (*Server).handleDidChangeWorkspaceConfiguration)
+	registerNotificationHandler(handlers, lsproto.WorkspaceDidChangeWorkspaceFoldersInfo, (*Server).handleDidChangeWorkspaceFolders)
 	registerNotificationHandler(handlers, lsproto.TextDocumentDidOpenInfo, (*Server).handleDidOpen)
 	registerNotificationHandler(handlers, lsproto.TextDocumentDidChangeInfo, (*Server).handleDidChange)
 	registerNotificationHandler(handlers, lsproto.TextDocumentDidSaveInfo, (*Server).handleDidSave)
@@ -1239,8 +1269,10 @@ var handlers = sync.OnceValue(func() handlerMap {
 	registerNotificationHandler(handlers, lsproto.SetTraceInfo, (*Server).handleSetTrace)
 	registerNotificationHandler(handlers, lsproto.CustomSetLogVerbosityInfo, (*Server).handleSetLogVerbosity)
 	registerRequestHandler(handlers, lsproto.WorkspaceWillRenameFilesInfo, (*Server).handleWillRenameFiles)
+	registerRequestHandler(handlers, lsproto.WorkspaceExecuteCommandInfo, (*Server).handleExecuteCommand)
 
 	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.TextDocumentDiagnosticInfo, (*Server).handleDocumentDiagnostic)
+	registerWorkspaceRequestHandler(handlers, lsproto.WorkspaceDiagnosticInfo, (*Server).handleWorkspaceDiagnostic)
 	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.TextDocumentHoverInfo, (*Server).handleHover)
 	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.TextDocumentDefinitionInfo, (*Server).handleDefinition)
 	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.CustomTextDocumentSourceDefinitionInfo, (*Server).handleSourceDefinition)
@@ -1252,12 +1284,16 @@ var handlers = sync.OnceValue(func() handlerMap {
 	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.TextDocumentDocumentSymbolInfo, (*Server).handleDocumentSymbol)
 	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.TextDocumentDocumentHighlightInfo, (*Server).handleDocumentHighlight)
 	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.CustomTextDocumentMultiDocumentHighlightInfo, (*Server).handleMultiDocumentHighlight)
+	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.CustomTextDocumentExplainRelationErrorInfo, (*Server).handleExplainRelationError)
+	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.CustomTextDocumentEvaluateTypeInfo, (*Server).handleEvaluateType)
 	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.TextDocumentSelectionRangeInfo, (*Server).handleSelectionRange)
 	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.TextDocumentInlayHintInfo, (*Server).handleInlayHint)
 	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.TextDocumentCodeLensInfo, (*Server).handleCodeLens)
 	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.TextDocumentCodeActionInfo, (*Server).handleCodeAction)
 	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.TextDocumentPrepareCallHierarchyInfo, (*Server).handlePrepareCallHierarchy)
+	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.TextDocumentPrepareTypeHierarchyInfo, (*Server).handlePrepareTypeHierarchy)
 	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.TextDocumentFoldingRangeInfo, (*Server).handleFoldingRange)
+	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.TextDocumentDocumentLinkInfo, (*Server).handleDocumentLink)
 	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.TextDocumentPrepareRenameInfo, (*Server).handlePrepareRename)
 	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.TextDocumentLinkedEditingRangeInfo, (*Server).handleLinkedEditingRange)
 
@@ -1273,11 +1309,15 @@ var handlers = sync.OnceValue(func() handlerMap {
 
 	registerRequestHandler(handlers, lsproto.CallHierarchyIncomingCallsInfo, (*Server).handleCallHierarchyIncomingCalls)
 	registerRequestHandler(handlers, lsproto.CallHierarchyOutgoingCallsInfo, (*Server).handleCallHierarchyOutgoingCalls)
+	registerRequestHandler(handlers, lsproto.TypeHierarchySupertypesInfo, (*Server).handleTypeHierarchySupertypes)
+	registerMultiProjectReferenceRequestHandler(handlers, lsproto.TypeHierarchySubtypesInfo, (*ls.LanguageService).ProvideTypeHierarchySubtypes)
 
 	registerRequestHandler(handlers, lsproto.WorkspaceSymbolInfo, (*Server).handleWorkspaceSymbol)
 	registerRequestHandler(handlers, lsproto.CompletionItemResolveInfo, (*Server).handleCompletionItemResolve)
+	registerRequestHandler(handlers, lsproto.CodeActionResolveInfo, (*Server).handleCodeActionResolve)
 	registerRequestHandler(handlers, lsproto.CodeLensResolveInfo, (*Server).handleCodeLensResolve)
-	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.TextDocumentSemanticTokensFullInfo, (*Server).handleSemanticTokensFull)
+	registerLanguageServiceAndSnapshotDocumentRequestHandler(handlers, lsproto.TextDocumentSemanticTokensFullInfo, (*Server).handleSemanticTokensFull)
+	registerLanguageServiceAndSnapshotDocumentRequestHandler(handlers, lsproto.TextDocumentSemanticTokensFullDeltaInfo, (*Server).handleSemanticTokensFullDelta)
 	registerLanguageServiceDocumentRequestHandler(handlers, lsproto.TextDocumentSemanticTokensRangeInfo, (*Server).handleSemanticTokensRange)
 
 	// Developer/debugging commands
@@ -1335,6 +1375,31 @@ func registerRequestHandler[Req, Resp any](
 	}
 }
 
+// registerWorkspaceRequestHandler registers a handler for a request about the whole workspace. The handler runs
+// asynchronously and acquires the snapshots it needs itself, so that it may run for as long as it needs to.
+func registerWorkspaceRequestHandler[Req, Resp any](handlers handlerMap, info lsproto.RequestInfo[Req, Resp], fn func(*Server, context.Context, Req) (Resp, error)) {
+	handlers[info.Method] = func(s *Server, ctx context.Context, req *lsproto.RequestMessage) (func() error, error) {
+		if s.session == nil {
+			return nil, lsproto.ErrorCodeServerNotInitialized
+		}
+		params, err := lsproto.UnmarshalParams[Req](req)
+		if err != nil {
+			return nil, err
+		}
+		return func() error {
+			defer s.recover(req)
+			resp, err := fn(s, ctx, params)
+			if err != nil {
+				return err
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return s.sendResult(req.ID, resp)
+		}, nil
+	}
+}
+
 func registerLanguageServiceDocumentRequestHandler[Req lsproto.HasTextDocumentURI, Resp any](handlers handlerMap, info lsproto.RequestInfo[Req, Resp], fn func(*Server, context.Context, *ls.LanguageService, Req) (Resp, error)) {
 	handlers[info.Method] = func(s *Server, ctx context.Context, req *lsproto.RequestMessage) (func() error, error) {
 		params, err := lsproto.UnmarshalParams[Req](req)
@@ -1362,6 +1427,29 @@ func registerLanguageServiceDocumentRequestHandler[Req lsproto.HasTextDocumentUR
 	}
 }
 
+func registerLanguageServiceAndSnapshotDocumentRequestHandler[Req lsproto.HasTextDocumentURI, Resp any](handlers handlerMap, info lsproto.RequestInfo[Req, Resp], fn func(*Server, context.Context, *ls.LanguageService, *project.Snapshot, Req) (Resp, error)) {
+	handlers[info.Method] = func(s *Server, ctx context.Context, req *lsproto.RequestMessage) (func() error, error) {
+		params, err := lsproto.UnmarshalParams[Req](req)
+		if err != nil {
+			return nil, err
+		}
+		return s.session.WithLanguageServiceAndSnapshot(ctx, params.TextDocumentURI(), func(languageService *ls.LanguageService, snapshot *project.Snapshot) (func() error, error) {
+			return func() error {
+				defer s.recover(req)
+				resp, lsErr := fn(s, ctx, languageService, snapshot, params)
+				s.session.EnqueuePublishGlobalDiagnostics()
+				if lsErr != nil {
+					return lsErr
+				}
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				return s.sendResult(req.ID, resp)
+			}, nil
+		})
+	}
+}
+
 func registerLanguageServiceWithAutoImportsRequestHandler[Req lsproto.HasTextDocumentURI, Resp any](handlers handlerMap, info lsproto.RequestInfo[Req, Resp], fn func(*Server, context.Context, *ls.LanguageService, Req) (Resp, error)) {
 	handlers[info.Method] = func(s *Server, ctx context.Context, req *lsproto.RequestMessage) (func() error, error) {
 		params, err := lsproto.UnmarshalParams[Req](req)
@@ -1580,6 +1668,7 @@ func (s *Server) handleInitialize(ctx context.Context, params *lsproto.Initializ
 				Options: &lsproto.DiagnosticOptions{
 					Identifier:            new("typescript"),
 					InterFileDependencies: true,
+					WorkspaceDiagnostics:  true,
 				},
 			},
 			CompletionProvider: &lsproto.CompletionOptions{
@@ -1612,6 +1701,7 @@ func (s *Server) handleInitialize(ctx context.Context, params *lsproto.Initializ
 			FoldingRangeProvider: &lsproto.BooleanOrFoldingRangeOptionsOrFoldingRangeRegistrationOptions{
 				Boolean: new(true),
 			},
+			DocumentLinkProvider: &lsproto.DocumentLinkOptions{},
 			RenameProvider: &lsproto.BooleanOrRenameOptions{
 				RenameOptions: &lsproto.RenameOptions{
 					PrepareProvider: new(true),
@@ -1640,21 +1730,37 @@ func (s *Server) handleInitialize(ctx context.Context, params *lsproto.Initializ
 						lsproto.CodeActionKindSourceRemoveUnusedImports,
 						lsproto.CodeActionKindSourceSortImports,
 						lsproto.CodeActionKindSourceFixAll,
+						lsproto.CodeActionKindRefactor,
 					},
+					ResolveProvider: new(true),
 				},
 			},
+			ExecuteCommandProvider: &lsproto.ExecuteCommandOptions{
+				Commands: []string{ls.CommandMoveToFile},
+			},
 			CallHierarchyProvider: &lsproto.BooleanOrCallHierarchyOptionsOrCallHierarchyRegistrationOptions{
 				Boolean: new(true),
 			},
+			TypeHierarchyProvider: &lsproto.BooleanOrTypeHierarchyOptionsOrTypeHierarchyRegistrationOptions{
+				Boolean: new(true),
+			},
 			Experimental: &lsproto.ExperimentalServerCapabilities{
 				CustomSourceDefinitionProvider:       new(true),
 				CustomMultiDocumentHighlightProvider: new(true),
+				CustomExplainRelationErrorProvider:   new(true),
+				CustomEvaluateTypeProvider:           new(true),
 			},
 			VSReferencesProvider: new(true),
 			VSOnAutoInsertProvider: &lsproto.VSOnAutoInsertOptions{
 				VSTriggerCharacters: []string{">"},
 			},
 			Workspace: &lsproto.WorkspaceOptions{
+				WorkspaceFolders: &lsproto.WorkspaceFoldersServerCapabilities{
+					Supported: new(true),
+					ChangeNotifications: &lsproto.StringOrBoolean{
+						Boolean: new(true),
+					},
+				},
 				FileOperations: &lsproto.FileOperationOptions{
 					WillRename: &lsproto.FileOperationRegistrationOptions{
 						Filters: fileRenameFilters,
@@ -1665,7 +1771,9 @@ func (s *Server) handleInitialize(ctx context.Context, params *lsproto.Initializ
 				Options: &lsproto.SemanticTokensOptions{
 					Legend: ls.SemanticTokensLegend(s.clientCapabilities.TextDocument.SemanticTokens),
 					Full: &lsproto.BooleanOrSemanticTokensFullDelta{
-						Boolean: new(true),
+						SemanticTokensFullDelta: &lsproto.SemanticTokensFullDelta{
+							Delta: new(true),
+						},
 					},
 					Range: &lsproto.BooleanOrEmptyObject{
 						Boolean: new(true),
@@ -1713,11 +1821,15 @@ func (s *Server) handleInitialized(ctx context.Context, params *lsproto.Initiali
 	}
 
 	cwd := s.cwd
+	var workspaceFolders []string
 	if s.clientCapabilities.Workspace.WorkspaceFolders &&
 		s.initializeParams.WorkspaceFolders != nil &&
-		s.initializeParams.WorkspaceFolders.WorkspaceFolders != nil &&
-		len(*s.initializeParams.WorkspaceFolders.WorkspaceFolders) == 1 {
-		cwd = lsproto.DocumentUri((*s.initializeParams.WorkspaceFolders.WorkspaceFolders)[0].Uri).FileName()
+		s.initializeParams.WorkspaceFolders.WorkspaceFolders != nil {
+		workspaceFolders = workspaceFolderFileNames(*s.initializeParams.WorkspaceFolders.WorkspaceFolders)
+	}
+	if len(workspaceFolders) > 0 {
+		// The first folder serves as the current directory; the session tracks all of them.
+		cwd = workspaceFolders[0]
 	} else if s.initializeParams.RootUri.DocumentUri != nil {
 		cwd = s.initializeParams.RootUri.DocumentUri.FileName()
 	} else if s.initializeParams.RootPath != nil && s.initializeParams.RootPath.String != nil {
@@ -1733,6 +1845,7 @@ func (s *Server) handleInitialized(ctx context.Context, params *lsproto.Initiali
 		BackgroundCtx: lsproto.WithClientCapabilities(s.backgroundCtx, &s.clientCapabilities),
 		Options: &project.SessionOptions{
 			CurrentDirectory:       cwd,
+			WorkspaceFolders:       workspaceFolders,
 			DefaultLibraryPath:     s.defaultLibraryPath,
 			TypingsLocation:        s.typingsLocation,
 			PositionEncoding:       s.positionEncoding,
@@ -1743,13 +1856,14 @@ func (s *Server) handleInitialized(ctx context.Context, params *lsproto.Initiali
 			PushDiagnosticsEnabled: !disablePushDiagnostics,
 			RunExternalCode:        runExternalCode,
 		},
-		FS:                  s.fs,
-		Logger:              s.logger,
-		Client:              s,
-		NpmExecutor:         s,
-		Spawner:             s.contentMapperSpawner(),
-		ContentMapperLogger: s.contentMapperLogger(),
-		ParseCache:          s.parseCache,
+		FS:                          s.fs,
+		Logger:                      s.logger,
+		Client:                      s,
+		NpmExecutor:                 s,
+		Spawner:                     s.contentMapperSpawner(),
+		ContentMapperLogger:         s.contentMapperLogger(),
+		LanguageServicePluginLogger: lsplugin.Logger(s.contentMapperLogger()),
+		ParseCache:                  s.parseCache,
 	})
 
 	userPreferences, err := s.RequestConfiguration(ctx)
@@ -1810,6 +1924,26 @@ func (s *Server) handleDidChangeWorkspaceConfiguration(ctx context.Context, para
 	return nil
 }
 
+func (s *Server) handleDidChangeWorkspaceFolders(ctx context.Context, params *lsproto.DidChangeWorkspaceFoldersParams) error {
+	if params.Event == nil {
+		return nil
+	}
+	s.session.DidChangeWorkspaceFolders(ctx, workspaceFolderFileNames(params.Event.Added), workspaceFolderFileNames(params.Event.Removed))
+	return nil
+}
+
+// workspaceFolderFileNames returns the absolute file names of the given workspace folders, skipping
+// folders that are not on the file system.
+func workspaceFolderFileNames(folders []*lsproto.WorkspaceFolder) []string {
+	fileNames := make([]string, 0, len(folders))
+	for _, folder := range folders {
+		if fileName := lsproto.DocumentUri(folder.Uri).FileName(); tspath.PathIsAbsolute(fileName) {
+			fileNames = append(fileNames, fileName)
+		}
+	}
+	return fileNames
+}
+
 func (s *Server) handleDidOpen(ctx context.Context, params *lsproto.DidOpenTextDocumentParams) error {
 	s.session.DidOpenFile(ctx, params.TextDocument.Uri, params.TextDocument.Version, params.TextDocument.Text, params.TextDocument.LanguageId)
 	return nil
@@ -1827,6 +1961,7 @@ func (s *Server) handleDidSave(ctx context.Context, params *lsproto.DidSaveTextD
 
 func (s *Server) handleDidClose(ctx context.Context, params *lsproto.DidCloseTextDocumentParams) error {
 	s.session.DidCloseFile(ctx, params.TextDocument.Uri)
+	s.semanticTokens.delete(params.TextDocument.Uri)
 	return nil
 }
 
@@ -1851,6 +1986,181 @@ func (s *Server) handleSetLogVerbosity(_ context.Context, params *lsproto.SetLog
 }
 
 func (s *Server) handleDocumentDiagnostic(ctx context.Context, languageService *ls.LanguageService, params *lsproto.DocumentDiagnosticParams) (lsproto.DocumentDiagnosticResponse, error) {
+	response, err := s.provideDocumentDiagnostic(ctx, languageService, params)
+	if err != nil || response.FullDocumentDiagnosticReport == nil {
+		return response, err
+	}
+	response.FullDocumentDiagnosticReport.Items = s.appendPluginDiagnostics(ctx, languageService, params.TextDocument.Uri, response.FullDocumentDiagnosticReport.Items)
+	return response, nil
+}
+
+func (s *Server) appendPluginDiagnostics(ctx context.Context, languageService *ls.LanguageService, uri lsproto.DocumentUri, items []*lsproto.Diagnostic) []*lsproto.Diagnostic {
+	if host, plugins, file := s.languageServicePlugins(languageService, uri); host != nil {
+		diagnostics, pluginErr := host.Diagnostics(ctx, plugins, file)
+		s.logLanguageServicePluginError(pluginErr)
+		items = append(items, diagnostics...)
+	}
+	return items
+}
+
+// handleWorkspaceDiagnostic reports the diagnostics of every file in the workspace. If no report differs from the
+// result IDs the client already has, the request is held open until the workspace changes; the client issues the
+// request again as soon as it completes.
+func (s *Server) handleWorkspaceDiagnostic(ctx context.Context, params *lsproto.WorkspaceDiagnosticParams) (lsproto.WorkspaceDiagnosticResponse, error) {
+	previousResultIDs := make(map[lsproto.DocumentUri]string, len(params.PreviousResultIds))
+	for _, previous := range params.PreviousResultIds {
+		previousResultIDs[previous.Uri] = previous.Value
+	}
+	for {
+		// Take the channel first so that a change made while the diagnostics are computed is not missed.
+		workspaceChanged := s.session.WorkspaceChanged()
+		var response lsproto.WorkspaceDiagnosticResponse
+		var changed bool
+		var err error
+		s.session.WithSnapshotLoadingProjectTree(ctx, nil, func(snapshot *project.Snapshot) {
+			response, changed, err = s.provideWorkspaceDiagnostics(ctx, snapshot, params, previousResultIDs)
+		})
+		if err != nil || changed {
+			return response, err
+		}
+		select {
+		case <-workspaceChanged:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// provideWorkspaceDiagnostics computes the diagnostics of every file in every project, except for library files,
+// and reports whether any of them differs from what the client already has. A file in several projects is reported
+// once, with the diagnostics of all of them. Its result ID is derived from the program versions of those projects,
+// so that a file whose programs did not change since the client's result ID is reported as unchanged without
+// computing its diagnostics. If the client supplied a partial result token, the reports of each project are streamed
+// as soon as they are computed.
+func (s *Server) provideWorkspaceDiagnostics(
+	ctx context.Context,
+	snapshot *project.Snapshot,
+	params *lsproto.WorkspaceDiagnosticParams,
+	previousResultIDs map[lsproto.DocumentUri]string,
+) (lsproto.WorkspaceDiagnosticResponse, bool, error) {
+	ctx = core.WithCheckerLifetime(ctx, core.CheckerLifetimeDiagnostics)
+	projects := core.Filter(snapshot.ProjectCollection.Projects(), func(p *project.Project) bool { return p.GetProgram() != nil })
+	languageServices := make(map[*project.Project]*ls.LanguageService, len(projects))
+	getLanguageService := func(p *project.Project) *ls.LanguageService {
+		if languageService, ok := languageServices[p]; ok {
+			return languageService
+		}
+		languageService := ls.NewLanguageService(p.Id(), p.GetProgram(), snapshot, "")
+		languageServices[p] = languageService
+		return languageService
+	}
+
+	reports := []lsproto.WorkspaceFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport{}
+	changed := false
+	var seen collections.Set[tspath.Path]
+	var reported collections.Set[lsproto.DocumentUri]
+	// With a partial result token, the reports are streamed once something has changed, together with the
+	// unchanged reports held back until then; if nothing changes, nothing is sent.
+	sendReports := func(batch []lsproto.WorkspaceFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport) error {
+		reports = append(reports, batch...)
+		if params.PartialResultToken == nil || !changed || len(reports) == 0 {
+			return nil
+		}
+		items := reports
+		reports = nil
+		return sendPartialResult(s, *params.PartialResultToken, &lsproto.WorkspaceDiagnosticReportPartialResult{Items: items})
+	}
+
+	for i, p := range projects {
+		program := p.GetProgram()
+		var projectReports []lsproto.WorkspaceFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport
+		for _, file := range program.GetSourceFiles() {
+			if program.IsSourceFileDefaultLibrary(file.Path()) || program.IsSourceFileFromExternalLibrary(file) || !seen.AddIfAbsent(file.Path()) {
+				continue
+			}
+			if err := ctx.Err(); err != nil {
+				return nil, false, err
+			}
+			uri := lsconv.FileNameToDocumentURI(file.FileName())
+			reported.Add(uri)
+			// Projects before this one do not contain the file, or it would have been seen.
+			fileProjects := core.Filter(projects[i:], func(other *project.Project) bool {
+				return other.GetProgram().GetSourceFileByPath(file.Path()) != nil
+			})
+			resultID := workspaceDiagnosticsResultID(fileProjects)
+			if previousResultIDs[uri] == resultID {
+				projectReports = append(projectReports, lsproto.WorkspaceFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport{
+					UnchangedDocumentDiagnosticReport: &lsproto.WorkspaceUnchangedDocumentDiagnosticReport{
+						ResultId: resultID,
+						Uri:      uri,
+					},
+				})
+				continue
+			}
+
+			items := []*lsproto.Diagnostic{}
+			for _, fileProject := range fileProjects {
+				languageService := getLanguageService(fileProject)
+				response, err := languageService.ProvideDiagnostics(ctx, uri)
+				if err != nil {
+					return nil, false, err
+				}
+				if response.FullDocumentDiagnosticReport == nil {
+					continue
+				}
+				projectItems := s.appendPluginDiagnostics(ctx, languageService, uri, response.FullDocumentDiagnosticReport.Items)
+				newItems, _ := lsproto.CompareDiagnostics(items, projectItems)
+				items = append(items, newItems...)
+			}
+			changed = true
+			projectReports = append(projectReports, lsproto.WorkspaceFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport{
+				FullDocumentDiagnosticReport: &lsproto.WorkspaceFullDocumentDiagnosticReport{
+					ResultId: &resultID,
+					Items:    items,
+					Uri:      uri,
+				},
+			})
+		}
+		if err := sendReports(projectReports); err != nil {
+			return nil, false, err
+		}
+	}
+
+	// Files the client has diagnostics for that left the workspace are reported without diagnostics, so that the
+	// client clears them.
+	var removedReports []lsproto.WorkspaceFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport
+	for _, previous := range params.PreviousResultIds {
+		if !reported.AddIfAbsent(previous.Uri) {
+			continue
+		}
+		changed = true
+		removedReports = append(removedReports, lsproto.WorkspaceFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport{
+			FullDocumentDiagnosticReport: &lsproto.WorkspaceFullDocumentDiagnosticReport{
+				Items: []*lsproto.Diagnostic{},
+				Uri:   previous.Uri,
+			},
+		})
+	}
+	if err := sendReports(removedReports); err != nil {
+		return nil, false, err
+	}
+	if reports == nil {
+		reports = []lsproto.WorkspaceFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport{}
+	}
+	return &lsproto.WorkspaceDiagnosticReport{Items: reports}, changed, nil
+}
+
+// workspaceDiagnosticsResultID identifies the diagnostics of a file in the workspace by the program versions of the
+// projects that contain it, which change whenever its diagnostics may have changed.
+func workspaceDiagnosticsResultID(projects []*project.Project) string {
+	var b strings.Builder
+	for _, p := range projects {
+		fmt.Fprintf(&b, "%s@%d;", p.Id(), p.ProgramLastUpdate)
+	}
+	return strconv.FormatUint(xxh3.HashString(b.String()), 36)
+}
+
+func (s *Server) provideDocumentDiagnostic(ctx context.Context, languageService *ls.LanguageService, params *lsproto.DocumentDiagnosticParams) (lsproto.DocumentDiagnosticResponse, error) {
 	ctx = core.WithCheckerLifetime(ctx, core.CheckerLifetimeDiagnostics)
 	if s.flakeLogging == lsproto.DiagnosticFlakeLogLevelOff {
 		return languageService.ProvideDiagnostics(ctx, params.TextDocument.Uri)
@@ -2084,6 +2394,10 @@ func (s *Server) handleFoldingRange(ctx context.Context, ls *ls.LanguageService,
 	return ls.ProvideFoldingRange(ctx, params.TextDocument.Uri)
 }
 
+func (s *Server) handleDocumentLink(ctx context.Context, ls *ls.LanguageService, params *lsproto.DocumentLinkParams) (lsproto.DocumentLinkResponse, error) {
+	return ls.ProvideDocumentLinks(ctx, params.TextDocument.Uri)
+}
+
 func (s *Server) handleVSOnAutoInsert(ctx context.Context, ls *ls.LanguageService, params *lsproto.VSOnAutoInsertParams) (lsproto.VSOnAutoInsertResponse, error) {
 	return ls.ProvideOnAutoInsert(ctx, params)
 }
@@ -2109,18 +2423,37 @@ func (s *Server) handleTypeDefinition(ctx context.Context, ls *ls.LanguageServic
 }
 
 func (s *Server) handleCompletion(ctx context.Context, languageService *ls.LanguageService, params *lsproto.CompletionParams) (lsproto.CompletionResponse, error) {
-	return languageService.ProvideCompletion(
+	response, err := languageService.ProvideCompletion(
 		ctx,
 		params.TextDocument.Uri,
 		params.Position,
 		params.Context,
 	)
+	if err != nil {
+		return response, err
+	}
+	if host, plugins, file := s.languageServicePlugins(languageService, params.TextDocument.Uri); host != nil {
+		items, pluginErr := host.Completions(ctx, plugins, file, params.Position)
+		s.logLanguageServicePluginError(pluginErr)
+		if len(items) > 0 {
+			switch {
+			case response.List != nil:
+				response.List.Items = append(response.List.Items, items...)
+			case response.Items != nil:
+				*response.Items = append(*response.Items, items...)
+			default:
+				response.Items = &items
+			}
+		}
+	}
+	return response, nil
 }
 
 func (s *Server) handleCompletionItemResolve(ctx context.Context, params *lsproto.CompletionItem, reqMsg *lsproto.RequestMessage) (lsproto.CompletionResolveResponse, error) {
 	data := params.Data
 	if data == nil {
-		return nil, errors.New("completion item data is nil")
+		// Items contributed by language service plugins carry no resolve data and are already complete.
+		return params, nil
 	}
 	languageService, err := s.session.GetLanguageService(ctx, lsconv.FileNameToDocumentURI(data.FileName))
 	if err != nil {
@@ -2130,6 +2463,20 @@ func (s *Server) handleCompletionItemResolve(ctx context.Context, params *lsprot
 	return languageService.ResolveCompletionItem(ctx, params, data)
 }
 
+func (s *Server) handleCodeActionResolve(ctx context.Context, params *lsproto.CodeAction, reqMsg *lsproto.RequestMessage) (lsproto.CodeActionResolveResponse, error) {
+	data := params.Data
+	if data == nil || data.Uri == "" {
+		// Only refactorings are resolved; other code actions are returned complete.
+		return params, nil
+	}
+	languageService, err := s.session.GetLanguageService(ctx, data.Uri)
+	if err != nil {
+		return nil, err
+	}
+	defer s.recover(reqMsg)
+	return languageService.ResolveCodeAction(ctx, params)
+}
+
 func (s *Server) handleDocumentFormat(ctx context.Context, ls *ls.LanguageService, params *lsproto.DocumentFormattingParams) (lsproto.DocumentFormattingResponse, error) {
 	return ls.ProvideFormatDocument(
 		ctx,
@@ -2197,12 +2544,179 @@ func (s *Server) handleMultiDocumentHighlight(ctx context.Context, ls *ls.Langua
 	return ls.ProvideMultiDocumentHighlights(ctx, params.TextDocument.Uri, params.Position, params.FilesToSearch)
 }
 
+func (s *Server) handleExplainRelationError(ctx context.Context, ls *ls.LanguageService, params *lsproto.ExplainRelationErrorParams) (lsproto.CustomExplainRelationErrorResponse, error) {
+	return ls.ProvideRelationErrorExplanation(ctx, params.TextDocument.Uri, params.Range, params.Code)
+}
+
+func (s *Server) handleEvaluateType(ctx context.Context, ls *ls.LanguageService, params *lsproto.EvaluateTypeParams) (lsproto.CustomEvaluateTypeResponse, error) {
+	var verbosityLevel int
+	if params.VerbosityLevel != nil {
+		verbosityLevel = int(*params.VerbosityLevel)
+	}
+	return ls.ProvideEvaluatedType(ctx, params.TextDocument.Uri, params.Position, params.Expression, verbosityLevel)
+}
+
 func (s *Server) handleSelectionRange(ctx context.Context, ls *ls.LanguageService, params *lsproto.SelectionRangeParams) (lsproto.SelectionRangeResponse, error) {
 	return ls.ProvideSelectionRanges(ctx, params)
 }
 
-func (s *Server) handleCodeAction(ctx context.Context, ls *ls.LanguageService, params *lsproto.CodeActionParams) (lsproto.CodeActionResponse, error) {
-	return ls.ProvideCodeActions(ctx, params)
+func (s *Server) handleCodeAction(ctx context.Context, languageService *ls.LanguageService, params *lsproto.CodeActionParams) (lsproto.CodeActionResponse, error) {
+	response, err := languageService.ProvideCodeActions(ctx, params)
+	if err != nil {
+		return response, err
+	}
+	if host, plugins, file := s.languageServicePlugins(languageService, params.TextDocument.Uri); host != nil {
+		var diagnostics []*lsproto.Diagnostic
+		if params.Context != nil {
+			diagnostics = params.Context.Diagnostics
+		}
+		actions, pluginErr := host.CodeActions(ctx, plugins, file, params.Range, diagnostics)
+		s.logLanguageServicePluginError(pluginErr)
+		if len(actions) > 0 {
+			if response.CommandOrCodeActionArray == nil {
+				response.CommandOrCodeActionArray = &[]lsproto.CommandOrCodeAction{}
+			}
+			for _, action := range actions {
+				*response.CommandOrCodeActionArray = append(*response.CommandOrCodeActionArray, lsproto.CommandOrCodeAction{CodeAction: action})
+			}
+		}
+	}
+	return response, nil
+}
+
+func (s *Server) handleExecuteCommand(ctx context.Context, params *lsproto.ExecuteCommandParams, _ *lsproto.RequestMessage) (lsproto.ExecuteCommandResponse, error) {
+	switch params.Command {
+	case ls.CommandMoveToFile:
+		return lsproto.ExecuteCommandResponse{}, s.executeMoveToFile(ctx, params)
+	}
+	return lsproto.ExecuteCommandResponse{}, fmt.Errorf("%w: unknown command %q", lsproto.ErrorCodeInvalidParams, params.Command)
+}
+
+// maxMoveToFileSuggestions is the number of files the server offers when it asks for the target of "Move to file".
+const maxMoveToFileSuggestions = 5
+
+// executeMoveToFile applies the "Move to file" refactoring. Its arguments are the URI and selected range of the
+// document offered the refactoring, optionally followed by the URI of the target file. Without a target, the user
+// picks one of a few suggested files, or a new file, from a window/showMessageRequest.
+func (s *Server) executeMoveToFile(ctx context.Context, params *lsproto.ExecuteCommandParams) error {
+	var documentURI, targetURI lsproto.DocumentUri
+	var documentRange lsproto.Range
+	if params.Arguments == nil || len(*params.Arguments) < 2 || len(*params.Arguments) > 3 ||
+		decodeCommandArgument((*params.Arguments)[0], &documentURI) != nil ||
+		decodeCommandArgument((*params.Arguments)[1], &documentRange) != nil ||
+		len(*params.Arguments) == 3 && decodeCommandArgument((*params.Arguments)[2], &targetURI) != nil {
+		return fmt.Errorf("%w: invalid arguments for %s", lsproto.ErrorCodeInvalidParams, params.Command)
+	}
+
+	// An empty target file name moves the statements to a new file.
+	var targetFileName string
+	if targetURI != "" {
+		targetFileName = targetURI.FileName()
+	} else {
+		fileName, ok, err := s.promptForMoveToFileTarget(ctx, documentURI)
+		if err != nil || !ok {
+			return err
+		}
+		targetFileName = fileName
+	}
+
+	// The documents may have changed while the user was choosing.
+	languageService, err := s.session.GetLanguageService(ctx, documentURI)
+	if err != nil {
+		return err
+	}
+	edit, err := languageService.GetMoveToFileEdit(ctx, documentURI, documentRange, targetFileName)
+	if err != nil || edit == nil {
+		return err
+	}
+	if targetFileName != "" {
+		s.recentMoveToFileTargetsMu.Lock()
+		s.recentMoveToFileTargets = slices.Insert(slices.DeleteFunc(s.recentMoveToFileTargets, func(fileName string) bool {
+			return fileName == targetFileName
+		}), 0, targetFileName)
+		s.recentMoveToFileTargets = s.recentMoveToFileTargets[:min(len(s.recentMoveToFileTargets), maxMoveToFileSuggestions)]
+		s.recentMoveToFileTargetsMu.Unlock()
+	}
+	_, err = sendClientRequest(ctx, s, lsproto.WorkspaceApplyEditInfo, &lsproto.ApplyWorkspaceEditParams{Edit: edit})
+	return err
+}
+
+// promptForMoveToFileTarget asks the user for the target of "Move to file" among the files recently moved to and
+// other files in the directory of the document. It returns an empty file name if the user chose a new file, and
+// false if the user chose nothing.
+func (s *Server) promptForMoveToFileTarget(ctx context.Context, documentURI lsproto.DocumentUri) (string, bool, error) {
+	languageService, err := s.session.GetLanguageService(ctx, documentURI)
+	if err != nil {
+		return "", false, err
+	}
+	s.recentMoveToFileTargetsMu.Lock()
+	recent := slices.Clone(s.recentMoveToFileTargets)
+	s.recentMoveToFileTargetsMu.Unlock()
+	targets := languageService.GetMoveToFileTargets(documentURI, recent, maxMoveToFileSuggestions)
+
+	program := languageService.GetProgram()
+	comparePathsOptions := tspath.ComparePathsOptions{
+		UseCaseSensitiveFileNames: program.UseCaseSensitiveFileNames(),
+		CurrentDirectory:          program.GetCurrentDirectory(),
+	}
+	actions := core.Map(targets, func(target string) *lsproto.MessageActionItem {
+		return &lsproto.MessageActionItem{Title: tspath.GetRelativePathFromFile(documentURI.FileName(), target, comparePathsOptions)}
+	})
+	newFileAction := &lsproto.MessageActionItem{Title: diagnostics.Move_to_a_new_file.Localize(locale.FromContext(ctx))}
+	actions = append(actions, newFileAction)
+	selected, err := sendClientRequest(ctx, s, lsproto.WindowShowMessageRequestInfo, &lsproto.ShowMessageRequestParams{
+		Type:    lsproto.MessageTypeInfo,
+		Message: diagnostics.Move_to_file.Localize(locale.FromContext(ctx)),
+		Actions: &actions,
+	})
+	if err != nil || selected.MessageActionItem == nil {
+		return "", false, err
+	}
+	if selected.MessageActionItem.Title == newFileAction.Title {
+		return "", true, nil
+	}
+	index := slices.IndexFunc(actions, func(action *lsproto.MessageActionItem) bool {
+		return action.Title == selected.MessageActionItem.Title
+	})
+	if index < 0 {
+		return "", false, nil
+	}
+	return targets[index], true, nil
+}
+
+// decodeCommandArgument converts a command argument, as decoded from JSON, to the type it was sent as.
+func decodeCommandArgument(argument any, v any) error {
+	data, err := json.Marshal(argument)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// languageServicePlugins returns the plugin host, resolved plugins and file for a plugin request about uri,
+// or a nil host when the project of languageService runs no plugins.
+func (s *Server) languageServicePlugins(languageService *ls.LanguageService, uri lsproto.DocumentUri) (lsplugin.Host, []*lsplugin.Plugin, lsplugin.File) {
+	host, plugins := s.session.LanguageServicePlugins(languageService)
+	if host == nil {
+		return nil, nil, lsplugin.File{}
+	}
+	program := languageService.GetProgram()
+	sourceFile := program.GetSourceFile(uri.FileName())
+	if sourceFile == nil {
+		return nil, nil, lsplugin.File{}
+	}
+	return host, plugins, lsplugin.File{
+		ConfigFileName: program.Options().ConfigFilePath,
+		FileName:       sourceFile.FileName(),
+		Text:           sourceFile.Text(),
+	}
+}
+
+// logLanguageServicePluginError logs plugin failures; they never fail the request being augmented.
+func (s *Server) logLanguageServicePluginError(err error) {
+	if err != nil && !errors.Is(err, context.Canceled) {
+		s.logger.Error(err.Error())
+	}
 }
 
 func (s *Server) handleInlayHint(
@@ -2273,8 +2787,24 @@ func (s *Server) handleCallHierarchyOutgoingCalls(
 	return languageService.ProvideCallHierarchyOutgoingCalls(ctx, params.Item)
 }
 
-func (s *Server) handleSemanticTokensFull(ctx context.Context, ls *ls.LanguageService, params *lsproto.SemanticTokensParams) (lsproto.SemanticTokensResponse, error) {
-	return ls.ProvideSemanticTokens(ctx, params.TextDocument.Uri)
+func (s *Server) handlePrepareTypeHierarchy(
+	ctx context.Context,
+	languageService *ls.LanguageService,
+	params *lsproto.TypeHierarchyPrepareParams,
+) (lsproto.TypeHierarchyPrepareResponse, error) {
+	return languageService.ProvidePrepareTypeHierarchy(ctx, params.TextDocument.Uri, params.Position)
+}
+
+func (s *Server) handleTypeHierarchySupertypes(
+	ctx context.Context,
+	params *lsproto.TypeHierarchySupertypesParams,
+	_ *lsproto.RequestMessage,
+) (lsproto.TypeHierarchySupertypesResponse, error) {
+	languageService, err := s.session.GetLanguageService(ctx, params.Item.Uri)
+	if err != nil {
+		return lsproto.TypeHierarchyItemsOrNull{}, err
+	}
+	return languageService.ProvideTypeHierarchySupertypes(ctx, params.Item)
 }
 
 func (s *Server) handleSemanticTokensRange(ctx context.Context, ls *ls.LanguageService, params *lsproto.SemanticTokensRangeParams) (lsproto.SemanticTokensRangeResponse, error) {
@@ -2336,6 +2866,7 @@ func (s *Server) handleInitializeAPISession(ctx context.Context, params *lsproto
 		}()
 
 		conn := ipc.NewAsyncConn(rwc, apiSession)
+		apiSession.SetConnection(conn)
 		if apiErr := conn.Run(apiCtx); apiErr != nil {
 			s.logger.Errorf("API session %s: %v", apiSession.ID(), apiErr)
 		}
@@ -2375,6 +2906,14 @@ func (s *Server) NpmInstall(cwd string, args []string) ([]byte, error) {
 	return s.npmInstall(cwd, args)
 }
 
+// RunPackageManager implements ata.PackageManagerExecutor
+func (s *Server) RunPackageManager(cwd string, command string, args []string) ([]byte, error) {
+	if s.runPackageManager == nil {
+		return nil, fmt.Errorf("running %s is not supported", command)
+	}
+	return s.runPackageManager(cwd, command, args)
+}
+
 // contentMapperSpawner adapts the server's spawn callback to a content mapper spawner, or returns nil when
 // the server cannot spawn processes.
 func (s *Server) contentMapperSpawner() contentmapper.Spawner {
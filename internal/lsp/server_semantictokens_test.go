@@ -3,6 +3,7 @@ package lsp_test
 import (
 	"context"
 	"io"
+	"slices"
 	"strings"
 	"testing"
 
@@ -183,3 +184,100 @@ declare const console: { log(msg: any): void; };
 	}
 	assert.Assert(t, hasDefaultLibrary, "Expected at least one token with the defaultLibrary modifier (console is declared in the default library); data: %v", data)
 }
+
+func TestSemanticTokensFullDelta(t *testing.T) {
+	t.Parallel()
+	if !bundled.Embedded {
+		t.Skip("bundled files are not embedded")
+	}
+
+	files := map[string]string{
+		"/home/projects/tsconfig.json": `{}`,
+		"/home/projects/test.ts":       "const a = 1;\nfunction f(x: number) { return x + a; }\nclass C {}\n",
+	}
+	fs := bundled.WrapFS(vfstest.FromMap(files, false))
+
+	onServerRequest := func(_ context.Context, req *lsproto.RequestMessage) *lsproto.ResponseMessage {
+		if req.Method == lsproto.MethodClientRegisterCapability || req.Method == lsproto.MethodClientUnregisterCapability {
+			return &lsproto.ResponseMessage{ID: req.ID, JSONRPC: req.JSONRPC, Result: lsproto.Null{}}
+		}
+		return nil
+	}
+
+	client, closeClient := lsptestutil.NewLSPClient(t, lsp.ServerOptions{
+		Err: io.Discard, Cwd: "/home/projects", FS: fs, DefaultLibraryPath: bundled.LibPath(),
+	}, onServerRequest)
+	t.Cleanup(func() { _ = closeClient() })
+
+	initMsg, initResult, ok := lsptestutil.SendRequest(t, client, lsproto.InitializeInfo, &lsproto.InitializeParams{
+		Capabilities: &lsproto.ClientCapabilities{
+			TextDocument: &lsproto.TextDocumentClientCapabilities{
+				SemanticTokens: &lsproto.SemanticTokensClientCapabilities{
+					Requests: &lsproto.ClientSemanticTokensRequestOptions{
+						Full: &lsproto.BooleanOrClientSemanticTokensRequestFullDelta{
+							ClientSemanticTokensRequestFullDelta: &lsproto.ClientSemanticTokensRequestFullDelta{Delta: new(true)},
+						},
+					},
+					TokenTypes:     []string{"namespace", "type", "class", "enum", "interface", "struct", "typeParameter", "parameter", "variable", "property", "enumMember", "event", "function", "method", "macro", "keyword", "modifier", "comment", "string", "number", "regexp", "operator", "decorator"},
+					TokenModifiers: []string{"declaration", "definition", "readonly", "static", "deprecated", "abstract", "async", "modification", "documentation", "defaultLibrary", "local"},
+				},
+			},
+		},
+	})
+	assert.Assert(t, ok && initMsg.AsResponse().Error == nil, "Initialize failed")
+	full := initResult.Capabilities.SemanticTokensProvider.Options.Full
+	assert.Assert(t, full.SemanticTokensFullDelta != nil && *full.SemanticTokensFullDelta.Delta, "Expected delta support to be advertised")
+	lsptestutil.SendNotification(t, client, lsproto.InitializedInfo, &lsproto.InitializedParams{})
+	<-client.Server.InitComplete()
+
+	uri := lsproto.DocumentUri("file:///home/projects/test.ts")
+	lsptestutil.SendNotification(t, client, lsproto.TextDocumentDidOpenInfo, &lsproto.DidOpenTextDocumentParams{
+		TextDocument: &lsproto.TextDocumentItem{Uri: uri, LanguageId: "typescript", Version: 1, Text: files["/home/projects/test.ts"]},
+	})
+
+	requestFull := func() *lsproto.SemanticTokens {
+		msg, result, ok := lsptestutil.SendRequest(t, client, lsproto.TextDocumentSemanticTokensFullInfo, &lsproto.SemanticTokensParams{
+			TextDocument: lsproto.TextDocumentIdentifier{Uri: uri},
+		})
+		assert.Assert(t, ok && msg.AsResponse().Error == nil, "Semantic tokens request failed")
+		assert.Assert(t, result.SemanticTokens != nil && result.SemanticTokens.ResultId != nil, "Expected semantic tokens with a result ID")
+		return result.SemanticTokens
+	}
+	requestDelta := func(previousResultID string) lsproto.SemanticTokensDeltaResponse {
+		msg, result, ok := lsptestutil.SendRequest(t, client, lsproto.TextDocumentSemanticTokensFullDeltaInfo, &lsproto.SemanticTokensDeltaParams{
+			TextDocument:     lsproto.TextDocumentIdentifier{Uri: uri},
+			PreviousResultId: previousResultID,
+		})
+		assert.Assert(t, ok && msg.AsResponse().Error == nil, "Semantic tokens delta request failed")
+		return result
+	}
+
+	first := requestFull()
+
+	// Nothing changed: the cached tokens are reused and the delta is empty.
+	unchanged := requestDelta(*first.ResultId)
+	assert.Assert(t, unchanged.SemanticTokensDelta != nil, "Expected a delta")
+	assert.Equal(t, *unchanged.SemanticTokensDelta.ResultId, *first.ResultId)
+	assert.Equal(t, len(unchanged.SemanticTokensDelta.Edits), 0)
+
+	lsptestutil.SendNotification(t, client, lsproto.TextDocumentDidChangeInfo, &lsproto.DidChangeTextDocumentParams{
+		TextDocument: lsproto.VersionedTextDocumentIdentifier{Uri: uri, Version: 2},
+		ContentChanges: []lsproto.TextDocumentContentChangePartialOrWholeDocument{
+			{WholeDocument: &lsproto.TextDocumentContentChangeWholeDocument{Text: "const a = 1;\nlet b = a;\nfunction f(x: number) { return x + a; }\nclass C {}\n"}},
+		},
+	})
+
+	changed := requestDelta(*first.ResultId)
+	assert.Assert(t, changed.SemanticTokensDelta != nil, "Expected a delta")
+	assert.Assert(t, *changed.SemanticTokensDelta.ResultId != *first.ResultId, "Expected a new result ID")
+	assert.Equal(t, len(changed.SemanticTokensDelta.Edits), 1)
+	edit := changed.SemanticTokensDelta.Edits[0]
+	assert.Assert(t, edit.Start > 0 && int(edit.Start+edit.DeleteCount) < len(first.Data), "Expected an edit in the middle of the tokens: %+v", edit)
+	data := slices.Concat(first.Data[:edit.Start], *edit.Data, first.Data[edit.Start+edit.DeleteCount:])
+	assert.DeepEqual(t, data, requestFull().Data)
+
+	// An unknown result ID gets the full tokens.
+	stale := requestDelta(*first.ResultId)
+	assert.Assert(t, stale.SemanticTokens != nil, "Expected full semantic tokens")
+	assert.DeepEqual(t, stale.SemanticTokens.Data, data)
+}
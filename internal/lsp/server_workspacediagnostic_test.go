@@ -0,0 +1,252 @@
+package lsp_test
+
+import (
+	"context"
+	"io"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/microsoft/typescript-go/internal/bundled"
+	"github.com/microsoft/typescript-go/internal/json"
+	"github.com/microsoft/typescript-go/internal/lsp"
+	"github.com/microsoft/typescript-go/internal/lsp/lsproto"
+	"github.com/microsoft/typescript-go/internal/testutil/lsptestutil"
+	"github.com/microsoft/typescript-go/internal/vfs/vfstest"
+	"gotest.tools/v3/assert"
+)
+
+var workspaceDiagnosticFiles = map[string]string{
+	"/home/projects/tsconfig.json": `{}`,
+	"/home/projects/index.ts":      `import { x } from "./other"; export const y: string = x;`,
+	"/home/projects/other.ts":      `export const x = 1;`,
+}
+
+func initWorkspaceDiagnosticClient(t *testing.T) *lsptestutil.LSPClient {
+	t.Helper()
+	return initWorkspaceDiagnosticClientWithFiles(t, workspaceDiagnosticFiles, nil, "/home/projects/other.ts")
+}
+
+// initWorkspaceDiagnosticClientWithFiles starts a server on files and opens openFiles, so that their projects are
+// loaded. Notifications from the server are passed to onServerNotification, if set.
+func initWorkspaceDiagnosticClientWithFiles(t *testing.T, files map[string]string, onServerNotification lsptestutil.ServerNotificationHandler, openFiles ...string) *lsptestutil.LSPClient {
+	t.Helper()
+
+	fs := bundled.WrapFS(vfstest.FromMap(files, false))
+	onServerRequest := func(_ context.Context, req *lsproto.RequestMessage) *lsproto.ResponseMessage {
+		switch req.Method {
+		case lsproto.MethodClientRegisterCapability, lsproto.MethodClientUnregisterCapability, lsproto.MethodWindowWorkDoneProgressCreate:
+			return &lsproto.ResponseMessage{
+				ID:      req.ID,
+				JSONRPC: req.JSONRPC,
+				Result:  lsproto.Null{},
+			}
+		default:
+			return nil
+		}
+	}
+
+	client, closeClient := lsptestutil.NewLSPClient(t, lsp.ServerOptions{
+		Err:                io.Discard,
+		Cwd:                "/home/projects",
+		FS:                 fs,
+		DefaultLibraryPath: bundled.LibPath(),
+	}, onServerRequest)
+	t.Cleanup(func() { _ = closeClient() })
+	client.OnServerNotification = onServerNotification
+
+	initMsg, _, ok := lsptestutil.SendRequest(t, client, lsproto.InitializeInfo, &lsproto.InitializeParams{
+		Capabilities: &lsproto.ClientCapabilities{},
+	})
+	assert.Assert(t, ok && initMsg.AsResponse().Error == nil, "Initialize failed")
+	lsptestutil.SendNotification(t, client, lsproto.InitializedInfo, &lsproto.InitializedParams{})
+	<-client.Server.InitComplete()
+
+	for _, fileName := range openFiles {
+		lsptestutil.SendNotification(t, client, lsproto.TextDocumentDidOpenInfo, &lsproto.DidOpenTextDocumentParams{
+			TextDocument: &lsproto.TextDocumentItem{Uri: lsproto.DocumentUri("file://" + fileName), LanguageId: "typescript", Text: files[fileName]},
+		})
+	}
+	return client
+}
+
+func reportsByURI(items []lsproto.WorkspaceFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport) map[lsproto.DocumentUri]lsproto.WorkspaceFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport {
+	reports := make(map[lsproto.DocumentUri]lsproto.WorkspaceFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport, len(items))
+	for _, item := range items {
+		if item.FullDocumentDiagnosticReport != nil {
+			reports[item.FullDocumentDiagnosticReport.Uri] = item
+		} else {
+			reports[item.UnchangedDocumentDiagnosticReport.Uri] = item
+		}
+	}
+	return reports
+}
+
+func TestWorkspaceDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	if !bundled.Embedded {
+		t.Skip("bundled files are not embedded")
+	}
+
+	client := initWorkspaceDiagnosticClient(t)
+	indexURI := lsproto.DocumentUri("file:///home/projects/index.ts")
+	otherURI := lsproto.DocumentUri("file:///home/projects/other.ts")
+
+	msg, resp, ok := lsptestutil.SendRequest(t, client, lsproto.WorkspaceDiagnosticInfo, &lsproto.WorkspaceDiagnosticParams{
+		PreviousResultIds: []lsproto.PreviousResultId{},
+	})
+	assert.Assert(t, ok, "expected a response")
+	assert.Assert(t, msg.AsResponse().Error == nil)
+	reports := reportsByURI(resp.Items)
+	assert.Equal(t, len(reports), 2)
+
+	index := reports[indexURI].FullDocumentDiagnosticReport
+	assert.Assert(t, index != nil && index.ResultId != nil)
+	assert.Equal(t, len(index.Items), 1)
+	other := reports[otherURI].FullDocumentDiagnosticReport
+	assert.Assert(t, other != nil && other.ResultId != nil)
+	assert.Equal(t, len(other.Items), 0)
+
+	// Files whose diagnostics did not change are reported as unchanged.
+	_, resp, ok = lsptestutil.SendRequest(t, client, lsproto.WorkspaceDiagnosticInfo, &lsproto.WorkspaceDiagnosticParams{
+		PreviousResultIds: []lsproto.PreviousResultId{
+			{Uri: indexURI, Value: *index.ResultId},
+			{Uri: otherURI, Value: "stale"},
+		},
+	})
+	assert.Assert(t, ok, "expected a response")
+	reports = reportsByURI(resp.Items)
+	assert.Assert(t, reports[indexURI].UnchangedDocumentDiagnosticReport != nil)
+	assert.Equal(t, reports[indexURI].UnchangedDocumentDiagnosticReport.ResultId, *index.ResultId)
+	assert.Assert(t, reports[otherURI].FullDocumentDiagnosticReport != nil)
+}
+
+func TestWorkspaceDiagnosticPartialResults(t *testing.T) {
+	t.Parallel()
+
+	if !bundled.Embedded {
+		t.Skip("bundled files are not embedded")
+	}
+
+	token := "partial"
+	var mu sync.Mutex
+	var partialItems []lsproto.WorkspaceFullDocumentDiagnosticReportOrUnchangedDocumentDiagnosticReport
+	onServerNotification := func(_ context.Context, req *lsproto.RequestMessage) {
+		if req.Method != lsproto.MethodProgress {
+			return
+		}
+		data, err := json.Marshal(req.Params)
+		assert.NilError(t, err)
+		var params struct {
+			Token lsproto.IntegerOrString                        `json:"token"`
+			Value lsproto.WorkspaceDiagnosticReportPartialResult `json:"value"`
+		}
+		assert.NilError(t, json.Unmarshal(data, &params))
+		if params.Token.String != nil && *params.Token.String == token {
+			mu.Lock()
+			partialItems = append(partialItems, params.Value.Items...)
+			mu.Unlock()
+		}
+	}
+	client := initWorkspaceDiagnosticClientWithFiles(t, workspaceDiagnosticFiles, onServerNotification, "/home/projects/other.ts")
+
+	msg, resp, ok := lsptestutil.SendRequest(t, client, lsproto.WorkspaceDiagnosticInfo, &lsproto.WorkspaceDiagnosticParams{
+		PartialResultToken: &lsproto.IntegerOrString{String: &token},
+		PreviousResultIds:  []lsproto.PreviousResultId{},
+	})
+	assert.Assert(t, ok, "expected a response")
+	assert.Assert(t, msg.AsResponse().Error == nil)
+	assert.Equal(t, len(resp.Items), 0)
+
+	mu.Lock()
+	defer mu.Unlock()
+	reports := reportsByURI(partialItems)
+	assert.Equal(t, len(reports), 2)
+	assert.Equal(t, len(reports["file:///home/projects/index.ts"].FullDocumentDiagnosticReport.Items), 1)
+}
+
+func TestWorkspaceDiagnosticHeldUntilChange(t *testing.T) {
+	t.Parallel()
+
+	if !bundled.Embedded {
+		t.Skip("bundled files are not embedded")
+	}
+
+	client := initWorkspaceDiagnosticClient(t)
+	indexURI := lsproto.DocumentUri("file:///home/projects/index.ts")
+	otherURI := lsproto.DocumentUri("file:///home/projects/other.ts")
+
+	_, resp, ok := lsptestutil.SendRequest(t, client, lsproto.WorkspaceDiagnosticInfo, &lsproto.WorkspaceDiagnosticParams{
+		PreviousResultIds: []lsproto.PreviousResultId{},
+	})
+	assert.Assert(t, ok, "expected a response")
+	reports := reportsByURI(resp.Items)
+	previousResultIDs := []lsproto.PreviousResultId{
+		{Uri: indexURI, Value: *reports[indexURI].FullDocumentDiagnosticReport.ResultId},
+		{Uri: otherURI, Value: *reports[otherURI].FullDocumentDiagnosticReport.ResultId},
+	}
+
+	// Nothing changed since the previous results, so the request is held open.
+	wait := lsptestutil.SendRequestAsync(t, client, lsproto.WorkspaceDiagnosticInfo, &lsproto.WorkspaceDiagnosticParams{
+		PreviousResultIds: previousResultIDs,
+	})
+	done := make(chan lsproto.WorkspaceDiagnosticResponse, 1)
+	go func() {
+		_, resp, ok := wait()
+		assert.Check(t, ok, "expected a response")
+		done <- resp
+	}()
+	select {
+	case <-done:
+		t.Fatal("workspace diagnostics were reported although nothing changed")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	lsptestutil.SendNotification(t, client, lsproto.TextDocumentDidChangeInfo, &lsproto.DidChangeTextDocumentParams{
+		TextDocument: lsproto.VersionedTextDocumentIdentifier{Uri: otherURI, Version: 2},
+		ContentChanges: []lsproto.TextDocumentContentChangePartialOrWholeDocument{
+			{WholeDocument: &lsproto.TextDocumentContentChangeWholeDocument{Text: `export const x = "1";`}},
+		},
+	})
+	resp = <-done
+	reports = reportsByURI(resp.Items)
+	assert.Assert(t, reports[indexURI].FullDocumentDiagnosticReport != nil)
+	assert.Equal(t, len(reports[indexURI].FullDocumentDiagnosticReport.Items), 0)
+}
+
+func TestWorkspaceDiagnosticSharedFile(t *testing.T) {
+	t.Parallel()
+
+	if !bundled.Embedded {
+		t.Skip("bundled files are not embedded")
+	}
+
+	files := map[string]string{
+		"/home/projects/strict/tsconfig.json": `{ "compilerOptions": { "strict": true }, "files": ["index.ts", "../shared.ts"] }`,
+		"/home/projects/strict/index.ts":      `export {};`,
+		"/home/projects/loose/tsconfig.json":  `{ "compilerOptions": { "strict": false }, "files": ["index.ts", "../shared.ts"] }`,
+		"/home/projects/loose/index.ts":       `export {};`,
+		"/home/projects/shared.ts":            `export function f(x) { return x; }`,
+	}
+	client := initWorkspaceDiagnosticClientWithFiles(t, files, nil, "/home/projects/strict/index.ts", "/home/projects/loose/index.ts")
+
+	_, resp, ok := lsptestutil.SendRequest(t, client, lsproto.WorkspaceDiagnosticInfo, &lsproto.WorkspaceDiagnosticParams{
+		PreviousResultIds: []lsproto.PreviousResultId{},
+	})
+	assert.Assert(t, ok, "expected a response")
+	var shared []*lsproto.WorkspaceFullDocumentDiagnosticReport
+	for _, item := range resp.Items {
+		if item.FullDocumentDiagnosticReport != nil && item.FullDocumentDiagnosticReport.Uri == "file:///home/projects/shared.ts" {
+			shared = append(shared, item.FullDocumentDiagnosticReport)
+		}
+	}
+	assert.Equal(t, len(shared), 1)
+	// The implicit any is an error only in the strict project.
+	var codes []int32
+	for _, diagnostic := range shared[0].Items {
+		codes = append(codes, *diagnostic.Code.Integer)
+	}
+	assert.Assert(t, slices.Contains(codes, 7006), "codes: %v", codes)
+}
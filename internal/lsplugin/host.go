@@ -0,0 +1,431 @@
+package lsplugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/microsoft/typescript-go/internal/ipc"
+	"github.com/microsoft/typescript-go/internal/json"
+	"github.com/microsoft/typescript-go/internal/locale"
+	"github.com/microsoft/typescript-go/internal/lsp/lsproto"
+)
+
+const initializeTimeout = 5 * time.Second
+
+// File identifies the file a plugin request is made for.
+type File struct {
+	// ConfigFileName is the absolute name of the tsconfig that declared the plugins.
+	ConfigFileName string
+	FileName       string
+	Text           string
+}
+
+// Host augments language service responses with the contributions of language service plugins. Create one
+// with NewHost; Close tears down every plugin process it spawned.
+//
+// Each method asks every plugin that declared the matching capability, in order, and returns the
+// concatenated contributions. Plugins that fail are skipped; their errors are joined into the returned
+// error alongside the contributions of the plugins that succeeded.
+type Host interface {
+	// Diagnostics returns the diagnostics plugins add for file.
+	Diagnostics(ctx context.Context, plugins []*Plugin, file File) ([]*lsproto.Diagnostic, error)
+	// Completions returns the completion items plugins add at position in file.
+	Completions(ctx context.Context, plugins []*Plugin, file File, position lsproto.Position) ([]*lsproto.CompletionItem, error)
+	// CodeActions returns the code actions plugins add for a range of file.
+	CodeActions(ctx context.Context, plugins []*Plugin, file File, rng lsproto.Range, diagnostics []*lsproto.Diagnostic) ([]*lsproto.CodeAction, error)
+	// SetLocale updates the locale used to initialize plugin processes. Existing processes are stopped
+	// and respawned lazily so subsequent requests use the new locale.
+	SetLocale(locale locale.Locale)
+	// Close shuts down every plugin process the host spawned.
+	Close() error
+}
+
+// Spawner starts a child process, returning its stdio as an io.ReadWriteCloser (Read is the process's
+// stdout, Write is its stdin) whose Close tears the process down.
+type Spawner interface {
+	Spawn(command []string, dir string, stderr io.Writer) (io.ReadWriteCloser, error)
+}
+
+// SpawnerFunc adapts a spawn function to the Spawner interface.
+type SpawnerFunc func(command []string, dir string, stderr io.Writer) (io.ReadWriteCloser, error)
+
+func (f SpawnerFunc) Spawn(command []string, dir string, stderr io.Writer) (io.ReadWriteCloser, error) {
+	return f(command, dir, stderr)
+}
+
+// Logger receives plugin protocol and process output as complete log lines.
+type Logger func(message string)
+
+// HostOptions configures optional plugin process logging.
+type HostOptions struct {
+	Logger Logger
+}
+
+// InitializeError reports a plugin process that could not be started or initialized.
+type InitializeError struct {
+	PluginName string
+	Detail     string
+}
+
+func (e *InitializeError) Error() string {
+	return fmt.Sprintf("language service plugin %q failed to initialize: %s", e.PluginName, e.Detail)
+}
+
+// RequestError reports a failed request to an initialized plugin.
+type RequestError struct {
+	PluginName string
+	Method     string
+	err        error
+}
+
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("language service plugin %q failed to handle %s: %v", e.PluginName, e.Method, e.err)
+}
+
+func (e *RequestError) Unwrap() error { return e.err }
+
+// dialFunc establishes a running connection to a plugin. In production it spawns the plugin's process;
+// tests substitute an in-memory connection. It returns the connection, a closer that tears it down, and a
+// channel that is closed once the connection stops running.
+type dialFunc func(ctx context.Context, plugin *Plugin, pluginLocale locale.Locale) (ipc.Conn, io.Closer, <-chan struct{}, error)
+
+// host manages one child process per plugin identity. It is the production implementation of Host.
+type host struct {
+	ctx              context.Context
+	cancel           context.CancelFunc
+	stop             func() bool
+	dial             dialFunc
+	positionEncoding lsproto.PositionEncodingKind
+
+	mu     sync.Mutex
+	locale locale.Locale
+	conns  map[string]*pluginConn
+}
+
+// pluginConn is the connection to one plugin process. Its fields are set once ready is closed.
+type pluginConn struct {
+	ready  chan struct{}
+	conn   ipc.Conn
+	closer io.Closer
+	// err, when non-nil, records that this plugin failed to start; it is cached so we do not repeatedly
+	// try (and fail) to spawn a broken plugin.
+	err          error
+	capabilities Capabilities
+}
+
+var _ Host = (*host)(nil)
+
+// NewHost creates a Host that spawns each plugin's process via the given spawner and drives it over a
+// JSON-RPC connection. Positions exchanged with plugins use positionEncoding. The host's lifetime is bound
+// to ctx: cancelling it tears every plugin process down.
+func NewHost(ctx context.Context, spawner Spawner, pluginLocale locale.Locale, positionEncoding lsproto.PositionEncodingKind) Host {
+	return NewHostWithOptions(ctx, spawner, pluginLocale, positionEncoding, HostOptions{})
+}
+
+// NewHostWithOptions creates a Host with optional protocol and process logging.
+func NewHostWithOptions(ctx context.Context, spawner Spawner, pluginLocale locale.Locale, positionEncoding lsproto.PositionEncodingKind, options HostOptions) Host {
+	logger := options.Logger
+	return newWithDial(ctx, pluginLocale, positionEncoding, func(ctx context.Context, plugin *Plugin, pluginLocale locale.Locale) (ipc.Conn, io.Closer, <-chan struct{}, error) {
+		logPrefix := "[language service plugin: " + plugin.Name + "]"
+		var stderr io.Writer = io.Discard
+		if logger != nil {
+			stderr = &ipc.StderrLogger{Prefix: logPrefix, Logger: logger}
+		}
+		rwc, err := spawner.Spawn(plugin.Exec, plugin.PackageDirectory, stderr)
+		if err != nil {
+			return nil, nil, nil, &InitializeError{PluginName: plugin.Name, Detail: err.Error()}
+		}
+		rwc = &ipc.CloseOnceReadWriteCloser{ReadWriteCloser: rwc}
+		protocol := ipc.Protocol(ipc.NewJSONRPCProtocol(rwc))
+		if logger != nil {
+			protocol = &ipc.LoggingProtocol{Protocol: protocol, Prefix: logPrefix, Logger: logger}
+		}
+		conn := ipc.NewAsyncConnWithProtocol(rwc, protocol, ipc.RejectHandler{Peer: "language service plugin"})
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_ = conn.Run(ctx)
+			_ = rwc.Close()
+		}()
+		return conn, rwc, done, nil
+	})
+}
+
+func newWithDial(ctx context.Context, pluginLocale locale.Locale, positionEncoding lsproto.PositionEncodingKind, dial dialFunc) *host {
+	hostCtx, cancel := context.WithCancel(ctx)
+	h := &host{
+		ctx:              hostCtx,
+		cancel:           cancel,
+		dial:             dial,
+		positionEncoding: positionEncoding,
+		locale:           pluginLocale,
+		conns:            make(map[string]*pluginConn),
+	}
+	h.stop = context.AfterFunc(ctx, func() { _ = h.Close() })
+	return h
+}
+
+func (h *host) Diagnostics(ctx context.Context, plugins []*Plugin, file File) ([]*lsproto.Diagnostic, error) {
+	return collect(ctx, h, plugins, MethodDiagnostics,
+		func(capabilities Capabilities) bool { return capabilities.Diagnostics },
+		func(plugin *Plugin) any { return DiagnosticsParams{FileParams: fileParams(plugin, file)} },
+		func(_ *Plugin, result DiagnosticsResult) ([]*lsproto.Diagnostic, error) {
+			return result.Diagnostics, nil
+		},
+	)
+}
+
+func (h *host) Completions(ctx context.Context, plugins []*Plugin, file File, position lsproto.Position) ([]*lsproto.CompletionItem, error) {
+	return collect(ctx, h, plugins, MethodCompletions,
+		func(capabilities Capabilities) bool { return capabilities.Completions },
+		func(plugin *Plugin) any {
+			return CompletionsParams{FileParams: fileParams(plugin, file), Position: position}
+		},
+		func(_ *Plugin, result CompletionsResult) ([]*lsproto.CompletionItem, error) { return result.Items, nil },
+	)
+}
+
+func (h *host) CodeActions(ctx context.Context, plugins []*Plugin, file File, rng lsproto.Range, diagnostics []*lsproto.Diagnostic) ([]*lsproto.CodeAction, error) {
+	return collect(ctx, h, plugins, MethodCodeActions,
+		func(capabilities Capabilities) bool { return capabilities.CodeActions },
+		func(plugin *Plugin) any {
+			return CodeActionsParams{FileParams: fileParams(plugin, file), Range: rng, Diagnostics: diagnostics}
+		},
+		func(plugin *Plugin, result codeActionsResponse) ([]*lsproto.CodeAction, error) {
+			actions := make([]*lsproto.CodeAction, 0, len(result.Actions))
+			for _, raw := range result.Actions {
+				action, err := decodeCodeAction(plugin, raw)
+				if err != nil {
+					return nil, err
+				}
+				actions = append(actions, action)
+			}
+			return actions, nil
+		},
+	)
+}
+
+// codeActionsResponse is CodeActionsResult as received by the host, which leaves each action undecoded
+// so that its data can be passed through untouched.
+type codeActionsResponse struct {
+	Actions []json.Value `json:"actions"`
+}
+
+// decodeCodeAction decodes a code action returned by plugin. The plugin's data is opaque to the host, so it
+// is carried as is in CodeActionData.PluginData, tagged with the plugin's name.
+func decodeCodeAction(plugin *Plugin, raw json.Value) (*lsproto.CodeAction, error) {
+	var fields map[string]json.Value
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	pluginData, hasData := fields["data"]
+	delete(fields, "data")
+	rest, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	var action lsproto.CodeAction
+	if err := json.Unmarshal(rest, &action); err != nil {
+		return nil, err
+	}
+	action.Data = &lsproto.CodeActionData{Plugin: plugin.Name}
+	if hasData {
+		var data any = pluginData
+		action.Data.PluginData = &data
+	}
+	return &action, nil
+}
+
+func fileParams(plugin *Plugin, file File) FileParams {
+	return FileParams{
+		ConfigFileName: file.ConfigFileName,
+		Config:         plugin.Config,
+		FileName:       file.FileName,
+		Text:           file.Text,
+	}
+}
+
+// collect sends method to every plugin that declared the capability selected by handles, and concatenates
+// the items extracted from their results.
+func collect[Result any, Item any](
+	ctx context.Context,
+	h *host,
+	plugins []*Plugin,
+	method string,
+	handles func(Capabilities) bool,
+	params func(*Plugin) any,
+	items func(*Plugin, Result) ([]Item, error),
+) ([]Item, error) {
+	var all []Item
+	var errs []error
+	for _, plugin := range plugins {
+		conn, capabilities, err := h.connFor(ctx, plugin)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !handles(capabilities) {
+			continue
+		}
+		raw, err := conn.Call(ctx, method, params(plugin))
+		if err != nil {
+			if ctx.Err() != nil {
+				return all, ctx.Err()
+			}
+			errs = append(errs, &RequestError{PluginName: plugin.Name, Method: method, err: err})
+			continue
+		}
+		var result Result
+		if err := json.Unmarshal(raw, &result); err != nil {
+			errs = append(errs, &RequestError{PluginName: plugin.Name, Method: method, err: err})
+			continue
+		}
+		pluginItems, err := items(plugin, result)
+		if err != nil {
+			errs = append(errs, &RequestError{PluginName: plugin.Name, Method: method, err: err})
+			continue
+		}
+		all = append(all, pluginItems...)
+	}
+	return all, errors.Join(errs...)
+}
+
+func (h *host) SetLocale(pluginLocale locale.Locale) {
+	h.mu.Lock()
+	if h.locale.String() == pluginLocale.String() {
+		h.mu.Unlock()
+		return
+	}
+	h.locale = pluginLocale
+	var closers []io.Closer
+	if h.conns != nil {
+		for _, entry := range h.conns {
+			if entry.closer != nil {
+				closers = append(closers, entry.closer)
+			}
+		}
+		h.conns = make(map[string]*pluginConn)
+	}
+	h.mu.Unlock()
+	for _, closer := range closers {
+		_ = closer.Close()
+	}
+}
+
+// Close shuts down every plugin process. It is safe to call more than once and is invoked automatically
+// when the context passed to NewHost is cancelled.
+func (h *host) Close() error {
+	h.stop()
+	h.cancel()
+	h.mu.Lock()
+	var closers []io.Closer
+	for _, entry := range h.conns {
+		if entry.closer != nil {
+			closers = append(closers, entry.closer)
+		}
+	}
+	h.conns = nil
+	h.mu.Unlock()
+	var errs []error
+	for _, closer := range closers {
+		if err := closer.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// connFor returns the initialized connection for a plugin's identity, spawning its process on first use.
+// Plugins sharing an identity share a single process. The process is spawned and initialized outside
+// the host's lock, so requests to other plugins are not held up; concurrent requests to the same plugin
+// wait for the one that spawns it.
+func (h *host) connFor(ctx context.Context, plugin *Plugin) (ipc.Conn, Capabilities, error) {
+	identity := plugin.Identity()
+	h.mu.Lock()
+	if h.conns == nil {
+		h.mu.Unlock()
+		return nil, Capabilities{}, errors.New("language service plugin host is closed")
+	}
+	entry := h.conns[identity]
+	if entry != nil {
+		h.mu.Unlock()
+		select {
+		case <-entry.ready:
+			return entry.conn, entry.capabilities, entry.err
+		case <-ctx.Done():
+			return nil, Capabilities{}, ctx.Err()
+		}
+	}
+	entry = &pluginConn{ready: make(chan struct{})}
+	h.conns[identity] = entry
+	pluginLocale := h.locale
+	h.mu.Unlock()
+
+	defer close(entry.ready)
+	conn, closer, done, err := h.dial(h.ctx, plugin, pluginLocale)
+	var capabilities Capabilities
+	if err == nil {
+		capabilities, err = h.initialize(conn, plugin, pluginLocale)
+		if err != nil {
+			_ = closer.Close()
+		}
+	}
+	h.mu.Lock()
+	// The host may have been closed, or its locale changed, while the plugin was starting.
+	stopped := err == nil && h.conns[identity] != entry
+	if stopped {
+		err = &InitializeError{PluginName: plugin.Name, Detail: "the plugin was stopped while it was starting"}
+	}
+	if err != nil {
+		entry.err = err
+	} else {
+		entry.conn = conn
+		entry.closer = closer
+		entry.capabilities = capabilities
+	}
+	h.mu.Unlock()
+	if err != nil {
+		if stopped {
+			_ = closer.Close()
+		}
+		return nil, Capabilities{}, err
+	}
+	// A plugin process that exits is forgotten, so the next request respawns it.
+	go func() {
+		<-done
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if h.conns[identity] == entry {
+			delete(h.conns, identity)
+		}
+	}()
+	return conn, capabilities, nil
+}
+
+func (h *host) initialize(conn ipc.Conn, plugin *Plugin, pluginLocale locale.Locale) (Capabilities, error) {
+	ctx, cancel := context.WithTimeout(h.ctx, initializeTimeout)
+	defer cancel()
+	raw, err := conn.Call(ctx, MethodInitialize, InitializeParams{
+		ProtocolVersion:  ProtocolVersion,
+		Locale:           pluginLocale.String(),
+		PositionEncoding: h.positionEncoding,
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return Capabilities{}, &InitializeError{PluginName: plugin.Name, Detail: "the plugin did not respond to the initialize request"}
+		}
+		return Capabilities{}, &InitializeError{PluginName: plugin.Name, Detail: err.Error()}
+	}
+	var result InitializeResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return Capabilities{}, &InitializeError{PluginName: plugin.Name, Detail: "invalid initialize response: " + err.Error()}
+	}
+	if result.ProtocolVersion != ProtocolVersion {
+		return Capabilities{}, &InitializeError{PluginName: plugin.Name, Detail: fmt.Sprintf("unsupported protocol version %d (expected %d)", result.ProtocolVersion, ProtocolVersion)}
+	}
+	return result.Capabilities, nil
+}
@@ -0,0 +1,275 @@
+package lsplugin_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/ipc"
+	"github.com/microsoft/typescript-go/internal/json"
+	"github.com/microsoft/typescript-go/internal/locale"
+	"github.com/microsoft/typescript-go/internal/lsp/lsproto"
+	"github.com/microsoft/typescript-go/internal/lsplugin"
+	"github.com/microsoft/typescript-go/internal/vfs"
+	"github.com/microsoft/typescript-go/internal/vfs/vfstest"
+	"gotest.tools/v3/assert"
+)
+
+// fakePlugin is an in-process plugin that reports one diagnostic per file and one completion named after
+// the "prefix" setting of its tsconfig entry. Its code actions carry codeActionData, or fail when it is empty.
+type fakePlugin struct {
+	capabilities   lsplugin.Capabilities
+	codeActionData string
+}
+
+func (p fakePlugin) HandleRequest(ctx context.Context, method string, params json.Value) (any, error) {
+	switch method {
+	case lsplugin.MethodInitialize:
+		return lsplugin.InitializeResult{ProtocolVersion: lsplugin.ProtocolVersion, Capabilities: p.capabilities}, nil
+	case lsplugin.MethodDiagnostics:
+		var p lsplugin.DiagnosticsParams
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		return lsplugin.DiagnosticsResult{Diagnostics: []*lsproto.Diagnostic{{
+			Message: lsproto.StringOrMarkupContent{String: new(fmt.Sprintf("%s has %d characters", p.FileName, len(p.Text)))},
+			Source:  new("fake"),
+		}}}, nil
+	case lsplugin.MethodCompletions:
+		var p lsplugin.CompletionsParams
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		var config struct {
+			Prefix string `json:"prefix"`
+		}
+		if err := json.Unmarshal(p.Config, &config); err != nil {
+			return nil, err
+		}
+		return lsplugin.CompletionsResult{Items: []*lsproto.CompletionItem{{Label: fmt.Sprintf("%s%d", config.Prefix, p.Position.Character)}}}, nil
+	case lsplugin.MethodCodeActions:
+		if p.codeActionData == "" {
+			return nil, errors.New("code actions are broken")
+		}
+		return json.Value(`{"actions":[{"title":"Fix it","kind":"quickfix","data":` + p.codeActionData + `}]}`), nil
+	default:
+		return nil, fmt.Errorf("unexpected method %s", method)
+	}
+}
+
+func (fakePlugin) HandleNotification(ctx context.Context, method string, params json.Value) error {
+	return nil
+}
+
+// fakeSpawner serves each spawn request with handler over a net.Pipe, counting spawns so tests can assert
+// process consolidation.
+type fakeSpawner struct {
+	spawns  atomic.Int32
+	handler ipc.Handler
+}
+
+func (s *fakeSpawner) Spawn(command []string, dir string, stderr io.Writer) (io.ReadWriteCloser, error) {
+	s.spawns.Add(1)
+	client, server := net.Pipe()
+	go func() { _ = ipc.NewAsyncConn(server, s.handler).Run(context.Background()) }()
+	return client, nil
+}
+
+func newPlugin(name string, config string) *lsplugin.Plugin {
+	return &lsplugin.Plugin{
+		Manifest: lsplugin.Manifest{Name: name, Version: "1.0.0", Exec: []string{name}},
+		Config:   json.Value(config),
+	}
+}
+
+func TestHostRequests(t *testing.T) {
+	t.Parallel()
+	spawner := &fakeSpawner{handler: fakePlugin{capabilities: lsplugin.Capabilities{Diagnostics: true, Completions: true, CodeActions: true}}}
+	host := lsplugin.NewHost(t.Context(), spawner, locale.Default, lsproto.PositionEncodingKindUTF16)
+	defer host.Close()
+
+	plugins := []*lsplugin.Plugin{
+		newPlugin("plugin-a", `{"name":"plugin-a","prefix":"a"}`),
+		newPlugin("plugin-b", `{"name":"plugin-b","prefix":"b"}`),
+	}
+	file := lsplugin.File{ConfigFileName: "/project/tsconfig.json", FileName: "/project/index.ts", Text: "let x = 1;"}
+
+	diagnostics, err := host.Diagnostics(t.Context(), plugins, file)
+	assert.NilError(t, err)
+	assert.Equal(t, len(diagnostics), 2)
+	assert.Equal(t, *diagnostics[0].Message.String, "/project/index.ts has 10 characters")
+
+	items, err := host.Completions(t.Context(), plugins, file, lsproto.Position{Line: 0, Character: 4})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{items[0].Label, items[1].Label}, []string{"a4", "b4"})
+
+	actions, err := host.CodeActions(t.Context(), plugins, file, lsproto.Range{}, nil)
+	assert.Equal(t, len(actions), 0)
+	var requestErr *lsplugin.RequestError
+	assert.Assert(t, errors.As(err, &requestErr))
+	assert.Equal(t, requestErr.Method, lsplugin.MethodCodeActions)
+
+	assert.Equal(t, spawner.spawns.Load(), int32(2))
+	_, err = host.Diagnostics(t.Context(), plugins[:1], file)
+	assert.NilError(t, err)
+	assert.Equal(t, spawner.spawns.Load(), int32(2), "plugin processes should be reused")
+}
+
+func TestHostPassesCodeActionDataThrough(t *testing.T) {
+	t.Parallel()
+	spawner := &fakeSpawner{handler: fakePlugin{
+		capabilities:   lsplugin.Capabilities{CodeActions: true},
+		codeActionData: `{"kind":"custom","ids":[1,2]}`,
+	}}
+	host := lsplugin.NewHost(t.Context(), spawner, locale.Default, lsproto.PositionEncodingKindUTF16)
+	defer host.Close()
+
+	plugins := []*lsplugin.Plugin{newPlugin("plugin-a", `{"name":"plugin-a"}`)}
+	actions, err := host.CodeActions(t.Context(), plugins, lsplugin.File{FileName: "/index.ts"}, lsproto.Range{}, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, len(actions), 1)
+	assert.Equal(t, actions[0].Title, "Fix it")
+	assert.Equal(t, actions[0].Data.Plugin, "plugin-a")
+
+	// The data must survive the round trip through the client and back on codeAction/resolve.
+	encoded, err := json.Marshal(actions[0])
+	assert.NilError(t, err)
+	var resolved lsproto.CodeAction
+	assert.NilError(t, json.Unmarshal(encoded, &resolved))
+	assert.Equal(t, resolved.Data.Plugin, "plugin-a")
+	assert.DeepEqual(t, *resolved.Data.PluginData, any(map[string]any{"kind": "custom", "ids": []any{1.0, 2.0}}))
+}
+
+func TestHostSkipsUndeclaredCapabilities(t *testing.T) {
+	t.Parallel()
+	spawner := &fakeSpawner{handler: fakePlugin{capabilities: lsplugin.Capabilities{Completions: true}}}
+	host := lsplugin.NewHost(t.Context(), spawner, locale.Default, lsproto.PositionEncodingKindUTF16)
+	defer host.Close()
+
+	plugins := []*lsplugin.Plugin{newPlugin("plugin-a", `{"name":"plugin-a","prefix":"a"}`)}
+	file := lsplugin.File{FileName: "/index.ts"}
+	diagnostics, err := host.Diagnostics(t.Context(), plugins, file)
+	assert.NilError(t, err)
+	assert.Equal(t, len(diagnostics), 0)
+	actions, err := host.CodeActions(t.Context(), plugins, file, lsproto.Range{}, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, len(actions), 0)
+}
+
+func TestHostInitializeFailure(t *testing.T) {
+	t.Parallel()
+	spawner := lsplugin.SpawnerFunc(func(command []string, dir string, stderr io.Writer) (io.ReadWriteCloser, error) {
+		return nil, errors.New("not found")
+	})
+	host := lsplugin.NewHost(t.Context(), spawner, locale.Default, lsproto.PositionEncodingKindUTF16)
+	defer host.Close()
+
+	_, err := host.Diagnostics(t.Context(), []*lsplugin.Plugin{newPlugin("missing", `{"name":"missing"}`)}, lsplugin.File{})
+	var initializeErr *lsplugin.InitializeError
+	assert.Assert(t, errors.As(err, &initializeErr))
+	assert.Equal(t, initializeErr.PluginName, "missing")
+}
+
+func TestHostRespawnsExitedPlugin(t *testing.T) {
+	t.Parallel()
+	var servers []net.Conn
+	var spawns atomic.Int32
+	spawner := lsplugin.SpawnerFunc(func(command []string, dir string, stderr io.Writer) (io.ReadWriteCloser, error) {
+		spawns.Add(1)
+		client, server := net.Pipe()
+		servers = append(servers, server)
+		go func() {
+			_ = ipc.NewAsyncConn(server, fakePlugin{capabilities: lsplugin.Capabilities{Diagnostics: true}}).Run(context.Background())
+		}()
+		return client, nil
+	})
+	host := lsplugin.NewHost(t.Context(), spawner, locale.Default, lsproto.PositionEncodingKindUTF16)
+	defer host.Close()
+
+	plugins := []*lsplugin.Plugin{newPlugin("plugin-a", `{"name":"plugin-a"}`)}
+	_, err := host.Diagnostics(t.Context(), plugins, lsplugin.File{FileName: "/index.ts"})
+	assert.NilError(t, err)
+	assert.Equal(t, spawns.Load(), int32(1))
+
+	// Simulate the process exiting; requests fail until the host notices and respawns it.
+	assert.NilError(t, servers[0].Close())
+	for range 100 {
+		if _, err = host.Diagnostics(t.Context(), plugins, lsplugin.File{FileName: "/index.ts"}); err == nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.NilError(t, err)
+	assert.Equal(t, spawns.Load(), int32(2))
+}
+
+func TestHostStartsPluginsConcurrently(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	defer close(release)
+	handler := fakePlugin{capabilities: lsplugin.Capabilities{Diagnostics: true}}
+	spawner := lsplugin.SpawnerFunc(func(command []string, dir string, stderr io.Writer) (io.ReadWriteCloser, error) {
+		if command[0] == "slow" {
+			<-release
+			return nil, errors.New("not started")
+		}
+		client, server := net.Pipe()
+		go func() { _ = ipc.NewAsyncConn(server, handler).Run(context.Background()) }()
+		return client, nil
+	})
+	host := lsplugin.NewHost(t.Context(), spawner, locale.Default, lsproto.PositionEncodingKindUTF16)
+	defer host.Close()
+
+	file := lsplugin.File{FileName: "/index.ts"}
+	go func() {
+		_, _ = host.Diagnostics(t.Context(), []*lsplugin.Plugin{newPlugin("slow", `{"name":"slow"}`)}, file)
+	}()
+	diagnostics, err := host.Diagnostics(t.Context(), []*lsplugin.Plugin{newPlugin("fast", `{"name":"fast"}`)}, file)
+	assert.NilError(t, err)
+	assert.Equal(t, len(diagnostics), 1)
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+	fs := vfstest.FromMap(map[string]any{
+		"/project/tsconfig.json": `{}`,
+		"/project/node_modules/styled-plugin/package.json": `{
+			"name": "styled-plugin",
+			"version": "2.0.0",
+			"typescript": { "languageServicePlugin": { "exec": ["node", "./server.js"] } }
+		}`,
+		"/node_modules/no-manifest/package.json": `{ "name": "no-manifest", "version": "1.0.0" }`,
+	}, false /*useCaseSensitiveFileNames*/)
+
+	imports := []*core.PluginImport{
+		{Name: "styled-plugin", Config: json.Value(`{"name":"styled-plugin","tags":["css"]}`)},
+		{Name: "no-manifest"},
+		{Name: "missing"},
+	}
+	plugins, errs := lsplugin.Resolve(resolutionHost{fs}, "/project/tsconfig.json", imports)
+	assert.Equal(t, len(plugins), 1)
+	assert.Equal(t, plugins[0].Identity(), "styled-plugin@2.0.0")
+	assert.DeepEqual(t, plugins[0].Exec, []string{"node", "./server.js"})
+	assert.Equal(t, plugins[0].PackageDirectory, "/project/node_modules/styled-plugin")
+	assert.Equal(t, string(plugins[0].Config), `{"name":"styled-plugin","tags":["css"]}`)
+
+	assert.Equal(t, len(errs), 2)
+	for i, name := range []string{"no-manifest", "missing"} {
+		var resolveErr *lsplugin.ResolveError
+		assert.Assert(t, errors.As(errs[i], &resolveErr))
+		assert.Equal(t, resolveErr.Package, name)
+	}
+}
+
+type resolutionHost struct {
+	fs vfs.FS
+}
+
+func (h resolutionHost) FS() vfs.FS                  { return h.fs }
+func (h resolutionHost) GetCurrentDirectory() string { return "/" }
@@ -0,0 +1,113 @@
+// Package lsplugin drives language service plugins: programs declared in a tsconfig's "plugins" compiler
+// option that contribute diagnostics, completions and code actions on top of the responses computed by
+// the language service.
+//
+// A plugin is declared in tsconfig (core.PluginImport), its implementation is described by the
+// "typescript.languageServicePlugin" field of its npm package's package.json (Manifest), and the two are
+// combined once the package is resolved (Plugin).
+//
+// Plugins run out of process. The Host spawns each plugin's package as a child process and talks to it
+// over a JSON-RPC connection (reusing internal/ipc), the same way content mappers are driven. Processes
+// are consolidated by plugin identity, so many projects that use the same plugin version share a single
+// process.
+package lsplugin
+
+import (
+	"fmt"
+
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/json"
+	"github.com/microsoft/typescript-go/internal/module"
+	"github.com/microsoft/typescript-go/internal/packagejson"
+	"github.com/microsoft/typescript-go/internal/tspath"
+)
+
+// Manifest is the plugin information read from a package's package.json: its name and version (which
+// form the plugin's identity) and the argv used to run it.
+type Manifest struct {
+	Name    string
+	Version string
+	Exec    []string
+}
+
+// Plugin is a resolved language service plugin: its tsconfig entry combined with the Manifest resolved
+// from the package's package.json, plus the package directory used as the plugin's working directory.
+type Plugin struct {
+	Manifest
+	// Config is the plugin's complete tsconfig entry, passed to the plugin with every request.
+	Config           json.Value
+	PackageDirectory string
+}
+
+// Identity returns the plugin's "name@version" identity, or just the name when it declares no version.
+func (p *Plugin) Identity() string {
+	if p.Version == "" {
+		return p.Name
+	}
+	return p.Name + "@" + p.Version
+}
+
+// ResolveError reports a plugin package that could not be resolved or does not describe how to run it.
+type ResolveError struct {
+	Package string
+	Detail  string
+}
+
+func (e *ResolveError) Error() string {
+	return fmt.Sprintf("language service plugin %q could not be loaded: %s", e.Package, e.Detail)
+}
+
+// Resolve locates the package of each plugin import in node_modules (walking up from the directory of
+// configFileName via node module resolution) and reads its package.json. It never executes the package.
+// Plugins that cannot be resolved are omitted from the result and reported as errors.
+func Resolve(host module.ResolutionHost, configFileName string, imports []*core.PluginImport) ([]*Plugin, []error) {
+	if len(imports) == 0 {
+		return nil, nil
+	}
+	resolver := module.NewResolver(host, &core.CompilerOptions{ModuleResolution: core.ModuleResolutionKindBundler}, "", "", nil)
+	var plugins []*Plugin
+	var errs []error
+	for _, pluginImport := range imports {
+		plugin, err := resolvePlugin(host, resolver, configFileName, pluginImport)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		plugins = append(plugins, plugin)
+	}
+	return plugins, errs
+}
+
+func resolvePlugin(host module.ResolutionHost, resolver *module.Resolver, configFileName string, pluginImport *core.PluginImport) (*Plugin, error) {
+	resolved := resolver.ResolvePackageDirectory(pluginImport.Name, configFileName, core.ResolutionModeNone, nil)
+	if resolved == nil || resolved.ResolvedFileName == "" {
+		return nil, &ResolveError{Package: pluginImport.Name, Detail: "the package could not be resolved"}
+	}
+	packageDirectory := resolved.ResolvedFileName
+	contents, ok := host.FS().ReadFile(tspath.CombinePaths(packageDirectory, "package.json"))
+	if !ok {
+		return nil, &ResolveError{Package: pluginImport.Name, Detail: "the package has no package.json"}
+	}
+	fields, err := packagejson.Parse([]byte(contents))
+	if err != nil {
+		return nil, &ResolveError{Package: pluginImport.Name, Detail: "its package.json could not be parsed"}
+	}
+	name, _ := fields.Name.GetValue()
+	if name == "" {
+		return nil, &ResolveError{Package: pluginImport.Name, Detail: "its package.json does not specify a name"}
+	}
+	version, _ := fields.Version.GetValue()
+	manifest, ok := fields.LanguageServicePlugin.GetValue()
+	if !ok {
+		return nil, &ResolveError{Package: pluginImport.Name, Detail: `its package.json does not declare a "typescript.languageServicePlugin" object`}
+	}
+	exec, ok := manifest.Exec.GetValue()
+	if !ok || len(exec) == 0 {
+		return nil, &ResolveError{Package: pluginImport.Name, Detail: `"typescript.languageServicePlugin.exec" must be a non-empty array of strings`}
+	}
+	return &Plugin{
+		Manifest:         Manifest{Name: name, Version: version, Exec: exec},
+		Config:           pluginImport.Config,
+		PackageDirectory: packageDirectory,
+	}, nil
+}
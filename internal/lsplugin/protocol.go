@@ -0,0 +1,86 @@
+package lsplugin
+
+import (
+	"github.com/microsoft/typescript-go/internal/json"
+	"github.com/microsoft/typescript-go/internal/lsp/lsproto"
+)
+
+// ProtocolVersion is the language service plugin protocol version this host speaks.
+const ProtocolVersion = 1
+
+// Language service plugin protocol method names.
+const (
+	MethodInitialize  = "initialize"
+	MethodDiagnostics = "getDiagnostics"
+	MethodCompletions = "getCompletions"
+	MethodCodeActions = "getCodeActions"
+)
+
+// InitializeParams is the parameter object for the initialize request.
+type InitializeParams struct {
+	ProtocolVersion int `json:"protocolVersion"`
+	// Locale is the BCP 47 locale to use for plugin-authored messages, when configured.
+	Locale string `json:"locale,omitempty"`
+	// PositionEncoding is the encoding of every position and range exchanged with the plugin.
+	PositionEncoding lsproto.PositionEncodingKind `json:"positionEncoding"`
+}
+
+// InitializeResult is the plugin's response to the initialize request.
+type InitializeResult struct {
+	ProtocolVersion int          `json:"protocolVersion"`
+	Capabilities    Capabilities `json:"capabilities"`
+}
+
+// Capabilities declares which requests a plugin handles. The host never sends a request the plugin did
+// not declare.
+type Capabilities struct {
+	Diagnostics bool `json:"diagnostics,omitempty"`
+	Completions bool `json:"completions,omitempty"`
+	CodeActions bool `json:"codeActions,omitempty"`
+}
+
+// FileParams identifies the project and file a request is made for. The file's text is sent with every
+// request since the plugin cannot see unsaved editor content.
+type FileParams struct {
+	// ConfigFileName is the absolute name of the tsconfig that declared the plugin.
+	ConfigFileName string `json:"configFileName"`
+	// Config is the plugin's entry in the "plugins" compiler option.
+	Config   json.Value `json:"config"`
+	FileName string     `json:"fileName"`
+	Text     string     `json:"text"`
+}
+
+// DiagnosticsParams is the parameter object for the getDiagnostics request.
+type DiagnosticsParams struct {
+	FileParams
+}
+
+// DiagnosticsResult lists the diagnostics a plugin adds for a file.
+type DiagnosticsResult struct {
+	Diagnostics []*lsproto.Diagnostic `json:"diagnostics"`
+}
+
+// CompletionsParams is the parameter object for the getCompletions request.
+type CompletionsParams struct {
+	FileParams
+	Position lsproto.Position `json:"position"`
+}
+
+// CompletionsResult lists the completion items a plugin adds at a position.
+type CompletionsResult struct {
+	Items []*lsproto.CompletionItem `json:"items"`
+}
+
+// CodeActionsParams is the parameter object for the getCodeActions request.
+type CodeActionsParams struct {
+	FileParams
+	Range lsproto.Range `json:"range"`
+	// Diagnostics are the diagnostics the client reported for Range, including any added by plugins.
+	Diagnostics []*lsproto.Diagnostic `json:"diagnostics"`
+}
+
+// CodeActionsResult lists the code actions a plugin adds for a range. The data of each action is opaque to
+// the host: it reaches the client unchanged, tagged with the name of the plugin.
+type CodeActionsResult struct {
+	Actions []*lsproto.CodeAction `json:"actions"`
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/microsoft/typescript-go/internal/collections"
 	"github.com/microsoft/typescript-go/internal/core"
 	"github.com/microsoft/typescript-go/internal/packagejson"
+	"github.com/microsoft/typescript-go/internal/pnp"
 )
 
 type ModeAwareCache[T any] map[ModeAwareCacheKey]T
@@ -59,6 +60,11 @@ type caches struct {
 	// Doesn't handle other path patterns like in `typesVersions`.
 	parsedPatternsForPathsOnce sync.Once
 	parsedPatternsForPaths     *ParsedPatterns
+
+	// Path of the Plug'n'Play manifest governing each directory ("" if none), and each
+	// loaded manifest by path (nil if it could not be read).
+	pnpManifestPaths collections.SyncMap[string, string]
+	pnpManifests     collections.SyncMap[string, *pnp.Manifest]
 }
 
 func newCaches(
@@ -0,0 +1,92 @@
+package module
+
+import (
+	"strings"
+
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/pnp"
+	"github.com/microsoft/typescript-go/internal/tspath"
+)
+
+// GetPnpManifest returns the Yarn Plug'n'Play manifest governing directory, or nil if directory is not
+// part of a Plug'n'Play project.
+func (r *Resolver) GetPnpManifest(directory string) *pnp.Manifest {
+	manifestPath, ok := r.pnpManifestPaths.Load(directory)
+	if !ok {
+		manifestPath, _ = r.pnpManifestPaths.LoadOrStore(directory, pnp.FindManifest(r.host.FS(), directory))
+	}
+	if manifestPath == "" {
+		return nil
+	}
+	manifest, ok := r.pnpManifests.Load(manifestPath)
+	if !ok {
+		// An unreadable manifest is treated like a missing one, so resolution falls back to node_modules.
+		manifest, _ = pnp.Load(r.host.FS(), manifestPath)
+		manifest, _ = r.pnpManifests.LoadOrStore(manifestPath, manifest)
+	}
+	return manifest
+}
+
+// loadModuleFromPnpManifest resolves a bare specifier through the Plug'n'Play manifest governing the
+// containing directory, in place of the ancestor node_modules lookup. Like the node_modules lookup, it
+// tries the implementation package and then its @types package. The boolean result is false when the
+// containing directory is not part of a Plug'n'Play dependency tree.
+func (r *resolutionState) loadModuleFromPnpManifest(ext extensions, typesScopeOnly bool) (*resolved, bool) {
+	manifest := r.resolver.GetPnpManifest(r.containingDirectory)
+	if manifest == nil {
+		return nil, false
+	}
+	issuer := manifest.FindPackage(r.containingDirectory)
+	if issuer == nil {
+		return nil, false
+	}
+	packageName, rest := ParsePackageName(r.name)
+	if packageName == "" {
+		return continueSearching(), true
+	}
+	if !typesScopeOnly {
+		if pkg, ok := manifest.ResolveDependency(issuer, packageName); ok {
+			if result := r.loadModuleFromPnpPackage(ext, pkg, rest); !result.shouldContinueSearching() {
+				return result, true
+			}
+		}
+	}
+	if ext&extensionsDeclaration != 0 {
+		if pkg, ok := manifest.ResolveDependency(issuer, "@types/"+r.mangleScopedPackageName(packageName)); ok {
+			return r.loadModuleFromPnpPackage(extensionsDeclaration, pkg, rest), true
+		}
+	}
+	return continueSearching(), true
+}
+
+func (r *resolutionState) loadModuleFromPnpPackage(ext extensions, pkg *pnp.Package, rest string) *resolved {
+	candidate := tspath.RemoveTrailingDirectorySeparator(tspath.NormalizePath(tspath.CombinePaths(pkg.Location, rest)))
+	return r.loadModuleFromPackageDirectory(ext, candidate, pkg.Location, rest)
+}
+
+// getPnpTypesPackageNames returns the @types packages that the package of the Plug'n'Play project containing
+// the config directory depends on, named like the node_modules/@types directories they replace.
+func (r *Resolver) getPnpTypesPackageNames(options *core.CompilerOptions) []string {
+	directory := r.host.GetCurrentDirectory()
+	if options.ConfigFilePath != "" {
+		directory = tspath.GetDirectoryPath(options.ConfigFilePath)
+	}
+	manifest := r.GetPnpManifest(directory)
+	if manifest == nil {
+		return nil
+	}
+	pkg := manifest.FindPackage(directory)
+	if pkg == nil {
+		pkg = manifest.TopLevel()
+		if pkg == nil {
+			return nil
+		}
+	}
+	var names []string
+	for _, name := range pkg.DependencyNames() {
+		if typesName, ok := strings.CutPrefix(name, "@types/"); ok {
+			names = append(names, typesName)
+		}
+	}
+	return names
+}
@@ -1015,6 +1015,9 @@ func (r *resolutionState) loadModuleFromNearestNodeModulesDirectory(typesScopeOn
 }
 
 func (r *resolutionState) loadModuleFromNearestNodeModulesDirectoryWorker(ext extensions, mode core.ResolutionMode, typesScopeOnly bool) *resolved {
+	if result, ok := r.loadModuleFromPnpManifest(ext, typesScopeOnly); ok {
+		return result
+	}
 	result, _ := tspath.ForEachAncestorDirectory(
 		r.containingDirectory,
 		func(directory string) (result *resolved, stop bool) {
@@ -1073,7 +1076,11 @@ func (r *resolutionState) loadModuleFromSpecificNodeModulesDirectory(ext extensi
 	if packageName == "" {
 		packageDirectory = candidate
 	}
+	return r.loadModuleFromPackageDirectory(ext, candidate, packageDirectory, rest)
+}
 
+// loadModuleFromPackageDirectory loads `candidate`, which is the path `rest` within the package rooted at `packageDirectory`.
+func (r *resolutionState) loadModuleFromPackageDirectory(ext extensions, candidate string, packageDirectory string, rest string) *resolved {
 	if r.resolvePackageDirectoryOnly {
 		if r.resolver.host.FS().DirectoryExists(packageDirectory) {
 			return &resolved{path: packageDirectory}
@@ -2095,7 +2102,10 @@ func ResolveConfig(moduleName string, containingFile string, host ResolutionHost
 	return resolver.resolveConfig(moduleName, containingFile)
 }
 
-func GetAutomaticTypeDirectiveNames(options *core.CompilerOptions, host ResolutionHost) []string {
+// GetAutomaticTypeDirectiveNames returns the names of the type reference directives included automatically
+// by the "types" compiler option.
+func (r *Resolver) GetAutomaticTypeDirectiveNames(options *core.CompilerOptions) []string {
+	host := r.host
 	if !options.UsesWildcardTypes() {
 		if options.Types != nil {
 			return options.Types
@@ -2105,7 +2115,10 @@ func GetAutomaticTypeDirectiveNames(options *core.CompilerOptions, host Resoluti
 
 	// Walk the primary type lookup locations
 	var wildcardMatches []string
-	typeRoots, _ := options.GetEffectiveTypeRoots(host.GetCurrentDirectory())
+	typeRoots, fromConfig := options.GetEffectiveTypeRoots(host.GetCurrentDirectory())
+	if !fromConfig {
+		wildcardMatches = r.getPnpTypesPackageNames(options)
+	}
 	for _, root := range typeRoots {
 		if host.FS().DirectoryExists(root) {
 			for _, typeDirectivePath := range host.FS().GetAccessibleEntries(root).Directories {
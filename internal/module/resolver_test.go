@@ -1,6 +1,8 @@
 package module_test
 
 import (
+	"archive/zip"
+	"bytes"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -10,6 +12,8 @@ import (
 	"github.com/microsoft/typescript-go/internal/module"
 	"github.com/microsoft/typescript-go/internal/vfs"
 	"github.com/microsoft/typescript-go/internal/vfs/vfstest"
+	"github.com/microsoft/typescript-go/internal/vfs/zipvfs"
+	"gotest.tools/v3/assert"
 )
 
 type resolutionHostStub struct {
@@ -414,3 +418,65 @@ func TestResolvePeerDependencyNilContentsRace(t *testing.T) {
 		}
 	}
 }
+
+func TestResolveModuleNamePnp(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, contents := range map[string]string{
+		"node_modules/lib/package.json": `{"name":"lib","version":"1.0.0","types":"index.d.ts"}`,
+		"node_modules/lib/index.d.ts":   `export * from "dep";`,
+	} {
+		f, err := w.Create(name)
+		assert.NilError(t, err)
+		_, err = f.Write([]byte(contents))
+		assert.NilError(t, err)
+	}
+	assert.NilError(t, w.Close())
+
+	manifest := `{
+		"enableTopLevelFallback": false,
+		"fallbackExclusionList": [],
+		"fallbackPool": [],
+		"ignorePatternData": null,
+		"packageRegistryData": [
+			[null, [[null, {"packageLocation": "./", "packageDependencies": [["lib", "npm:1.0.0"], ["@types/untyped", "npm:2.0.0"]], "linkType": "SOFT"}]]],
+			["lib", [["npm:1.0.0", {"packageLocation": "./.yarn/cache/lib-npm-1.0.0.zip/node_modules/lib/", "packageDependencies": [["lib", "npm:1.0.0"]], "linkType": "HARD"}]]],
+			["@types/untyped", [["npm:2.0.0", {"packageLocation": "./.yarn/unplugged/@types-untyped-npm-2.0.0/node_modules/@types/untyped/", "packageDependencies": [], "linkType": "HARD"}]]]
+		]
+	}`
+	fs := zipvfs.From(vfstest.FromMap(map[string]any{
+		"/repo/.pnp.cjs":                      "",
+		"/repo/.pnp.data.json":                manifest,
+		"/repo/.yarn/cache/lib-npm-1.0.0.zip": buf.String(),
+		"/repo/.yarn/unplugged/@types-untyped-npm-2.0.0/node_modules/@types/untyped/index.d.ts": "export {};",
+		"/repo/node_modules/undeclared/index.d.ts":                                              "export {};",
+		"/repo/src/file.ts": "",
+	}, true))
+	resolver := module.NewResolver(&resolutionHostStub{fs: fs, cwd: "/repo"}, &core.CompilerOptions{
+		ModuleResolution: core.ModuleResolutionKindBundler,
+		Module:           core.ModuleKindESNext,
+	}, "", "", nil)
+	assert.Equal(t, resolver.GetPnpManifest("/repo/src").Directory, "/repo")
+
+	r, _ := resolver.ResolveModuleName("lib", "/repo/src/file.ts", core.ModuleKindESNext, nil)
+	assert.Equal(t, r.ResolvedFileName, "/repo/.yarn/cache/lib-npm-1.0.0.zip/node_modules/lib/index.d.ts")
+	assert.Assert(t, r.IsExternalLibraryImport)
+	assert.Equal(t, r.PackageId.Name, "lib")
+
+	r, _ = resolver.ResolveModuleName("untyped", "/repo/src/file.ts", core.ModuleKindESNext, nil)
+	assert.Equal(t, r.ResolvedFileName, "/repo/.yarn/unplugged/@types-untyped-npm-2.0.0/node_modules/@types/untyped/index.d.ts")
+
+	// Packages the issuer does not declare are not reachable, even if a node_modules directory has them.
+	r, _ = resolver.ResolveModuleName("undeclared", "/repo/src/file.ts", core.ModuleKindESNext, nil)
+	assert.Assert(t, !r.IsResolved())
+	r, _ = resolver.ResolveModuleName("dep", "/repo/.yarn/cache/lib-npm-1.0.0.zip/node_modules/lib/index.d.ts", core.ModuleKindESNext, nil)
+	assert.Assert(t, !r.IsResolved())
+
+	// Automatic type directives come from the @types dependencies of the project containing the config,
+	// even when the current directory is elsewhere.
+	options := &core.CompilerOptions{Types: []string{"*"}, ConfigFilePath: "/repo/tsconfig.json"}
+	resolver = module.NewResolver(&resolutionHostStub{fs: fs, cwd: "/"}, options, "", "", nil)
+	assert.DeepEqual(t, resolver.GetAutomaticTypeDirectiveNames(options), []string{"untyped"})
+}
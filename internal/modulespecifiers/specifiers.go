@@ -808,6 +808,19 @@ func tryGetModuleNameAsNodeModule(
 		return ""
 	}
 
+	if manifest := host.GetPnpManifest(info.SourceDirectory); manifest != nil && manifest.FindPackage(info.SourceDirectory) != nil {
+		// In a Yarn Plug'n'Play project packages are not installed in ancestor node_modules directories;
+		// the package can be named only if the manifest resolves its name to this location for the importer.
+		nodeModulesDirectoryName := moduleSpecifier[parts.TopLevelPackageNameIndex+1:]
+		packageName, _ := module.ParsePackageName(nodeModulesDirectoryName)
+		packageDirectory := moduleSpecifier[:parts.TopLevelPackageNameIndex+1+len(packageName)]
+		resolvedDirectory, ok := manifest.ResolvePackageDirectory(packageName, info.SourceDirectory)
+		if !ok || tspath.ComparePaths(resolvedDirectory, packageDirectory, tspath.ComparePathsOptions{UseCaseSensitiveFileNames: caseSensitive}) != 0 {
+			return ""
+		}
+		return module.GetPackageNameFromTypesPackageName(nodeModulesDirectoryName)
+	}
+
 	globalTypingsCacheLocation := host.GetGlobalTypingsCacheLocation()
 	// Get a path that's relative to node_modules or the importing file's path
 	// if node_modules folder is in this folder or any of its parent folders, no need to keep it.
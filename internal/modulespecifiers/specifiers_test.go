@@ -7,6 +7,7 @@ import (
 	"github.com/microsoft/typescript-go/internal/core"
 	"github.com/microsoft/typescript-go/internal/module"
 	"github.com/microsoft/typescript-go/internal/packagejson"
+	"github.com/microsoft/typescript-go/internal/pnp"
 	"github.com/microsoft/typescript-go/internal/symlinks"
 	"github.com/microsoft/typescript-go/internal/tsoptions"
 	"github.com/microsoft/typescript-go/internal/tspath"
@@ -40,6 +41,10 @@ func (h *mockModuleSpecifierGenerationHost) GetGlobalTypingsCacheLocation() stri
 	return ""
 }
 
+func (h *mockModuleSpecifierGenerationHost) GetPnpManifest(directory string) *pnp.Manifest {
+	return nil
+}
+
 func (h *mockModuleSpecifierGenerationHost) CommonSourceDirectory() string {
 	return h.currentDir
 }
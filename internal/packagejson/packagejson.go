@@ -111,7 +111,8 @@ type Fields struct {
 	HeaderFields
 	PathFields
 	DependencyFields
-	ContentMapper Expected[ContentMapperFields] `json:"-"`
+	ContentMapper         Expected[ContentMapperFields]         `json:"-"`
+	LanguageServicePlugin Expected[LanguageServicePluginFields] `json:"-"`
 }
 
 type ContentMapperFields struct {
@@ -120,8 +121,13 @@ type ContentMapperFields struct {
 	DynamicConfig   Expected[bool]     `json:"dynamicConfig"`
 }
 
+type LanguageServicePluginFields struct {
+	Exec Expected[[]string] `json:"exec"`
+}
+
 type typeScriptFields struct {
-	ContentMapper Expected[ContentMapperFields] `json:"contentMapper"`
+	ContentMapper         Expected[ContentMapperFields]         `json:"contentMapper"`
+	LanguageServicePlugin Expected[LanguageServicePluginFields] `json:"languageServicePlugin"`
 }
 
 func Parse(data []byte) (Fields, error) {
@@ -136,9 +142,10 @@ func Parse(data []byte) (Fields, error) {
 	}
 	typeScript, _ := parsed.TypeScript.GetValue()
 	return Fields{
-		HeaderFields:     parsed.HeaderFields,
-		PathFields:       parsed.PathFields,
-		DependencyFields: parsed.DependencyFields,
-		ContentMapper:    typeScript.ContentMapper,
+		HeaderFields:          parsed.HeaderFields,
+		PathFields:            parsed.PathFields,
+		DependencyFields:      parsed.DependencyFields,
+		ContentMapper:         typeScript.ContentMapper,
+		LanguageServicePlugin: typeScript.LanguageServicePlugin,
 	}, nil
 }
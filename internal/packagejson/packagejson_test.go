@@ -99,6 +99,21 @@ func TestParse(t *testing.T) {
 				}),
 			},
 		},
+		{
+			name: "language service plugin",
+			content: `{
+				"name": "test-plugin",
+				"typescript": {
+					"languageServicePlugin": { "exec": ["node", "./plugin.js"] }
+				}
+			}`,
+			want: packagejson.Fields{
+				HeaderFields: packagejson.HeaderFields{Name: packagejson.ExpectedOf("test-plugin")},
+				LanguageServicePlugin: packagejson.ExpectedOf(packagejson.LanguageServicePluginFields{
+					Exec: packagejson.ExpectedOf([]string{"node", "./plugin.js"}),
+				}),
+			},
+		},
 		{
 			name:    "invalid typescript field is ignored",
 			content: `{ "name": "test-package", "typescript": "invalid" }`,
@@ -122,6 +137,7 @@ func TestParse(t *testing.T) {
 				packagejson.Expected[map[string]string]{},
 				packagejson.Expected[[]string]{},
 				packagejson.Expected[packagejson.ContentMapperFields]{},
+				packagejson.Expected[packagejson.LanguageServicePluginFields]{},
 				packagejson.ExportsOrImports{},
 			))
 		})
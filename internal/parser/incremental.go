@@ -0,0 +1,288 @@
+package parser
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/core"
+)
+
+// binderNodeFlags are the node flags set by the binder rather than the parser. They are cleared on
+// reused nodes, which are cloned from a file that may already be bound.
+const binderNodeFlags = ast.NodeFlagsExportContext |
+	ast.NodeFlagsContainsThis |
+	ast.NodeFlagsReachabilityAndEmitFlags |
+	ast.NodeFlagsThisNodeOrAnySubNodesHasError |
+	ast.NodeFlagsUnreachable
+
+// UpdateSourceFile parses the new text of a source file previously parsed as oldFile. Statements of
+// oldFile that are not affected by the change between the old and the new text are reused rather
+// than parsed again, and the result is equivalent to the result of ParseSourceFile for the new text.
+//
+// Reused statements are cloned, since the binder annotates the nodes of a file in place and oldFile
+// may still be in use. If oldFile may be bound concurrently, the caller must ensure it is already
+// bound. JavaScript and JSON files, whose parse depends on JSDoc elsewhere in the file, are always
+// parsed in full.
+func UpdateSourceFile(opts ast.SourceFileParseOptions, oldFile *ast.SourceFile, sourceText string, scriptKind core.ScriptKind) *ast.SourceFile {
+	if !canUpdateSourceFile(opts, oldFile, scriptKind) {
+		return ParseSourceFile(opts, sourceText, scriptKind)
+	}
+	p := getParser()
+	defer putParser(p)
+	p.initializeState(opts, sourceText, scriptKind)
+	p.reuse = newStatementReuse(oldFile, sourceText)
+	// The parser does not record which statements contain dynamic imports or 'import.meta', so the
+	// flags of the old file are carried over. They are approximations that are never cleared.
+	p.sourceFlags = oldFile.Flags & (ast.NodeFlagsPossiblyContainsDynamicImport | ast.NodeFlagsPossiblyContainsImportMeta)
+	p.nextToken()
+	result := p.parseSourceFileWorker()
+	p.finishReusedStatements(result)
+	return result
+}
+
+func canUpdateSourceFile(opts ast.SourceFileParseOptions, oldFile *ast.SourceFile, scriptKind core.ScriptKind) bool {
+	if oldFile == nil || oldFile.ScriptKind != scriptKind || oldFile.ParseOptions() != opts {
+		return false
+	}
+	if scriptKind != core.ScriptKindTS && scriptKind != core.ScriptKindTSX {
+		return false
+	}
+	return len(oldFile.ReparsedClones) == 0
+}
+
+// statementReuse holds the statements of a previous parse that can be reused at their positions in
+// the new text.
+type statementReuse struct {
+	oldFile    *ast.SourceFile
+	candidates []reusableStatement
+
+	// State of the statement being cloned.
+	delta  int
+	failed bool
+	cloner *ast.NodeVisitor
+}
+
+type reusableStatement struct {
+	node     *ast.Node
+	pos      int  // The position of the statement in the new text.
+	delta    int  // The offset from the position of the statement in the old text.
+	topLevel bool // Whether the statement is in the statement list of the file.
+}
+
+func newStatementReuse(oldFile *ast.SourceFile, newText string) *statementReuse {
+	changeStart, oldChangeEnd, newChangeEnd := textChangeRange(oldFile.Text(), newText)
+	delta := newChangeEnd - oldChangeEnd
+
+	diagnosticPositions := make([]int, 0, len(oldFile.Diagnostics())+len(oldFile.JSDocDiagnostics()))
+	for _, diagnostic := range oldFile.Diagnostics() {
+		diagnosticPositions = append(diagnosticPositions, diagnostic.Pos())
+	}
+	for _, diagnostic := range oldFile.JSDocDiagnostics() {
+		diagnosticPositions = append(diagnosticPositions, diagnostic.Pos())
+	}
+	slices.Sort(diagnosticPositions)
+	hasDiagnostic := func(node *ast.Node) bool {
+		i := sort.SearchInts(diagnosticPositions, node.Pos())
+		return i < len(diagnosticPositions) && diagnosticPositions[i] <= node.End()
+	}
+
+	r := &statementReuse{oldFile: oldFile}
+	// The candidates are collected in preorder, which keeps them sorted by position. The statements
+	// that contain the change are not reusable, but the statements nested in them may be.
+	var visit func(node *ast.Node) bool
+	visit = func(node *ast.Node) bool {
+		if node == nil {
+			return false
+		}
+		switch node.Kind {
+		case ast.KindCaseClause, ast.KindDefaultClause:
+			visit(node.Expression())
+			fallthrough
+		case ast.KindSourceFile, ast.KindBlock, ast.KindModuleBlock:
+			statements := node.Statements()
+			for i, statement := range statements {
+				candidate := reusableStatement{node: statement, topLevel: node.Kind == ast.KindSourceFile}
+				switch {
+				// A statement before the change is reusable only if the statement after it also ends
+				// before the change, since the parser looks at the token after a statement to decide
+				// where the statement ends.
+				case i+1 < len(statements) && statements[i+1].End() < changeStart:
+					candidate.pos = statement.Pos()
+				case statement.Pos() >= oldChangeEnd:
+					candidate.pos = statement.Pos() + delta
+					candidate.delta = delta
+				default:
+					visit(statement)
+					continue
+				}
+				if hasDiagnostic(statement) {
+					visit(statement)
+					continue
+				}
+				r.candidates = append(r.candidates, candidate)
+			}
+		default:
+			if node.End() >= changeStart && node.Pos() <= oldChangeEnd || hasDiagnostic(node) {
+				node.ForEachChild(visit)
+			}
+		}
+		return false
+	}
+	visit(oldFile.AsNode())
+	return r
+}
+
+// textChangeRange returns the range of text that differs between oldText and newText, as its start
+// and its ends in each of the texts.
+func textChangeRange(oldText string, newText string) (start int, oldEnd int, newEnd int) {
+	length := min(len(oldText), len(newText))
+	for start < length && oldText[start] == newText[start] {
+		start++
+	}
+	suffix := 0
+	for suffix < length-start && oldText[len(oldText)-1-suffix] == newText[len(newText)-1-suffix] {
+		suffix++
+	}
+	return start, len(oldText) - suffix, len(newText) - suffix
+}
+
+// reuseStatement returns a clone of the statement of the previous parse that starts at the current
+// position in a statement list of the given kind, and advances past it, or returns nil if there is no
+// such statement.
+func (p *Parser) reuseStatement(kind ParsingContext) *ast.Node {
+	r := p.reuse
+	// A pending parse error is attached to the next node the parser finishes, which would be in the
+	// statement.
+	if r == nil || p.hasParseError {
+		return nil
+	}
+	pos := p.nodePos()
+	i, found := slices.BinarySearchFunc(r.candidates, pos, func(candidate reusableStatement, pos int) int {
+		return candidate.pos - pos
+	})
+	if !found {
+		return nil
+	}
+	candidate := r.candidates[i]
+	// Exported classes are parsed differently at the top level, and all statements are parsed
+	// differently in different contexts.
+	if candidate.topLevel != (kind == PCSourceElements) || candidate.node.Flags&ast.NodeFlagsContextFlags != p.contextFlags {
+		return nil
+	}
+	statement := p.cloneReusedStatement(candidate)
+	if statement == nil {
+		return nil
+	}
+	// The leading trivia of the statement has been scanned already, so only the comment directives
+	// inside the statement are carried over.
+	directives := r.oldFile.CommentDirectives
+	tokenStart := p.scanner.TokenStart() - candidate.delta
+	for i := sort.Search(len(directives), func(i int) bool { return directives[i].Loc.Pos() >= tokenStart }); i < len(directives) && directives[i].Loc.End() <= candidate.node.End(); i++ {
+		p.reusedCommentDirectives = append(p.reusedCommentDirectives, ast.CommentDirective{
+			Loc:  core.NewTextRange(directives[i].Loc.Pos()+candidate.delta, directives[i].Loc.End()+candidate.delta),
+			Kind: directives[i].Kind,
+		})
+	}
+	p.scanner.ResetPos(statement.End())
+	p.nextToken()
+	return statement
+}
+
+// cloneReusedStatement clones a statement of the previous parse at its position in the new text. It
+// returns nil if the statement turns out not to be reusable.
+func (p *Parser) cloneReusedStatement(candidate reusableStatement) *ast.Node {
+	r := p.reuse
+	if r.cloner == nil {
+		r.cloner = p.newReusedNodeCloner()
+	}
+	r.delta = candidate.delta
+	r.failed = false
+	saveIdentifierCount := p.identifierCount
+	saveEagerJSDocLen := len(p.reusedEagerJSDoc)
+	statement := r.cloner.VisitNode(candidate.node)
+	if r.failed {
+		p.identifierCount = saveIdentifierCount
+		p.reusedEagerJSDoc = p.reusedEagerJSDoc[:saveEagerJSDocLen]
+		return nil
+	}
+	ast.SetParentInChildren(statement)
+	return statement
+}
+
+func (p *Parser) newReusedNodeCloner() *ast.NodeVisitor {
+	r := p.reuse
+	shift := func(loc core.TextRange) core.TextRange {
+		return core.NewTextRange(loc.Pos()+r.delta, loc.End()+r.delta)
+	}
+	var v *ast.NodeVisitor
+	v = ast.NewNodeVisitor(
+		func(node *ast.Node) *ast.Node {
+			if r.failed {
+				return node
+			}
+			// Statements with parse errors are not reused, nor are statements with 'await' identifiers,
+			// whose parse depends on whether the file is a module.
+			if node.Flags&ast.NodeFlagsThisNodeHasError != 0 || ast.IsIdentifier(node) && node.Text() == "await" {
+				r.failed = true
+				return node
+			}
+			clone := v.VisitEachChild(node)
+			if clone == node {
+				clone = node.Clone(v.Factory)
+			}
+			clone.Loc = shift(node.Loc)
+			clone.Flags = node.Flags &^ binderNodeFlags
+			if ast.IsIdentifier(node) {
+				p.identifierCount++
+			}
+			// JSDoc that was parsed for the old node, eagerly or on demand, is parsed eagerly for the clone.
+			if node.Flags&ast.NodeFlagsHasJSDoc != 0 && len(node.EagerJSDoc(r.oldFile)) != 0 {
+				p.reusedEagerJSDoc = append(p.reusedEagerJSDoc, clone)
+			}
+			return clone
+		},
+		&p.factory,
+		ast.NodeVisitorHooks{
+			VisitNodes: func(nodes *ast.NodeList, v *ast.NodeVisitor) *ast.NodeList {
+				if nodes == nil {
+					return nil
+				}
+				clone := v.Factory.NewNodeList(p.cloneReusedNodes(v, nodes.Nodes))
+				clone.Loc = shift(nodes.Loc)
+				return clone
+			},
+			VisitModifiers: func(nodes *ast.ModifierList, v *ast.NodeVisitor) *ast.ModifierList {
+				if nodes == nil {
+					return nil
+				}
+				clone := v.Factory.NewModifierList(p.cloneReusedNodes(v, nodes.Nodes))
+				clone.Loc = shift(nodes.Loc)
+				return clone
+			},
+		},
+	)
+	return v
+}
+
+func (p *Parser) cloneReusedNodes(v *ast.NodeVisitor, nodes []*ast.Node) []*ast.Node {
+	clones := p.nodeSliceArena.NewSlice(len(nodes))
+	for i, node := range nodes {
+		clones[i] = v.VisitNode(node)
+	}
+	return clones
+}
+
+// finishReusedStatements records in the new file the properties of the reused statements that the
+// parser collects while scanning.
+func (p *Parser) finishReusedStatements(result *ast.SourceFile) {
+	if len(p.reusedCommentDirectives) != 0 {
+		result.CommentDirectives = append(slices.Clip(result.CommentDirectives), p.reusedCommentDirectives...)
+		slices.SortStableFunc(result.CommentDirectives, func(a, b ast.CommentDirective) int {
+			return a.Loc.Pos() - b.Loc.Pos()
+		})
+	}
+	for _, node := range p.reusedEagerJSDoc {
+		node.JSDoc(result)
+	}
+}
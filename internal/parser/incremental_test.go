@@ -0,0 +1,275 @@
+package parser_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/parser"
+	"github.com/microsoft/typescript-go/internal/testutil/fixtures"
+	"github.com/microsoft/typescript-go/internal/tspath"
+	"github.com/microsoft/typescript-go/internal/vfs/osvfs"
+	"gotest.tools/v3/assert"
+)
+
+const incrementalSourceText = `import { readFile } from "fs";
+import type { Options } from "./options";
+
+/** The default options. */
+export const defaultOptions: Options = { verbose: false, depth: 3 };
+
+// @ts-ignore
+let counter = 0
+
+function increment(step = 1): number {
+    counter += step;
+    return counter;
+}
+
+/**
+ * A queue of items.
+ * @see {@link increment}
+ */
+export class Queue<T> {
+    private items: T[] = [];
+    enqueue(item: T) {
+        this.items.push(item);
+    }
+    dequeue(): T | undefined {
+        return this.items.shift();
+    }
+}
+
+interface Point {
+    x: number;
+    y: number;
+}
+
+enum Direction { Up, Down, Left, Right }
+
+namespace Geometry {
+    export function distance(a: Point, b: Point) {
+        return Math.sqrt((a.x - b.x) ** 2 + (a.y - b.y) ** 2);
+    }
+}
+
+type Mapped<T> = { [K in keyof T]: T[K] extends Function ? never : T[K] };
+
+const template = ` + "`value: ${counter}`" + `;
+const regex = /ab+c/g;
+
+if (counter > 0) {
+    increment();
+}
+
+for (const item of [1, 2, 3]) {
+    increment(item)
+}
+
+const lazy = () => import("./lazy");
+
+// @ts-expect-error
+const wrong: string = 1;
+
+export default Queue;
+`
+
+func BenchmarkUpdateSourceFile(b *testing.B) {
+	for _, f := range fixtures.BenchFixtures {
+		b.Run(f.Name(), func(b *testing.B) {
+			f.SkipIfNotExist(b)
+
+			fileName := tspath.GetNormalizedAbsolutePath(f.Path(), "/")
+			path := tspath.ToPath(fileName, "/", osvfs.FS().UseCaseSensitiveFileNames())
+			sourceText := f.ReadFile(b)
+			scriptKind := core.GetScriptKindFromFileName(fileName)
+
+			opts := ast.SourceFileParseOptions{
+				FileName: fileName,
+				Path:     path,
+			}
+			oldFile := parser.ParseSourceFile(opts, sourceText, scriptKind)
+			// Insert a statement at the start of a line in the middle of the file.
+			middle := strings.IndexByte(sourceText[len(sourceText)/2:], '\n') + len(sourceText)/2 + 1
+			newText := sourceText[:middle] + "x;\n" + sourceText[middle:]
+
+			for b.Loop() {
+				parser.UpdateSourceFile(opts, oldFile, newText, scriptKind)
+			}
+		})
+	}
+}
+
+func TestUpdateSourceFile(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		oldText string
+		newText string
+	}{
+		{"edit inside a function", incrementalSourceText, strings.Replace(incrementalSourceText, "counter += step;", "counter += step * 2;", 1)},
+		{"insert a statement", incrementalSourceText, strings.Replace(incrementalSourceText, "interface Point", "const inserted = 1;\n\ninterface Point", 1)},
+		{"delete a statement", incrementalSourceText, strings.Replace(incrementalSourceText, "enum Direction { Up, Down, Left, Right }\n", "", 1)},
+		{"introduce a syntax error", incrementalSourceText, strings.Replace(incrementalSourceText, "x: number;", "x: number;;(", 1)},
+		{"fix a syntax error", strings.Replace(incrementalSourceText, "x: number;", "x: number;;(", 1), incrementalSourceText},
+		{"open a block comment", incrementalSourceText, strings.Replace(incrementalSourceText, "interface Point", "/* interface Point", 1)},
+		{"open a template literal", incrementalSourceText, strings.Replace(incrementalSourceText, "interface Point", "`interface Point", 1)},
+		{"open a string literal", incrementalSourceText, strings.Replace(incrementalSourceText, "interface Point", "\"interface Point", 1)},
+		{"continue an expression", incrementalSourceText, strings.Replace(incrementalSourceText, "let counter = 0\n", "let counter = 0\n+ 1\n", 1)},
+		{"add an else clause", incrementalSourceText, strings.Replace(incrementalSourceText, "    increment();\n}\n", "    increment();\n} else {\n    counter = 0;\n}\n", 1)},
+		{"add a comment directive", incrementalSourceText, strings.Replace(incrementalSourceText, "interface Point", "// @ts-ignore\ninterface Point", 1)},
+		{"remove a comment directive", incrementalSourceText, strings.Replace(incrementalSourceText, "// @ts-expect-error\n", "", 1)},
+		{"remove the imports", incrementalSourceText, strings.Replace(incrementalSourceText, "import { readFile } from \"fs\";\nimport type { Options } from \"./options\";\n", "", 1)},
+		{"close a block early", incrementalSourceText, strings.Replace(incrementalSourceText, "export function distance", "}\nexport function distance", 1)},
+		{"open a block", incrementalSourceText, strings.Replace(incrementalSourceText, "interface Point", "{\ninterface Point", 1)},
+		{"nest an exported class", incrementalSourceText, strings.Replace(incrementalSourceText, "/**\n * A queue of items.", "namespace N {\n/**\n * A queue of items.", 1) + "}\n"},
+		{"make a function async", incrementalSourceText, strings.Replace(incrementalSourceText, "function increment", "async function increment", 1)},
+		{"append to the end", incrementalSourceText, incrementalSourceText + "export const appended = 1;\n"},
+		{"prepend to the start", incrementalSourceText, "\"use strict\";\n" + incrementalSourceText},
+		{"replace everything", incrementalSourceText, "const x = 1;\n"},
+		{"start from empty", "", incrementalSourceText},
+		{
+			"make a script a module with top-level await",
+			"const a = await;\nfunction f() {}\nconst b = await foo();\n",
+			"export {};\nconst a = await;\nfunction f() {}\nconst b = await foo();\n",
+		},
+		{
+			"make a module a script with top-level await",
+			"export {};\nconst a = await foo();\nfunction f() {}\nconst b = await foo();\n",
+			"const a = await foo();\nfunction f() {}\nconst b = await foo();\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			for _, scriptKind := range []core.ScriptKind{core.ScriptKindTS, core.ScriptKindTSX} {
+				opts := incrementalParseOptions(scriptKind)
+				oldFile := parser.ParseSourceFile(opts, test.oldText, scriptKind)
+				assertUpdatedSourceFile(t, oldFile, test.newText)
+			}
+		})
+	}
+}
+
+func TestUpdateSourceFileRandomEdits(t *testing.T) {
+	t.Parallel()
+	snippets := []string{
+		"", " ", "\n", ";", "{", "}", "(", ")", "[", "]", "<", ">", ",", ".", "=", "=>", "+", "/", "*", "`", "'", "\"",
+		"/*", "*/", "//", "${", "await ", "yield ", "async ", "function ", "class ", "const x = ", "if (a) ", "else ",
+		"export ", "import ", "declare ", "@dec ", "x", "foo()", "a < b", "a > b", "<T>", "as const", "?.", "...",
+		"/** @deprecated */\n", "// @ts-ignore\n",
+	}
+	text := strings.Repeat(incrementalSourceText, 4)
+	for _, scriptKind := range []core.ScriptKind{core.ScriptKindTS, core.ScriptKindTSX} {
+		t.Run(scriptKind.String(), func(t *testing.T) {
+			t.Parallel()
+			rng := rand.New(rand.NewPCG(1, uint64(scriptKind)))
+			file := parser.ParseSourceFile(incrementalParseOptions(scriptKind), text, scriptKind)
+			for i := range 300 {
+				oldText := file.Text()
+				start := rng.IntN(len(oldText) + 1)
+				end := min(start+rng.IntN(20), len(oldText))
+				newText := oldText[:start] + snippets[rng.IntN(len(snippets))] + oldText[end:]
+				// Periodically start over so that the edits do not degrade the text entirely.
+				if i%50 == 49 {
+					newText = text
+				}
+				t.Run(fmt.Sprintf("edit %d", i), func(t *testing.T) {
+					file = assertUpdatedSourceFile(t, file, newText)
+				})
+			}
+		})
+	}
+}
+
+func incrementalParseOptions(scriptKind core.ScriptKind) ast.SourceFileParseOptions {
+	fileName := core.IfElse(scriptKind == core.ScriptKindTSX, "/index.tsx", "/index.ts")
+	return ast.SourceFileParseOptions{
+		FileName: fileName,
+		Path:     tspath.Path(fileName),
+	}
+}
+
+// assertUpdatedSourceFile asserts that updating oldFile to newText produces the same file as parsing
+// newText in full, and returns the updated file.
+func assertUpdatedSourceFile(t *testing.T, oldFile *ast.SourceFile, newText string) *ast.SourceFile {
+	t.Helper()
+	opts := oldFile.ParseOptions()
+	updated := parser.UpdateSourceFile(opts, oldFile, newText, oldFile.ScriptKind)
+	expected := parser.ParseSourceFile(opts, newText, oldFile.ScriptKind)
+
+	assert.DeepEqual(t, diagnosticSummaries(updated.Diagnostics()), diagnosticSummaries(expected.Diagnostics()))
+	assert.Assert(t, slices.Equal(updated.CommentDirectives, expected.CommentDirectives), "comment directives differ")
+	assert.Equal(t, updated.ExternalModuleIndicator == nil, expected.ExternalModuleIndicator == nil)
+	assert.DeepEqual(t, nodeSummaries(updated.Imports()), nodeSummaries(expected.Imports()))
+
+	updatedNodes := preorderNodes(updated)
+	expectedNodes := preorderNodes(expected)
+	if updatedSummaries, expectedSummaries := nodeSummaries(updatedNodes), nodeSummaries(expectedNodes); !slices.Equal(updatedSummaries, expectedSummaries) {
+		assert.DeepEqual(t, updatedSummaries, expectedSummaries)
+	}
+	for i, node := range updatedNodes {
+		assert.Equal(t, len(node.EagerJSDoc(updated)), len(expectedNodes[i].EagerJSDoc(expected)), "eager JSDoc of %s at %d", node.Kind, node.Pos())
+	}
+	// Parsing JSDoc on demand caches it in the file, so it is checked on a separate copy of the
+	// updated file to keep the JSDoc that was parsed eagerly distinguishable in the next update.
+	copied := parser.UpdateSourceFile(opts, oldFile, newText, oldFile.ScriptKind)
+	for i, node := range preorderNodes(copied) {
+		assert.Equal(t, len(node.JSDoc(copied)), len(expectedNodes[i].JSDoc(expected)), "JSDoc of %s at %d", node.Kind, node.Pos())
+	}
+	return updated
+}
+
+type nodeSummary struct {
+	Kind  ast.Kind
+	Pos   int
+	End   int
+	Flags ast.NodeFlags
+}
+
+func nodeSummaries(nodes []*ast.Node) []nodeSummary {
+	summaries := make([]nodeSummary, 0, len(nodes))
+	for _, node := range nodes {
+		// The flags for dynamic imports and 'import.meta' are approximations that updates never clear.
+		flags := node.Flags &^ (ast.NodeFlagsPossiblyContainsDynamicImport | ast.NodeFlagsPossiblyContainsImportMeta)
+		summaries = append(summaries, nodeSummary{node.Kind, node.Pos(), node.End(), flags})
+	}
+	return summaries
+}
+
+type diagnosticSummary struct {
+	Code int32
+	Pos  int
+	End  int
+}
+
+func diagnosticSummaries(diagnostics []*ast.Diagnostic) []diagnosticSummary {
+	summaries := make([]diagnosticSummary, 0, len(diagnostics))
+	for _, diagnostic := range diagnostics {
+		summaries = append(summaries, diagnosticSummary{diagnostic.Code(), diagnostic.Pos(), diagnostic.End()})
+	}
+	return summaries
+}
+
+// preorderNodes returns the nodes of file in preorder, checking that each node's parent is the node
+// it is a child of.
+func preorderNodes(file *ast.SourceFile) []*ast.Node {
+	var nodes []*ast.Node
+	var visit func(node *ast.Node) bool
+	visit = func(node *ast.Node) bool {
+		nodes = append(nodes, node)
+		node.ForEachChild(func(child *ast.Node) bool {
+			if child.Parent != node {
+				panic(fmt.Sprintf("%s at %d has the wrong parent", child.Kind, child.Pos()))
+			}
+			return visit(child)
+		})
+		return false
+	}
+	visit(file.AsNode())
+	return nodes
+}
@@ -99,6 +99,10 @@ type Parser struct {
 	currentParent        *ast.Node
 	setParentFromContext ast.Visitor
 	reparsedClones       []*ast.Node
+
+	reuse                   *statementReuse
+	reusedCommentDirectives []ast.CommentDirective
+	reusedEagerJSDoc        []*ast.Node
 }
 
 func newParser() *Parser {
@@ -345,6 +349,8 @@ type ParserState struct {
 	jsDiagnosticsLen            int
 	jsdocInfosLen               int
 	reparsedClonesLen           int
+	reusedCommentDirectivesLen  int
+	reusedEagerJSDocLen         int
 	statementHasAwaitIdentifier bool
 	hasParseError               bool
 }
@@ -357,6 +363,8 @@ func (p *Parser) mark() ParserState {
 		jsDiagnosticsLen:            len(p.jsDiagnostics),
 		jsdocInfosLen:               len(p.jsdocInfos),
 		reparsedClonesLen:           len(p.reparsedClones),
+		reusedCommentDirectivesLen:  len(p.reusedCommentDirectives),
+		reusedEagerJSDocLen:         len(p.reusedEagerJSDoc),
 		statementHasAwaitIdentifier: p.statementHasAwaitIdentifier,
 		hasParseError:               p.hasParseError,
 	}
@@ -370,6 +378,8 @@ func (p *Parser) rewind(state ParserState) {
 	p.jsDiagnostics = p.jsDiagnostics[0:state.jsDiagnosticsLen]
 	p.jsdocInfos = p.jsdocInfos[0:state.jsdocInfosLen]
 	p.reparsedClones = p.reparsedClones[0:state.reparsedClonesLen]
+	p.reusedCommentDirectives = p.reusedCommentDirectives[0:state.reusedCommentDirectivesLen]
+	p.reusedEagerJSDoc = p.reusedEagerJSDoc[0:state.reusedEagerJSDocLen]
 	p.statementHasAwaitIdentifier = state.statementHasAwaitIdentifier
 	p.hasParseError = state.hasParseError
 }
@@ -618,6 +628,12 @@ func (p *Parser) parseListIndex(kind ParsingContext, parseElement func(p *Parser
 	list := make([]*ast.Node, 0, 16)
 	for i := 0; !p.isListTerminator(kind); i++ {
 		if p.isListElement(kind, false /*inErrorRecovery*/) {
+			if p.reuse != nil && (kind == PCSourceElements || kind == PCBlockStatements || kind == PCSwitchClauseStatements) {
+				if elt := p.reuseStatement(kind); elt != nil {
+					list = append(list, elt)
+					continue
+				}
+			}
 			elt := parseElement(p, len(list))
 			if len(p.reparseList) != 0 {
 				for _, e := range p.reparseList {
@@ -0,0 +1,94 @@
+package parser
+
+import (
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/tspath"
+)
+
+// ParseSummary holds the properties of a parsed source file that the parser
+// collects while scanning and that cannot be recomputed from its syntax tree.
+type ParseSummary struct {
+	NodeCount         int
+	TextCount         int
+	IdentifierCount   int
+	CommentDirectives []ast.CommentDirective
+	// EagerJSDoc lists, in preorder, the indices of the nodes whose JSDoc the
+	// parser parsed eagerly instead of on first access.
+	EagerJSDoc []int
+}
+
+// SummarizeSourceFile returns the parse summary of file.
+func SummarizeSourceFile(file *ast.SourceFile) ParseSummary {
+	summary := ParseSummary{
+		NodeCount:         file.NodeCount,
+		TextCount:         file.TextCount,
+		IdentifierCount:   file.IdentifierCount,
+		CommentDirectives: file.CommentDirectives,
+	}
+	forEachNodeInPreorder(file, func(node *ast.Node, index int) {
+		if len(node.EagerJSDoc(file)) > 0 {
+			summary.EagerJSDoc = append(summary.EagerJSDoc, index)
+		}
+	})
+	return summary
+}
+
+// CanRestoreSourceFile reports whether file can be reproduced by
+// RestoreSourceFile from its syntax tree and parse summary. Files with parse
+// diagnostics or nodes synthesized from JSDoc carry state outside the tree.
+func CanRestoreSourceFile(file *ast.SourceFile) bool {
+	if file.ScriptKind == core.ScriptKindJS || file.ScriptKind == core.ScriptKindJSX || file.ScriptKind == core.ScriptKindJSON {
+		return false
+	}
+	return len(file.Diagnostics()) == 0 &&
+		len(file.JSDiagnostics()) == 0 &&
+		len(file.JSDocDiagnostics()) == 0 &&
+		len(file.ReparsedClones) == 0
+}
+
+// RestoreSourceFile completes a source file whose syntax tree was reconstructed
+// outside the parser, such as one decoded from a serialized AST, so that it is
+// equivalent to the file the parser produced. It sets parent pointers and
+// recomputes the properties the parser derives from the tree and text.
+func RestoreSourceFile(file *ast.SourceFile, scriptKind core.ScriptKind, summary ParseSummary) {
+	p := getParser()
+	defer putParser(p)
+	opts := file.ParseOptions()
+	p.initializeState(opts, file.Text(), scriptKind)
+
+	ast.SetParentInChildren(file.AsNode())
+	file.CommentDirectives = summary.CommentDirectives
+	file.Pragmas = getCommentPragmas(&p.factory, p.sourceText)
+	p.processPragmasIntoFields(file)
+	file.IsDeclarationFile = tspath.IsDeclarationFileName(opts.FileName)
+	file.LanguageVariant = p.languageVariant
+	file.ScriptKind = p.scriptKind
+	file.NodeCount = summary.NodeCount
+	file.TextCount = summary.TextCount
+	file.IdentifierCount = summary.IdentifierCount
+	file.SetHasLazyJSDoc(true)
+	ast.SetExternalModuleIndicator(file, opts.ExternalModuleIndicatorOptions)
+	collectExternalModuleReferences(file)
+
+	if len(summary.EagerJSDoc) > 0 {
+		eager := summary.EagerJSDoc
+		forEachNodeInPreorder(file, func(node *ast.Node, index int) {
+			if len(eager) > 0 && eager[0] == index {
+				node.JSDoc(file)
+				eager = eager[1:]
+			}
+		})
+	}
+}
+
+func forEachNodeInPreorder(file *ast.SourceFile, cb func(node *ast.Node, index int)) {
+	index := 0
+	var visit func(node *ast.Node) bool
+	visit = func(node *ast.Node) bool {
+		cb(node, index)
+		index++
+		return node.ForEachChild(visit)
+	}
+	file.AsNode().ForEachChild(visit)
+}
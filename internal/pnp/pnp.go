@@ -0,0 +1,371 @@
+// Package pnp reads Yarn Plug'n'Play manifests. A Plug'n'Play install has no node_modules directories;
+// instead the project root holds a ".pnp.cjs" file describing every package of the dependency tree: where
+// it is located (often inside a zip archive, see [zipvfs]) and which packages each one may import.
+//
+// [zipvfs]: https://pkg.go.dev/github.com/microsoft/typescript-go/internal/vfs/zipvfs
+package pnp
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/microsoft/typescript-go/internal/json"
+	"github.com/microsoft/typescript-go/internal/tspath"
+	"github.com/microsoft/typescript-go/internal/vfs"
+)
+
+const (
+	// ManifestFileName is the name of the Plug'n'Play loader that marks the root of a Plug'n'Play project.
+	ManifestFileName = ".pnp.cjs"
+	// DataFileName is the name of the manifest data file Yarn writes next to the loader when
+	// pnpEnableInlining is disabled.
+	DataFileName = ".pnp.data.json"
+)
+
+// Locator identifies one package instance of the dependency tree. The top-level locator of a project
+// has an empty name and reference.
+type Locator struct {
+	Name      string
+	Reference string
+}
+
+// Package describes a package instance: its location and the dependencies it may import.
+type Package struct {
+	Locator Locator
+	// Location is the absolute directory of the package, without a trailing separator.
+	Location string
+	// Dependencies maps each name the package may import to the locator it resolves to. A nil locator
+	// marks a peer dependency that was not provided.
+	Dependencies map[string]*Locator
+}
+
+// Manifest is a parsed Plug'n'Play manifest.
+type Manifest struct {
+	// Directory is the directory containing the manifest, which is the root of the project.
+	Directory string
+
+	enableTopLevelFallback bool
+	ignorePattern          *regexp.Regexp
+	fallbackPool           map[string]*Locator
+	fallbackExclusions     map[Locator]struct{}
+	packages               map[Locator]*Package
+	packagesByLocation     map[string]*Package
+}
+
+// FindManifest returns the path of the Plug'n'Play manifest governing directory, or "" if there is none.
+func FindManifest(fs vfs.FS, directory string) string {
+	manifestPath, _ := tspath.ForEachAncestorDirectory(directory, func(dir string) (string, bool) {
+		candidate := tspath.CombinePaths(dir, ManifestFileName)
+		return candidate, fs.FileExists(candidate)
+	})
+	return manifestPath
+}
+
+// Load reads the manifest at manifestPath, preferring the data file next to it when one exists.
+func Load(fs vfs.FS, manifestPath string) (*Manifest, error) {
+	directory := tspath.GetDirectoryPath(manifestPath)
+	if data, ok := fs.ReadFile(tspath.CombinePaths(directory, DataFileName)); ok {
+		return Parse(directory, data)
+	}
+	script, ok := fs.ReadFile(manifestPath)
+	if !ok {
+		return nil, fmt.Errorf("could not read %s", manifestPath)
+	}
+	data, err := extractRuntimeState(script)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", manifestPath, err)
+	}
+	return Parse(directory, data)
+}
+
+type rawManifest struct {
+	EnableTopLevelFallback bool                 `json:"enableTopLevelFallback"`
+	IgnorePatternData      *string              `json:"ignorePatternData"`
+	FallbackExclusionList  []rawExclusion       `json:"fallbackExclusionList"`
+	FallbackPool           []rawDependency      `json:"fallbackPool"`
+	PackageRegistryData    []rawPackageRegistry `json:"packageRegistryData"`
+}
+
+// rawExclusion is a [name, [reference...]] tuple.
+type rawExclusion struct {
+	Name       string
+	References []string
+}
+
+// rawDependency is a [name, reference] tuple, where reference is a string, an [alias, reference] tuple, or
+// null for a missing peer dependency.
+type rawDependency struct {
+	Name    string
+	Locator *Locator
+}
+
+// rawPackageRegistry is a [name, [[reference, information]...]] tuple.
+type rawPackageRegistry struct {
+	Name     *string
+	Packages []rawPackageEntry
+}
+
+// rawPackageEntry is a [reference, information] tuple.
+type rawPackageEntry struct {
+	Reference   *string
+	Information rawPackageInformation
+}
+
+type rawPackageInformation struct {
+	PackageLocation     string          `json:"packageLocation"`
+	PackageDependencies []rawDependency `json:"packageDependencies"`
+}
+
+var (
+	_ json.UnmarshalerFrom = (*rawExclusion)(nil)
+	_ json.UnmarshalerFrom = (*rawDependency)(nil)
+	_ json.UnmarshalerFrom = (*rawPackageRegistry)(nil)
+	_ json.UnmarshalerFrom = (*rawPackageEntry)(nil)
+)
+
+// decodePair decodes a two-element JSON array into first and second.
+func decodePair(dec *json.Decoder, what string, first any, second any) error {
+	var tuple []json.Value
+	if err := json.UnmarshalDecode(dec, &tuple); err != nil {
+		return err
+	}
+	if len(tuple) != 2 {
+		return fmt.Errorf("malformed %s", what)
+	}
+	if err := json.Unmarshal(tuple[0], first); err != nil {
+		return err
+	}
+	return json.Unmarshal(tuple[1], second)
+}
+
+func (e *rawExclusion) UnmarshalJSONFrom(dec *json.Decoder) error {
+	return decodePair(dec, "fallback exclusion", &e.Name, &e.References)
+}
+
+func (d *rawDependency) UnmarshalJSONFrom(dec *json.Decoder) error {
+	var reference any
+	if err := decodePair(dec, "package dependency", &d.Name, &reference); err != nil {
+		return err
+	}
+	switch reference := reference.(type) {
+	case nil:
+		d.Locator = nil
+	case string:
+		d.Locator = &Locator{Name: d.Name, Reference: reference}
+	case []any:
+		if len(reference) != 2 {
+			return errors.New("malformed aliased package dependency")
+		}
+		name, nameOk := reference[0].(string)
+		ref, refOk := reference[1].(string)
+		if !nameOk || !refOk {
+			return errors.New("malformed aliased package dependency")
+		}
+		d.Locator = &Locator{Name: name, Reference: ref}
+	default:
+		return errors.New("malformed package dependency reference")
+	}
+	return nil
+}
+
+func (r *rawPackageRegistry) UnmarshalJSONFrom(dec *json.Decoder) error {
+	return decodePair(dec, "package registry entry", &r.Name, &r.Packages)
+}
+
+func (e *rawPackageEntry) UnmarshalJSONFrom(dec *json.Decoder) error {
+	return decodePair(dec, "package information entry", &e.Reference, &e.Information)
+}
+
+// Parse parses manifest data (the contents of ".pnp.data.json", or the runtime state inlined in
+// ".pnp.cjs") for the project rooted at directory.
+func Parse(directory string, data string) (*Manifest, error) {
+	var raw rawManifest
+	if err := json.Unmarshal([]byte(data), &raw); err != nil {
+		return nil, fmt.Errorf("invalid Plug'n'Play manifest: %w", err)
+	}
+	m := &Manifest{
+		Directory:              directory,
+		enableTopLevelFallback: raw.EnableTopLevelFallback,
+		fallbackPool:           make(map[string]*Locator, len(raw.FallbackPool)),
+		fallbackExclusions:     make(map[Locator]struct{}),
+		packages:               make(map[Locator]*Package),
+		packagesByLocation:     make(map[string]*Package),
+	}
+	if raw.IgnorePatternData != nil {
+		// Yarn's default ignore patterns use lookaheads, which regexp does not support. An ignore pattern
+		// only excludes paths from the dependency tree, so failing to compile one is not fatal.
+		m.ignorePattern, _ = regexp.Compile(*raw.IgnorePatternData)
+	}
+	for _, dependency := range raw.FallbackPool {
+		m.fallbackPool[dependency.Name] = dependency.Locator
+	}
+	for _, exclusion := range raw.FallbackExclusionList {
+		for _, reference := range exclusion.References {
+			m.fallbackExclusions[Locator{Name: exclusion.Name, Reference: reference}] = struct{}{}
+		}
+	}
+	for _, registry := range raw.PackageRegistryData {
+		for _, entry := range registry.Packages {
+			locator := Locator{}
+			if registry.Name != nil {
+				locator.Name = *registry.Name
+			}
+			if entry.Reference != nil {
+				locator.Reference = *entry.Reference
+			}
+			pkg := &Package{
+				Locator:      locator,
+				Location:     tspath.RemoveTrailingDirectorySeparator(tspath.GetNormalizedAbsolutePath(entry.Information.PackageLocation, directory)),
+				Dependencies: make(map[string]*Locator, len(entry.Information.PackageDependencies)),
+			}
+			for _, dependency := range entry.Information.PackageDependencies {
+				pkg.Dependencies[dependency.Name] = dependency.Locator
+			}
+			m.packages[locator] = pkg
+			// Several locators can share a location (e.g. virtual instances of a package with peer
+			// dependencies); the first registered one owns the location, as in Yarn's own lookup.
+			if _, ok := m.packagesByLocation[pkg.Location]; !ok {
+				m.packagesByLocation[pkg.Location] = pkg
+			}
+		}
+	}
+	if _, ok := m.packages[Locator{}]; !ok {
+		return nil, errors.New("invalid Plug'n'Play manifest: missing top-level package")
+	}
+	return m, nil
+}
+
+// TopLevel returns the top-level package of the project.
+func (m *Manifest) TopLevel() *Package {
+	return m.packages[Locator{}]
+}
+
+// Package returns the package with the given locator, or nil.
+func (m *Manifest) Package(locator Locator) *Package {
+	return m.packages[locator]
+}
+
+// FindPackage returns the package that owns path: the package whose location is the nearest ancestor of
+// path. It returns nil when path is outside the dependency tree.
+func (m *Manifest) FindPackage(path string) *Package {
+	if !tspath.StartsWithDirectory(path, m.Directory, true /*useCaseSensitiveFileNames*/) && path != m.Directory {
+		return nil
+	}
+	if m.ignorePattern != nil {
+		relative := strings.TrimPrefix(strings.TrimPrefix(path, m.Directory), "/")
+		if m.ignorePattern.MatchString(relative) {
+			return nil
+		}
+	}
+	pkg, _ := tspath.ForEachAncestorDirectory(path, func(dir string) (*Package, bool) {
+		pkg, ok := m.packagesByLocation[dir]
+		return pkg, ok
+	})
+	return pkg
+}
+
+// ResolveDependency returns the package that name refers to when imported from issuer, applying Yarn's
+// top-level fallback. The boolean result is false when the issuer may not import name.
+func (m *Manifest) ResolveDependency(issuer *Package, name string) (*Package, bool) {
+	if locator, ok := issuer.Dependencies[name]; ok {
+		if locator == nil {
+			return nil, false
+		}
+		pkg := m.packages[*locator]
+		return pkg, pkg != nil
+	}
+	if !m.enableTopLevelFallback {
+		return nil, false
+	}
+	if _, excluded := m.fallbackExclusions[issuer.Locator]; excluded {
+		return nil, false
+	}
+	if issuer != m.TopLevel() {
+		if locator, ok := m.TopLevel().Dependencies[name]; ok && locator != nil {
+			pkg := m.packages[*locator]
+			return pkg, pkg != nil
+		}
+	}
+	if locator := m.fallbackPool[name]; locator != nil {
+		pkg := m.packages[*locator]
+		return pkg, pkg != nil
+	}
+	return nil, false
+}
+
+// ResolvePackageDirectory returns the directory of the package that name refers to when imported from
+// a file in issuerDirectory. The boolean result is false when issuerDirectory is outside the dependency
+// tree or its package may not import name.
+func (m *Manifest) ResolvePackageDirectory(name string, issuerDirectory string) (string, bool) {
+	issuer := m.FindPackage(issuerDirectory)
+	if issuer == nil {
+		return "", false
+	}
+	pkg, ok := m.ResolveDependency(issuer, name)
+	if !ok {
+		return "", false
+	}
+	return pkg.Location, true
+}
+
+// DependencyNames returns the sorted names of the dependencies declared by pkg.
+func (pkg *Package) DependencyNames() []string {
+	names := make([]string, 0, len(pkg.Dependencies))
+	for name, locator := range pkg.Dependencies {
+		if locator != nil {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// extractRuntimeState extracts the manifest data that Yarn inlines in ".pnp.cjs" as the single-quoted
+// JavaScript string assigned to RAW_RUNTIME_STATE.
+func extractRuntimeState(script string) (string, error) {
+	const marker = "RAW_RUNTIME_STATE"
+	index := strings.Index(script, marker)
+	if index < 0 {
+		return "", errors.New("RAW_RUNTIME_STATE not found")
+	}
+	rest := script[index+len(marker):]
+	start := strings.IndexByte(rest, '\'')
+	if start < 0 {
+		return "", errors.New("RAW_RUNTIME_STATE is not a string literal")
+	}
+	var b strings.Builder
+	for i := start + 1; i < len(rest); i++ {
+		c := rest[i]
+		switch c {
+		case '\'':
+			return b.String(), nil
+		case '\\':
+			i++
+			if i >= len(rest) {
+				break
+			}
+			switch escaped := rest[i]; escaped {
+			case '\n':
+				// Line continuation.
+			case '\r':
+				if i+1 < len(rest) && rest[i+1] == '\n' {
+					i++
+				}
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			default:
+				b.WriteByte(escaped)
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", errors.New("unterminated RAW_RUNTIME_STATE string literal")
+}
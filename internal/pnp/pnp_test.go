@@ -0,0 +1,84 @@
+package pnp_test
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/internal/pnp"
+	"github.com/microsoft/typescript-go/internal/vfs/vfstest"
+	"gotest.tools/v3/assert"
+)
+
+const manifestData = `{
+	"__info": ["This file is automatically generated."],
+	"dependencyTreeRoots": [{"name": "app", "reference": "workspace:."}],
+	"enableTopLevelFallback": true,
+	"ignorePatternData": null,
+	"fallbackExclusionList": [["strict", ["npm:1.0.0"]]],
+	"fallbackPool": [["lodash", "npm:4.17.21"]],
+	"packageRegistryData": [
+		[null, [[null, {"packageLocation": "./", "packageDependencies": [["app", "workspace:."], ["lodash", "npm:4.17.21"], ["lib", ["@scope/lib", "npm:2.0.0"]]], "linkType": "SOFT"}]]],
+		["app", [["workspace:.", {"packageLocation": "./", "packageDependencies": [["app", "workspace:."], ["lodash", "npm:4.17.21"], ["lib", ["@scope/lib", "npm:2.0.0"]]], "linkType": "SOFT"}]]],
+		["lodash", [["npm:4.17.21", {"packageLocation": "./.yarn/cache/lodash-npm-4.17.21-6382451519-eb835a2e51.zip/node_modules/lodash/", "packageDependencies": [["lodash", "npm:4.17.21"]], "linkType": "HARD"}]]],
+		["@scope/lib", [["npm:2.0.0", {"packageLocation": "./.yarn/cache/@scope-lib-npm-2.0.0-0123456789-abcdef0123.zip/node_modules/@scope/lib/", "packageDependencies": [["@scope/lib", "npm:2.0.0"], ["react", null]], "linkType": "HARD"}]]],
+		["strict", [["npm:1.0.0", {"packageLocation": "./.yarn/unplugged/strict-npm-1.0.0-0123456789/node_modules/strict/", "packageDependencies": [["strict", "npm:1.0.0"]], "linkType": "HARD"}]]]
+	]
+}`
+
+func TestResolvePackageDirectory(t *testing.T) {
+	t.Parallel()
+	manifest, err := pnp.Parse("/project", manifestData)
+	assert.NilError(t, err)
+
+	tests := []struct {
+		name       string
+		request    string
+		issuer     string
+		expected   string
+		resolvable bool
+	}{
+		{"declared dependency", "lodash", "/project/src", "/project/.yarn/cache/lodash-npm-4.17.21-6382451519-eb835a2e51.zip/node_modules/lodash", true},
+		{"aliased dependency", "lib", "/project/src", "/project/.yarn/cache/@scope-lib-npm-2.0.0-0123456789-abcdef0123.zip/node_modules/@scope/lib", true},
+		{"self reference", "lodash", "/project/.yarn/cache/lodash-npm-4.17.21-6382451519-eb835a2e51.zip/node_modules/lodash/fp", "/project/.yarn/cache/lodash-npm-4.17.21-6382451519-eb835a2e51.zip/node_modules/lodash", true},
+		{"top-level fallback", "lodash", "/project/.yarn/cache/@scope-lib-npm-2.0.0-0123456789-abcdef0123.zip/node_modules/@scope/lib", "/project/.yarn/cache/lodash-npm-4.17.21-6382451519-eb835a2e51.zip/node_modules/lodash", true},
+		{"missing peer dependency", "react", "/project/.yarn/cache/@scope-lib-npm-2.0.0-0123456789-abcdef0123.zip/node_modules/@scope/lib", "", false},
+		{"excluded from fallback", "lodash", "/project/.yarn/unplugged/strict-npm-1.0.0-0123456789/node_modules/strict", "", false},
+		{"undeclared dependency", "express", "/project/src", "", false},
+		{"outside the project", "lodash", "/elsewhere", "", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			directory, ok := manifest.ResolvePackageDirectory(test.request, test.issuer)
+			assert.Equal(t, ok, test.resolvable)
+			assert.Equal(t, directory, test.expected)
+		})
+	}
+}
+
+func TestLoadInlinedRuntimeState(t *testing.T) {
+	t.Parallel()
+	script := "#!/usr/bin/env node\n/* eslint-disable */\n\"use strict\";\n\nconst RAW_RUNTIME_STATE =\n'{\\\n" +
+		`  "__info": ["Don\'t edit this file"],\` + "\n" +
+		`  "enableTopLevelFallback": false,\` + "\n" +
+		`  "ignorePatternData": "(^(?:\\\\.yarn\\\\/sdks(?:\\\\/.*)?)$)",\` + "\n" +
+		`  "fallbackExclusionList": [],\` + "\n" +
+		`  "fallbackPool": [],\` + "\n" +
+		`  "packageRegistryData": [[null, [[null, {"packageLocation": "./", "packageDependencies": [["lodash", "npm:4.17.21"]], "linkType": "SOFT"}]]], ["lodash", [["npm:4.17.21", {"packageLocation": "./.yarn/cache/lodash.zip/node_modules/lodash/", "packageDependencies": [], "linkType": "HARD"}]]]]\` + "\n" +
+		"}';\n\nfunction $$SETUP_STATE(hydrateRuntimeState, basePath) {}\n"
+	fs := vfstest.FromMap(map[string]any{
+		"/project/.pnp.cjs":     script,
+		"/project/src/index.ts": "",
+	}, true /*useCaseSensitiveFileNames*/)
+
+	manifestPath := pnp.FindManifest(fs, "/project/src")
+	assert.Equal(t, manifestPath, "/project/.pnp.cjs")
+	manifest, err := pnp.Load(fs, manifestPath)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, manifest.TopLevel().DependencyNames(), []string{"lodash"})
+	directory, ok := manifest.ResolvePackageDirectory("lodash", "/project/src")
+	assert.Assert(t, ok)
+	assert.Equal(t, directory, "/project/.yarn/cache/lodash.zip/node_modules/lodash")
+	assert.Assert(t, manifest.FindPackage("/project/.yarn/sdks/typescript") == nil, "ignored paths are outside the dependency tree")
+
+	assert.Equal(t, pnp.FindManifest(fs, "/elsewhere"), "")
+}
@@ -473,6 +473,24 @@ func (c *EmitContext) SetOriginalEx(node *ast.Node, original *ast.Node, allowOve
 	}
 }
 
+// Sets the original node for a given node that is an exact copy of it, and also copies the emit state that
+// SetOriginal does not carry over: synthesized comments, the erased type node, and generated name information.
+func (c *EmitContext) SetOriginalForCopy(node *ast.Node, original *ast.Node) {
+	c.SetOriginal(node, original)
+	if emitNode := c.emitNodes.TryGet(original); emitNode != nil {
+		copied := c.emitNodes.Get(node)
+		copied.leadingComments = slices.Clone(emitNode.leadingComments)
+		copied.trailingComments = slices.Clone(emitNode.trailingComments)
+		copied.typeNode = emitNode.typeNode
+	}
+	if ast.IsIdentifier(node) || ast.IsPrivateIdentifier(node) {
+		if autoGenerate := c.autoGenerate[original]; autoGenerate != nil {
+			autoGenerateCopy := *autoGenerate
+			c.autoGenerate[node] = &autoGenerateCopy
+		}
+	}
+}
+
 // Gets the original node for a given node.
 //
 // NOTE: This is the equivalent to reading `node.original` in Strada.
@@ -38,12 +38,19 @@ type CachedTyping struct {
 type TypingsInstallerOptions struct {
 	TypingsLocation string
 	ThrottleLimit   int
+	// PackageManager installs the typings; npm if nil.
+	PackageManager PackageManager
 }
 
 type NpmExecutor interface {
 	NpmInstall(cwd string, args []string) ([]byte, error)
 }
 
+// PackageManagerExecutor is implemented by hosts that can run package managers other than npm.
+type PackageManagerExecutor interface {
+	RunPackageManager(cwd string, command string, args []string) ([]byte, error)
+}
+
 type TypingsInstallerHost interface {
 	NpmExecutor
 	module.ResolutionHost
@@ -51,6 +58,7 @@ type TypingsInstallerHost interface {
 
 type TypingsInstaller struct {
 	typingsLocation string
+	packageManager  PackageManager
 	host            TypingsInstallerHost
 
 	initOnce sync.Once
@@ -65,8 +73,13 @@ type TypingsInstaller struct {
 }
 
 func NewTypingsInstaller(options *TypingsInstallerOptions, host TypingsInstallerHost) *TypingsInstaller {
+	packageManager := options.PackageManager
+	if packageManager == nil {
+		packageManager = npmPackageManager{}
+	}
 	return &TypingsInstaller{
 		typingsLocation:      options.TypingsLocation,
+		packageManager:       packageManager,
 		host:                 host,
 		concurrencySemaphore: make(chan struct{}, options.ThrottleLimit),
 	}
@@ -262,21 +275,35 @@ func (ti *TypingsInstaller) installWorker(
 	logger.Log(fmt.Sprintf("ATA:: #%d with cwd: %s arguments: %v", requestId, ti.typingsLocation, packageNames))
 	ctx := context.Background()
 	err := installNpmPackages(ctx, packageNames, ti.concurrencySemaphore, func(packageNames []string) error {
-		var npmArgs []string
-		npmArgs = append(npmArgs, "install", "--ignore-scripts")
-		npmArgs = append(npmArgs, packageNames...)
-		npmArgs = append(npmArgs, "--save-dev", "--user-agent=\"typesInstaller/"+core.Version()+"\"")
-		output, err := ti.host.NpmInstall(ti.typingsLocation, npmArgs)
+		args := ti.packageManager.InstallArgs(ti.host.FS(), packageNames)
+		if args == nil {
+			return fmt.Errorf("%s cannot install %v", ti.packageManager.Name(), packageNames)
+		}
+		output, err := ti.runPackageManager(args)
 		if err != nil {
 			logger.Log(fmt.Sprintf("ATA:: Output is: %s", output))
 			return err
 		}
 		return nil
 	})
-	logger.Log(fmt.Sprintf("TI:: npm install #%d completed", requestId))
+	logger.Log(fmt.Sprintf("TI:: %s install #%d completed", ti.packageManager.Name(), requestId))
 	return packageNames, err == nil
 }
 
+// runPackageManager runs the package manager in the typings location. npm is run through the host's
+// NpmExecutor; other package managers require the host to implement PackageManagerExecutor.
+func (ti *TypingsInstaller) runPackageManager(args []string) ([]byte, error) {
+	command := ti.packageManager.Command()
+	if command == "npm" {
+		return ti.host.NpmInstall(ti.typingsLocation, args)
+	}
+	executor, ok := ti.host.(PackageManagerExecutor)
+	if !ok {
+		return nil, fmt.Errorf("host cannot run %s", command)
+	}
+	return executor.RunPackageManager(ti.typingsLocation, command, args)
+}
+
 func installNpmPackages(
 	ctx context.Context,
 	packageNames []string,
@@ -365,8 +392,8 @@ func (ti *TypingsInstaller) init(projectID string, fs vfs.FS, logger logging.Log
 		//     }
 
 		ti.ensureTypingsLocationExists(fs, logger)
-		logger.Log("ATA:: Updating types-registry@latest npm package...")
-		if _, err := ti.host.NpmInstall(ti.typingsLocation, []string{"install", "--ignore-scripts", "types-registry@latest"}); err == nil {
+		logger.Log("ATA:: Updating types-registry@latest npm package with " + ti.packageManager.Name() + "...")
+		if err := ti.updateTypesRegistry(fs); err == nil {
 			logger.Log("ATA:: Updated types-registry npm package")
 		} else {
 			logger.Log(fmt.Sprintf("ATA:: Error updating types-registry package: %v", err))
@@ -389,6 +416,15 @@ func (ti *TypingsInstaller) init(projectID string, fs vfs.FS, logger logging.Log
 	})
 }
 
+func (ti *TypingsInstaller) updateTypesRegistry(fs vfs.FS) error {
+	args := ti.packageManager.UpdateArgs(fs, "types-registry@latest")
+	if args == nil {
+		return fmt.Errorf("%s cannot install types-registry", ti.packageManager.Name())
+	}
+	_, err := ti.runPackageManager(args)
+	return err
+}
+
 type npmConfig struct {
 	DevDependencies map[string]any `json:"devDependencies"`
 }
@@ -404,27 +440,24 @@ type npmLock struct {
 func (ti *TypingsInstaller) processCacheLocation(projectID string, fs vfs.FS, logger logging.Logger) {
 	logger.Log("ATA:: Processing cache location " + ti.typingsLocation)
 	packageJson := tspath.CombinePaths(ti.typingsLocation, "package.json")
-	packageLockJson := tspath.CombinePaths(ti.typingsLocation, "package-lock.json")
+	lockfile := tspath.CombinePaths(ti.typingsLocation, ti.packageManager.Lockfile())
 	logger.Log("ATA:: Trying to find '" + packageJson + "'...")
-	if fs.FileExists(packageJson) && fs.FileExists(packageLockJson) {
+	if fs.FileExists(packageJson) && fs.FileExists(lockfile) {
 		var npmConfig npmConfig
 		npmConfigContents := parseNpmConfigOrLock(fs, logger, packageJson, &npmConfig)
-		var npmLock npmLock
-		npmLockContents := parseNpmConfigOrLock(fs, logger, packageLockJson, &npmLock)
+		lockfileContents, _ := fs.ReadFile(lockfile)
+		installedVersions := ti.packageManager.ParseLockfile(lockfileContents)
 
 		logger.Log("ATA:: Loaded content of " + packageJson + ": " + npmConfigContents)
-		logger.Log("ATA:: Loaded content of " + packageLockJson + ": " + npmLockContents)
+		logger.Log("ATA:: Loaded content of " + lockfile + ": " + lockfileContents)
 
 		// !!! sheetal strada uses Node10
 		resolver := module.NewResolver(ti.host, &core.CompilerOptions{ModuleResolution: core.ModuleResolutionKindNodeNext}, "", "", nil)
-		if npmConfig.DevDependencies != nil && (npmLock.Packages != nil || npmLock.Dependencies != nil) {
+		if npmConfig.DevDependencies != nil && installedVersions != nil {
 			for key := range npmConfig.DevDependencies {
-				npmLockValue, npmLockValueExists := npmLock.Packages["node_modules/"+key]
-				if !npmLockValueExists {
-					npmLockValue, npmLockValueExists = npmLock.Dependencies[key]
-				}
-				if !npmLockValueExists {
-					// if package in package.json but not package-lock.json, skip adding to cache so it is reinstalled on next use
+				version, installed := installedVersions[key]
+				if !installed {
+					// if package in package.json but not the lockfile, skip adding to cache so it is reinstalled on next use
 					continue
 				}
 				// key is @types/<package name>
@@ -444,11 +477,13 @@ func (ti *TypingsInstaller) processCacheLocation(projectID string, fs vfs.FS, lo
 					logger.Log("ATA:: New typing for package " + packageName + " from " + typingFile + " conflicts with existing typing file " + existingTypingFile.TypingsLocation)
 				}
 				logger.Log("ATA:: Adding entry into typings cache: " + packageName + " => " + typingFile)
-				version := npmLockValue.Version
 				if version == "" {
 					continue
 				}
-				newVersion := semver.MustParse(version)
+				newVersion, err := semver.TryParseVersion(version)
+				if err != nil {
+					continue
+				}
 				newTyping := &CachedTyping{TypingsLocation: typingFile, Version: &newVersion}
 				ti.packageNameToTypingLocation.Store(packageName, newTyping)
 			}
@@ -474,6 +509,12 @@ func (ti *TypingsInstaller) ensureTypingsLocationExists(fs vfs.FS, logger loggin
 			logger.Log(fmt.Sprintf("ATA:: Npm config file write failed: %v", err))
 		}
 	}
+
+	if writer, ok := ti.packageManager.(packageManagerConfigWriter); ok {
+		if err := writer.WriteConfig(fs, ti.typingsLocation); err != nil {
+			logger.Log(fmt.Sprintf("ATA:: %s config write failed: %v", ti.packageManager.Name(), err))
+		}
+	}
 }
 
 func (ti *TypingsInstaller) typingToFileName(resolver *module.Resolver, packageName string) string {
@@ -0,0 +1,385 @@
+package ata
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/json"
+	"github.com/microsoft/typescript-go/internal/semver"
+	"github.com/microsoft/typescript-go/internal/tspath"
+	"github.com/microsoft/typescript-go/internal/vfs"
+)
+
+// Names of the package managers that can install typings, as selected in the user preferences.
+const (
+	PackageManagerNpm   = "npm"
+	PackageManagerPnpm  = "pnpm"
+	PackageManagerYarn  = "yarn"
+	PackageManagerBun   = "bun"
+	PackageManagerLocal = "local"
+)
+
+// PackageManager installs typings packages into the global typings cache and reads back which versions
+// it installed, so that typings installed in an earlier session can be reused.
+type PackageManager interface {
+	// Name identifies the package manager in log messages.
+	Name() string
+	// Command is the executable that is run to install packages.
+	Command() string
+	// InstallArgs returns the arguments that add the given package specifiers to the devDependencies of the
+	// typings cache, or nil if none of them can be installed.
+	InstallArgs(fs vfs.FS, packageSpecs []string) []string
+	// UpdateArgs returns the arguments that install the given package specifier into the typings cache
+	// without recording it as a typing.
+	UpdateArgs(fs vfs.FS, packageSpec string) []string
+	// Lockfile is the name of the lockfile that the package manager writes next to package.json.
+	Lockfile() string
+	// ParseLockfile returns the installed version of each top-level package recorded in the lockfile.
+	ParseLockfile(contents string) map[string]string
+}
+
+// packageManagerConfigWriter is implemented by package managers that need configuration files in the
+// typings cache before they install into it.
+type packageManagerConfigWriter interface {
+	WriteConfig(fs vfs.FS, typingsLocation string) error
+}
+
+// NewPackageManager returns the package manager with the given name, falling back to npm for unknown
+// names. localRegistryPath is the directory of package tarballs used by the "local" package manager,
+// which cannot be used without one.
+func NewPackageManager(name string, localRegistryPath string) (PackageManager, error) {
+	switch name {
+	case PackageManagerPnpm:
+		return pnpmPackageManager{}, nil
+	case PackageManagerYarn:
+		return yarnPackageManager{}, nil
+	case PackageManagerBun:
+		return bunPackageManager{}, nil
+	case PackageManagerLocal:
+		if localRegistryPath == "" {
+			return nil, errors.New("the local package manager requires a local registry path")
+		}
+		return localPackageManager{registryPath: localRegistryPath}, nil
+	}
+	return npmPackageManager{}, nil
+}
+
+type npmPackageManager struct{}
+
+func (npmPackageManager) Name() string     { return PackageManagerNpm }
+func (npmPackageManager) Command() string  { return "npm" }
+func (npmPackageManager) Lockfile() string { return "package-lock.json" }
+
+func (npmPackageManager) InstallArgs(fs vfs.FS, packageSpecs []string) []string {
+	var args []string
+	args = append(args, "install", "--ignore-scripts")
+	args = append(args, packageSpecs...)
+	args = append(args, "--save-dev", "--user-agent=\"typesInstaller/"+core.Version()+"\"")
+	return args
+}
+
+func (npmPackageManager) UpdateArgs(fs vfs.FS, packageSpec string) []string {
+	return []string{"install", "--ignore-scripts", packageSpec}
+}
+
+func (npmPackageManager) ParseLockfile(contents string) map[string]string {
+	var lock npmLock
+	if err := json.Unmarshal([]byte(contents), &lock); err != nil || lock.Packages == nil && lock.Dependencies == nil {
+		return nil
+	}
+	// Lockfile version 1 lists packages under "dependencies"; later versions list them under "packages",
+	// keyed by their location, and keep "dependencies" only for compatibility.
+	versions := make(map[string]string, len(lock.Dependencies)+len(lock.Packages))
+	for name, entry := range lock.Dependencies {
+		versions[name] = entry.Version
+	}
+	for location, entry := range lock.Packages {
+		if name, ok := strings.CutPrefix(location, "node_modules/"); ok {
+			versions[name] = entry.Version
+		}
+	}
+	return versions
+}
+
+type pnpmPackageManager struct{}
+
+func (pnpmPackageManager) Name() string     { return PackageManagerPnpm }
+func (pnpmPackageManager) Command() string  { return "pnpm" }
+func (pnpmPackageManager) Lockfile() string { return "pnpm-lock.yaml" }
+
+func (pnpmPackageManager) InstallArgs(fs vfs.FS, packageSpecs []string) []string {
+	return append([]string{"add", "--save-dev", "--ignore-scripts"}, packageSpecs...)
+}
+
+func (pnpmPackageManager) UpdateArgs(fs vfs.FS, packageSpec string) []string {
+	return []string{"add", "--ignore-scripts", packageSpec}
+}
+
+// ParseLockfile reads the dependencies of the root project from pnpm-lock.yaml. Lockfile version 9 lists
+// them under "importers", version 6 at the top level with a "version" field per package, and earlier
+// versions map each package directly to its version.
+func (pnpmPackageManager) ParseLockfile(contents string) map[string]string {
+	versions := map[string]string{}
+	sectionIndent := -1
+	entryIndent := -1
+	name := ""
+	for line := range strings.Lines(contents) {
+		line = strings.TrimRight(line, "\r\n")
+		trimmed := strings.TrimLeft(line, " ")
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		indent := len(line) - len(trimmed)
+		key, value, _ := strings.Cut(trimmed, ":")
+		value = strings.TrimSpace(value)
+		switch {
+		case sectionIndent >= 0 && indent > sectionIndent:
+			if entryIndent < 0 {
+				entryIndent = indent
+			}
+			if indent == entryIndent {
+				name = strings.Trim(key, `'"`)
+				if value != "" {
+					setVersionIfAbsent(versions, name, pnpmVersion(value))
+				}
+			} else if key == "version" && name != "" {
+				setVersionIfAbsent(versions, name, pnpmVersion(value))
+			}
+		case value == "" && (key == "dependencies" || key == "devDependencies"):
+			sectionIndent = indent
+			entryIndent = -1
+			name = ""
+		default:
+			sectionIndent = -1
+		}
+	}
+	return versions
+}
+
+// pnpmVersion strips the peer dependency suffix, as in "1.0.0(react@18.2.0)", and quotes from a version.
+func pnpmVersion(value string) string {
+	value = strings.Trim(value, `'"`)
+	if index := strings.IndexByte(value, '('); index >= 0 {
+		value = value[:index]
+	}
+	return value
+}
+
+type yarnPackageManager struct{}
+
+func (yarnPackageManager) Name() string     { return PackageManagerYarn }
+func (yarnPackageManager) Command() string  { return "yarn" }
+func (yarnPackageManager) Lockfile() string { return "yarn.lock" }
+
+// InstallArgs leaves install scripts to the configuration written by WriteConfig, since Yarn 2 and later
+// reject the --ignore-scripts flag of Yarn 1.
+func (yarnPackageManager) InstallArgs(fs vfs.FS, packageSpecs []string) []string {
+	return append([]string{"add", "--dev"}, packageSpecs...)
+}
+
+func (yarnPackageManager) UpdateArgs(fs vfs.FS, packageSpec string) []string {
+	return []string{"add", packageSpec}
+}
+
+// yarnConfigFiles are written to the typings cache so that every Yarn version skips install scripts and
+// Yarn 2 and later install into node_modules, where typings are resolved, rather than using Plug'n'Play.
+// Yarn 1 reads .yarnrc and later versions read .yarnrc.yml. The empty yarn.lock makes the typings cache
+// the root of its own project for Yarn 2 and later, even inside another Yarn project.
+var yarnConfigFiles = []struct{ name, contents string }{
+	{".yarnrc", "ignore-scripts true\n"},
+	{".yarnrc.yml", "nodeLinker: node-modules\nenableScripts: false\n"},
+	{"yarn.lock", ""},
+}
+
+// WriteConfig writes the Yarn configuration files that are missing from the typings cache.
+func (yarnPackageManager) WriteConfig(fs vfs.FS, typingsLocation string) error {
+	for _, file := range yarnConfigFiles {
+		path := tspath.CombinePaths(typingsLocation, file.name)
+		if fs.FileExists(path) {
+			continue
+		}
+		if err := fs.WriteFile(path, file.contents); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParseLockfile reads yarn.lock, in which each entry starts with an unindented line listing the
+// specifiers it resolves, followed by an indented version field: `version "1.0.0"` in Yarn 1 and
+// `version: 1.0.0` in later versions.
+func (yarnPackageManager) ParseLockfile(contents string) map[string]string {
+	versions := map[string]string{}
+	var names []string
+	for line := range strings.Lines(contents) {
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if !strings.HasPrefix(line, " ") {
+			names = nil
+			if header, ok := strings.CutSuffix(line, ":"); ok && header != "__metadata" {
+				for spec := range strings.SplitSeq(header, ",") {
+					if name, _ := splitPackageSpec(strings.Trim(strings.TrimSpace(spec), `"`)); name != "" {
+						names = append(names, name)
+					}
+				}
+			}
+			continue
+		}
+		if len(names) == 0 || strings.HasPrefix(line, "   ") {
+			continue
+		}
+		if rest, ok := strings.CutPrefix(strings.TrimSpace(line), "version"); ok && (strings.HasPrefix(rest, " ") || strings.HasPrefix(rest, ":")) {
+			version := strings.Trim(strings.TrimSpace(strings.TrimPrefix(rest, ":")), `"`)
+			for _, name := range names {
+				setVersionIfAbsent(versions, name, version)
+			}
+			names = nil
+		}
+	}
+	return versions
+}
+
+type bunPackageManager struct{}
+
+func (bunPackageManager) Name() string     { return PackageManagerBun }
+func (bunPackageManager) Command() string  { return "bun" }
+func (bunPackageManager) Lockfile() string { return "bun.lock" }
+
+// InstallArgs does not need to disable install scripts, since Bun only runs those of trusted dependencies.
+func (bunPackageManager) InstallArgs(fs vfs.FS, packageSpecs []string) []string {
+	return append([]string{"add", "--dev"}, packageSpecs...)
+}
+
+func (bunPackageManager) UpdateArgs(fs vfs.FS, packageSpec string) []string {
+	return []string{"add", packageSpec}
+}
+
+// ParseLockfile reads the text lockfile written by Bun 1.2 and later, a JSON document with trailing
+// commas whose "packages" map each package to an array starting with its resolved "name@version".
+// The binary bun.lockb of earlier versions is not supported.
+func (bunPackageManager) ParseLockfile(contents string) map[string]string {
+	var lock struct {
+		Packages map[string][]any `json:"packages"`
+	}
+	if err := json.Unmarshal([]byte(stripTrailingCommas(contents)), &lock); err != nil {
+		return nil
+	}
+	versions := make(map[string]string, len(lock.Packages))
+	for _, entry := range lock.Packages {
+		if len(entry) == 0 {
+			continue
+		}
+		if resolved, ok := entry[0].(string); ok {
+			if name, version := splitPackageSpec(resolved); name != "" {
+				setVersionIfAbsent(versions, name, version)
+			}
+		}
+	}
+	return versions
+}
+
+// stripTrailingCommas removes the commas that directly precede a closing brace or bracket.
+func stripTrailingCommas(text string) string {
+	var sb strings.Builder
+	inString := false
+	for i := 0; i < len(text); i++ {
+		ch := text[i]
+		switch {
+		case inString:
+			if ch == '\\' && i+1 < len(text) {
+				sb.WriteByte(ch)
+				i++
+				ch = text[i]
+			} else if ch == '"' {
+				inString = false
+			}
+		case ch == '"':
+			inString = true
+		case ch == ',':
+			next := strings.TrimLeft(text[i+1:], " \t\r\n")
+			if next != "" && (next[0] == '}' || next[0] == ']') {
+				continue
+			}
+		}
+		sb.WriteByte(ch)
+	}
+	return sb.String()
+}
+
+// localPackageManager installs packages with npm from a directory of tarballs, as produced by `npm pack`,
+// without accessing the network.
+type localPackageManager struct {
+	npmPackageManager
+	registryPath string
+}
+
+func (localPackageManager) Name() string { return PackageManagerLocal }
+
+func (m localPackageManager) InstallArgs(fs vfs.FS, packageSpecs []string) []string {
+	tarballs := m.findTarballs(fs, packageSpecs)
+	if len(tarballs) == 0 {
+		return nil
+	}
+	return append([]string{"install", "--ignore-scripts", "--offline", "--save-dev"}, tarballs...)
+}
+
+func (m localPackageManager) UpdateArgs(fs vfs.FS, packageSpec string) []string {
+	tarballs := m.findTarballs(fs, []string{packageSpec})
+	if len(tarballs) == 0 {
+		return nil
+	}
+	return []string{"install", "--ignore-scripts", "--offline", tarballs[0]}
+}
+
+// findTarballs returns the tarball with the highest version for each package, skipping packages that have
+// none. The requested versions are ignored, since a mirror usually holds a single version of each package.
+func (m localPackageManager) findTarballs(fs vfs.FS, packageSpecs []string) []string {
+	files := fs.GetAccessibleEntries(m.registryPath).Files
+	var tarballs []string
+	for _, spec := range packageSpecs {
+		name, _ := splitPackageSpec(spec)
+		// `npm pack` names the tarball of @scope/name "scope-name-<version>.tgz".
+		prefix := strings.ReplaceAll(strings.TrimPrefix(name, "@"), "/", "-") + "-"
+		var best string
+		var bestVersion semver.Version
+		for _, file := range files {
+			versionText, ok := strings.CutPrefix(file, prefix)
+			if !ok {
+				continue
+			}
+			versionText, ok = strings.CutSuffix(versionText, ".tgz")
+			if !ok {
+				continue
+			}
+			version, err := semver.TryParseVersion(versionText)
+			if err != nil {
+				continue
+			}
+			if best == "" || version.Compare(&bestVersion) > 0 {
+				best = file
+				bestVersion = version
+			}
+		}
+		if best != "" {
+			tarballs = append(tarballs, tspath.CombinePaths(m.registryPath, best))
+		}
+	}
+	return tarballs
+}
+
+// splitPackageSpec splits a specifier such as "@types/node@latest" into the package name and the version.
+func splitPackageSpec(spec string) (name string, version string) {
+	if index := strings.LastIndexByte(spec, '@'); index > 0 {
+		return spec[:index], spec[index+1:]
+	}
+	return spec, ""
+}
+
+func setVersionIfAbsent(versions map[string]string, name string, version string) {
+	if _, ok := versions[name]; !ok {
+		versions[name] = version
+	}
+}
@@ -0,0 +1,181 @@
+package ata
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/internal/vfs/vfstest"
+	"gotest.tools/v3/assert"
+)
+
+func TestPackageManagerParseLockfile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("npm", func(t *testing.T) {
+		t.Parallel()
+		versions := newPackageManager(t, PackageManagerNpm, "").ParseLockfile(`{
+			"lockfileVersion": 3,
+			"packages": {
+				"": { "devDependencies": { "@types/node": "^20.0.0" } },
+				"node_modules/@types/node": { "version": "20.1.0" }
+			},
+			"dependencies": {
+				"@types/node": { "version": "19.0.0" },
+				"@types/react": { "version": "18.2.0" }
+			}
+		}`)
+		assert.DeepEqual(t, versions, map[string]string{"@types/node": "20.1.0", "@types/react": "18.2.0"})
+	})
+
+	t.Run("pnpm v9", func(t *testing.T) {
+		t.Parallel()
+		versions := newPackageManager(t, PackageManagerPnpm, "").ParseLockfile(`lockfileVersion: '9.0'
+
+importers:
+
+  .:
+    devDependencies:
+      '@types/node':
+        specifier: ^20.0.0
+        version: 20.1.0
+      '@types/react':
+        specifier: ^18.0.0
+        version: 18.2.0(@types/prop-types@15.7.5)
+
+packages:
+
+  '@types/node@20.1.0':
+    resolution: {integrity: sha512-abc}
+`)
+		assert.DeepEqual(t, versions, map[string]string{"@types/node": "20.1.0", "@types/react": "18.2.0"})
+	})
+
+	t.Run("pnpm v5", func(t *testing.T) {
+		t.Parallel()
+		versions := newPackageManager(t, PackageManagerPnpm, "").ParseLockfile(`lockfileVersion: 5.4
+
+specifiers:
+  '@types/node': ^20.0.0
+
+devDependencies:
+  '@types/node': 20.1.0
+
+packages:
+
+  /@types/node/20.1.0:
+    dev: true
+`)
+		assert.DeepEqual(t, versions, map[string]string{"@types/node": "20.1.0"})
+	})
+
+	t.Run("yarn v1", func(t *testing.T) {
+		t.Parallel()
+		versions := newPackageManager(t, PackageManagerYarn, "").ParseLockfile(`# THIS IS AN AUTOGENERATED FILE. DO NOT EDIT THIS FILE DIRECTLY.
+# yarn lockfile v1
+
+
+"@types/node@^20.0.0", "@types/node@latest":
+  version "20.1.0"
+  resolved "https://registry.yarnpkg.com/@types/node/-/node-20.1.0.tgz"
+
+csstype@^3.0.2:
+  version "3.1.2"
+`)
+		assert.DeepEqual(t, versions, map[string]string{"@types/node": "20.1.0", "csstype": "3.1.2"})
+	})
+
+	t.Run("yarn berry", func(t *testing.T) {
+		t.Parallel()
+		versions := newPackageManager(t, PackageManagerYarn, "").ParseLockfile(`__metadata:
+  version: 8
+  cacheKey: 10
+
+"@types/node@npm:^20.0.0":
+  version: 20.1.0
+  resolution: "@types/node@npm:20.1.0"
+  dependencies:
+    undici-types: "npm:~5.26.4"
+`)
+		assert.DeepEqual(t, versions, map[string]string{"@types/node": "20.1.0"})
+	})
+
+	t.Run("bun", func(t *testing.T) {
+		t.Parallel()
+		versions := newPackageManager(t, PackageManagerBun, "").ParseLockfile(`{
+  "lockfileVersion": 1,
+  "workspaces": {
+    "": {
+      "devDependencies": {
+        "@types/node": "^20.0.0",
+      },
+    },
+  },
+  "packages": {
+    "@types/node": ["@types/node@20.1.0", "", { "dependencies": { "undici-types": "~5.26.4" } }, "sha512-abc,"],
+    "undici-types": ["undici-types@5.26.5", "", {}, "sha512-def"],
+  }
+}
+`)
+		assert.DeepEqual(t, versions, map[string]string{"@types/node": "20.1.0", "undici-types": "5.26.5"})
+	})
+}
+
+func TestPackageManagerArgs(t *testing.T) {
+	t.Parallel()
+	fs := vfstest.FromMap(map[string]string{
+		"/mirror/types-node-18.0.0.tgz":      "",
+		"/mirror/types-node-20.1.0.tgz":      "",
+		"/mirror/types-node-20.0.0.tgz":      "",
+		"/mirror/types-registry-0.1.tgz":     "",
+		"/mirror/types-registry-0.1.700.tgz": "",
+	}, false /*useCaseSensitiveFileNames*/)
+	packages := []string{"@types/node@ts5.9", "@types/jquery@ts5.9"}
+
+	assert.DeepEqual(t, newPackageManager(t, PackageManagerPnpm, "").InstallArgs(fs, packages),
+		[]string{"add", "--save-dev", "--ignore-scripts", "@types/node@ts5.9", "@types/jquery@ts5.9"})
+	assert.DeepEqual(t, newPackageManager(t, PackageManagerYarn, "").InstallArgs(fs, packages),
+		[]string{"add", "--dev", "@types/node@ts5.9", "@types/jquery@ts5.9"})
+	assert.DeepEqual(t, newPackageManager(t, PackageManagerBun, "").UpdateArgs(fs, "types-registry@latest"),
+		[]string{"add", "types-registry@latest"})
+
+	local := newPackageManager(t, PackageManagerLocal, "/mirror")
+	assert.Equal(t, local.Command(), "npm")
+	assert.DeepEqual(t, local.InstallArgs(fs, packages),
+		[]string{"install", "--ignore-scripts", "--offline", "--save-dev", "/mirror/types-node-20.1.0.tgz"})
+	assert.DeepEqual(t, local.UpdateArgs(fs, "types-registry@latest"),
+		[]string{"install", "--ignore-scripts", "--offline", "/mirror/types-registry-0.1.700.tgz"})
+	assert.Assert(t, local.InstallArgs(fs, []string{"@types/jquery@ts5.9"}) == nil)
+
+	assert.Equal(t, newPackageManager(t, "unknown", "").Name(), PackageManagerNpm)
+}
+
+func TestLocalPackageManagerRequiresRegistryPath(t *testing.T) {
+	t.Parallel()
+	packageManager, err := NewPackageManager(PackageManagerLocal, "")
+	assert.ErrorContains(t, err, "requires a local registry path")
+	assert.Assert(t, packageManager == nil)
+}
+
+func TestYarnWriteConfig(t *testing.T) {
+	t.Parallel()
+	fs := vfstest.FromMap(map[string]string{
+		"/cache/package.json": `{ "private": true }`,
+		"/cache/.yarnrc.yml":  "nodeLinker: node-modules\n",
+	}, false /*useCaseSensitiveFileNames*/)
+	writer, ok := newPackageManager(t, PackageManagerYarn, "").(packageManagerConfigWriter)
+	assert.Assert(t, ok)
+	assert.NilError(t, writer.WriteConfig(fs, "/cache"))
+
+	yarnrc, _ := fs.ReadFile("/cache/.yarnrc")
+	assert.Equal(t, yarnrc, "ignore-scripts true\n")
+	// Existing configuration is left alone.
+	yarnrcYml, _ := fs.ReadFile("/cache/.yarnrc.yml")
+	assert.Equal(t, yarnrcYml, "nodeLinker: node-modules\n")
+	assert.Assert(t, fs.FileExists("/cache/yarn.lock"))
+}
+
+func newPackageManager(t *testing.T, name string, localRegistryPath string) PackageManager {
+	t.Helper()
+	packageManager, err := NewPackageManager(name, localRegistryPath)
+	assert.NilError(t, err)
+	return packageManager
+}
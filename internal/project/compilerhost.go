@@ -98,16 +98,38 @@ func (c *compilerHost) GetResolvedProjectReference(fileName string, path tspath.
 }
 
 // GetSourceFile implements compiler.CompilerHost. Files are cached in parseCache
-// and acquired immediately for the in-progress program.
+// and acquired immediately for the in-progress program. Files that changed since
+// the project's previous program are parsed incrementally from their previous version.
 func (c *compilerHost) GetSourceFile(opts ast.SourceFileParseOptions) *ast.SourceFile {
 	c.ensureAlive()
 	if fh := c.sourceFS.GetFileByPath(opts.FileName, opts.Path); fh != nil {
 		key := NewParseCacheKey(opts, fh.Hash(), fh.Kind())
+		if oldFile := c.getPreviousSourceFile(key); oldFile != nil {
+			file, _ := c.builder.parseCache.AcquireOrError(key, func() (*ast.SourceFile, error) {
+				return updateSourceFile(key, fh, oldFile), nil
+			})
+			return file
+		}
 		return c.builder.parseCache.Acquire(key, fh)
 	}
 	return nil
 }
 
+// getPreviousSourceFile returns the version of a changed file in the project's
+// previous program, if there is one that the file can be parsed incrementally from.
+func (c *compilerHost) getPreviousSourceFile(key ParseCacheKey) *ast.SourceFile {
+	if c.project.Program == nil {
+		return nil
+	}
+	oldFile := c.project.Program.GetSourceFileByPath(key.Path)
+	// The previous version is still in use, so it is only read once it has been
+	// bound, after which it is not modified.
+	if oldFile == nil || oldFile.Hash == key.Hash || oldFile.ContentMapper() != "" || !oldFile.IsBound() {
+		return nil
+	}
+	return oldFile
+}
+
 // GetContentMappedSourceFile implements compiler.CompilerHost.
 func (c *compilerHost) GetContentMappedSourceFiles(parseOptions ast.SourceFileParseOptions, mapper *contentmapper.Mapper) (contentmapper.SourceFiles, error) {
 	c.ensureAlive()
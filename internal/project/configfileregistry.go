@@ -21,7 +21,10 @@ type ConfigFileRegistry struct {
 	configFileNames map[tspath.Path]*configFileNames
 	// customConfigFileName is the custom config file name preference that was
 	// used when building this registry's configFileNames cache.
-	customConfigFileName        string
+	customConfigFileName string
+	// workspaceFolders are the normalized workspace folders that bounded config
+	// file discovery when building this registry's configFileNames cache.
+	workspaceFolders            []string
 	allConfiguredContentMappers *configuredContentMappers
 }
 
@@ -152,6 +155,7 @@ func (c *ConfigFileRegistry) clone() *ConfigFileRegistry {
 		configs:                     maps.Clone(c.configs),
 		configFileNames:             maps.Clone(c.configFileNames),
 		customConfigFileName:        c.customConfigFileName,
+		workspaceFolders:            c.workspaceFolders,
 		allConfiguredContentMappers: c.allConfiguredContentMappers,
 	}
 }
@@ -32,11 +32,13 @@ type configFileRegistryBuilder struct {
 	snapshotID                   uint64
 	sessionOptions               *SessionOptions
 	customConfigFileName         string
+	workspaceFolders             []string
 
 	base                        *ConfigFileRegistry
 	configs                     *dirty.SyncMap[tspath.Path, *configFileEntry]
 	configFileNames             *dirty.Map[tspath.Path, *configFileNames]
 	customConfigFileNameChanged bool
+	workspaceFoldersChanged     bool
 	contentMappersMu            sync.Mutex
 	allConfiguredContentMappers *configuredContentMappers
 }
@@ -49,6 +51,7 @@ func newConfigFileRegistryBuilder(
 	snapshotID uint64,
 	sessionOptions *SessionOptions,
 	customConfigFileName string,
+	workspaceFolders []string,
 	logger *logging.LogTree,
 ) *configFileRegistryBuilder {
 	return &configFileRegistryBuilder{
@@ -61,6 +64,8 @@ func newConfigFileRegistryBuilder(
 		snapshotID:                   snapshotID,
 		customConfigFileName:         customConfigFileName,
 		customConfigFileNameChanged:  customConfigFileName != oldConfigFileRegistry.customConfigFileName,
+		workspaceFolders:             workspaceFolders,
+		workspaceFoldersChanged:      !slices.Equal(workspaceFolders, oldConfigFileRegistry.workspaceFolders),
 		allConfiguredContentMappers:  oldConfigFileRegistry.contentMappers(),
 
 		configs:         dirty.NewSyncMap(oldConfigFileRegistry.configs),
@@ -96,6 +101,11 @@ func (c *configFileRegistryBuilder) Finalize() *ConfigFileRegistry {
 		newRegistry.customConfigFileName = c.customConfigFileName
 	}
 
+	if c.workspaceFoldersChanged {
+		ensureCloned()
+		newRegistry.workspaceFolders = c.workspaceFolders
+	}
+
 	return newRegistry
 }
 
@@ -392,6 +402,18 @@ func (c *configFileRegistryBuilder) DidChangeCustomConfigFileName(logger *loggin
 	return true
 }
 
+// DidChangeWorkspaceFolders clears the config file name cache when workspace folders
+// were added or removed, since they bound the search for config files.
+func (c *configFileRegistryBuilder) DidChangeWorkspaceFolders(logger *logging.LogTree) bool {
+	if !c.workspaceFoldersChanged {
+		return false
+	}
+
+	logger.Logf("Workspace folders changed: %v", c.workspaceFolders)
+	c.configFileNames.Clear()
+	return true
+}
+
 func (c *configFileRegistryBuilder) invalidateCache(logger *logging.LogTree) changeFileResult {
 	var affectedProjects map[tspath.Path]struct{}
 	var affectedFiles map[tspath.Path]struct{}
@@ -647,6 +669,21 @@ func contentMapperManifestPath(commandLine *tsoptions.ParsedCommandLine, toPath
 
 func (c *configFileRegistryBuilder) computeConfigFileName(fileName string, skipSearchInDirectoryOfFile bool, logger *logging.LogTree) string {
 	searchPath := tspath.GetDirectoryPath(fileName)
+	// Like TSServer's projectRootPath, in a multi-root workspace the workspace folder containing the file
+	// bounds the search, so that one folder does not pick up the config files of an enclosing directory.
+	// A single-root workspace is not bounded: its files may rely on a config file above the root.
+	comparePathsOptions := tspath.ComparePathsOptions{
+		CurrentDirectory:          c.sessionOptions.CurrentDirectory,
+		UseCaseSensitiveFileNames: c.FS().UseCaseSensitiveFileNames(),
+	}
+	var workspaceFolder string
+	if len(c.workspaceFolders) > 1 {
+		workspaceFolder = getWorkspaceFolderForFile(c.workspaceFolders, fileName, comparePathsOptions)
+	}
+	stopSearch := func(directory string) bool {
+		return strings.HasSuffix(directory, "/node_modules") ||
+			workspaceFolder != "" && tspath.ComparePaths(directory, workspaceFolder, comparePathsOptions) == 0
+	}
 	// Prefer custom config file if provided; search ancestors with correct skip behavior.
 	if c.customConfigFileName != "" {
 		skip := skipSearchInDirectoryOfFile
@@ -657,7 +694,7 @@ func (c *configFileRegistryBuilder) computeConfigFileName(fileName string, skipS
 					return customPath, true
 				}
 			}
-			if strings.HasSuffix(directory, "/node_modules") {
+			if stopSearch(directory) {
 				return "", true
 			}
 			skip = false
@@ -687,7 +724,7 @@ func (c *configFileRegistryBuilder) computeConfigFileName(fileName string, skipS
 				return jsconfigPath, true
 			}
 		}
-		if strings.HasSuffix(directory, "/node_modules") {
+		if stopSearch(directory) {
 			return "", true
 		}
 		skipTsconfig = false
@@ -265,6 +265,28 @@ func (m *SyncMap[K, V]) LoadOrStore(key K, value V) (*SyncMapEntry[K, V], bool)
 	return entry, loaded
 }
 
+// Store sets the value for a key, replacing any existing value. Unlike LoadOrStore,
+// it also succeeds for keys that were deleted earlier in the same build.
+func (m *SyncMap[K, V]) Store(key K, value V) *SyncMapEntry[K, V] {
+	entry, loaded := m.dirty.LoadOrStore(key, &SyncMapEntry[K, V]{
+		m: m,
+		mapEntry: mapEntry[K, V]{
+			key:      key,
+			original: m.base[key],
+			value:    value,
+			dirty:    true,
+		},
+	})
+	if loaded {
+		entry.mu.Lock()
+		defer entry.mu.Unlock()
+		entry.value = value
+		entry.dirty = true
+		entry.delete = false
+	}
+	return entry
+}
+
 func (m *SyncMap[K, V]) Delete(key K) {
 	entry, loaded := m.dirty.LoadOrStore(key, &SyncMapEntry[K, V]{
 		m: m,
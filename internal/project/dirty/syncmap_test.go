@@ -243,3 +243,35 @@ func TestSyncMapProxyFor(t *testing.T) {
 		assert.Equal(t, "changed", entry.Value().data)
 	})
 }
+
+func TestSyncMapStore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("store after delete", func(t *testing.T) {
+		t.Parallel()
+
+		base := map[string]*testValue{
+			"key1": {data: "original"},
+		}
+		syncMap := NewSyncMap(base)
+
+		syncMap.Delete("key1")
+		_, ok := syncMap.LoadOrStore("key1", &testValue{data: "ignored"})
+		assert.Equal(t, false, ok, "LoadOrStore should not resurrect a deleted entry")
+
+		syncMap.Store("key1", &testValue{data: "replaced"})
+		entry, ok := syncMap.Load("key1")
+		assert.Assert(t, ok)
+		assert.Equal(t, "replaced", entry.Value().data)
+
+		var changed bool
+		result, _ := syncMap.FinalizeWith(FinalizationHooks[string, *testValue]{
+			OnChange: func(key string, oldValue *testValue, newValue *testValue) {
+				changed = true
+				assert.Equal(t, "original", oldValue.data)
+			},
+		})
+		assert.Assert(t, changed)
+		assert.Equal(t, "replaced", result["key1"].data)
+	})
+}
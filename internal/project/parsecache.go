@@ -81,6 +81,14 @@ func NewParseCache(options RefCountCacheOptions) *ParseCache {
 	)
 }
 
+// updateSourceFile parses the content of a changed file, reusing the statements of
+// its previous version that the change did not affect.
+func updateSourceFile(key ParseCacheKey, fh FileHandle, oldFile *ast.SourceFile) *ast.SourceFile {
+	file := parser.UpdateSourceFile(key.SourceFileParseOptions, oldFile, fh.Content(), key.ScriptKind)
+	file.Hash = fh.Hash()
+	return file
+}
+
 type ContentMappedParseCache struct {
 	// One reference owns the canonical file and all supplemental files as a bundle. Callers ref and
 	// deref the canonical file only.
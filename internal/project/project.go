@@ -102,6 +102,7 @@ func NewConfiguredProject(
 }
 
 func NewInferredProject(
+	projectName string,
 	currentDirectory string,
 	compilerOptions *core.CompilerOptions,
 	rootFileNames []string,
@@ -109,7 +110,7 @@ func NewInferredProject(
 	builder *ProjectCollectionBuilder,
 	logger *logging.LogTree,
 ) *Project {
-	p := NewProject(inferredProjectName, KindInferred, currentDirectory, builder, logger)
+	p := NewProject(projectName, KindInferred, currentDirectory, builder, logger)
 	if compilerOptions == nil {
 		compilerOptions = &core.CompilerOptions{
 			AllowJs:                    core.TSTrue,
@@ -11,6 +11,7 @@ import (
 	"github.com/microsoft/typescript-go/internal/diagnostics"
 	"github.com/microsoft/typescript-go/internal/ls/lsutil"
 	"github.com/microsoft/typescript-go/internal/lsp/lsproto"
+	"github.com/microsoft/typescript-go/internal/parser"
 	"github.com/microsoft/typescript-go/internal/project"
 	"github.com/microsoft/typescript-go/internal/testutil/projecttestutil"
 	"github.com/microsoft/typescript-go/internal/tspath"
@@ -834,3 +835,45 @@ func TestProgressNotifications(t *testing.T) {
 		assert.Equal(t, starts, finishes, "ProgressStart and ProgressFinish calls for Project_0 should be balanced")
 	})
 }
+
+func TestProjectIncrementalParse(t *testing.T) {
+	t.Parallel()
+	if !bundled.Embedded {
+		t.Skip("bundled files are not embedded")
+	}
+
+	files := map[string]any{
+		"/src/tsconfig.json": "{}",
+		"/src/index.ts":      "export const a = 1;\nexport function f() {\n    const b: string = a;\n    return b;\n}\n",
+	}
+	session, _ := projecttestutil.Setup(files)
+	uri := lsproto.DocumentUri("file:///src/index.ts")
+	session.DidOpenFile(context.Background(), uri, 1, files["/src/index.ts"].(string), lsproto.LanguageKindTypeScript)
+	ls, err := session.GetLanguageService(context.Background(), uri)
+	assert.NilError(t, err)
+	program := ls.GetProgram()
+	oldFile := program.GetSourceFile("/src/index.ts")
+	diags := program.GetSemanticDiagnostics(projecttestutil.WithRequestID(t.Context()), oldFile)
+	assert.Equal(t, len(diags), 1)
+	assert.Equal(t, diags[0].Code(), diagnostics.Type_0_is_not_assignable_to_type_1.Code())
+
+	session.DidChangeFile(context.Background(), uri, 2, []lsproto.TextDocumentContentChangePartialOrWholeDocument{{
+		Partial: &lsproto.TextDocumentContentChangePartial{Text: `"one"`, Range: lsproto.Range{Start: lsproto.Position{Line: 0, Character: 17}, End: lsproto.Position{Line: 0, Character: 18}}},
+	}})
+	ls, err = session.GetLanguageService(context.Background(), uri)
+	assert.NilError(t, err)
+	program = ls.GetProgram()
+	file := program.GetSourceFile("/src/index.ts")
+	assert.Assert(t, file != oldFile)
+	assert.Equal(t, file.Text(), "export const a = \"one\";\nexport function f() {\n    const b: string = a;\n    return b;\n}\n")
+	assert.Equal(t, len(program.GetSemanticDiagnostics(projecttestutil.WithRequestID(t.Context()), file)), 0)
+
+	// The function declaration is reused from the previous version at its new position.
+	expected := parser.ParseSourceFile(file.ParseOptions(), file.Text(), file.ScriptKind)
+	assert.Equal(t, len(file.Statements.Nodes), len(expected.Statements.Nodes))
+	for i, statement := range file.Statements.Nodes {
+		assert.Equal(t, statement.Kind, expected.Statements.Nodes[i].Kind)
+		assert.Equal(t, statement.Loc, expected.Statements.Nodes[i].Loc)
+	}
+	assert.Assert(t, file.Statements.Nodes[1] != oldFile.Statements.Nodes[1])
+}
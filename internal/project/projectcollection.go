@@ -17,7 +17,7 @@ type ProjectCollection struct {
 	configFileRegistry *ConfigFileRegistry
 	// fileDefaultProjects is a map of file paths to the config file path (the key
 	// into `configuredProjects`) of the default project for that file. If the file
-	// belongs to the inferred project, the value is `inferredProjectName`. This map
+	// belongs to an inferred project, the value is that project's path. This map
 	// contains quick lookups for only the associations discovered during the latest
 	// snapshot update.
 	fileDefaultProjects map[tspath.Path]tspath.Path
@@ -27,9 +27,11 @@ type ProjectCollection struct {
 	// openFiles is the set of open file paths associated with the snapshot that owns
 	// this project collection.
 	openFiles collections.Set[tspath.Path]
-	// inferredProject is a fallback project that is used when no configured
-	// project can be found for an open file.
-	inferredProject *Project
+	// inferredProjects are fallback projects that are used when no configured
+	// project can be found for an open file, keyed by project path. In a workspace
+	// with multiple folders, each folder gets its own inferred project; otherwise
+	// there is at most one, keyed by `inferredProjectName`.
+	inferredProjects map[tspath.Path]*Project
 	// apiState tracks the projects and files that API clients have explicitly
 	// opened so they are kept loaded across snapshots.
 	apiState APIState
@@ -80,11 +82,7 @@ func (c *ProjectCollection) GetProjectByPath(projectPath tspath.Path) *Project {
 		return project
 	}
 
-	if projectPath == inferredProjectName {
-		return c.inferredProject
-	}
-
-	return nil
+	return c.inferredProjects[projectPath]
 }
 
 // ConfiguredProjects returns all configured projects in a stable order.
@@ -104,33 +102,47 @@ func (c *ProjectCollection) fillConfiguredProjects(projects *[]*Project) {
 }
 
 // ProjectsByPath returns an ordered map of configured projects keyed by their config file path,
-// plus the inferred project, if it exists, with the key `inferredProjectName`.
+// plus the inferred projects keyed by their project paths.
 func (c *ProjectCollection) ProjectsByPath() *collections.OrderedMap[tspath.Path, *Project] {
-	projects := collections.NewOrderedMapWithSizeHint[tspath.Path, *Project](
-		len(c.configuredProjects) + core.IfElse(c.inferredProject != nil, 1, 0),
-	)
-	for _, project := range c.ConfiguredProjects() {
+	projects := collections.NewOrderedMapWithSizeHint[tspath.Path, *Project](len(c.configuredProjects) + len(c.inferredProjects))
+	for _, project := range c.Projects() {
 		projects.Set(project.configFilePath, project)
 	}
-	if c.inferredProject != nil {
-		projects.Set(inferredProjectName, c.inferredProject)
-	}
 	return projects
 }
 
-// Projects returns all projects, including the inferred project if it exists, in a stable order.
+// Projects returns all projects, including the inferred projects, in a stable order.
 func (c *ProjectCollection) Projects() []*Project {
-	if c.inferredProject == nil {
+	if len(c.inferredProjects) == 0 {
 		return c.ConfiguredProjects()
 	}
-	projects := make([]*Project, 0, len(c.configuredProjects)+1)
+	projects := make([]*Project, 0, len(c.configuredProjects)+len(c.inferredProjects))
 	c.fillConfiguredProjects(&projects)
-	projects = append(projects, c.inferredProject)
-	return projects
+	return append(projects, c.InferredProjects()...)
 }
 
+// InferredProject returns the inferred project for files outside of every workspace folder, which is
+// the only inferred project unless the workspace has multiple folders.
 func (c *ProjectCollection) InferredProject() *Project {
-	return c.inferredProject
+	return c.inferredProjects[inferredProjectName]
+}
+
+// InferredProjects returns all inferred projects in a stable order.
+func (c *ProjectCollection) InferredProjects() []*Project {
+	projects := slices.Collect(maps.Values(c.inferredProjects))
+	slices.SortFunc(projects, func(a, b *Project) int {
+		return cmp.Compare(a.Name(), b.Name())
+	})
+	return projects
+}
+
+func (c *ProjectCollection) getInferredProjectContainingFile(path tspath.Path) *Project {
+	for _, project := range c.InferredProjects() {
+		if project.containsFile(path) {
+			return project
+		}
+	}
+	return nil
 }
 
 func (c *ProjectCollection) GetProjectsContainingFile(path tspath.Path) []ls.Project {
@@ -140,8 +152,8 @@ func (c *ProjectCollection) GetProjectsContainingFile(path tspath.Path) []ls.Pro
 			projects = append(projects, project)
 		}
 	}
-	if c.inferredProject != nil && c.inferredProject.containsFile(path) {
-		projects = append(projects, c.inferredProject)
+	if project := c.getInferredProjectContainingFile(path); project != nil {
+		projects = append(projects, project)
 	}
 	return projects
 }
@@ -151,7 +163,7 @@ func (c *ProjectCollection) GetOpenConfiguredProjects() *collections.Set[tspath.
 	c.openConfiguredProjectsOnce.Do(func() {
 		openProjects := collections.NewSetWithSizeHint[tspath.Path](len(c.configuredProjects))
 		for path := range c.openFiles.Keys() {
-			if projectPath, ok := c.fileDefaultProjects[path]; ok && projectPath != inferredProjectName {
+			if projectPath, ok := c.fileDefaultProjects[path]; ok {
 				if _, ok := c.configuredProjects[projectPath]; ok {
 					openProjects.Add(projectPath)
 					continue
@@ -180,8 +192,8 @@ func openFilePaths(overlays map[tspath.Path]*Overlay) collections.Set[tspath.Pat
 // !!! result could be cached
 func (c *ProjectCollection) GetDefaultProject(path tspath.Path) *Project {
 	if result, ok := c.fileDefaultProjects[path]; ok {
-		if result == inferredProjectName {
-			return c.inferredProject
+		if project, ok := c.inferredProjects[result]; ok {
+			return project
 		}
 		return c.configuredProjects[result]
 	}
@@ -211,10 +223,7 @@ func (c *ProjectCollection) GetDefaultProject(path tspath.Path) *Project {
 		return containingProjects[0]
 	}
 	if len(containingProjects) == 0 {
-		if c.inferredProject != nil && c.inferredProject.containsFile(path) {
-			return c.inferredProject
-		}
-		return nil
+		return c.getInferredProjectContainingFile(path)
 	}
 	if !multipleDirectInclusions {
 		if firstNonSourceOfProjectReferenceRedirect != nil {
@@ -301,7 +310,7 @@ func (c *ProjectCollection) clone() *ProjectCollection {
 		configFileRegistry:  c.configFileRegistry,
 		configuredProjects:  c.configuredProjects,
 		openFiles:           c.openFiles,
-		inferredProject:     c.inferredProject,
+		inferredProjects:    c.inferredProjects,
 		fileDefaultProjects: c.fileDefaultProjects,
 		apiState:            c.apiState,
 	}
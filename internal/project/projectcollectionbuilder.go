@@ -55,7 +55,7 @@ type ProjectCollectionBuilder struct {
 
 	fileDefaultProjects map[tspath.Path]tspath.Path
 	configuredProjects  *dirty.SyncMap[tspath.Path, *Project]
-	inferredProject     *dirty.Box[*Project]
+	inferredProjects    *dirty.SyncMap[tspath.Path, *Project]
 
 	apiState APIState
 }
@@ -72,6 +72,7 @@ func newProjectCollectionBuilder(
 	inferredContentMapperExtensions []string,
 	sessionOptions *SessionOptions,
 	customConfigFileName string,
+	workspaceFolders []string,
 	parseCache *ParseCache,
 	contentMappedParseCache *ContentMappedParseCache,
 	extendedConfigCache *ExtendedConfigCache,
@@ -91,10 +92,10 @@ func newProjectCollectionBuilder(
 		extendedConfigCache:                extendedConfigCache,
 		contentMapperHost:                  contentMapperHost,
 		base:                               oldProjectCollection,
-		configFileRegistryBuilder:          newConfigFileRegistryBuilder(lsproto.GetClientCapabilities(ctx).Workspace.DidChangeWatchedFiles.RelativePatternSupport, fs, oldConfigFileRegistry, extendedConfigCache, newSnapshotID, sessionOptions, customConfigFileName, nil),
+		configFileRegistryBuilder:          newConfigFileRegistryBuilder(lsproto.GetClientCapabilities(ctx).Workspace.DidChangeWatchedFiles.RelativePatternSupport, fs, oldConfigFileRegistry, extendedConfigCache, newSnapshotID, sessionOptions, customConfigFileName, workspaceFolders, nil),
 		newSnapshotID:                      newSnapshotID,
 		configuredProjects:                 dirty.NewSyncMap(oldProjectCollection.configuredProjects),
-		inferredProject:                    dirty.NewBox(oldProjectCollection.inferredProject),
+		inferredProjects:                   dirty.NewSyncMap(oldProjectCollection.inferredProjects),
 		apiState:                           oldAPIState.clone(),
 		client:                             client,
 	}
@@ -125,9 +126,9 @@ func (b *ProjectCollectionBuilder) Finalize(logger *logging.LogTree) (*ProjectCo
 		newProjectCollection.fileDefaultProjects = b.fileDefaultProjects
 	}
 
-	if newInferredProject, inferredProjectChanged := b.inferredProject.Finalize(); inferredProjectChanged {
+	if inferredProjects, inferredProjectsChanged := b.inferredProjects.Finalize(); inferredProjectsChanged {
 		ensureCloned()
-		newProjectCollection.inferredProject = newInferredProject
+		newProjectCollection.inferredProjects = inferredProjects
 	}
 
 	configFileRegistry := b.configFileRegistryBuilder.Finalize()
@@ -153,9 +154,17 @@ func (b *ProjectCollectionBuilder) forEachProject(fn func(entry dirty.Value[*Pro
 	if !keepGoing {
 		return
 	}
-	if b.inferredProject.Value() != nil {
-		fn(b.inferredProject)
-	}
+	b.inferredProjects.Range(func(entry *dirty.SyncMapEntry[tspath.Path, *Project]) bool {
+		return fn(entry)
+	})
+}
+
+// updateInferredPrograms updates the programs of all inferred projects.
+func (b *ProjectCollectionBuilder) updateInferredPrograms(logger *logging.LogTree) {
+	b.inferredProjects.Range(func(entry *dirty.SyncMapEntry[tspath.Path, *Project]) bool {
+		b.updateProgram(entry, logger)
+		return true
+	})
 }
 
 func (b *ProjectCollectionBuilder) HandleAPIRequest(apiRequest *APISnapshotRequest, logger *logging.LogTree) error {
@@ -258,10 +267,16 @@ func (b *ProjectCollectionBuilder) HandleAPIRequest(apiRequest *APISnapshotReque
 			retain.Union(&result.retain)
 		}
 		b.cleanupConfiguredProjects(&retain, logger)
+	} else {
+		// Nothing was opened or closed, but file changes may still have made the
+		// default projects of API-opened files dirty.
+		for path, file := range b.apiState.openFiles {
+			if entry := b.findDefaultConfiguredProject(file.fileName, path); entry != nil {
+				b.updateProgram(entry, logger)
+			}
+		}
 	}
-	if b.inferredProject.Value() != nil {
-		b.updateProgram(b.inferredProject, logger)
-	}
+	b.updateInferredPrograms(logger)
 
 	return nil
 }
@@ -293,6 +308,8 @@ func (b *ProjectCollectionBuilder) DidChangeFiles(summary FileChangeSummary, log
 
 	b.programStructureChanged = b.markProjectsAffectedByConfigChanges(configChangeResult, logger)
 
+	var inferredRootFiles []string
+	var closedInferredRoots bool
 	b.forEachProject(func(entry dirty.Value[*Project]) bool {
 		// Only consider change/delete; creates are handled by the config file registry
 		if summary.HasExcessiveNonCreateWatchEvents() {
@@ -310,7 +327,7 @@ func (b *ProjectCollectionBuilder) DidChangeFiles(summary FileChangeSummary, log
 		b.markFilesChanged(entry, changedFiles, lsproto.FileChangeTypeChanged, logger)
 		if entry.Value().Kind == KindInferred && summary.Closed.Len() > 0 {
 			rootFilesMap := entry.Value().CommandLine.FileNamesByPath()
-			newRootFiles := entry.Value().CommandLine.FileNames()
+			newRootFiles := slices.Clone(entry.Value().CommandLine.FileNames())
 			for uri := range summary.Closed.Keys() {
 				fileName := uri.FileName()
 				path := b.toPath(fileName)
@@ -318,7 +335,9 @@ func (b *ProjectCollectionBuilder) DidChangeFiles(summary FileChangeSummary, log
 					newRootFiles = slices.Delete(newRootFiles, slices.Index(newRootFiles, fileName), slices.Index(newRootFiles, fileName)+1)
 				}
 			}
-			b.updateInferredProjectRoots(newRootFiles, logger)
+			// Roots are redistributed among all inferred projects once every project has been visited.
+			closedInferredRoots = true
+			inferredRootFiles = append(inferredRootFiles, newRootFiles...)
 		}
 
 		// Handle deleted files
@@ -333,6 +352,9 @@ func (b *ProjectCollectionBuilder) DidChangeFiles(summary FileChangeSummary, log
 
 		return true
 	})
+	if closedInferredRoots {
+		b.updateInferredProjectRoots(inferredRootFiles, logger)
+	}
 
 	// Handle opened file
 	if summary.Opened != "" || summary.Reopened != "" {
@@ -488,9 +510,7 @@ func (b *ProjectCollectionBuilder) cleanupInferredProject(logger *logging.LogTre
 
 func (b *ProjectCollectionBuilder) DidChangeContentMapperContributions(logger *logging.LogTree) {
 	b.cleanupInferredProject(logger)
-	if b.inferredProject.Value() != nil {
-		b.updateProgram(b.inferredProject, logger)
-	}
+	b.updateInferredPrograms(logger)
 }
 
 func (b *ProjectCollectionBuilder) ensureInferredProjectIncludesClosedFile(fileName string, logger *logging.LogTree) {
@@ -498,9 +518,7 @@ func (b *ProjectCollectionBuilder) ensureInferredProjectIncludesClosedFile(fileN
 	// plus this closed file.
 	inferredProjectFiles := append(b.collectInferredProjectRoots(), fileName)
 	b.updateInferredProjectRoots(inferredProjectFiles, logger)
-	if b.inferredProject.Value() != nil {
-		b.updateProgram(b.inferredProject, logger)
-	}
+	b.updateInferredPrograms(logger)
 }
 
 // DidRequestFile ensures projects are loaded for the given URI.
@@ -525,9 +543,7 @@ func (b *ProjectCollectionBuilder) DidRequestFile(uri lsproto.DocumentUri, confi
 			if result.Value() != nil && result.Value().containsFile(path) {
 				if hasChanges {
 					b.cleanupInferredProject(logger)
-					if b.inferredProject.Value() != nil {
-						b.updateProgram(b.inferredProject, logger)
-					}
+					b.updateInferredPrograms(logger)
 				}
 				return
 			}
@@ -544,9 +560,7 @@ func (b *ProjectCollectionBuilder) DidRequestFile(uri lsproto.DocumentUri, confi
 			b.cleanupInferredProject(logger)
 		}
 
-		if b.inferredProject.Value() != nil {
-			b.updateProgram(b.inferredProject, logger)
-		}
+		b.updateInferredPrograms(logger)
 
 		// At this point we should be able to find the default project for the file without
 		// creating anything else. Initially, I verified that and panicked if nothing was found,
@@ -572,15 +586,10 @@ func (b *ProjectCollectionBuilder) DidRequestFile(uri lsproto.DocumentUri, confi
 
 func (b *ProjectCollectionBuilder) DidRequestProject(projectId tspath.Path, logger *logging.LogTree) {
 	startTime := time.Now()
-	if projectId == inferredProjectName {
-		// Update inferred project
-		if b.inferredProject.Value() != nil {
-			b.updateProgram(b.inferredProject, logger)
-		}
-	} else {
-		if entry, ok := b.configuredProjects.Load(projectId); ok {
-			b.updateProgram(entry, logger)
-		}
+	if entry, ok := b.inferredProjects.Load(projectId); ok {
+		b.updateProgram(entry, logger)
+	} else if entry, ok := b.configuredProjects.Load(projectId); ok {
+		b.updateProgram(entry, logger)
 	}
 
 	if logger != nil {
@@ -707,8 +716,8 @@ func (b *ProjectCollectionBuilder) DidUpdateATAState(ataChanges map[tspath.Path]
 
 	for projectPath, ataChange := range ataChanges {
 		logger.Embed(ataChange.Logs)
-		if projectPath == inferredProjectName {
-			updateProject(b.inferredProject, ataChange)
+		if project, ok := b.inferredProjects.Load(projectPath); ok {
+			updateProject(project, ataChange)
 		} else if project, ok := b.configuredProjects.Load(projectPath); ok {
 			updateProject(project, ataChange)
 		}
@@ -730,6 +739,42 @@ func (b *ProjectCollectionBuilder) DidChangeCustomConfigFileName(logger *logging
 	b.programStructureChanged = true
 }
 
+// DidChangeWorkspaceFolders recomputes the default projects of open files after workspace folders were
+// added or removed, since the folders bound config file discovery and group files into inferred projects.
+func (b *ProjectCollectionBuilder) DidChangeWorkspaceFolders(logger *logging.LogTree) {
+	if !b.configFileRegistryBuilder.DidChangeWorkspaceFolders(logger) {
+		return
+	}
+
+	b.fileDefaultProjects = nil
+	b.programStructureChanged = true
+	var retain collections.Set[tspath.Path]
+	for path, overlay := range b.fs.overlays {
+		result := b.ensureConfiguredProjectAndAncestorsForFile(overlay.FileName(), path, logger)
+		retain.Union(&result.retain)
+	}
+	for path, file := range b.apiState.openFiles {
+		if b.fs.isOpenFile(path) {
+			continue
+		}
+		result := b.ensureConfiguredProjectAndAncestorsForFile(file.fileName, path, logger)
+		retain.Union(&result.retain)
+	}
+	// Close the projects that were only found through the old folders; otherwise they would keep
+	// the open files they contain, since a loaded project takes precedence over the config file search.
+	var toDelete []*dirty.SyncMapEntry[tspath.Path, *Project]
+	b.configuredProjects.Range(func(entry *dirty.SyncMapEntry[tspath.Path, *Project]) bool {
+		if _, ok := b.apiState.openProjects[entry.Key()]; !ok && !retain.Has(entry.Key()) {
+			toDelete = append(toDelete, entry)
+		}
+		return true
+	})
+	for _, entry := range toDelete {
+		b.deleteConfiguredProject(entry, logger)
+	}
+	b.cleanupConfiguredProjects(&retain, logger)
+}
+
 func (b *ProjectCollectionBuilder) DidChangeUserPreferences(oldPreferences, newPreferences lsutil.UserPreferences, logger *logging.LogTree) {
 	if oldPreferences.Locale == newPreferences.Locale {
 		return
@@ -782,21 +827,31 @@ func (b *ProjectCollectionBuilder) findDefaultProject(fileName string, path tspa
 	if configuredProject := b.findDefaultConfiguredProject(fileName, path); configuredProject != nil {
 		return configuredProject
 	}
-	if key, ok := b.fileDefaultProjects[path]; ok && key == inferredProjectName {
-		return b.inferredProject
+	if key, ok := b.fileDefaultProjects[path]; ok {
+		if entry, ok := b.inferredProjects.Load(key); ok {
+			return entry
+		}
 	}
-	if inferredProject := b.inferredProject.Value(); inferredProject != nil && inferredProject.containsFile(path) {
+	var result *dirty.SyncMapEntry[tspath.Path, *Project]
+	b.inferredProjects.Range(func(entry *dirty.SyncMapEntry[tspath.Path, *Project]) bool {
+		if entry.Value().containsFile(path) {
+			result = entry
+			return false
+		}
+		return true
+	})
+	if result != nil {
 		if b.fileDefaultProjects == nil {
 			b.fileDefaultProjects = make(map[tspath.Path]tspath.Path)
 		}
-		b.fileDefaultProjects[path] = inferredProjectName
-		return b.inferredProject
+		b.fileDefaultProjects[path] = result.Key()
+		return result
 	}
 	return nil
 }
 
 func (b *ProjectCollectionBuilder) findDefaultConfiguredProject(fileName string, path tspath.Path) *dirty.SyncMapEntry[tspath.Path, *Project] {
-	if key, ok := b.fileDefaultProjects[path]; ok && key != inferredProjectName {
+	if key, ok := b.fileDefaultProjects[path]; ok {
 		if entry, ok := b.configuredProjects.Load(key); ok {
 			return entry
 		}
@@ -1052,8 +1107,8 @@ func (b *ProjectCollectionBuilder) findOrCreateDefaultConfiguredProjectForFile(
 	logger *logging.LogTree,
 ) searchResult {
 	if key, ok := b.fileDefaultProjects[path]; ok {
-		if key == inferredProjectName {
-			// The file belongs to the inferred project
+		if _, ok := b.inferredProjects.Load(key); ok {
+			// The file belongs to an inferred project
 			return searchResult{}
 		}
 		entry, _ := b.configuredProjects.Load(key)
@@ -1103,49 +1158,89 @@ func (b *ProjectCollectionBuilder) findOrCreateProject(
 	return entry
 }
 
+// updateInferredProjectRoots distributes the given root files among the inferred projects, creating,
+// updating, and deleting inferred projects as needed. It returns whether any inferred project changed.
 func (b *ProjectCollectionBuilder) updateInferredProjectRoots(rootFileNames []string, logger *logging.LogTree) bool {
 	rootFileNames = core.Filter(rootFileNames, b.isSupportedInInferredProject)
-	if len(rootFileNames) == 0 {
-		if b.inferredProject.Value() != nil {
-			if logger != nil {
+	rootFileNamesByProject := make(map[tspath.Path][]string)
+	projectFolders := make(map[tspath.Path]string)
+	for _, fileName := range rootFileNames {
+		folder := b.getInferredProjectFolderForFile(fileName)
+		projectName := getInferredProjectNameForFolder(core.IfElse(folder == "", "", b.toPath(folder)))
+		projectPath := b.toPath(projectName)
+		rootFileNamesByProject[projectPath] = append(rootFileNamesByProject[projectPath], fileName)
+		projectFolders[projectPath] = folder
+	}
+
+	var changed bool
+	var toDelete []*dirty.SyncMapEntry[tspath.Path, *Project]
+	b.inferredProjects.Range(func(entry *dirty.SyncMapEntry[tspath.Path, *Project]) bool {
+		if _, ok := rootFileNamesByProject[entry.Key()]; !ok {
+			toDelete = append(toDelete, entry)
+		}
+		return true
+	})
+	for _, entry := range toDelete {
+		if logger != nil {
+			if entry.Key() == inferredProjectName {
 				logger.Log("Deleting inferred project")
+			} else {
+				logger.Log("Deleting inferred project " + entry.Value().Name())
 			}
-			b.inferredProject.Delete()
-			return true
 		}
-		return false
+		entry.Delete()
+		changed = true
 	}
 
+	for _, projectPath := range slices.Sorted(maps.Keys(rootFileNamesByProject)) {
+		changed = b.updateInferredProject(projectPath, projectFolders[projectPath], rootFileNamesByProject[projectPath], logger) || changed
+	}
+	return changed
+}
+
+// getInferredProjectFolderForFile returns the workspace folder whose inferred project holds fileName, or ""
+// for the default inferred project. Files are only grouped by folder when the workspace has several folders.
+func (b *ProjectCollectionBuilder) getInferredProjectFolderForFile(fileName string) string {
+	workspaceFolders := b.configFileRegistryBuilder.workspaceFolders
+	if len(workspaceFolders) < 2 {
+		return ""
+	}
+	return getWorkspaceFolderForFile(workspaceFolders, fileName, tspath.ComparePathsOptions{
+		UseCaseSensitiveFileNames: b.fs.fs.UseCaseSensitiveFileNames(),
+		CurrentDirectory:          b.sessionOptions.CurrentDirectory,
+	})
+}
+
+func (b *ProjectCollectionBuilder) updateInferredProject(projectPath tspath.Path, folder string, rootFileNames []string, logger *logging.LogTree) bool {
 	slices.Sort(rootFileNames)
+	currentDirectory := core.IfElse(folder == "", b.sessionOptions.CurrentDirectory, folder)
 	contentMappers := b.inferredContentMappers
-	if b.inferredProject.Value() == nil {
-		b.inferredProject.Set(NewInferredProject(b.sessionOptions.CurrentDirectory, b.compilerOptionsForInferredProjects, rootFileNames, contentMappers, b, logger))
-	} else {
-		newCompilerOptions := b.inferredProject.Value().CommandLine.CompilerOptions()
-		if b.compilerOptionsForInferredProjects != nil {
-			newCompilerOptions = b.compilerOptionsForInferredProjects
-		}
-		newCommandLine := newInferredProjectCommandLine(newCompilerOptions, rootFileNames, contentMappers, tspath.ComparePathsOptions{
-			UseCaseSensitiveFileNames: b.fs.fs.UseCaseSensitiveFileNames(),
-			CurrentDirectory:          b.sessionOptions.CurrentDirectory,
-		})
-		changed := b.inferredProject.ChangeIf(
-			func(p *Project) bool {
-				return !maps.Equal(p.CommandLine.FileNamesByPath(), newCommandLine.FileNamesByPath()) ||
-					!slices.Equal(p.CommandLine.ContentMappers(), newCommandLine.ContentMappers())
-			},
-			func(p *Project) {
-				if logger != nil {
-					logger.Log(fmt.Sprintf("Updating inferred project config with %d root files", len(rootFileNames)))
-				}
-				p.SetCommandLine(newCommandLine)
-			},
-		)
-		if !changed {
-			return false
-		}
+	entry, ok := b.inferredProjects.Load(projectPath)
+	if !ok {
+		projectName := getInferredProjectNameForFolder(core.IfElse(folder == "", "", b.toPath(folder)))
+		b.inferredProjects.Store(projectPath, NewInferredProject(projectName, currentDirectory, b.compilerOptionsForInferredProjects, rootFileNames, contentMappers, b, logger))
+		return true
+	}
+	newCompilerOptions := entry.Value().CommandLine.CompilerOptions()
+	if b.compilerOptionsForInferredProjects != nil {
+		newCompilerOptions = b.compilerOptionsForInferredProjects
 	}
-	return true
+	newCommandLine := newInferredProjectCommandLine(newCompilerOptions, rootFileNames, contentMappers, tspath.ComparePathsOptions{
+		UseCaseSensitiveFileNames: b.fs.fs.UseCaseSensitiveFileNames(),
+		CurrentDirectory:          currentDirectory,
+	})
+	return entry.ChangeIf(
+		func(p *Project) bool {
+			return !maps.Equal(p.CommandLine.FileNamesByPath(), newCommandLine.FileNamesByPath()) ||
+				!slices.Equal(p.CommandLine.ContentMappers(), newCommandLine.ContentMappers())
+		},
+		func(p *Project) {
+			if logger != nil {
+				logger.Log(fmt.Sprintf("Updating inferred project config with %d root files", len(rootFileNames)))
+			}
+			p.SetCommandLine(newCommandLine)
+		},
+	)
 }
 
 func (b *ProjectCollectionBuilder) isSupportedInInferredProject(fileName string) bool {
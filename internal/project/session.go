@@ -26,6 +26,7 @@ import (
 	"github.com/microsoft/typescript-go/internal/ls/lsconv"
 	"github.com/microsoft/typescript-go/internal/ls/lsutil"
 	"github.com/microsoft/typescript-go/internal/lsp/lsproto"
+	"github.com/microsoft/typescript-go/internal/lsplugin"
 	"github.com/microsoft/typescript-go/internal/project/ata"
 	"github.com/microsoft/typescript-go/internal/project/background"
 	"github.com/microsoft/typescript-go/internal/project/logging"
@@ -52,6 +53,7 @@ const (
 	UpdateReasonIdleCleanDiskCache
 	UpdateReasonDidChangeConfigFile
 	UpdateReasonDidChangeContentMapperContributions
+	UpdateReasonDidChangeWorkspaceFolders
 )
 
 type ContentMapperContributions struct {
@@ -66,7 +68,10 @@ const watchRequestTimeout = time.Second
 // SessionOptions are the immutable initialization options for a session.
 // Snapshots may reference them as a pointer since they never change.
 type SessionOptions struct {
-	CurrentDirectory       string
+	CurrentDirectory string
+	// WorkspaceFolders are the root directories of the workspace when the session starts.
+	// Folders added or removed later are tracked by snapshots; see Session.DidChangeWorkspaceFolders.
+	WorkspaceFolders       []string
 	DefaultLibraryPath     string
 	TypingsLocation        string
 	PositionEncoding       lsproto.PositionEncodingKind
@@ -74,8 +79,8 @@ type SessionOptions struct {
 	LoggingEnabled         bool
 	TelemetryEnabled       bool
 	PushDiagnosticsEnabled bool
-	// RunExternalCode allows configured content mappers to run their (external) processes,
-	// gated on workspace trust by the client. It corresponds to the --runExternalCode CLI flag.
+	// RunExternalCode allows configured content mappers and language service plugins to run their
+	// (external) processes, gated on workspace trust by the client. It corresponds to the --runExternalCode CLI flag.
 	RunExternalCode    bool
 	DebounceDelay      time.Duration
 	CheckerPoolOptions CheckerPoolOptions
@@ -88,11 +93,13 @@ type SessionInit struct {
 	Client        Client
 	Logger        logging.Logger
 	NpmExecutor   ata.NpmExecutor
-	// Spawner launches content mapper processes. It is nil when the host cannot spawn processes.
-	Spawner                 contentmapper.Spawner
-	ContentMapperLogger     contentmapper.Logger
-	ParseCache              *ParseCache
-	ContentMappedParseCache *ContentMappedParseCache
+	// Spawner launches content mapper and language service plugin processes. It is nil when the host
+	// cannot spawn processes.
+	Spawner                     contentmapper.Spawner
+	ContentMapperLogger         contentmapper.Logger
+	LanguageServicePluginLogger lsplugin.Logger
+	ParseCache                  *ParseCache
+	ContentMappedParseCache     *ContentMappedParseCache
 }
 
 // Session manages the state of an LSP session. It receives textDocument
@@ -116,7 +123,13 @@ type Session struct {
 	// contentMapperTimings is the cumulative host snapshot at the most recent session snapshot adoption.
 	contentMapperTimings   contentmapper.Timings
 	contentMapperTimingsMu sync.Mutex
-	fs                     *overlayFS
+	// languageServicePluginHost drives the language service plugins configured by projects in the session.
+	// Like contentMapperHost, it is nil unless the workspace is trusted and a spawner is available.
+	languageServicePluginHost lsplugin.Host
+	// languageServicePlugins caches the plugins resolved for each config file, keyed by config file path.
+	languageServicePlugins   map[string]*resolvedLanguageServicePlugins
+	languageServicePluginsMu sync.Mutex
+	fs                       *overlayFS
 
 	// registeredContentMapperSnapshotID is the ID of the newest snapshot whose registration has been
 	// applied. Registration runs from background tasks that may finish out of order, so
@@ -144,6 +157,8 @@ type Session struct {
 	workspaceUserPreferences           lsutil.UserPreferences
 	compilerOptionsForInferredProjects *core.CompilerOptions
 	typingsInstaller                   *ata.TypingsInstaller
+	typingsInstallersMu                sync.Mutex
+	typingsInstallers                  map[typingsPackageManagerKey]*ata.TypingsInstaller
 	backgroundQueue                    *background.Queue
 
 	// snapshotID is the counter for snapshot IDs. It does not necessarily
@@ -184,6 +199,12 @@ type Session struct {
 	diagnosticsRefreshGeneration uint64
 	diagnosticsRefreshMu         sync.Mutex
 
+	// workspaceChanged is closed at the next change to the workspace: a file
+	// event, a configuration change or an ATA installation. Requests that are
+	// held open until something changes wait on it.
+	workspaceChanged   chan struct{}
+	workspaceChangedMu sync.Mutex
+
 	// warmAutoImportCancel is the cancelation function for a running
 	// auto-import cache warming task. It is cancelled on file opens,
 	// closes, changes, watched-file changes, new auto-import warming
@@ -227,6 +248,19 @@ func newContentMapperHost(init *SessionInit) contentmapper.Host {
 	return contentmapper.NewHostWithOptions(init.BackgroundCtx, init.Spawner, diagnosticLocale, contentmapper.HostOptions{Logger: init.ContentMapperLogger})
 }
 
+// newLanguageServicePluginHost creates the session's shared language service plugin host when the workspace
+// is trusted and a spawner is available; otherwise it returns nil, and configured plugins are ignored.
+func newLanguageServicePluginHost(init *SessionInit) lsplugin.Host {
+	if !init.Options.RunExternalCode || init.Spawner == nil {
+		return nil
+	}
+	pluginLocale := locale.Default
+	if init.Client != nil {
+		pluginLocale = init.Client.GetLocale()
+	}
+	return lsplugin.NewHostWithOptions(init.BackgroundCtx, init.Spawner, pluginLocale, init.Options.PositionEncoding, lsplugin.HostOptions{Logger: init.LanguageServicePluginLogger})
+}
+
 func NewSession(init *SessionInit) *Session {
 	currentDirectory := init.Options.CurrentDirectory
 	useCaseSensitiveFileNames := init.FS.UseCaseSensitiveFileNames()
@@ -249,20 +283,21 @@ func NewSession(init *SessionInit) *Session {
 		sessionLogger = logging.NewNopLogger()
 	}
 	session := &Session{
-		backgroundCtx:           init.BackgroundCtx,
-		options:                 init.Options,
-		toPath:                  toPath,
-		client:                  init.Client,
-		logger:                  sessionLogger,
-		npmExecutor:             init.NpmExecutor,
-		contentMapperHost:       newContentMapperHost(init),
-		fs:                      overlayFS,
-		parseCache:              parseCache,
-		contentMappedParseCache: contentMappedParseCache,
-		extendedConfigCache:     extendedConfigCache,
-		programCounter:          &programCounter{},
-		backgroundQueue:         background.NewQueue(),
-		startTime:               time.Now(),
+		backgroundCtx:             init.BackgroundCtx,
+		options:                   init.Options,
+		toPath:                    toPath,
+		client:                    init.Client,
+		logger:                    sessionLogger,
+		npmExecutor:               init.NpmExecutor,
+		contentMapperHost:         newContentMapperHost(init),
+		languageServicePluginHost: newLanguageServicePluginHost(init),
+		fs:                        overlayFS,
+		parseCache:                parseCache,
+		contentMappedParseCache:   contentMappedParseCache,
+		extendedConfigCache:       extendedConfigCache,
+		programCounter:            &programCounter{},
+		backgroundQueue:           background.NewQueue(),
+		startTime:                 time.Now(),
 		snapshot: NewSnapshot(
 			uint64(0),
 			&SnapshotFS{
@@ -270,7 +305,12 @@ func NewSession(init *SessionInit) *Session {
 				fs:     init.FS,
 			},
 			init.Options,
-			&ConfigFileRegistry{},
+			&ConfigFileRegistry{
+				workspaceFolders: normalizeWorkspaceFolders(init.Options.WorkspaceFolders, tspath.ComparePathsOptions{
+					UseCaseSensitiveFileNames: useCaseSensitiveFileNames,
+					CurrentDirectory:          currentDirectory,
+				}),
+			},
 			nil,
 			lsutil.NewDefaultUserPreferences(),
 			nil,
@@ -320,6 +360,61 @@ func (s *Session) GetCurrentDirectory() string {
 	return s.options.CurrentDirectory
 }
 
+// PositionEncoding returns the encoding of line/character positions used by the session.
+func (s *Session) PositionEncoding() lsproto.PositionEncodingKind {
+	return s.options.PositionEncoding
+}
+
+// LanguageServicePlugins resolves the language service plugins configured for the project of
+// languageService. It returns a nil host when plugins cannot run in this session or the project
+// configures none. Plugins that fail to resolve are skipped; their errors are logged when resolved.
+func (s *Session) LanguageServicePlugins(languageService *ls.LanguageService) (lsplugin.Host, []*lsplugin.Plugin) {
+	if s.languageServicePluginHost == nil {
+		return nil, nil
+	}
+	options := languageService.GetProgram().Options()
+	if options.ConfigFilePath == "" || len(options.Plugins) == 0 {
+		return nil, nil
+	}
+	plugins := s.resolveLanguageServicePlugins(options)
+	if len(plugins) == 0 {
+		return nil, nil
+	}
+	return s.languageServicePluginHost, plugins
+}
+
+type resolvedLanguageServicePlugins struct {
+	options *core.CompilerOptions
+	plugins []*lsplugin.Plugin
+}
+
+// resolveLanguageServicePlugins resolves the plugins of a config file once for each version of its
+// compiler options, which are replaced whenever the config file, and so its plugin list, changes.
+// Resolution errors are logged here, once, rather than by every request that uses the plugins.
+func (s *Session) resolveLanguageServicePlugins(options *core.CompilerOptions) []*lsplugin.Plugin {
+	s.languageServicePluginsMu.Lock()
+	resolved := s.languageServicePlugins[options.ConfigFilePath]
+	s.languageServicePluginsMu.Unlock()
+	if resolved != nil && resolved.options == options {
+		return resolved.plugins
+	}
+	plugins, errs := lsplugin.Resolve(s, options.ConfigFilePath, options.Plugins)
+	s.languageServicePluginsMu.Lock()
+	defer s.languageServicePluginsMu.Unlock()
+	if resolved := s.languageServicePlugins[options.ConfigFilePath]; resolved != nil && resolved.options == options {
+		// Another request resolved the same options first and has already logged their errors.
+		return resolved.plugins
+	}
+	for _, err := range errs {
+		s.logger.Error(err.Error())
+	}
+	if s.languageServicePlugins == nil {
+		s.languageServicePlugins = make(map[string]*resolvedLanguageServicePlugins)
+	}
+	s.languageServicePlugins[options.ConfigFilePath] = &resolvedLanguageServicePlugins{options: options, plugins: plugins}
+	return plugins
+}
+
 // Gets copy of current configuration
 func (s *Session) Config() lsutil.UserPreferences {
 	s.userConfigRWMu.Lock()
@@ -351,6 +446,7 @@ func (s *Session) Configure(config lsutil.UserPreferences) {
 	oldConfig := s.workspaceUserPreferences
 	s.workspaceUserPreferences = config
 	s.userConfigRWMu.Unlock()
+	s.notifyWorkspaceChanged()
 
 	if config.Locale != "" {
 		oldLocale := s.client.GetLocale()
@@ -360,6 +456,9 @@ func (s *Session) Configure(config lsutil.UserPreferences) {
 			if s.contentMapperHost != nil {
 				s.contentMapperHost.SetLocale(newLocale)
 			}
+			if s.languageServicePluginHost != nil {
+				s.languageServicePluginHost.SetLocale(newLocale)
+			}
 		}
 	}
 
@@ -378,6 +477,7 @@ func (s *Session) InitializeWithUserConfig(config lsutil.UserPreferences) {
 func (s *Session) DidOpenFile(ctx context.Context, uri lsproto.DocumentUri, version int32, content string, languageKind lsproto.LanguageKind) {
 	s.cancelWarmAutoImportCache()
 	s.scheduleIdleCacheClean()
+	defer s.notifyWorkspaceChanged()
 	s.cancelScheduledSnapshotUpdate()
 	s.snapshotUpdateMu.Lock()
 	defer s.snapshotUpdateMu.Unlock()
@@ -426,6 +526,7 @@ func (s *Session) SetContentMapperContributions(ctx context.Context, contributio
 func (s *Session) DidCloseFile(ctx context.Context, uri lsproto.DocumentUri) {
 	s.cancelWarmAutoImportCache()
 	s.scheduleIdleCacheClean()
+	defer s.notifyWorkspaceChanged()
 	s.pendingFileChangesMu.Lock()
 	s.pendingFileChanges = append(s.pendingFileChanges, FileChange{
 		Kind: FileChangeKindClose,
@@ -438,6 +539,7 @@ func (s *Session) DidCloseFile(ctx context.Context, uri lsproto.DocumentUri) {
 func (s *Session) DidChangeFile(ctx context.Context, uri lsproto.DocumentUri, version int32, changes []lsproto.TextDocumentContentChangePartialOrWholeDocument) {
 	s.cancelWarmAutoImportCache()
 	s.scheduleIdleCacheClean()
+	defer s.notifyWorkspaceChanged()
 	s.pendingFileChangesMu.Lock()
 	s.pendingFileChanges = append(s.pendingFileChanges, FileChange{
 		Kind:    FileChangeKindChange,
@@ -472,6 +574,7 @@ func (s *Session) isContentMapperFile(uri lsproto.DocumentUri) bool {
 
 func (s *Session) DidSaveFile(ctx context.Context, uri lsproto.DocumentUri) {
 	s.scheduleIdleCacheClean()
+	defer s.notifyWorkspaceChanged()
 	s.pendingFileChangesMu.Lock()
 	defer s.pendingFileChangesMu.Unlock()
 	s.pendingFileChanges = append(s.pendingFileChanges, FileChange{
@@ -484,6 +587,7 @@ func (s *Session) DidChangeWatchedFiles(ctx context.Context, changes []*lsproto.
 	fileChanges := make([]FileChange, 0, len(changes))
 	hasRelevantChange := false
 	hasConfigChange := false
+	hasPackageJSONChange := false
 	snapshot := s.Snapshot()
 	configFileRegistry := snapshot.ConfigFileRegistry
 	contentMapperExtensions, contentMapperWatchedFiles := snapshot.contentMapperWatchState()
@@ -507,6 +611,9 @@ func (s *Session) DidChangeWatchedFiles(ctx context.Context, changes []*lsproto.
 		if !hasConfigChange && configFileRegistry.isTracked(s.toPath(change.Uri.FileName())) {
 			hasConfigChange = true
 		}
+		if !hasPackageJSONChange && tspath.GetBaseFileName(change.Uri.FileName()) == "package.json" {
+			hasPackageJSONChange = true
+		}
 
 		if !hasRelevantChange {
 			fileName := change.Uri.FileName()
@@ -544,6 +651,13 @@ func (s *Session) DidChangeWatchedFiles(ctx context.Context, changes []*lsproto.
 	s.pendingFileChanges = append(s.pendingFileChanges, fileChanges...)
 	s.pendingFileChangesMu.Unlock()
 
+	if hasPackageJSONChange {
+		// Plugins are resolved from the package.json files of their packages.
+		s.languageServicePluginsMu.Lock()
+		s.languageServicePlugins = nil
+		s.languageServicePluginsMu.Unlock()
+	}
+
 	if hasRelevantChange {
 		// Schedule a debounced diagnostics refresh only for paths
 		// that can affect the TypeScript program (relevant extensions or directories).
@@ -557,6 +671,7 @@ func (s *Session) DidChangeWatchedFiles(ctx context.Context, changes []*lsproto.
 	}
 	s.cancelWarmAutoImportCache()
 	s.scheduleIdleCacheClean()
+	s.notifyWorkspaceChanged()
 }
 
 func (s *Session) DidChangeCompilerOptionsForInferredProjects(ctx context.Context, options *core.CompilerOptions) {
@@ -565,6 +680,61 @@ func (s *Session) DidChangeCompilerOptionsForInferredProjects(ctx context.Contex
 		reason:                             UpdateReasonDidChangeCompilerOptionsForInferredProjects,
 		compilerOptionsForInferredProjects: options,
 	})
+	s.notifyWorkspaceChanged()
+}
+
+// DidChangeWorkspaceFolders adds and removes workspace folders. Since multiple workspace folders bound the search
+// for config files and group files into inferred projects, the projects of open files are recomputed.
+func (s *Session) DidChangeWorkspaceFolders(ctx context.Context, added []string, removed []string) {
+	s.cancelScheduledSnapshotUpdate()
+	s.snapshotUpdateMu.Lock()
+	defer s.snapshotUpdateMu.Unlock()
+
+	comparePathsOptions := tspath.ComparePathsOptions{
+		UseCaseSensitiveFileNames: s.fs.fs.UseCaseSensitiveFileNames(),
+		CurrentDirectory:          s.options.CurrentDirectory,
+	}
+	removedFolders := normalizeWorkspaceFolders(removed, comparePathsOptions)
+	addedFolders := normalizeWorkspaceFolders(added, comparePathsOptions)
+	workspaceFolders := slices.DeleteFunc(slices.Concat(s.Snapshot().WorkspaceFolders(), addedFolders), func(folder string) bool {
+		return slices.ContainsFunc(removedFolders, func(removedFolder string) bool {
+			return tspath.ComparePaths(folder, removedFolder, comparePathsOptions) == 0
+		})
+	})
+	workspaceFolders = normalizeWorkspaceFolders(workspaceFolders, comparePathsOptions)
+	if slices.Equal(workspaceFolders, s.Snapshot().WorkspaceFolders()) {
+		return
+	}
+
+	s.pendingFileChangesMu.Lock()
+	changes, overlays := s.flushChangesLocked(ctx)
+	s.pendingFileChangesMu.Unlock()
+	s.UpdateSnapshot(ctx, overlays, SnapshotChange{
+		reason:           UpdateReasonDidChangeWorkspaceFolders,
+		fileChanges:      changes,
+		workspaceFolders: workspaceFolders,
+	})
+	s.notifyWorkspaceChanged()
+	s.ScheduleDiagnosticsRefresh()
+}
+
+// WorkspaceChanged returns a channel that is closed at the next change to the workspace.
+func (s *Session) WorkspaceChanged() <-chan struct{} {
+	s.workspaceChangedMu.Lock()
+	defer s.workspaceChangedMu.Unlock()
+	if s.workspaceChanged == nil {
+		s.workspaceChanged = make(chan struct{})
+	}
+	return s.workspaceChanged
+}
+
+func (s *Session) notifyWorkspaceChanged() {
+	s.workspaceChangedMu.Lock()
+	defer s.workspaceChangedMu.Unlock()
+	if s.workspaceChanged != nil {
+		close(s.workspaceChanged)
+		s.workspaceChanged = nil
+	}
 }
 
 func (s *Session) ScheduleDiagnosticsRefresh() {
@@ -1751,6 +1921,9 @@ func (s *Session) Close() {
 	if s.contentMapperHost != nil {
 		_ = s.contentMapperHost.Close()
 	}
+	if s.languageServicePluginHost != nil {
+		_ = s.languageServicePluginHost.Close()
+	}
 }
 
 func (s *Session) flushChanges(ctx context.Context) (FileChangeSummary, map[tspath.Path]*Overlay, map[tspath.Path]*ATAStateChange, *lsutil.UserPreferences) {
@@ -1886,6 +2059,53 @@ func (s *Session) NpmInstall(cwd string, npmInstallArgs []string) ([]byte, error
 	return s.npmExecutor.NpmInstall(cwd, npmInstallArgs)
 }
 
+// RunPackageManager implements ata.PackageManagerExecutor
+func (s *Session) RunPackageManager(cwd string, command string, args []string) ([]byte, error) {
+	executor, ok := s.npmExecutor.(ata.PackageManagerExecutor)
+	if !ok {
+		return nil, fmt.Errorf("cannot run %s", command)
+	}
+	return executor.RunPackageManager(cwd, command, args)
+}
+
+type typingsPackageManagerKey struct {
+	name              string
+	localRegistryPath string
+}
+
+// typingsInstallerFor returns the typings installer for the package manager selected in the preferences.
+// Each package manager other than npm installs into its own subdirectory of the typings location, so that
+// their node_modules layouts and lockfiles do not conflict. It returns nil if the preferences do not select
+// a usable package manager, in which case typings are not installed.
+func (s *Session) typingsInstallerFor(prefs lsutil.UserPreferences) *ata.TypingsInstaller {
+	key := typingsPackageManagerKey{name: prefs.TypingsPackageManager, localRegistryPath: prefs.TypingsLocalRegistryPath}
+	s.typingsInstallersMu.Lock()
+	defer s.typingsInstallersMu.Unlock()
+	if installer, ok := s.typingsInstallers[key]; ok {
+		return installer
+	}
+	var installer *ata.TypingsInstaller
+	packageManager, err := ata.NewPackageManager(prefs.TypingsPackageManager, prefs.TypingsLocalRegistryPath)
+	switch {
+	case err != nil:
+		// Remember the failure, so that it is logged once per setting rather than on every installation.
+		s.logger.Error(fmt.Sprintf("Automatic type acquisition is disabled: %v", err))
+	case packageManager.Name() == ata.PackageManagerNpm:
+		installer = s.typingsInstaller
+	default:
+		installer = ata.NewTypingsInstaller(&ata.TypingsInstallerOptions{
+			TypingsLocation: tspath.CombinePaths(s.options.TypingsLocation, packageManager.Name()),
+			ThrottleLimit:   5,
+			PackageManager:  packageManager,
+		}, s)
+	}
+	if s.typingsInstallers == nil {
+		s.typingsInstallers = make(map[typingsPackageManagerKey]*ata.TypingsInstaller)
+	}
+	s.typingsInstallers[key] = installer
+	return installer
+}
+
 func (s *Session) refreshInlayHintsIfNeeded(oldPrefs lsutil.UserPreferences, newPrefs lsutil.UserPreferences) {
 	if oldPrefs.InlayHints != newPrefs.InlayHints {
 		if err := s.client.RefreshInlayHints(s.backgroundContext()); err != nil && s.options.LoggingEnabled {
@@ -1915,6 +2135,10 @@ func (s *Session) refreshATAIfNeeded(oldPrefs lsutil.UserPreferences, newPrefs l
 		// ATA was re-enabled; schedule a diagnostics refresh so the next snapshot update
 		// re-triggers ATA for existing projects with the new setting.
 		s.ScheduleDiagnosticsRefresh()
+	} else if !newPrefs.IsATADisabled() && (oldPrefs.TypingsPackageManager != newPrefs.TypingsPackageManager ||
+		oldPrefs.TypingsLocalRegistryPath != newPrefs.TypingsLocalRegistryPath) {
+		// Retry installing typings that the previously selected package manager failed to install.
+		s.ScheduleDiagnosticsRefresh()
 	}
 }
 
@@ -2048,6 +2272,11 @@ func (s *Session) triggerATAForUpdatedProjects(newSnapshot *Snapshot) {
 					logTree = logging.NewLogTree("Triggering ATA for project " + project.Name())
 				}
 
+				typingsInstaller := s.typingsInstallerFor(newSnapshot.UserPreferences())
+				if typingsInstaller == nil {
+					return
+				}
+
 				typingsInfo := project.ComputeTypingsInfo()
 				request := &ata.TypingsInstallRequest{
 					ProjectID:        project.configFilePath,
@@ -2065,7 +2294,7 @@ func (s *Session) triggerATAForUpdatedProjects(newSnapshot *Snapshot) {
 				if s.client != nil {
 					s.client.ProgressStart(diagnostics.Installing_types_for_0, projectDisplayName)
 				}
-				result, err := s.typingsInstaller.InstallTypings(request)
+				result, err := typingsInstaller.InstallTypings(request)
 				if s.client != nil {
 					s.client.ProgressFinish(diagnostics.Installing_types_for_0, projectDisplayName)
 				}
@@ -2084,6 +2313,7 @@ func (s *Session) triggerATAForUpdatedProjects(newSnapshot *Snapshot) {
 							TypingsFilesToWatch: result.FilesToWatch,
 							Logs:                logTree,
 						}
+						s.notifyWorkspaceChanged()
 						s.ScheduleDiagnosticsRefresh()
 					}
 				}
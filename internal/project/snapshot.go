@@ -119,6 +119,11 @@ func (s *Snapshot) GetProjectsContainingFile(uri lsproto.DocumentUri) []ls.Proje
 	return s.ProjectCollection.GetProjectsContainingFile(path)
 }
 
+// WorkspaceFolders returns the normalized workspace folders of the session at the time of the snapshot.
+func (s *Snapshot) WorkspaceFolders() []string {
+	return s.ConfigFileRegistry.workspaceFolders
+}
+
 func (s *Snapshot) GetFile(fileName string) FileHandle {
 	return s.fs.GetFile(fileName)
 }
@@ -243,6 +248,10 @@ type SnapshotChange struct {
 	compilerOptionsForInferredProjects *core.CompilerOptions
 	contentMapperContributions         *ContentMapperContributions
 	newConfig                          *lsutil.UserPreferences
+	// workspaceFolders is the normalized set of workspace folders. It should only be set
+	// if the folders in the next snapshot should change; if nil, the folders of the
+	// previous snapshot are kept.
+	workspaceFolders []string
 	// ataChanges contains ATA-related changes to apply to projects in the new snapshot.
 	ataChanges map[tspath.Path]*ATAStateChange
 	apiRequest *APISnapshotRequest
@@ -321,6 +330,8 @@ func (s *Snapshot) Clone(
 			logger.Logf("Reason: DidChangeConfigFile - %v", getDetails())
 		case UpdateReasonDidChangeContentMapperContributions:
 			logger.Logf("Reason: DidChangeContentMapperContributions - %v", getDetails())
+		case UpdateReasonDidChangeWorkspaceFolders:
+			logger.Logf("Reason: DidChangeWorkspaceFolders - %v", change.workspaceFolders)
 		}
 	}
 
@@ -378,6 +389,11 @@ func (s *Snapshot) Clone(
 		customConfigFileName = change.newConfig.CustomConfigFileName
 	}
 
+	workspaceFolders := s.ConfigFileRegistry.workspaceFolders
+	if change.workspaceFolders != nil {
+		workspaceFolders = change.workspaceFolders
+	}
+
 	newSnapshotID := session.snapshotID.Add(1)
 	projectCollectionBuilder := newProjectCollectionBuilder(
 		ctx,
@@ -391,6 +407,7 @@ func (s *Snapshot) Clone(
 		inferredContentMapperExtensions,
 		s.sessionOptions,
 		customConfigFileName,
+		workspaceFolders,
 		session.parseCache,
 		session.contentMappedParseCache,
 		session.extendedConfigCache,
@@ -403,6 +420,7 @@ func (s *Snapshot) Clone(
 	}
 
 	projectCollectionBuilder.DidChangeCustomConfigFileName(logger.Fork("DidChangeCustomConfigFileName"))
+	projectCollectionBuilder.DidChangeWorkspaceFolders(logger.Fork("DidChangeWorkspaceFolders"))
 	if change.contentMapperContributions != nil {
 		projectCollectionBuilder.DidChangeContentMapperContributions(logger.Fork("DidChangeContentMapperContributions"))
 	}
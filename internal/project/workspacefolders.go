@@ -0,0 +1,47 @@
+package project
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/microsoft/typescript-go/internal/tspath"
+)
+
+// normalizeWorkspaceFolders returns the normalized workspace folder paths in a stable order,
+// without duplicates, so that folder sets can be compared between snapshots.
+func normalizeWorkspaceFolders(folders []string, comparePathsOptions tspath.ComparePathsOptions) []string {
+	result := make([]string, 0, len(folders))
+	for _, folder := range folders {
+		if folder == "" {
+			continue
+		}
+		result = append(result, tspath.RemoveTrailingDirectorySeparator(tspath.NormalizePath(folder)))
+	}
+	slices.SortFunc(result, func(a, b string) int {
+		return tspath.ComparePaths(a, b, comparePathsOptions)
+	})
+	return slices.CompactFunc(result, func(a, b string) bool {
+		return tspath.ComparePaths(a, b, comparePathsOptions) == 0
+	})
+}
+
+// getWorkspaceFolderForFile returns the innermost workspace folder containing fileName,
+// or "" if the file is outside of every workspace folder.
+func getWorkspaceFolderForFile(folders []string, fileName string, comparePathsOptions tspath.ComparePathsOptions) string {
+	var result string
+	for _, folder := range folders {
+		if len(folder) > len(result) && tspath.ContainsPath(folder, fileName, comparePathsOptions) {
+			result = folder
+		}
+	}
+	return result
+}
+
+// getInferredProjectNameForFolder returns the name of the inferred project holding the
+// files of a workspace folder; the empty folder names the project for all other files.
+func getInferredProjectNameForFolder(folder tspath.Path) string {
+	if folder == "" {
+		return inferredProjectName
+	}
+	return inferredProjectName + "/" + strings.TrimPrefix(string(folder), "/")
+}
@@ -0,0 +1,136 @@
+package project_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/microsoft/typescript-go/internal/bundled"
+	"github.com/microsoft/typescript-go/internal/lsp/lsproto"
+	"github.com/microsoft/typescript-go/internal/project"
+	"github.com/microsoft/typescript-go/internal/testutil/projecttestutil"
+	"gotest.tools/v3/assert"
+)
+
+func TestWorkspaceFolders(t *testing.T) {
+	t.Parallel()
+	if !bundled.Embedded {
+		t.Skip("bundled files are not embedded")
+	}
+
+	files := map[string]any{
+		"/repo/tsconfig.json":   `{"compilerOptions": {"strict": true}}`,
+		"/repo/a/index.ts":      `export const a = 1;`,
+		"/repo/b/index.ts":      `export const b = 2;`,
+		"/repo/c/tsconfig.json": `{}`,
+		"/repo/c/index.ts":      `export const c = 3;`,
+		"/other/index.ts":       `export const other = 4;`,
+		"/elsewhere/index.ts":   `export const elsewhere = 5;`,
+	}
+	uriA := lsproto.DocumentUri("file:///repo/a/index.ts")
+	uriB := lsproto.DocumentUri("file:///repo/b/index.ts")
+	uriC := lsproto.DocumentUri("file:///repo/c/index.ts")
+	uriOther := lsproto.DocumentUri("file:///other/index.ts")
+	uriElsewhere := lsproto.DocumentUri("file:///elsewhere/index.ts")
+
+	sessionOptions := func(workspaceFolders ...string) *project.SessionOptions {
+		return &project.SessionOptions{
+			CurrentDirectory:   "/repo",
+			WorkspaceFolders:   workspaceFolders,
+			DefaultLibraryPath: bundled.LibPath(),
+			PositionEncoding:   lsproto.PositionEncodingKindUTF8,
+			LoggingEnabled:     true,
+		}
+	}
+
+	openFile := func(t *testing.T, session *project.Session, uri lsproto.DocumentUri) {
+		t.Helper()
+		session.DidOpenFile(context.Background(), uri, 1, files[uri.FileName()].(string), lsproto.LanguageKindTypeScript)
+		_, err := session.GetLanguageService(context.Background(), uri)
+		assert.NilError(t, err)
+	}
+
+	t.Run("config file discovery stops at the workspace folder", func(t *testing.T) {
+		t.Parallel()
+		session, _ := projecttestutil.SetupWithOptions(files, sessionOptions("/repo/a", "/repo/c"))
+		openFile(t, session, uriA)
+		openFile(t, session, uriC)
+
+		snapshot := session.Snapshot()
+		assert.Equal(t, snapshot.GetDefaultProject(uriA).Kind, project.KindInferred)
+		assert.Equal(t, snapshot.GetDefaultProject(uriC).Name(), "/repo/c/tsconfig.json")
+		assert.Assert(t, snapshot.ProjectCollection.ConfiguredProject("/repo/tsconfig.json") == nil)
+	})
+
+	t.Run("config file discovery continues above a single workspace folder", func(t *testing.T) {
+		t.Parallel()
+		session, _ := projecttestutil.SetupWithOptions(files, sessionOptions("/repo/a"))
+		openFile(t, session, uriA)
+
+		snapshot := session.Snapshot()
+		assert.Equal(t, snapshot.GetDefaultProject(uriA).Name(), "/repo/tsconfig.json")
+	})
+
+	t.Run("inferred projects are scoped per workspace folder", func(t *testing.T) {
+		t.Parallel()
+		session, _ := projecttestutil.SetupWithOptions(files, sessionOptions("/repo/a", "/repo/b"))
+		openFile(t, session, uriA)
+		openFile(t, session, uriB)
+
+		snapshot := session.Snapshot()
+		inferredProjects := snapshot.ProjectCollection.InferredProjects()
+		assert.Equal(t, len(inferredProjects), 2)
+		assert.Assert(t, snapshot.ProjectCollection.InferredProject() == nil)
+
+		projectA := snapshot.GetDefaultProject(uriA)
+		projectB := snapshot.GetDefaultProject(uriB)
+		assert.Assert(t, projectA != projectB)
+		assert.Equal(t, projectA.DisplayName("/repo"), "a")
+		assert.Equal(t, projectB.DisplayName("/repo"), "b")
+		assert.Assert(t, projectA.GetProgram().GetSourceFile("/repo/b/index.ts") == nil)
+	})
+
+	t.Run("single workspace folder shares one inferred project", func(t *testing.T) {
+		t.Parallel()
+		session, _ := projecttestutil.SetupWithOptions(files, sessionOptions("/other"))
+		openFile(t, session, uriOther)
+		openFile(t, session, uriElsewhere)
+
+		snapshot := session.Snapshot()
+		assert.Equal(t, len(snapshot.ProjectCollection.InferredProjects()), 1)
+		assert.Assert(t, snapshot.GetDefaultProject(uriOther) == snapshot.ProjectCollection.InferredProject())
+		assert.Assert(t, snapshot.GetDefaultProject(uriElsewhere) == snapshot.ProjectCollection.InferredProject())
+	})
+
+	t.Run("projects follow added and removed workspace folders", func(t *testing.T) {
+		t.Parallel()
+		session, _ := projecttestutil.SetupWithOptions(files, sessionOptions())
+		openFile(t, session, uriA)
+		openFile(t, session, uriB)
+
+		snapshot := session.Snapshot()
+		assert.Equal(t, snapshot.GetDefaultProject(uriA).Name(), "/repo/tsconfig.json")
+		assert.Equal(t, snapshot.GetDefaultProject(uriB).Name(), "/repo/tsconfig.json")
+
+		session.DidChangeWorkspaceFolders(context.Background(), []string{"/repo/a", "/repo/b"}, nil)
+		_, err := session.GetLanguageService(context.Background(), uriA)
+		assert.NilError(t, err)
+
+		snapshot = session.Snapshot()
+		assert.DeepEqual(t, snapshot.WorkspaceFolders(), []string{"/repo/a", "/repo/b"})
+		assert.Equal(t, snapshot.GetDefaultProject(uriA).Kind, project.KindInferred)
+		assert.Equal(t, snapshot.GetDefaultProject(uriB).Kind, project.KindInferred)
+		assert.Equal(t, len(snapshot.ProjectCollection.InferredProjects()), 2)
+		assert.Assert(t, snapshot.ProjectCollection.ConfiguredProject("/repo/tsconfig.json") == nil)
+
+		session.DidChangeWorkspaceFolders(context.Background(), nil, []string{"/repo/b"})
+		_, err = session.GetLanguageService(context.Background(), uriB)
+		assert.NilError(t, err)
+
+		// The config file found for uriB also includes uriA, which takes precedence over an inferred project.
+		snapshot = session.Snapshot()
+		assert.DeepEqual(t, snapshot.WorkspaceFolders(), []string{"/repo/a"})
+		assert.Equal(t, snapshot.GetDefaultProject(uriA).Name(), "/repo/tsconfig.json")
+		assert.Equal(t, snapshot.GetDefaultProject(uriB).Name(), "/repo/tsconfig.json")
+		assert.Equal(t, len(snapshot.ProjectCollection.InferredProjects()), 0)
+	})
+}
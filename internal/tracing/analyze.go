@@ -0,0 +1,419 @@
+package tracing
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/json"
+	"github.com/microsoft/typescript-go/internal/scanner"
+	"github.com/microsoft/typescript-go/internal/tspath"
+	"github.com/microsoft/typescript-go/internal/vfs"
+)
+
+// AnalyzeTraceOptions controls the report written by AnalyzeTrace.
+type AnalyzeTraceOptions struct {
+	// ThresholdMillis is the shortest duration of a checker event that is
+	// reported as a hot spot.
+	ThresholdMillis float64
+	// ComparePathsOptions is used to print paths relative to the current
+	// directory.
+	ComparePathsOptions tspath.ComparePathsOptions
+}
+
+// maxTypeNameDepth bounds how deeply type arguments and union constituents
+// are expanded when naming a type from types.json.
+const maxTypeNameDepth = 3
+
+// AnalyzeTrace reads the trace.json, types_N.json and legend.json files that
+// --generateTrace writes to traceDir, and prints the hot spots of type checking:
+// the checker events that took longer than the threshold, nested under the
+// events they were part of, and the places where the checker hit a depth limit
+// such as the type instantiation limit. Types are named from the types files
+// and positions are resolved against the source files, if they still exist.
+func AnalyzeTrace(fs vfs.FS, traceDir string, w io.Writer, options AnalyzeTraceOptions) error {
+	a := &traceAnalyzer{
+		fs:      fs,
+		options: options,
+		types:   make(map[int]map[uint32]*TypeDescriptor),
+		files:   make(map[string]*traceSourceFile),
+	}
+	if err := a.read(traceDir); err != nil {
+		return err
+	}
+	a.buildSpans()
+	a.writeHotSpots(w)
+	a.writeDepthLimits(w)
+	return nil
+}
+
+type traceAnalyzer struct {
+	fs      vfs.FS
+	options AnalyzeTraceOptions
+
+	events []*traceEvent
+	// types maps checker IDs to the types recorded by that checker.
+	types map[int]map[uint32]*TypeDescriptor
+	files map[string]*traceSourceFile
+
+	roots  []*traceSpan
+	spans  []*traceSpan
+	limits []*traceEvent
+}
+
+// traceSpan is a duration event, from a "B"/"E" pair or a complete "X" event,
+// along with the events that happened during it on the same thread.
+type traceSpan struct {
+	event    *traceEvent
+	start    float64
+	end      float64
+	children []*traceSpan
+}
+
+func (s *traceSpan) duration() float64 {
+	return s.end - s.start
+}
+
+// traceSourceFile is the text of a file named by a trace event, used to turn
+// positions into line and character numbers.
+type traceSourceFile struct {
+	text    string
+	lineMap []core.TextPos
+}
+
+func (f *traceSourceFile) Text() string                { return f.text }
+func (f *traceSourceFile) ECMALineMap() []core.TextPos { return f.lineMap }
+
+func (a *traceAnalyzer) read(traceDir string) error {
+	legendText, ok := a.fs.ReadFile(tspath.CombinePaths(traceDir, "legend.json"))
+	if !ok {
+		return fmt.Errorf("could not read %s; was it written with --generateTrace?", tspath.CombinePaths(traceDir, "legend.json"))
+	}
+	var legend []TraceRecord
+	if err := json.Unmarshal([]byte(legendText), &legend); err != nil {
+		return fmt.Errorf("failed to parse legend file: %w", err)
+	}
+	if len(legend) == 0 {
+		return errors.New("the legend file lists no traces")
+	}
+
+	var tracePaths []string
+	for _, record := range legend {
+		if record.TracePath != "" && !slices.Contains(tracePaths, record.TracePath) {
+			tracePaths = append(tracePaths, record.TracePath)
+		}
+		if record.TypesPath == "" {
+			continue
+		}
+		// Checkers that created no types do not write a types file.
+		typesText, ok := a.fs.ReadFile(record.TypesPath)
+		if !ok {
+			continue
+		}
+		var types []*TypeDescriptor
+		if err := json.Unmarshal([]byte(typesText), &types); err != nil {
+			return fmt.Errorf("failed to parse types file %s: %w", record.TypesPath, err)
+		}
+		byID := make(map[uint32]*TypeDescriptor, len(types))
+		for _, t := range types {
+			byID[t.ID] = t
+		}
+		a.types[record.CheckerID] = byID
+	}
+
+	for _, tracePath := range tracePaths {
+		traceText, ok := a.fs.ReadFile(tracePath)
+		if !ok {
+			return fmt.Errorf("could not read trace file %s", tracePath)
+		}
+		var events []*traceEvent
+		if err := json.Unmarshal([]byte(traceText), &events); err != nil {
+			return fmt.Errorf("failed to parse trace file %s: %w", tracePath, err)
+		}
+		a.events = append(a.events, events...)
+	}
+	return nil
+}
+
+// buildSpans pairs begin and end events and nests the resulting spans by
+// thread, so that each span's children are the spans it contains.
+func (a *traceAnalyzer) buildSpans() {
+	open := make(map[int][]*traceSpan)
+	for _, event := range a.events {
+		switch event.PH {
+		case "B":
+			open[event.TID] = append(open[event.TID], &traceSpan{event: event, start: event.TS})
+		case "E":
+			stack := open[event.TID]
+			for i := len(stack) - 1; i >= 0; i-- {
+				if stack[i].event.Name == event.Name {
+					stack[i].end = event.TS
+					a.spans = append(a.spans, stack[i])
+					open[event.TID] = slices.Delete(stack, i, i+1)
+					break
+				}
+			}
+		case "X":
+			if event.Dur != nil {
+				a.spans = append(a.spans, &traceSpan{event: event, start: event.TS, end: event.TS + *event.Dur})
+			}
+		case "I":
+			if strings.HasSuffix(event.Name, "_DepthLimit") {
+				a.limits = append(a.limits, event)
+			}
+		}
+	}
+
+	slices.SortStableFunc(a.spans, func(x, y *traceSpan) int {
+		return cmp.Or(cmp.Compare(x.event.TID, y.event.TID), cmp.Compare(x.start, y.start), cmp.Compare(y.end, x.end))
+	})
+	var stack []*traceSpan
+	for _, span := range a.spans {
+		for len(stack) > 0 && (stack[len(stack)-1].event.TID != span.event.TID || stack[len(stack)-1].end < span.end) {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) > 0 {
+			parent := stack[len(stack)-1]
+			parent.children = append(parent.children, span)
+		} else {
+			a.roots = append(a.roots, span)
+		}
+		stack = append(stack, span)
+	}
+}
+
+// writeHotSpots prints the checker events that took at least the threshold,
+// slowest first, each followed by the hot spots within it.
+func (a *traceAnalyzer) writeHotSpots(w io.Writer) {
+	var hot []*traceSpan
+	var collect func(spans []*traceSpan)
+	collect = func(spans []*traceSpan) {
+		for _, span := range spans {
+			if a.isHot(span) {
+				hot = append(hot, span)
+			} else {
+				collect(span.children)
+			}
+		}
+	}
+	collect(a.roots)
+
+	fmt.Fprintln(w, "Hot spots:")
+	if len(hot) == 0 {
+		fmt.Fprintf(w, "  No checker events took %gms or longer.\n\n", a.options.ThresholdMillis)
+		return
+	}
+	var write func(spans []*traceSpan, indent string)
+	write = func(spans []*traceSpan, indent string) {
+		slices.SortStableFunc(spans, func(x, y *traceSpan) int {
+			return cmp.Compare(y.duration(), x.duration())
+		})
+		for _, span := range spans {
+			if !a.isHot(span) {
+				continue
+			}
+			fmt.Fprintf(w, "%s%s (%s)\n", indent, a.describeEvent(span.event), formatMillis(span.duration()))
+			write(span.children, indent+"  ")
+		}
+	}
+	write(hot, "  ")
+	fmt.Fprintln(w)
+}
+
+// isHot reports whether span was recorded by a checker and took at least the
+// threshold. Durations in the trace are in microseconds.
+func (a *traceAnalyzer) isHot(span *traceSpan) bool {
+	_, ok := checkerID(span.event)
+	return ok && span.duration() >= a.options.ThresholdMillis*1000
+}
+
+// writeDepthLimits prints the places where the checker gave up on a
+// computation because it nested too deeply or grew too large.
+func (a *traceAnalyzer) writeDepthLimits(w io.Writer) {
+	if len(a.limits) == 0 {
+		return
+	}
+	fmt.Fprintln(w, "Depth limits reached:")
+	for _, event := range a.limits {
+		description := a.describeEvent(event)
+		if span := a.enclosingSpan(event); span != nil {
+			if location := a.location(span.event); location != "" {
+				description += " at " + location
+			}
+		}
+		fmt.Fprintf(w, "  %s\n", description)
+	}
+	fmt.Fprintln(w)
+}
+
+// enclosingSpan returns the innermost span about a file that was open on the
+// thread of event when it happened.
+func (a *traceAnalyzer) enclosingSpan(event *traceEvent) *traceSpan {
+	var result *traceSpan
+	for _, span := range a.spans {
+		if span.event.TID == event.TID && span.start <= event.TS && event.TS <= span.end && a.location(span.event) != "" {
+			if result == nil || span.duration() <= result.duration() {
+				result = span
+			}
+		}
+	}
+	return result
+}
+
+func (a *traceAnalyzer) describeEvent(event *traceEvent) string {
+	args := event.Args
+	switch event.Name {
+	case "checkSourceFile":
+		return "Check file " + a.relativePath(stringArg(args, "path"))
+	case "checkExpression", "checkVariableDeclaration", "checkDeferredNode":
+		text := event.Name
+		if kind, ok := numberArg(args, "kind"); ok {
+			text += " (" + strings.TrimPrefix(ast.Kind(kind).String(), "Kind") + ")"
+		}
+		if location := a.location(event); location != "" {
+			text += " at " + location
+		}
+		return text
+	case "structuredTypeRelatedTo", "checkTypeRelatedTo_DepthLimit", "recursiveTypeRelatedTo_DepthLimit",
+		"typeRelatedToDiscriminatedType_DepthLimit", "traceUnionsOrIntersectionsTooLarge_DepthLimit":
+		text := fmt.Sprintf("%s %s -> %s", event.Name, a.typeArg(event, "sourceId"), a.typeArg(event, "targetId"))
+		return text + formatArgs(args, "depth", "targetDepth", "numCombinations", "sourceSize", "targetSize")
+	case "instantiateType_DepthLimit":
+		return fmt.Sprintf("%s %s", event.Name, a.typeArg(event, "typeId")) + formatArgs(args, "instantiationDepth", "instantiationCount")
+	case "checkTypeParameterDeferred":
+		return fmt.Sprintf("%s %s of %s", event.Name, a.typeArg(event, "id"), a.typeArg(event, "parent"))
+	}
+	return event.Name + formatArgs(args, "size", "estimatedCount", "depth")
+}
+
+// location returns the position of the node an event was recorded for, the
+// file it was recorded for if it has no position, or the empty string if the
+// event is not about a file.
+func (a *traceAnalyzer) location(event *traceEvent) string {
+	path := stringArg(event.Args, "path")
+	if path == "" {
+		return ""
+	}
+	pos, ok := numberArg(event.Args, "pos")
+	if !ok {
+		return a.relativePath(path)
+	}
+	file := a.sourceFile(path)
+	if file == nil || pos < 0 || pos > len(file.text) {
+		return a.relativePath(path)
+	}
+	line, character := scanner.GetECMALineAndUTF16CharacterOfPosition(file, scanner.SkipTrivia(file.text, pos))
+	return fmt.Sprintf("%s:%d:%d", a.relativePath(path), line+1, int(character)+1)
+}
+
+func (a *traceAnalyzer) sourceFile(path string) *traceSourceFile {
+	if file, ok := a.files[path]; ok {
+		return file
+	}
+	var file *traceSourceFile
+	if text, ok := a.fs.ReadFile(path); ok {
+		file = &traceSourceFile{text: text, lineMap: core.ComputeECMALineStarts(text)}
+	}
+	a.files[path] = file
+	return file
+}
+
+func (a *traceAnalyzer) relativePath(path string) string {
+	if a.options.ComparePathsOptions.CurrentDirectory == "" {
+		return path
+	}
+	return tspath.ConvertToRelativePath(path, a.options.ComparePathsOptions)
+}
+
+func (a *traceAnalyzer) typeArg(event *traceEvent, name string) string {
+	id, ok := numberArg(event.Args, name)
+	if !ok {
+		return "?"
+	}
+	checker, _ := checkerID(event)
+	return a.typeName(checker, uint32(id), 0)
+}
+
+// typeName describes the type with the given ID as recorded by the given
+// checker, preferring the display text the checker wrote for it.
+func (a *traceAnalyzer) typeName(checker int, id uint32, depth int) string {
+	t := a.types[checker][id]
+	if t == nil {
+		return "type " + strconv.FormatUint(uint64(id), 10)
+	}
+	if t.Display != "" {
+		return t.Display
+	}
+	if t.IntrinsicName != "" {
+		return t.IntrinsicName
+	}
+	if depth >= maxTypeNameDepth {
+		return "..."
+	}
+	names := func(ids []uint32, separator string) string {
+		return strings.Join(core.Map(ids, func(id uint32) string { return a.typeName(checker, id, depth+1) }), separator)
+	}
+	switch {
+	case len(t.UnionTypes) > 0:
+		return names(t.UnionTypes, " | ")
+	case len(t.IntersectionTypes) > 0:
+		return names(t.IntersectionTypes, " & ")
+	case t.KeyofType != nil:
+		return "keyof " + a.typeName(checker, *t.KeyofType, depth+1)
+	case t.IndexedAccessObjectType != nil && t.IndexedAccessIndexType != nil:
+		return a.typeName(checker, *t.IndexedAccessObjectType, depth+1) + "[" + a.typeName(checker, *t.IndexedAccessIndexType, depth+1) + "]"
+	case t.SymbolName != "":
+		if len(t.AliasTypeArguments) > 0 {
+			return t.SymbolName + "<" + names(t.AliasTypeArguments, ", ") + ">"
+		}
+		if len(t.TypeArguments) > 0 {
+			return t.SymbolName + "<" + names(t.TypeArguments, ", ") + ">"
+		}
+		return t.SymbolName
+	}
+	return "type " + strconv.FormatUint(uint64(id), 10)
+}
+
+func checkerID(event *traceEvent) (int, bool) {
+	return numberArg(event.Args, "checkerId")
+}
+
+func stringArg(args map[string]any, name string) string {
+	s, _ := args[name].(string)
+	return s
+}
+
+// numberArg returns an integer argument of an event. Arguments read back from
+// trace.json are float64, but events built in memory may hold ints.
+func numberArg(args map[string]any, name string) (int, bool) {
+	switch n := args[name].(type) {
+	case float64:
+		return int(n), true
+	case int:
+		return n, true
+	}
+	return 0, false
+}
+
+func formatArgs(args map[string]any, names ...string) string {
+	var parts []string
+	for _, name := range names {
+		if n, ok := numberArg(args, name); ok {
+			parts = append(parts, fmt.Sprintf("%s: %d", name, n))
+		}
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(parts, ", ") + ")"
+}
+
+func formatMillis(micros float64) string {
+	return strconv.FormatFloat(micros/1000, 'f', 1, 64) + "ms"
+}
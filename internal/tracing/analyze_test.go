@@ -0,0 +1,84 @@
+package tracing
+
+import (
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/json"
+	"github.com/microsoft/typescript-go/internal/tspath"
+	"github.com/microsoft/typescript-go/internal/vfs/vfstest"
+	"gotest.tools/v3/assert"
+)
+
+func TestAnalyzeTraceReportsHotSpotsAndDepthLimits(t *testing.T) {
+	t.Parallel()
+
+	legend := []TraceRecord{{ConfigFilePath: "/p/tsconfig.json", TracePath: "/p/trace/trace.json", TypesPath: "/p/trace/types_0.json", CheckerID: 0}}
+	types := `[{"id":1,"intrinsicName":"number","flags":["Number"]},
+{"id":2,"symbolName":"Box","flags":["Object"]},
+{"id":3,"symbolName":"Box","instantiatedType":2,"typeArguments":[1],"flags":["Object"]},
+{"id":4,"intrinsicName":"string","flags":["String"]},
+{"id":5,"symbolName":"Box","instantiatedType":2,"typeArguments":[4],"flags":["Object"]},
+{"id":6,"unionTypes":[1,4],"flags":["Union"]}]
+`
+	checkFileA := map[string]any{"checkerId": 0, "path": "/p/a.ts"}
+	checkFileB := map[string]any{"checkerId": 0, "path": "/p/b.ts"}
+	events := []traceEvent{
+		{PID: 1, TID: 1, PH: "M", Cat: "__metadata", Name: "thread_name", Args: map[string]any{"name": "Main"}},
+		{PID: 1, TID: 1, PH: "B", Cat: "check", TS: 10, Name: "checkSourceFiles"},
+		{PID: 1, TID: 2, PH: "B", Cat: "check", TS: 100, Name: "checkSourceFile", Args: checkFileA},
+		{PID: 1, TID: 2, PH: "X", Cat: "check", TS: 200, Dur: new(600_000.0), Name: "checkVariableDeclaration", Args: map[string]any{"checkerId": 0, "kind": int(ast.KindVariableDeclaration), "pos": 23, "end": 45, "path": "/p/a.ts"}},
+		{PID: 1, TID: 2, PH: "X", Cat: "checkTypes", TS: 300, Dur: new(550_000.0), Name: "structuredTypeRelatedTo", Args: map[string]any{"checkerId": 0, "sourceId": 3, "targetId": 5}},
+		{PID: 1, TID: 2, PH: "I", Cat: "checkTypes", TS: 400, Name: "instantiateType_DepthLimit", S: "g", Args: map[string]any{"checkerId": 0, "typeId": 6, "instantiationDepth": 100, "instantiationCount": 5_000_000}},
+		{PID: 1, TID: 2, PH: "X", Cat: "check", TS: 700_000, Dur: new(50_000.0), Name: "checkExpression", Args: map[string]any{"checkerId": 0, "kind": int(ast.KindIdentifier), "pos": 44, "end": 45, "path": "/p/a.ts"}},
+		{PID: 1, TID: 2, PH: "E", Cat: "check", TS: 800_100, Name: "checkSourceFile", Args: checkFileA},
+		{PID: 1, TID: 2, PH: "B", Cat: "check", TS: 800_200, Name: "checkSourceFile", Args: checkFileB},
+		{PID: 1, TID: 2, PH: "E", Cat: "check", TS: 800_300, Name: "checkSourceFile", Args: checkFileB},
+		{PID: 1, TID: 1, PH: "E", Cat: "check", TS: 900_000, Name: "checkSourceFiles"},
+	}
+
+	fsys := vfstest.FromMap(map[string]string{
+		"/p/a.ts":               "const a = box(1);\nconst b: Box<string> = a;\n",
+		"/p/trace/legend.json":  marshalForTest(t, legend),
+		"/p/trace/types_0.json": types,
+		"/p/trace/trace.json":   marshalForTest(t, events),
+	}, true)
+
+	var output strings.Builder
+	assert.NilError(t, AnalyzeTrace(fsys, "/p/trace", &output, AnalyzeTraceOptions{
+		ThresholdMillis:     100,
+		ComparePathsOptions: tspath.ComparePathsOptions{CurrentDirectory: "/p", UseCaseSensitiveFileNames: true},
+	}))
+	assert.Equal(t, output.String(), `Hot spots:
+  Check file a.ts (800.0ms)
+    checkVariableDeclaration (VariableDeclaration) at a.ts:2:7 (600.0ms)
+      structuredTypeRelatedTo Box<number> -> Box<string> (550.0ms)
+
+Depth limits reached:
+  instantiateType_DepthLimit number | string (instantiationDepth: 100, instantiationCount: 5000000) at a.ts:2:7
+
+`)
+}
+
+func TestAnalyzeTraceRequiresLegend(t *testing.T) {
+	t.Parallel()
+
+	fsys := vfstest.FromMap(fstest.MapFS{
+		"/trace": &fstest.MapFile{Mode: fs.ModeDir},
+	}, true)
+
+	var output strings.Builder
+	err := AnalyzeTrace(fsys, "/trace", &output, AnalyzeTraceOptions{ThresholdMillis: 100})
+	assert.ErrorContains(t, err, "could not read /trace/legend.json")
+	assert.Equal(t, output.String(), "")
+}
+
+func marshalForTest(t *testing.T, v any) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	assert.NilError(t, err)
+	return string(data)
+}
@@ -0,0 +1,284 @@
+package tracing
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/microsoft/typescript-go/internal/ast"
+	"github.com/microsoft/typescript-go/internal/core"
+	"github.com/microsoft/typescript-go/internal/scanner"
+	"github.com/microsoft/typescript-go/internal/tspath"
+)
+
+// checkReportLimit is the number of entries listed in each table of the report.
+const checkReportLimit = 10
+
+// CheckReport aggregates where type checking spends its time: per file, per
+// declaration and per type relation, along with the type instantiations caused
+// by checking each file and declaration. It backs --checkPerformanceReport.
+// Safe for concurrent use.
+type CheckReport struct {
+	// deterministic omits timings, which vary from run to run, so the report
+	// can be used in test baselines.
+	deterministic bool
+
+	mu           sync.Mutex
+	files        map[string]*CheckReportEntry
+	declarations map[*ast.Node]*CheckReportEntry
+	relations    map[checkReportRelationKey]*CheckReportEntry
+}
+
+// CheckReportEntry holds the totals for one file, declaration or type relation.
+type CheckReportEntry struct {
+	Name           string
+	Node           *ast.Node
+	Count          int
+	Duration       time.Duration
+	Instantiations uint64
+
+	describe func() string
+}
+
+type checkReportRelationKey struct {
+	checkerIndex int
+	sourceId     uint32
+	targetId     uint32
+}
+
+func NewCheckReport(deterministic bool) *CheckReport {
+	return &CheckReport{
+		deterministic: deterministic,
+		files:         make(map[string]*CheckReportEntry),
+		declarations:  make(map[*ast.Node]*CheckReportEntry),
+		relations:     make(map[checkReportRelationKey]*CheckReportEntry),
+	}
+}
+
+// Start returns the time an event being recorded starts at.
+func (r *CheckReport) Start() time.Time {
+	if r.deterministic {
+		return time.Time{}
+	}
+	return time.Now()
+}
+
+func (r *CheckReport) since(start time.Time) time.Duration {
+	if r.deterministic {
+		return 0
+	}
+	return time.Since(start)
+}
+
+// AddFile records the checking of a source file that started at start.
+func (r *CheckReport) AddFile(fileName string, start time.Time, instantiations uint32) {
+	duration := r.since(start)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry := r.files[fileName]
+	if entry == nil {
+		entry = &CheckReportEntry{Name: fileName}
+		r.files[fileName] = entry
+	}
+	entry.add(duration, instantiations)
+}
+
+// AddDeclaration records the checking of a declaration that started at start.
+// The name of the declaration is only computed the first time it is recorded.
+func (r *CheckReport) AddDeclaration(node *ast.Node, name func() string, start time.Time, instantiations uint32) {
+	duration := r.since(start)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry := r.declarations[node]
+	if entry == nil {
+		entry = &CheckReportEntry{Name: name(), Node: node}
+		r.declarations[node] = entry
+	}
+	entry.add(duration, instantiations)
+}
+
+// AddRelation records a structural comparison of two types that started at
+// start while checking node. Types are only identified by the checker that
+// created them, so describe is called when the report is written to name the
+// relation, and relations with the same name are merged.
+func (r *CheckReport) AddRelation(checkerIndex int, sourceId uint32, targetId uint32, node *ast.Node, describe func() string, start time.Time) {
+	duration := r.since(start)
+	key := checkReportRelationKey{checkerIndex: checkerIndex, sourceId: sourceId, targetId: targetId}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry := r.relations[key]
+	if entry == nil {
+		entry = &CheckReportEntry{Node: node, describe: describe}
+		r.relations[key] = entry
+	}
+	entry.add(duration, 0)
+}
+
+func (e *CheckReportEntry) add(duration time.Duration, instantiations uint32) {
+	e.Count++
+	e.Duration += duration
+	e.Instantiations += uint64(instantiations)
+}
+
+// Write prints the slowest files, declarations and type relations, and the
+// declarations that caused the most type instantiations. Times include the
+// time spent checking nested declarations.
+func (r *CheckReport) Write(w io.Writer, comparePathsOptions tspath.ComparePathsOptions) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	location := func(e *CheckReportEntry) string {
+		if e.Node == nil {
+			return ""
+		}
+		file := ast.GetSourceFileOfNode(e.Node)
+		if file == nil {
+			return ""
+		}
+		line, character := scanner.GetECMALineAndUTF16CharacterOfPosition(file, scanner.GetTokenPosOfNode(e.Node, file, false))
+		return fmt.Sprintf("%s:%d:%d", tspath.ConvertToRelativePath(file.FileName(), comparePathsOptions), line+1, int(character)+1)
+	}
+
+	files := slices.Collect(maps.Values(r.files))
+	for _, file := range files {
+		file.Name = tspath.ConvertToRelativePath(file.Name, comparePathsOptions)
+	}
+	r.writeTable(w, "Slowest files", []string{"File", "Instantiations"}, rankCheckReportEntries(files, byDuration), func(e *CheckReportEntry) []string {
+		return []string{e.Name, fmt.Sprint(e.Instantiations)}
+	})
+
+	declarations := slices.Collect(maps.Values(r.declarations))
+	r.writeTable(w, "Slowest declarations", []string{"Declaration", "Location", "Instantiations"}, rankCheckReportEntries(declarations, byDuration), func(e *CheckReportEntry) []string {
+		return []string{e.Name, location(e), fmt.Sprint(e.Instantiations)}
+	})
+
+	// Naming a relation is expensive, so relations are named from the slowest
+	// until enough are named to fill the table. The same relation may be
+	// compared by each checker, so at least that many are named per entry to
+	// merge them; a merged relation is reported at the first location it was
+	// compared at.
+	relations, checkers := r.sortedRelations()
+	relationsByName := make(map[string]*CheckReportEntry)
+	for i, relation := range relations {
+		if len(relationsByName) >= checkReportLimit && i >= checkReportLimit*checkers {
+			break
+		}
+		name := relation.describe()
+		merged, ok := relationsByName[name]
+		if !ok {
+			merged = &CheckReportEntry{Name: name, Node: relation.Node}
+			relationsByName[name] = merged
+		} else if compareNodes(relation.Node, merged.Node) < 0 {
+			merged.Node = relation.Node
+		}
+		merged.Count += relation.Count
+		merged.Duration += relation.Duration
+	}
+	r.writeTable(w, "Most expensive type relations", []string{"Relation", "Location", "Count"}, rankCheckReportEntries(slices.Collect(maps.Values(relationsByName)), byDuration), func(e *CheckReportEntry) []string {
+		return []string{e.Name, location(e), fmt.Sprint(e.Count)}
+	})
+
+	instantiating := slices.DeleteFunc(slices.Clone(declarations), func(e *CheckReportEntry) bool {
+		return e.Instantiations == 0
+	})
+	r.writeTable(w, "Largest instantiation counts", []string{"Declaration", "Location", "Instantiations"}, rankCheckReportEntries(instantiating, byInstantiations), func(e *CheckReportEntry) []string {
+		return []string{e.Name, location(e), fmt.Sprint(e.Instantiations)}
+	})
+}
+
+// sortedRelations returns the relations from the slowest, before relations
+// compared by several checkers are merged, and the number of checkers.
+func (r *CheckReport) sortedRelations() ([]*CheckReportEntry, int) {
+	keys := slices.Collect(maps.Keys(r.relations))
+	checkers := 0
+	for _, key := range keys {
+		checkers = max(checkers, key.checkerIndex+1)
+	}
+	slices.SortFunc(keys, func(a, b checkReportRelationKey) int {
+		return cmp.Or(
+			byDuration(r.relations[a], r.relations[b]),
+			cmp.Compare(r.relations[b].Count, r.relations[a].Count),
+			compareNodes(r.relations[a].Node, r.relations[b].Node),
+			cmp.Compare(a.checkerIndex, b.checkerIndex),
+			cmp.Compare(a.sourceId, b.sourceId),
+			cmp.Compare(a.targetId, b.targetId),
+		)
+	})
+	return core.Map(keys, func(key checkReportRelationKey) *CheckReportEntry {
+		return r.relations[key]
+	}), checkers
+}
+
+func byDuration(a, b *CheckReportEntry) int {
+	return cmp.Compare(b.Duration, a.Duration)
+}
+
+func byInstantiations(a, b *CheckReportEntry) int {
+	return cmp.Compare(b.Instantiations, a.Instantiations)
+}
+
+// rankCheckReportEntries sorts the entries by the given order, breaking ties
+// so that the report is stable, and returns the first checkReportLimit entries.
+func rankCheckReportEntries(entries []*CheckReportEntry, order func(a, b *CheckReportEntry) int) []*CheckReportEntry {
+	slices.SortFunc(entries, func(a, b *CheckReportEntry) int {
+		return cmp.Or(
+			order(a, b),
+			byInstantiations(a, b),
+			cmp.Compare(b.Count, a.Count),
+			strings.Compare(a.Name, b.Name),
+			compareNodes(a.Node, b.Node),
+		)
+	})
+	return entries[:min(len(entries), checkReportLimit)]
+}
+
+func compareNodes(a, b *ast.Node) int {
+	if a == nil || b == nil {
+		return cmp.Compare(core.IfElse(a == nil, 0, 1), core.IfElse(b == nil, 0, 1))
+	}
+	return cmp.Or(
+		strings.Compare(ast.GetSourceFileOfNode(a).FileName(), ast.GetSourceFileOfNode(b).FileName()),
+		cmp.Compare(a.Pos(), b.Pos()),
+	)
+}
+
+func (r *CheckReport) writeTable(w io.Writer, title string, columns []string, entries []*CheckReportEntry, cells func(e *CheckReportEntry) []string) {
+	if len(entries) == 0 {
+		return
+	}
+	if !r.deterministic {
+		columns = append([]string{"Time"}, columns...)
+	}
+	rows := [][]string{columns}
+	for _, entry := range entries {
+		row := cells(entry)
+		if !r.deterministic {
+			row = append([]string{fmt.Sprintf("%.1fms", float64(entry.Duration.Microseconds())/1000)}, row...)
+		}
+		rows = append(rows, row)
+	}
+	widths := make([]int, len(columns))
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], len(cell))
+		}
+	}
+	fmt.Fprintf(w, "%s:\n", title)
+	for _, row := range rows {
+		var line strings.Builder
+		for i, cell := range row {
+			if i == len(row)-1 {
+				line.WriteString(cell)
+			} else {
+				fmt.Fprintf(&line, "%-*s  ", widths[i], cell)
+			}
+		}
+		fmt.Fprintf(w, "  %s\n", strings.TrimRight(line.String(), " "))
+	}
+	fmt.Fprintln(w)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/microsoft/typescript-go/internal/core"
 	"github.com/microsoft/typescript-go/internal/module"
 	"github.com/microsoft/typescript-go/internal/packagejson"
+	"github.com/microsoft/typescript-go/internal/pnp"
 	"github.com/microsoft/typescript-go/internal/printer"
 	"github.com/microsoft/typescript-go/internal/symlinks"
 	"github.com/microsoft/typescript-go/internal/testutil/emittestutil"
@@ -74,6 +75,10 @@ func (p *fakeProgram) GetNearestAncestorDirectoryWithPackageJson(dirname string)
 	return ""
 }
 
+func (p *fakeProgram) GetPnpManifest(directory string) *pnp.Manifest {
+	return nil
+}
+
 func (p *fakeProgram) GetSymlinkCache() *symlinks.KnownSymlinks {
 	return nil
 }
@@ -64,6 +64,8 @@ type CommandLineOption struct {
 
 	// used for CommandLineOptionTypeList
 	listPreserveFalsyValues bool
+	// used for CommandLineOptionTypeEnum options that may be given without a value on the command line
+	valueIfOmitted any
 	// used for compilerOptionsDeclaration
 	ElementOptions CommandLineOptionNameMap
 }
@@ -122,6 +124,11 @@ var commandLineOptionElements = map[string]*CommandLineOption{
 		Name: "types",
 		Kind: CommandLineOptionTypeString,
 	},
+	"affectedBy": {
+		Name:       "affectedBy",
+		Kind:       CommandLineOptionTypeString,
+		IsFilePath: true,
+	},
 	"moduleSuffixes": {
 		Name: "moduleSuffixes",
 		Kind: CommandLineOptionTypeString,
@@ -188,6 +195,8 @@ var commandLineOptionEnumMap = map[string]*collections.OrderedMap[string, any]{
 	"moduleDetection":  moduleDetectionOptionMap,
 	"jsx":              jsxOptionMap,
 	"newLine":          newLineOptionMap,
+	"diagnosticFormat": diagnosticFormatOptionMap,
+	"graph":            buildGraphFormatOptionMap,
 	"watchFile":        watchFileEnumMap,
 	"watchDirectory":   watchDirectoryEnumMap,
 	"fallbackPolling":  fallbackEnumMap,
@@ -108,10 +108,48 @@ func ParseBuildCommandLine(
 	if result.CompilerOptions.Watch.IsTrue() && result.BuildOptions.Dry.IsTrue() {
 		result.Errors = append(result.Errors, ast.NewCompilerDiagnostic(diagnostics.Options_0_and_1_cannot_be_combined, "watch", "dry"))
 	}
+	if result.BuildOptions.ListAffected.IsTrue() && len(result.BuildOptions.AffectedBy) == 0 {
+		result.Errors = append(result.Errors, ast.NewCompilerDiagnostic(diagnostics.Option_0_cannot_be_specified_without_specifying_option_1, "listAffected", "affectedBy"))
+	}
+	if result.BuildOptions.ListAffected.IsTrue() && result.CompilerOptions.Watch.IsTrue() {
+		result.Errors = append(result.Errors, ast.NewCompilerDiagnostic(diagnostics.Options_0_and_1_cannot_be_combined, "listAffected", "watch"))
+	}
+	if result.BuildOptions.Graph != core.BuildGraphFormatNone && result.CompilerOptions.Watch.IsTrue() {
+		result.Errors = append(result.Errors, ast.NewCompilerDiagnostic(diagnostics.Options_0_and_1_cannot_be_combined, "graph", "watch"))
+	}
+	if result.CompilerOptions.DiagnosticFormat != core.DiagnosticFormatNone {
+		for _, name := range TextOutputOptions(result.CompilerOptions) {
+			result.Errors = append(result.Errors, ast.NewCompilerDiagnostic(diagnostics.Options_0_and_1_cannot_be_combined, "diagnosticFormat", name))
+		}
+	}
 
 	return result
 }
 
+// TextOutputOptions returns the names of the options set in options that write text to stdout, where it would
+// be mixed into the document written for --diagnosticFormat.
+func TextOutputOptions(options *core.CompilerOptions) []string {
+	var names []string
+	for _, option := range []struct {
+		name  string
+		value core.Tristate
+	}{
+		{"diagnostics", options.Diagnostics},
+		{"extendedDiagnostics", options.ExtendedDiagnostics},
+		{"checkPerformanceReport", options.CheckPerformanceReport},
+		{"listFiles", options.ListFiles},
+		{"listFilesOnly", options.ListFilesOnly},
+		{"listEmittedFiles", options.ListEmittedFiles},
+		{"explainFiles", options.ExplainFiles},
+		{"traceResolution", options.TraceResolution},
+	} {
+		if option.value.IsTrue() {
+			names = append(names, option.name)
+		}
+	}
+	return names
+}
+
 func parseCommandLineWorker(
 	parseCommandLineWithDiagnostics *ParseCommandLineWorkerDiagnostics,
 	commandLine []string,
@@ -267,7 +305,9 @@ func (p *commandLineParser) parseOptionValue(
 	} else {
 		// Check to see if no argument was provided (e.g. "--locale" is the last command-line argument).
 		if i >= len(args) {
-			if opt.Kind != "boolean" {
+			if opt.valueIfOmitted != nil {
+				p.options.Set(opt.Name, opt.valueIfOmitted)
+			} else if opt.Kind != "boolean" {
 				p.errors = append(p.errors, ast.NewCompilerDiagnostic(diag, opt.Name, getCompilerOptionValueTypeString(opt)))
 				if opt.Kind == "list" {
 					p.options.Set(opt.Name, []string{})
@@ -327,7 +367,14 @@ func (p *commandLineParser) parseOptionValue(
 				// If not a primitive, the possible types are specified in what is effectively a map of options.
 				panic("listOrElement not supported here")
 			default:
-				val, err := convertJsonOptionOfEnumType(opt, strings.TrimFunc(args[i], stringutil.IsWhiteSpaceLike), nil, nil)
+				optValue := strings.TrimFunc(args[i], stringutil.IsWhiteSpaceLike)
+				// do not consume the next argument as the value of an enum option that may omit its value,
+				// unless it is one of the values of the option
+				if opt.valueIfOmitted != nil && !opt.EnumMap().Has(strings.ToLower(optValue)) {
+					p.options.Set(opt.Name, opt.valueIfOmitted)
+					break
+				}
+				val, err := convertJsonOptionOfEnumType(opt, optValue, nil, nil)
 				p.options.Set(opt.Name, val)
 				p.errors = append(p.errors, err...)
 				i++
@@ -56,14 +56,6 @@ func TestCompilerOptionsDeclaration(t *testing.T) {
 		checkCompilerOptionJsonTagName(t, field, decl.Name)
 	}
 
-	skippedOptions := []string{
-		"plugins",
-	}
-
-	for _, opt := range skippedOptions {
-		delete(decls, strings.ToLower(opt))
-	}
-
 	for _, decl := range decls {
 		t.Errorf("Option declaration %s is not present in CompilerOptions", decl.Name)
 	}
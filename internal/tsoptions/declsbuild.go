@@ -3,6 +3,7 @@ package tsoptions
 import (
 	"slices"
 
+	"github.com/microsoft/typescript-go/internal/core"
 	"github.com/microsoft/typescript-go/internal/diagnostics"
 )
 
@@ -64,6 +65,33 @@ var OptionsForBuild = []*CommandLineOption{
 		Kind:                    "boolean",
 		DefaultValueDescription: false,
 	},
+	{
+		Name:        "buildCacheDir",
+		Kind:        CommandLineOptionTypeString,
+		IsFilePath:  true,
+		Category:    diagnostics.Command_line_Options,
+		Description: diagnostics.Reuse_project_outputs_from_the_build_cache_in_the_given_directory_and_store_the_outputs_of_built_projects_there,
+	},
+	{
+		Name:           "graph",
+		Kind:           CommandLineOptionTypeEnum, // buildGraphFormatOptionMap
+		Category:       diagnostics.Command_line_Options,
+		Description:    diagnostics.Print_the_project_reference_graph_and_the_up_to_date_status_of_each_project_instead_of_building,
+		valueIfOmitted: core.BuildGraphFormatDot,
+	},
+	{
+		Name:        "affectedBy",
+		Kind:        CommandLineOptionTypeList,
+		Category:    diagnostics.Command_line_Options,
+		Description: diagnostics.Build_only_the_projects_affected_by_changes_to_the_given_files_and_the_projects_they_reference,
+	},
+	{
+		Name:                    "listAffected",
+		Category:                diagnostics.Command_line_Options,
+		Description:             diagnostics.Print_the_files_affected_by_changes_to_the_files_given_to_affectedBy_instead_of_building,
+		Kind:                    "boolean",
+		DefaultValueDescription: false,
+	},
 }
 
 var BuildOpts = slices.Concat(commonOptionsWithBuild, OptionsForBuild)
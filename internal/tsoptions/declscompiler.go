@@ -77,6 +77,20 @@ var commonOptionsWithBuild = []*CommandLineOption{
 		Description:              diagnostics.Enable_color_and_formatting_in_TypeScript_s_output_to_make_compiler_errors_easier_to_read,
 		DefaultValueDescription:  true,
 	},
+	{
+		Name:              "diagnosticFormat",
+		Kind:              CommandLineOptionTypeEnum, // diagnosticFormatOptionMap
+		IsCommandLineOnly: true,
+		Category:          diagnostics.Output_Formatting,
+		Description:       diagnostics.Report_diagnostics_as_a_single_machine_readable_document_instead_of_formatted_text,
+	},
+	{
+		Name:              "watchStatusPipe",
+		Kind:              CommandLineOptionTypeString,
+		IsCommandLineOnly: true,
+		Category:          diagnostics.Watch_and_Build_Modes,
+		Description:       diagnostics.Serve_the_watch_status_and_rebuild_events_over_JSON_RPC_on_the_given_pipe_or_socket,
+	},
 	{
 		Name:                    "traceResolution",
 		Kind:                    CommandLineOptionTypeBoolean,
@@ -114,6 +128,13 @@ var commonOptionsWithBuild = []*CommandLineOption{
 		Category:    diagnostics.Compiler_Diagnostics,
 		Description: diagnostics.Generates_an_event_trace_and_a_list_of_types,
 	},
+	{
+		Name:                    "checkPerformanceReport",
+		Kind:                    CommandLineOptionTypeBoolean,
+		Category:                diagnostics.Compiler_Diagnostics,
+		Description:             diagnostics.Report_the_files_declarations_and_type_relations_that_take_the_longest_to_check,
+		DefaultValueDescription: false,
+	},
 	{
 		Name:                    "incremental",
 		ShortName:               "i",
@@ -242,6 +263,13 @@ var commonOptionsWithBuild = []*CommandLineOption{
 		Category:    diagnostics.Command_line_Options,
 		Description: diagnostics.Generate_pprof_CPU_Slashmemory_profiles_to_the_given_directory,
 	},
+	{
+		Name:        "astCacheDir",
+		Kind:        CommandLineOptionTypeString,
+		IsFilePath:  true,
+		Category:    diagnostics.Command_line_Options,
+		Description: diagnostics.Cache_parsed_declaration_files_in_the_given_directory_and_reuse_them_in_later_compilations,
+	},
 	{
 		Name:                    "checkers",
 		Kind:                    CommandLineOptionTypeNumber,
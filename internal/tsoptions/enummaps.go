@@ -204,6 +204,16 @@ var newLineOptionMap = collections.NewOrderedMapFromList([]collections.MapEntry[
 	{Key: "lf", Value: core.NewLineKindLF},
 })
 
+var diagnosticFormatOptionMap = collections.NewOrderedMapFromList([]collections.MapEntry[string, any]{
+	{Key: "json", Value: core.DiagnosticFormatJSON},
+	{Key: "sarif", Value: core.DiagnosticFormatSARIF},
+})
+
+var buildGraphFormatOptionMap = collections.NewOrderedMapFromList([]collections.MapEntry[string, any]{
+	{Key: "dot", Value: core.BuildGraphFormatDot},
+	{Key: "json", Value: core.BuildGraphFormatJSON},
+})
+
 var targetToLibMap = map[core.ScriptTarget]string{
 	core.ScriptTargetESNext: "lib.esnext.full.d.ts",
 	core.ScriptTargetES2025: "lib.es2025.full.d.ts",
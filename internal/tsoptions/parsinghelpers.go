@@ -140,6 +140,32 @@ func parseContentMapper(value any) (*contentmapper.Mapper, []*ast.Diagnostic) {
 	return mapper, errors
 }
 
+// parsePluginImports converts the entries of the "plugins" compiler option, skipping any entry that is
+// not an object with a string "name".
+func parsePluginImports(value any) []*core.PluginImport {
+	arr, ok := value.([]any)
+	if !ok {
+		return nil
+	}
+	result := make([]*core.PluginImport, 0, len(arr))
+	for _, element := range arr {
+		entry, ok := element.(*collections.OrderedMap[string, any])
+		if !ok {
+			continue
+		}
+		name, _ := entry.GetOrZero("name").(string)
+		if name == "" {
+			continue
+		}
+		config, err := json.Marshal(entry)
+		if err != nil {
+			continue
+		}
+		result = append(result, &core.PluginImport{Name: name, Config: config})
+	}
+	return result
+}
+
 // parseStringArrayStrict returns the string slice and true only if value is an array whose
 // elements are all strings. A missing element or wrong element type yields false.
 func parseStringArrayStrict(value any) ([]string, bool) {
@@ -356,6 +382,8 @@ func parseCompilerOptions(key string, value any, allOptions *core.CompilerOption
 		allOptions.GenerateCpuProfile = ParseString(value)
 	case "generateTrace":
 		allOptions.GenerateTrace = ParseString(value)
+	case "checkPerformanceReport":
+		allOptions.CheckPerformanceReport = ParseTristate(value)
 	case "isolatedModules":
 		allOptions.IsolatedModules = ParseTristate(value)
 	case "ignoreConfig":
@@ -450,6 +478,8 @@ func parseCompilerOptions(key string, value any, allOptions *core.CompilerOption
 		allOptions.Paths = parseStringMap(value)
 	case "preserveWatchOutput":
 		allOptions.PreserveWatchOutput = ParseTristate(value)
+	case "plugins":
+		allOptions.Plugins = parsePluginImports(value)
 	case "preserveConstEnums":
 		allOptions.PreserveConstEnums = ParseTristate(value)
 	case "preserveSymlinks":
@@ -458,6 +488,10 @@ func parseCompilerOptions(key string, value any, allOptions *core.CompilerOption
 		allOptions.Project = ParseString(value)
 	case "pretty":
 		allOptions.Pretty = ParseTristate(value)
+	case "diagnosticFormat":
+		allOptions.DiagnosticFormat = floatOrInt32ToFlag[core.DiagnosticFormat](value)
+	case "watchStatusPipe":
+		allOptions.WatchStatusPipe = ParseString(value)
 	case "resolveJsonModule":
 		allOptions.ResolveJsonModule = ParseTristate(value)
 	case "resolvePackageJsonExports":
@@ -542,6 +576,8 @@ func parseCompilerOptions(key string, value any, allOptions *core.CompilerOption
 		allOptions.Watch = ParseTristate(value)
 	case "pprofDir":
 		allOptions.PprofDir = ParseString(value)
+	case "astCacheDir":
+		allOptions.AstCacheDir = ParseString(value)
 	case "singleThreaded":
 		allOptions.SingleThreaded = ParseTristate(value)
 	case "quiet":
@@ -635,6 +671,14 @@ func ParseBuildOptions(key string, value any, allOptions *core.BuildOptions) []*
 		allOptions.Builders = parseNumber(value)
 	case "stopBuildOnErrors":
 		allOptions.StopBuildOnErrors = ParseTristate(value)
+	case "buildCacheDir":
+		allOptions.BuildCacheDir = ParseString(value)
+	case "graph":
+		allOptions.Graph = floatOrInt32ToFlag[core.BuildGraphFormat](value)
+	case "affectedBy":
+		allOptions.AffectedBy = ParseStringArray(value)
+	case "listAffected":
+		allOptions.ListAffected = ParseTristate(value)
 	case "verbose":
 		allOptions.Verbose = ParseTristate(value)
 	}
@@ -587,6 +587,13 @@ func getExtendsConfigPath(
 	return "", errors
 }
 
+// ResolveExtendedConfigPath resolves an "extends" entry of the config file configFileName the same way
+// config parsing does. It returns "" if the extended config cannot be found.
+func ResolveExtendedConfigPath(extendedConfig string, host ParseConfigHost, configFileName string) string {
+	extendedConfigPath, _ := getExtendsConfigPath(extendedConfig, host, tspath.GetDirectoryPath(configFileName), nil, nil)
+	return extendedConfigPath
+}
+
 type tsConfigOptions struct {
 	prop       map[string][]string
 	references []*core.ProjectReference
@@ -1141,6 +1141,46 @@ func TestParseNullEnumCompilerOptions(t *testing.T) {
 	}
 }
 
+func TestParsePlugins(t *testing.T) {
+	t.Parallel()
+
+	config := testConfig{
+		jsonText: `{
+			"compilerOptions": {
+				"plugins": [
+					{ "name": "styled-plugin", "tags": ["styled", "css"] },
+					{ "name": "graphql-plugin" },
+					{ "tags": ["missing-name"] }
+				]
+			}
+		}`,
+		configFileName: "tsconfig.json",
+		basePath:       "/",
+		allFileList:    map[string]string{"/app.ts": ""},
+	}
+	for name, getParsed := range map[string]func(testConfig, tsoptions.ParseConfigHost, string) *tsoptions.ParsedCommandLine{
+		"json api":           getParsedWithJsonApi,
+		"jsonSourceFile api": getParsedWithJsonSourceFileApi,
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			allFileLists := make(map[string]string, len(config.allFileList)+1)
+			maps.Copy(allFileLists, config.allFileList)
+			allFileLists["/tsconfig.json"] = config.jsonText
+			host := tsoptionstest.NewVFSParseConfigHost(allFileLists, config.basePath, true /*useCaseSensitiveFileNames*/)
+			parsed := getParsed(config, host, config.basePath)
+
+			assert.Equal(t, len(parsed.Errors), 0)
+			plugins := parsed.CompilerOptions().Plugins
+			assert.Equal(t, len(plugins), 2)
+			assert.Equal(t, plugins[0].Name, "styled-plugin")
+			assert.Equal(t, string(plugins[0].Config), `{"name":"styled-plugin","tags":["styled","css"]}`)
+			assert.Equal(t, plugins[1].Name, "graphql-plugin")
+		})
+	}
+}
+
 func TestContentMappers(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,180 @@
+// Package zipvfs serves the contents of zip archives as read-only directories of an underlying file
+// system. Yarn Plug'n'Play installs packages as zip archives (for example
+// ".yarn/cache/lodash-npm-4.17.21-6382451519-eb835a2e51.zip") and refers to their files with paths that
+// continue through the archive, such as ".../lodash-npm-4.17.21-6382451519-eb835a2e51.zip/node_modules/lodash/index.js".
+package zipvfs
+
+import (
+	"archive/zip"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/microsoft/typescript-go/internal/collections"
+	"github.com/microsoft/typescript-go/internal/vfs"
+	"github.com/microsoft/typescript-go/internal/vfs/iovfs"
+)
+
+const archiveExtension = ".zip"
+
+// From returns a file system that resolves paths continuing through a zip archive (".../name.zip/inner/path")
+// inside the archive, and passes every other path through to fs. Archive contents are read-only; writes to
+// them fail with [vfs.ErrPermission].
+func From(fs vfs.FS) vfs.FS {
+	return &zipFS{fs: fs}
+}
+
+type zipFS struct {
+	fs       vfs.FS
+	archives collections.SyncMap[string, *archive]
+}
+
+// archive is an opened zip file, exposed as a file system rooted at "/". It is reopened when the
+// archive file's size or modification time changes.
+type archive struct {
+	size    int64
+	modTime time.Time
+	once    sync.Once
+	fs      vfs.FS
+}
+
+var _ vfs.FS = (*zipFS)(nil)
+
+// split returns the archive containing path and the path's location inside the archive, rooted at "/".
+func (z *zipFS) split(path string) (archivePath string, inner string, ok bool) {
+	offset := 0
+	for {
+		index := strings.Index(path[offset:], archiveExtension+"/")
+		if index < 0 {
+			return "", "", false
+		}
+		end := offset + index + len(archiveExtension)
+		if z.fs.FileExists(path[:end]) {
+			return path[:end], path[end:], true
+		}
+		offset = end
+	}
+}
+
+// open returns the file system of the archive at archivePath, or nil if it cannot be read as a zip file.
+func (z *zipFS) open(archivePath string) vfs.FS {
+	info := z.fs.Stat(archivePath)
+	if info == nil {
+		return nil
+	}
+	entry, ok := z.archives.Load(archivePath)
+	if !ok || entry.size != info.Size() || !entry.modTime.Equal(info.ModTime()) {
+		entry = &archive{size: info.Size(), modTime: info.ModTime()}
+		z.archives.Store(archivePath, entry)
+	}
+	entry.once.Do(func() {
+		content, ok := z.fs.ReadFile(archivePath)
+		if !ok {
+			return
+		}
+		reader, err := zip.NewReader(strings.NewReader(content), int64(len(content)))
+		if err != nil {
+			return
+		}
+		entry.fs = iovfs.From(reader, true /*useCaseSensitiveFileNames*/)
+	})
+	return entry.fs
+}
+
+func (z *zipFS) UseCaseSensitiveFileNames() bool {
+	return z.fs.UseCaseSensitiveFileNames()
+}
+
+func (z *zipFS) FileExists(path string) bool {
+	if archivePath, inner, ok := z.split(path); ok {
+		fs := z.open(archivePath)
+		return fs != nil && fs.FileExists(inner)
+	}
+	return z.fs.FileExists(path)
+}
+
+func (z *zipFS) ReadFile(path string) (contents string, ok bool) {
+	if archivePath, inner, ok := z.split(path); ok {
+		if fs := z.open(archivePath); fs != nil {
+			return fs.ReadFile(inner)
+		}
+		return "", false
+	}
+	return z.fs.ReadFile(path)
+}
+
+func (z *zipFS) WriteFile(path string, data string) error {
+	if _, _, ok := z.split(path); ok {
+		return vfs.ErrPermission
+	}
+	return z.fs.WriteFile(path, data)
+}
+
+func (z *zipFS) AppendFile(path string, data string) error {
+	if _, _, ok := z.split(path); ok {
+		return vfs.ErrPermission
+	}
+	return z.fs.AppendFile(path, data)
+}
+
+func (z *zipFS) Remove(path string) error {
+	if _, _, ok := z.split(path); ok {
+		return vfs.ErrPermission
+	}
+	return z.fs.Remove(path)
+}
+
+func (z *zipFS) Chtimes(path string, aTime time.Time, mTime time.Time) error {
+	if _, _, ok := z.split(path); ok {
+		return vfs.ErrPermission
+	}
+	return z.fs.Chtimes(path, aTime, mTime)
+}
+
+func (z *zipFS) DirectoryExists(path string) bool {
+	if archivePath, inner, ok := z.split(path); ok {
+		fs := z.open(archivePath)
+		return fs != nil && fs.DirectoryExists(inner)
+	}
+	return z.fs.DirectoryExists(path)
+}
+
+func (z *zipFS) GetAccessibleEntries(path string) vfs.Entries {
+	if archivePath, inner, ok := z.split(path); ok {
+		if fs := z.open(archivePath); fs != nil {
+			return fs.GetAccessibleEntries(inner)
+		}
+		return vfs.Entries{}
+	}
+	return z.fs.GetAccessibleEntries(path)
+}
+
+func (z *zipFS) Stat(path string) vfs.FileInfo {
+	if archivePath, inner, ok := z.split(path); ok {
+		if fs := z.open(archivePath); fs != nil {
+			return fs.Stat(inner)
+		}
+		return nil
+	}
+	return z.fs.Stat(path)
+}
+
+func (z *zipFS) WalkDir(root string, walkFn vfs.WalkDirFunc) error {
+	if archivePath, inner, ok := z.split(root); ok {
+		fs := z.open(archivePath)
+		if fs == nil {
+			return walkFn(root, nil, vfs.ErrNotExist)
+		}
+		return fs.WalkDir(inner, func(path string, d vfs.DirEntry, err error) error {
+			return walkFn(archivePath+path, d, err)
+		})
+	}
+	return z.fs.WalkDir(root, walkFn)
+}
+
+func (z *zipFS) Realpath(path string) string {
+	if archivePath, inner, ok := z.split(path); ok {
+		return z.fs.Realpath(archivePath) + inner
+	}
+	return z.fs.Realpath(path)
+}
@@ -0,0 +1,66 @@
+package zipvfs_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/microsoft/typescript-go/internal/vfs"
+	"github.com/microsoft/typescript-go/internal/vfs/vfstest"
+	"github.com/microsoft/typescript-go/internal/vfs/zipvfs"
+	"gotest.tools/v3/assert"
+)
+
+func makeZip(t *testing.T, files map[string]string) string {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		f, err := w.Create(name)
+		assert.NilError(t, err)
+		_, err = f.Write([]byte(files[name]))
+		assert.NilError(t, err)
+	}
+	assert.NilError(t, w.Close())
+	return buf.String()
+}
+
+func TestZipFS(t *testing.T) {
+	t.Parallel()
+	archive := makeZip(t, map[string]string{
+		"node_modules/lodash/package.json": `{"name":"lodash"}`,
+		"node_modules/lodash/index.d.ts":   "export {};",
+	})
+	fs := zipvfs.From(vfstest.FromMap(map[string]any{
+		"/project/.yarn/cache/lodash.zip": archive,
+		"/project/index.ts":               "import 'lodash';",
+	}, true /*useCaseSensitiveFileNames*/))
+
+	const root = "/project/.yarn/cache/lodash.zip/node_modules/lodash"
+	assert.Assert(t, fs.DirectoryExists(root))
+	assert.Assert(t, fs.FileExists(root+"/index.d.ts"))
+	assert.Assert(t, !fs.FileExists(root+"/missing.d.ts"))
+	contents, ok := fs.ReadFile(root + "/package.json")
+	assert.Assert(t, ok)
+	assert.Equal(t, contents, `{"name":"lodash"}`)
+	assert.DeepEqual(t, fs.GetAccessibleEntries(root).Files, []string{"index.d.ts", "package.json"})
+	assert.Equal(t, fs.Realpath(root+"/index.d.ts"), root+"/index.d.ts")
+
+	var walked []string
+	assert.NilError(t, fs.WalkDir(root, func(path string, d vfs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			walked = append(walked, path)
+		}
+		return err
+	}))
+	assert.DeepEqual(t, walked, []string{root + "/index.d.ts", root + "/package.json"})
+
+	assert.ErrorIs(t, fs.WriteFile(root+"/index.d.ts", ""), vfs.ErrPermission)
+
+	// Paths outside archives pass through.
+	assert.Assert(t, fs.FileExists("/project/index.ts"))
+	assert.Assert(t, fs.FileExists("/project/.yarn/cache/lodash.zip"))
+	assert.Assert(t, !fs.DirectoryExists("/project/missing.zip/node_modules"))
+}
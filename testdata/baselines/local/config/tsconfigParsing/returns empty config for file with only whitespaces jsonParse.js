@@ -0,0 +1,13 @@
+Input::
+
+Config::
+{}
+Errors::
+
+
+Input::
+ 
+Config::
+{}
+Errors::
+
@@ -0,0 +1,593 @@
+currentDirectory::/home/src/workspaces/solution
+useCaseSensitiveFileNames::true
+Input::
+//// [/home/src/workspaces/solution/app/index.ts] *new* 
+import { shared } from "../shared"; export const app = shared;
+//// [/home/src/workspaces/solution/app/other.ts] *new* 
+export const other = 3;
+//// [/home/src/workspaces/solution/app/tsconfig.json] *new* 
+{
+    "compilerOptions": { "composite": true },
+    "references": [{ "path": "../shared" }]
+}
+//// [/home/src/workspaces/solution/shared/index.ts] *new* 
+export const shared = 1;
+//// [/home/src/workspaces/solution/shared/tsconfig.json] *new* 
+{ "compilerOptions": { "composite": true } }
+//// [/home/src/workspaces/solution/shared/util.ts] *new* 
+export const util = 2;
+//// [/home/src/workspaces/solution/tools/index.ts] *new* 
+export const tools = 4;
+//// [/home/src/workspaces/solution/tools/tsconfig.json] *new* 
+{ "compilerOptions": { "composite": true } }
+//// [/home/src/workspaces/solution/tsconfig.json] *new* 
+{
+    "files": [],
+    "references": [{ "path": "./shared" }, { "path": "./app" }, { "path": "./tools" }]
+}
+
+tsgo --build
+ExitStatus:: Success
+Output::
+//// [/home/src/tslibs/TS/Lib/lib.es2025.full.d.ts] *Lib*
+/// <reference no-default-lib="true"/>
+interface Boolean {}
+interface Function {}
+interface CallableFunction {}
+interface NewableFunction {}
+interface IArguments {}
+interface Number { toExponential: any; }
+interface Object {}
+interface RegExp {}
+interface String { charAt: any; }
+interface Array<T> { length: number; [n: number]: T; }
+interface ReadonlyArray<T> {}
+interface SymbolConstructor {
+    (desc?: string | number): symbol;
+    for(name: string): symbol;
+    readonly toStringTag: symbol;
+}
+declare var Symbol: SymbolConstructor;
+interface Symbol {
+    readonly [Symbol.toStringTag]: string;
+}
+declare const console: { log(msg: any): void; };
+//// [/home/src/workspaces/solution/app/index.d.ts] *new* 
+export declare const app = 1;
+
+//// [/home/src/workspaces/solution/app/index.js] *new* 
+import { shared } from "../shared";
+export const app = shared;
+
+//// [/home/src/workspaces/solution/app/other.d.ts] *new* 
+export declare const other = 3;
+
+//// [/home/src/workspaces/solution/app/other.js] *new* 
+export const other = 3;
+
+//// [/home/src/workspaces/solution/app/tsconfig.tsbuildinfo] *new* 
+{"version":"FakeTSVersion","root":[[3,4]],"fileNames":["lib.es2025.full.d.ts","../shared/index.d.ts","./index.ts","./other.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},"01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",{"version":"f96c18fae745244985e4f93509351d88-import { shared } from \"../shared\"; export const app = shared;","signature":"f8dad51f84f5c63555d33a2ac858a42d-export declare const app = 1;\n","impliedNodeFormat":1},{"version":"6b1e4adb73ec4527d007c44ef5688991-export const other = 3;","signature":"d4dfbc49a8602b3bfc29d3149e54ba1d-export declare const other = 3;\n","impliedNodeFormat":1}],"fileIdsList":[[2]],"options":{"composite":true},"referencedMap":[[3,1]],"latestChangedDtsFile":"./other.d.ts"}
+//// [/home/src/workspaces/solution/app/tsconfig.tsbuildinfo.readable.baseline.txt] *new* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "./index.ts",
+        "./other.ts"
+      ],
+      "original": [
+        3,
+        4
+      ]
+    }
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "../shared/index.d.ts",
+    "./index.ts",
+    "./other.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "../shared/index.d.ts",
+      "version": "01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",
+      "signature": "01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",
+      "impliedNodeFormat": "CommonJS"
+    },
+    {
+      "fileName": "./index.ts",
+      "version": "f96c18fae745244985e4f93509351d88-import { shared } from \"../shared\"; export const app = shared;",
+      "signature": "f8dad51f84f5c63555d33a2ac858a42d-export declare const app = 1;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "f96c18fae745244985e4f93509351d88-import { shared } from \"../shared\"; export const app = shared;",
+        "signature": "f8dad51f84f5c63555d33a2ac858a42d-export declare const app = 1;\n",
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "./other.ts",
+      "version": "6b1e4adb73ec4527d007c44ef5688991-export const other = 3;",
+      "signature": "d4dfbc49a8602b3bfc29d3149e54ba1d-export declare const other = 3;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "6b1e4adb73ec4527d007c44ef5688991-export const other = 3;",
+        "signature": "d4dfbc49a8602b3bfc29d3149e54ba1d-export declare const other = 3;\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "fileIdsList": [
+    [
+      "../shared/index.d.ts"
+    ]
+  ],
+  "options": {
+    "composite": true
+  },
+  "referencedMap": {
+    "./index.ts": [
+      "../shared/index.d.ts"
+    ]
+  },
+  "latestChangedDtsFile": "./other.d.ts",
+  "size": 1480
+}
+//// [/home/src/workspaces/solution/shared/index.d.ts] *new* 
+export declare const shared = 1;
+
+//// [/home/src/workspaces/solution/shared/index.js] *new* 
+export const shared = 1;
+
+//// [/home/src/workspaces/solution/shared/tsconfig.tsbuildinfo] *new* 
+{"version":"FakeTSVersion","root":[[2,3]],"fileNames":["lib.es2025.full.d.ts","./index.ts","./util.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},{"version":"587a897ca44ef0e2d921d4398af51184-export const shared = 1;","signature":"01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n","impliedNodeFormat":1},{"version":"59479373836560e1ee2499018111085b-export const util = 2;","signature":"d42896f64c0a3810319fe4904b586748-export declare const util = 2;\n","impliedNodeFormat":1}],"options":{"composite":true},"latestChangedDtsFile":"./util.d.ts"}
+//// [/home/src/workspaces/solution/shared/tsconfig.tsbuildinfo.readable.baseline.txt] *new* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "./index.ts",
+        "./util.ts"
+      ],
+      "original": [
+        2,
+        3
+      ]
+    }
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "./index.ts",
+    "./util.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "./index.ts",
+      "version": "587a897ca44ef0e2d921d4398af51184-export const shared = 1;",
+      "signature": "01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "587a897ca44ef0e2d921d4398af51184-export const shared = 1;",
+        "signature": "01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "./util.ts",
+      "version": "59479373836560e1ee2499018111085b-export const util = 2;",
+      "signature": "d42896f64c0a3810319fe4904b586748-export declare const util = 2;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "59479373836560e1ee2499018111085b-export const util = 2;",
+        "signature": "d42896f64c0a3810319fe4904b586748-export declare const util = 2;\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "options": {
+    "composite": true
+  },
+  "latestChangedDtsFile": "./util.d.ts",
+  "size": 1302
+}
+//// [/home/src/workspaces/solution/shared/util.d.ts] *new* 
+export declare const util = 2;
+
+//// [/home/src/workspaces/solution/shared/util.js] *new* 
+export const util = 2;
+
+//// [/home/src/workspaces/solution/tools/index.d.ts] *new* 
+export declare const tools = 4;
+
+//// [/home/src/workspaces/solution/tools/index.js] *new* 
+export const tools = 4;
+
+//// [/home/src/workspaces/solution/tools/tsconfig.tsbuildinfo] *new* 
+{"version":"FakeTSVersion","root":[2],"fileNames":["lib.es2025.full.d.ts","./index.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},{"version":"5b880d013e6438467dcfce6424eeeca3-export const tools = 4;","signature":"15e1483205a95f1f795dbe316cbb4ef3-export declare const tools = 4;\n","impliedNodeFormat":1}],"options":{"composite":true},"latestChangedDtsFile":"./index.d.ts"}
+//// [/home/src/workspaces/solution/tools/tsconfig.tsbuildinfo.readable.baseline.txt] *new* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "./index.ts"
+      ],
+      "original": 2
+    }
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "./index.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "./index.ts",
+      "version": "5b880d013e6438467dcfce6424eeeca3-export const tools = 4;",
+      "signature": "15e1483205a95f1f795dbe316cbb4ef3-export declare const tools = 4;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "5b880d013e6438467dcfce6424eeeca3-export const tools = 4;",
+        "signature": "15e1483205a95f1f795dbe316cbb4ef3-export declare const tools = 4;\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "options": {
+    "composite": true
+  },
+  "latestChangedDtsFile": "./index.d.ts",
+  "size": 1113
+}
+
+shared/tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/tslibs/TS/Lib/lib.es2025.full.d.ts
+*refresh*    /home/src/workspaces/solution/shared/index.ts
+*refresh*    /home/src/workspaces/solution/shared/util.ts
+Signatures::
+(stored at emit) /home/src/workspaces/solution/shared/index.ts
+(stored at emit) /home/src/workspaces/solution/shared/util.ts
+
+app/tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/tslibs/TS/Lib/lib.es2025.full.d.ts
+*refresh*    /home/src/workspaces/solution/shared/index.d.ts
+*refresh*    /home/src/workspaces/solution/app/index.ts
+*refresh*    /home/src/workspaces/solution/app/other.ts
+Signatures::
+(stored at emit) /home/src/workspaces/solution/app/index.ts
+(stored at emit) /home/src/workspaces/solution/app/other.ts
+
+tools/tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/tslibs/TS/Lib/lib.es2025.full.d.ts
+*refresh*    /home/src/workspaces/solution/tools/index.ts
+Signatures::
+(stored at emit) /home/src/workspaces/solution/tools/index.ts
+
+
+Edit [0]:: change upstream project
+//// [/home/src/workspaces/solution/shared/index.ts] *modified* 
+export const shared = 10;
+
+tsgo --build --verbose --affectedBy shared/index.ts
+ExitStatus:: Success
+Output::
+[[90mHH:MM:SS AM[0m] Files affected in project 'shared/tsconfig.json':
+    * shared/index.ts
+
+[[90mHH:MM:SS AM[0m] Files affected in project 'app/tsconfig.json':
+    * app/index.ts
+
+[[90mHH:MM:SS AM[0m] Projects in this build: 
+    * shared/tsconfig.json
+    * app/tsconfig.json
+    * tools/tsconfig.json
+    * tsconfig.json
+
+[[90mHH:MM:SS AM[0m] Project 'shared/tsconfig.json' is out of date because output 'shared/tsconfig.tsbuildinfo' is older than input 'shared/index.ts'
+
+[[90mHH:MM:SS AM[0m] Building project 'shared/tsconfig.json'...
+
+[[90mHH:MM:SS AM[0m] Project 'app/tsconfig.json' is out of date because output 'app/tsconfig.tsbuildinfo' is older than input 'shared/index.d.ts'
+
+[[90mHH:MM:SS AM[0m] Building project 'app/tsconfig.json'...
+
+[[90mHH:MM:SS AM[0m] Skipping build of project 'tools/tsconfig.json' because it is not affected by the changed files.
+
+//// [/home/src/workspaces/solution/app/index.d.ts] *modified* 
+export declare const app = 10;
+
+//// [/home/src/workspaces/solution/app/index.js] *rewrite with same content*
+//// [/home/src/workspaces/solution/app/tsconfig.tsbuildinfo] *modified* 
+{"version":"FakeTSVersion","root":[[3,4]],"fileNames":["lib.es2025.full.d.ts","../shared/index.d.ts","./index.ts","./other.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},"22cfc690a794228513936a4b765281d0-export declare const shared = 10;\n",{"version":"f96c18fae745244985e4f93509351d88-import { shared } from \"../shared\"; export const app = shared;","signature":"80afb14d89feca01528b3fa4e624c74f-export declare const app = 10;\n","impliedNodeFormat":1},{"version":"6b1e4adb73ec4527d007c44ef5688991-export const other = 3;","signature":"d4dfbc49a8602b3bfc29d3149e54ba1d-export declare const other = 3;\n","impliedNodeFormat":1}],"fileIdsList":[[2]],"options":{"composite":true},"referencedMap":[[3,1]],"latestChangedDtsFile":"./index.d.ts"}
+//// [/home/src/workspaces/solution/app/tsconfig.tsbuildinfo.readable.baseline.txt] *modified* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "./index.ts",
+        "./other.ts"
+      ],
+      "original": [
+        3,
+        4
+      ]
+    }
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "../shared/index.d.ts",
+    "./index.ts",
+    "./other.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "../shared/index.d.ts",
+      "version": "22cfc690a794228513936a4b765281d0-export declare const shared = 10;\n",
+      "signature": "22cfc690a794228513936a4b765281d0-export declare const shared = 10;\n",
+      "impliedNodeFormat": "CommonJS"
+    },
+    {
+      "fileName": "./index.ts",
+      "version": "f96c18fae745244985e4f93509351d88-import { shared } from \"../shared\"; export const app = shared;",
+      "signature": "80afb14d89feca01528b3fa4e624c74f-export declare const app = 10;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "f96c18fae745244985e4f93509351d88-import { shared } from \"../shared\"; export const app = shared;",
+        "signature": "80afb14d89feca01528b3fa4e624c74f-export declare const app = 10;\n",
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "./other.ts",
+      "version": "6b1e4adb73ec4527d007c44ef5688991-export const other = 3;",
+      "signature": "d4dfbc49a8602b3bfc29d3149e54ba1d-export declare const other = 3;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "6b1e4adb73ec4527d007c44ef5688991-export const other = 3;",
+        "signature": "d4dfbc49a8602b3bfc29d3149e54ba1d-export declare const other = 3;\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "fileIdsList": [
+    [
+      "../shared/index.d.ts"
+    ]
+  ],
+  "options": {
+    "composite": true
+  },
+  "referencedMap": {
+    "./index.ts": [
+      "../shared/index.d.ts"
+    ]
+  },
+  "latestChangedDtsFile": "./index.d.ts",
+  "size": 1482
+}
+//// [/home/src/workspaces/solution/shared/index.d.ts] *modified* 
+export declare const shared = 10;
+
+//// [/home/src/workspaces/solution/shared/index.js] *modified* 
+export const shared = 10;
+
+//// [/home/src/workspaces/solution/shared/tsconfig.tsbuildinfo] *modified* 
+{"version":"FakeTSVersion","root":[[2,3]],"fileNames":["lib.es2025.full.d.ts","./index.ts","./util.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},{"version":"bf6252c8c96ab334f77b15da349e8421-export const shared = 10;","signature":"22cfc690a794228513936a4b765281d0-export declare const shared = 10;\n","impliedNodeFormat":1},{"version":"59479373836560e1ee2499018111085b-export const util = 2;","signature":"d42896f64c0a3810319fe4904b586748-export declare const util = 2;\n","impliedNodeFormat":1}],"options":{"composite":true},"latestChangedDtsFile":"./index.d.ts"}
+//// [/home/src/workspaces/solution/shared/tsconfig.tsbuildinfo.readable.baseline.txt] *modified* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "./index.ts",
+        "./util.ts"
+      ],
+      "original": [
+        2,
+        3
+      ]
+    }
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "./index.ts",
+    "./util.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "./index.ts",
+      "version": "bf6252c8c96ab334f77b15da349e8421-export const shared = 10;",
+      "signature": "22cfc690a794228513936a4b765281d0-export declare const shared = 10;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "bf6252c8c96ab334f77b15da349e8421-export const shared = 10;",
+        "signature": "22cfc690a794228513936a4b765281d0-export declare const shared = 10;\n",
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "./util.ts",
+      "version": "59479373836560e1ee2499018111085b-export const util = 2;",
+      "signature": "d42896f64c0a3810319fe4904b586748-export declare const util = 2;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "59479373836560e1ee2499018111085b-export const util = 2;",
+        "signature": "d42896f64c0a3810319fe4904b586748-export declare const util = 2;\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "options": {
+    "composite": true
+  },
+  "latestChangedDtsFile": "./index.d.ts",
+  "size": 1305
+}
+
+shared/tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/workspaces/solution/shared/index.ts
+Signatures::
+(computed .d.ts) /home/src/workspaces/solution/shared/index.ts
+
+app/tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/workspaces/solution/shared/index.d.ts
+*refresh*    /home/src/workspaces/solution/app/index.ts
+Signatures::
+(used version)   /home/src/workspaces/solution/shared/index.d.ts
+(computed .d.ts) /home/src/workspaces/solution/app/index.ts
+
+
+Edit [1]:: change file of unrelated project
+//// [/home/src/workspaces/solution/tools/index.ts] *modified* 
+export const tools = 40;
+
+tsgo --build --affectedBy tools/index.ts,missing.ts
+ExitStatus:: Success
+Output::
+[[90mHH:MM:SS AM[0m] File 'missing.ts' is not part of any project in this build.
+
+[[90mHH:MM:SS AM[0m] Files affected in project 'tools/tsconfig.json':
+    * tools/index.ts
+
+//// [/home/src/workspaces/solution/tools/index.d.ts] *modified* 
+export declare const tools = 40;
+
+//// [/home/src/workspaces/solution/tools/index.js] *modified* 
+export const tools = 40;
+
+//// [/home/src/workspaces/solution/tools/tsconfig.tsbuildinfo] *modified* 
+{"version":"FakeTSVersion","root":[2],"fileNames":["lib.es2025.full.d.ts","./index.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},{"version":"17a8989a6fbab042dcbdcfa1ecc092ec-export const tools = 40;","signature":"85ca740e371de15837162eaaabeec9c8-export declare const tools = 40;\n","impliedNodeFormat":1}],"options":{"composite":true},"latestChangedDtsFile":"./index.d.ts"}
+//// [/home/src/workspaces/solution/tools/tsconfig.tsbuildinfo.readable.baseline.txt] *modified* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "./index.ts"
+      ],
+      "original": 2
+    }
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "./index.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "./index.ts",
+      "version": "17a8989a6fbab042dcbdcfa1ecc092ec-export const tools = 40;",
+      "signature": "85ca740e371de15837162eaaabeec9c8-export declare const tools = 40;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "17a8989a6fbab042dcbdcfa1ecc092ec-export const tools = 40;",
+        "signature": "85ca740e371de15837162eaaabeec9c8-export declare const tools = 40;\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "options": {
+    "composite": true
+  },
+  "latestChangedDtsFile": "./index.d.ts",
+  "size": 1115
+}
+
+tools/tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/workspaces/solution/tools/index.ts
+Signatures::
+(computed .d.ts) /home/src/workspaces/solution/tools/index.ts
+
+
+Edit [2]:: list affected files without building
+//// [/home/src/workspaces/solution/shared/util.ts] *modified* 
+export const util = 20;
+
+tsgo --build --affectedBy shared/util.ts --listAffected
+ExitStatus:: Success
+Output::
+[[90mHH:MM:SS AM[0m] Files affected in project 'shared/tsconfig.json':
+    * shared/util.ts
+
+[[90mHH:MM:SS AM[0m] Project 'app/tsconfig.json' is affected because it references an affected project.
+
+
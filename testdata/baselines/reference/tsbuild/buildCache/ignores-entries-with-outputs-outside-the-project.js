@@ -0,0 +1,191 @@
+currentDirectory::/home/src/workspaces/project
+useCaseSensitiveFileNames::true
+Input::
+//// [/home/src/workspaces/project/index.ts] *new* 
+export const value = 1;
+//// [/home/src/workspaces/project/tsconfig.json] *new* 
+{
+    "compilerOptions": { "composite": true, "outDir": "out" }
+}
+
+tsgo --build --verbose --buildCacheDir ../cache
+ExitStatus:: Success
+Output::
+[[90mHH:MM:SS AM[0m] Projects in this build: 
+    * tsconfig.json
+
+[[90mHH:MM:SS AM[0m] Project 'tsconfig.json' is out of date because output file 'out/tsconfig.tsbuildinfo' does not exist
+
+[[90mHH:MM:SS AM[0m] Building project 'tsconfig.json'...
+
+//// [/home/src/tslibs/TS/Lib/lib.es2025.full.d.ts] *Lib*
+/// <reference no-default-lib="true"/>
+interface Boolean {}
+interface Function {}
+interface CallableFunction {}
+interface NewableFunction {}
+interface IArguments {}
+interface Number { toExponential: any; }
+interface Object {}
+interface RegExp {}
+interface String { charAt: any; }
+interface Array<T> { length: number; [n: number]: T; }
+interface ReadonlyArray<T> {}
+interface SymbolConstructor {
+    (desc?: string | number): symbol;
+    for(name: string): symbol;
+    readonly toStringTag: symbol;
+}
+declare var Symbol: SymbolConstructor;
+interface Symbol {
+    readonly [Symbol.toStringTag]: string;
+}
+declare const console: { log(msg: any): void; };
+//// [/home/src/workspaces/cache/6b2d278d7cf19dc505e4fec8a6995826.json] *new* 
+{"inputs":{"../../../../package.json":"","../../../package.json":"","../../package.json":"","../package.json":"","package.json":""},"resolutions":{},"typeDirectives":[],"outputs":{"out/index.d.ts":"export declare const value = 1;\n","out/index.js":"export const value = 1;\n","out/tsconfig.tsbuildinfo":"{\"version\":\"7.1.0-dev\",\"root\":[2],\"fileNames\":[\"lib.es2025.full.d.ts\",\"../index.ts\"],\"fileInfos\":[{\"version\":\"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\\\"true\\\"/>\\ninterface Boolean {}\\ninterface Function {}\\ninterface CallableFunction {}\\ninterface NewableFunction {}\\ninterface IArguments {}\\ninterface Number { toExponential: any; }\\ninterface Object {}\\ninterface RegExp {}\\ninterface String { charAt: any; }\\ninterface Array<T> { length: number; [n: number]: T; }\\ninterface ReadonlyArray<T> {}\\ninterface SymbolConstructor {\\n    (desc?: string | number): symbol;\\n    for(name: string): symbol;\\n    readonly toStringTag: symbol;\\n}\\ndeclare var Symbol: SymbolConstructor;\\ninterface Symbol {\\n    readonly [Symbol.toStringTag]: string;\\n}\\ndeclare const console: { log(msg: any): void; };\",\"affectsGlobalScope\":true,\"impliedNodeFormat\":1},{\"version\":\"9ae38e3a9bd5acd9f384aed0787571ff-export const value = 1;\",\"signature\":\"ba5c8ef2b0978fc944b312728d63c3f1-export declare const value = 1;\\n\",\"impliedNodeFormat\":1}],\"options\":{\"composite\":true,\"outDir\":\"./\"},\"latestChangedDtsFile\":\"./index.d.ts\"}"}}
+//// [/home/src/workspaces/project/out/index.d.ts] *new* 
+export declare const value = 1;
+
+//// [/home/src/workspaces/project/out/index.js] *new* 
+export const value = 1;
+
+//// [/home/src/workspaces/project/out/tsconfig.tsbuildinfo] *new* 
+{"version":"FakeTSVersion","root":[2],"fileNames":["lib.es2025.full.d.ts","../index.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},{"version":"9ae38e3a9bd5acd9f384aed0787571ff-export const value = 1;","signature":"ba5c8ef2b0978fc944b312728d63c3f1-export declare const value = 1;\n","impliedNodeFormat":1}],"options":{"composite":true,"outDir":"./"},"latestChangedDtsFile":"./index.d.ts"}
+//// [/home/src/workspaces/project/out/tsconfig.tsbuildinfo.readable.baseline.txt] *new* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "../index.ts"
+      ],
+      "original": 2
+    }
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "../index.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "../index.ts",
+      "version": "9ae38e3a9bd5acd9f384aed0787571ff-export const value = 1;",
+      "signature": "ba5c8ef2b0978fc944b312728d63c3f1-export declare const value = 1;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "9ae38e3a9bd5acd9f384aed0787571ff-export const value = 1;",
+        "signature": "ba5c8ef2b0978fc944b312728d63c3f1-export declare const value = 1;\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "options": {
+    "composite": true,
+    "outDir": "./"
+  },
+  "latestChangedDtsFile": "./index.d.ts",
+  "size": 1128
+}
+
+tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/tslibs/TS/Lib/lib.es2025.full.d.ts
+*refresh*    /home/src/workspaces/project/index.ts
+Signatures::
+(stored at emit) /home/src/workspaces/project/index.ts
+
+
+Edit [0]:: add output that escapes the project to cache entries and remove outputs
+//// [/home/src/workspaces/cache/6b2d278d7cf19dc505e4fec8a6995826.json] *modified* 
+{"inputs":{"../../../../package.json":"","../../../package.json":"","../../package.json":"","../package.json":"","package.json":""},"resolutions":{},"typeDirectives":[],"outputs":{"../../escaped.js":"escaped","out/index.d.ts":"export declare const value = 1;\n","out/index.js":"export const value = 1;\n","out/tsconfig.tsbuildinfo":"{\"version\":\"7.1.0-dev\",\"root\":[2],\"fileNames\":[\"lib.es2025.full.d.ts\",\"../index.ts\"],\"fileInfos\":[{\"version\":\"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\\\"true\\\"/>\\ninterface Boolean {}\\ninterface Function {}\\ninterface CallableFunction {}\\ninterface NewableFunction {}\\ninterface IArguments {}\\ninterface Number { toExponential: any; }\\ninterface Object {}\\ninterface RegExp {}\\ninterface String { charAt: any; }\\ninterface Array<T> { length: number; [n: number]: T; }\\ninterface ReadonlyArray<T> {}\\ninterface SymbolConstructor {\\n    (desc?: string | number): symbol;\\n    for(name: string): symbol;\\n    readonly toStringTag: symbol;\\n}\\ndeclare var Symbol: SymbolConstructor;\\ninterface Symbol {\\n    readonly [Symbol.toStringTag]: string;\\n}\\ndeclare const console: { log(msg: any): void; };\",\"affectsGlobalScope\":true,\"impliedNodeFormat\":1},{\"version\":\"9ae38e3a9bd5acd9f384aed0787571ff-export const value = 1;\",\"signature\":\"ba5c8ef2b0978fc944b312728d63c3f1-export declare const value = 1;\\n\",\"impliedNodeFormat\":1}],\"options\":{\"composite\":true,\"outDir\":\"./\"},\"latestChangedDtsFile\":\"./index.d.ts\"}"}}
+//// [/home/src/workspaces/project/out/index.d.ts] *deleted*
+//// [/home/src/workspaces/project/out/index.js] *deleted*
+//// [/home/src/workspaces/project/out/tsconfig.tsbuildinfo] *deleted*
+//// [/home/src/workspaces/project/out/tsconfig.tsbuildinfo.readable.baseline.txt] *deleted*
+
+tsgo --build --verbose --buildCacheDir ../cache
+ExitStatus:: Success
+Output::
+[[90mHH:MM:SS AM[0m] Projects in this build: 
+    * tsconfig.json
+
+[[90mHH:MM:SS AM[0m] Project 'tsconfig.json' is out of date because output file 'out/tsconfig.tsbuildinfo' does not exist
+
+[[90mHH:MM:SS AM[0m] Building project 'tsconfig.json'...
+
+//// [/home/src/workspaces/cache/6b2d278d7cf19dc505e4fec8a6995826.json] *modified* 
+{"inputs":{"../../../../package.json":"","../../../package.json":"","../../package.json":"","../package.json":"","package.json":""},"resolutions":{},"typeDirectives":[],"outputs":{"out/index.d.ts":"export declare const value = 1;\n","out/index.js":"export const value = 1;\n","out/tsconfig.tsbuildinfo":"{\"version\":\"7.1.0-dev\",\"root\":[2],\"fileNames\":[\"lib.es2025.full.d.ts\",\"../index.ts\"],\"fileInfos\":[{\"version\":\"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\\\"true\\\"/>\\ninterface Boolean {}\\ninterface Function {}\\ninterface CallableFunction {}\\ninterface NewableFunction {}\\ninterface IArguments {}\\ninterface Number { toExponential: any; }\\ninterface Object {}\\ninterface RegExp {}\\ninterface String { charAt: any; }\\ninterface Array<T> { length: number; [n: number]: T; }\\ninterface ReadonlyArray<T> {}\\ninterface SymbolConstructor {\\n    (desc?: string | number): symbol;\\n    for(name: string): symbol;\\n    readonly toStringTag: symbol;\\n}\\ndeclare var Symbol: SymbolConstructor;\\ninterface Symbol {\\n    readonly [Symbol.toStringTag]: string;\\n}\\ndeclare const console: { log(msg: any): void; };\",\"affectsGlobalScope\":true,\"impliedNodeFormat\":1},{\"version\":\"9ae38e3a9bd5acd9f384aed0787571ff-export const value = 1;\",\"signature\":\"ba5c8ef2b0978fc944b312728d63c3f1-export declare const value = 1;\\n\",\"impliedNodeFormat\":1}],\"options\":{\"composite\":true,\"outDir\":\"./\"},\"latestChangedDtsFile\":\"./index.d.ts\"}"}}
+//// [/home/src/workspaces/project/out/index.d.ts] *new* 
+export declare const value = 1;
+
+//// [/home/src/workspaces/project/out/index.js] *new* 
+export const value = 1;
+
+//// [/home/src/workspaces/project/out/tsconfig.tsbuildinfo] *new* 
+{"version":"FakeTSVersion","root":[2],"fileNames":["lib.es2025.full.d.ts","../index.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},{"version":"9ae38e3a9bd5acd9f384aed0787571ff-export const value = 1;","signature":"ba5c8ef2b0978fc944b312728d63c3f1-export declare const value = 1;\n","impliedNodeFormat":1}],"options":{"composite":true,"outDir":"./"},"latestChangedDtsFile":"./index.d.ts"}
+//// [/home/src/workspaces/project/out/tsconfig.tsbuildinfo.readable.baseline.txt] *new* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "../index.ts"
+      ],
+      "original": 2
+    }
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "../index.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "../index.ts",
+      "version": "9ae38e3a9bd5acd9f384aed0787571ff-export const value = 1;",
+      "signature": "ba5c8ef2b0978fc944b312728d63c3f1-export declare const value = 1;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "9ae38e3a9bd5acd9f384aed0787571ff-export const value = 1;",
+        "signature": "ba5c8ef2b0978fc944b312728d63c3f1-export declare const value = 1;\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "options": {
+    "composite": true,
+    "outDir": "./"
+  },
+  "latestChangedDtsFile": "./index.d.ts",
+  "size": 1128
+}
+
+tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/tslibs/TS/Lib/lib.es2025.full.d.ts
+*refresh*    /home/src/workspaces/project/index.ts
+Signatures::
+(stored at emit) /home/src/workspaces/project/index.ts
@@ -0,0 +1,458 @@
+currentDirectory::/home/src/workspaces/project
+useCaseSensitiveFileNames::true
+Input::
+//// [/home/src/workspaces/node_modules/pkg/index.d.ts] *new* 
+export declare const value: number;
+//// [/home/src/workspaces/project/index.ts] *new* 
+import { value } from "pkg"; export const copy = value;
+//// [/home/src/workspaces/project/tsconfig.json] *new* 
+{
+    "compilerOptions": { "composite": true, "outDir": "out", "types": ["*"] }
+}
+
+tsgo --build --verbose --buildCacheDir ../cache
+ExitStatus:: Success
+Output::
+[[90mHH:MM:SS AM[0m] Projects in this build: 
+    * tsconfig.json
+
+[[90mHH:MM:SS AM[0m] Project 'tsconfig.json' is out of date because output file 'out/tsconfig.tsbuildinfo' does not exist
+
+[[90mHH:MM:SS AM[0m] Building project 'tsconfig.json'...
+
+//// [/home/src/tslibs/TS/Lib/lib.es2025.full.d.ts] *Lib*
+/// <reference no-default-lib="true"/>
+interface Boolean {}
+interface Function {}
+interface CallableFunction {}
+interface NewableFunction {}
+interface IArguments {}
+interface Number { toExponential: any; }
+interface Object {}
+interface RegExp {}
+interface String { charAt: any; }
+interface Array<T> { length: number; [n: number]: T; }
+interface ReadonlyArray<T> {}
+interface SymbolConstructor {
+    (desc?: string | number): symbol;
+    for(name: string): symbol;
+    readonly toStringTag: symbol;
+}
+declare var Symbol: SymbolConstructor;
+interface Symbol {
+    readonly [Symbol.toStringTag]: string;
+}
+declare const console: { log(msg: any): void; };
+//// [/home/src/workspaces/cache/3e320cd255ee9cb1a84e2e969f356da2.json] *new* 
+{"inputs":{"../../../../package.json":"","../../../package.json":"","../../package.json":"","../node_modules/package.json":"","../node_modules/pkg/index.d.ts":"063e55552b6163ff589adb460fd37fdd","../node_modules/pkg/package.json":"","../package.json":"","package.json":""},"resolutions":{"index.ts":[{"name":"pkg","mode":99,"resolvedFileName":"../node_modules/pkg/index.d.ts"}]},"outputs":{"out/index.d.ts":"export declare const copy: number;\n","out/index.js":"import { value } from \"pkg\";\nexport const copy = value;\n","out/tsconfig.tsbuildinfo":"{\"version\":\"7.1.0-dev\",\"root\":[3],\"missingPackageJsons\":[\"../../node_modules/package.json\",\"../../node_modules/pkg/package.json\"],\"fileNames\":[\"lib.es2025.full.d.ts\",\"../../node_modules/pkg/index.d.ts\",\"../index.ts\"],\"fileInfos\":[{\"version\":\"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\\\"true\\\"/>\\ninterface Boolean {}\\ninterface Function {}\\ninterface CallableFunction {}\\ninterface NewableFunction {}\\ninterface IArguments {}\\ninterface Number { toExponential: any; }\\ninterface Object {}\\ninterface RegExp {}\\ninterface String { charAt: any; }\\ninterface Array<T> { length: number; [n: number]: T; }\\ninterface ReadonlyArray<T> {}\\ninterface SymbolConstructor {\\n    (desc?: string | number): symbol;\\n    for(name: string): symbol;\\n    readonly toStringTag: symbol;\\n}\\ndeclare var Symbol: SymbolConstructor;\\ninterface Symbol {\\n    readonly [Symbol.toStringTag]: string;\\n}\\ndeclare const console: { log(msg: any): void; };\",\"affectsGlobalScope\":true,\"impliedNodeFormat\":1},\"063e55552b6163ff589adb460fd37fdd-export declare const value: number;\",{\"version\":\"d673c5da1e5e9c18d2b4913a15f11aeb-import { value } from \\\"pkg\\\"; export const copy = value;\",\"signature\":\"3eaf954f319965b713b82f9bd9676a9e-export declare const copy: number;\\n\",\"impliedNodeFormat\":1}],\"fileIdsList\":[[2]],\"options\":{\"composite\":true,\"outDir\":\"./\"},\"referencedMap\":[[3,1]],\"latestChangedDtsFile\":\"./index.d.ts\"}"}}
+//// [/home/src/workspaces/project/out/index.d.ts] *new* 
+export declare const copy: number;
+
+//// [/home/src/workspaces/project/out/index.js] *new* 
+import { value } from "pkg";
+export const copy = value;
+
+//// [/home/src/workspaces/project/out/tsconfig.tsbuildinfo] *new* 
+{"version":"FakeTSVersion","root":[3],"missingPackageJsons":["../../node_modules/package.json","../../node_modules/pkg/package.json"],"fileNames":["lib.es2025.full.d.ts","../../node_modules/pkg/index.d.ts","../index.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},"063e55552b6163ff589adb460fd37fdd-export declare const value: number;",{"version":"d673c5da1e5e9c18d2b4913a15f11aeb-import { value } from \"pkg\"; export const copy = value;","signature":"3eaf954f319965b713b82f9bd9676a9e-export declare const copy: number;\n","impliedNodeFormat":1}],"fileIdsList":[[2]],"options":{"composite":true,"outDir":"./"},"referencedMap":[[3,1]],"latestChangedDtsFile":"./index.d.ts"}
+//// [/home/src/workspaces/project/out/tsconfig.tsbuildinfo.readable.baseline.txt] *new* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "../index.ts"
+      ],
+      "original": 3
+    }
+  ],
+  "missingPackageJsons": [
+    "../../node_modules/package.json",
+    "../../node_modules/pkg/package.json"
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "../../node_modules/pkg/index.d.ts",
+    "../index.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "../../node_modules/pkg/index.d.ts",
+      "version": "063e55552b6163ff589adb460fd37fdd-export declare const value: number;",
+      "signature": "063e55552b6163ff589adb460fd37fdd-export declare const value: number;",
+      "impliedNodeFormat": "CommonJS"
+    },
+    {
+      "fileName": "../index.ts",
+      "version": "d673c5da1e5e9c18d2b4913a15f11aeb-import { value } from \"pkg\"; export const copy = value;",
+      "signature": "3eaf954f319965b713b82f9bd9676a9e-export declare const copy: number;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "d673c5da1e5e9c18d2b4913a15f11aeb-import { value } from \"pkg\"; export const copy = value;",
+        "signature": "3eaf954f319965b713b82f9bd9676a9e-export declare const copy: number;\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "fileIdsList": [
+    [
+      "../../node_modules/pkg/index.d.ts"
+    ]
+  ],
+  "options": {
+    "composite": true,
+    "outDir": "./"
+  },
+  "referencedMap": {
+    "../index.ts": [
+      "../../node_modules/pkg/index.d.ts"
+    ]
+  },
+  "latestChangedDtsFile": "./index.d.ts",
+  "size": 1412
+}
+
+tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/tslibs/TS/Lib/lib.es2025.full.d.ts
+*refresh*    /home/src/workspaces/node_modules/pkg/index.d.ts
+*refresh*    /home/src/workspaces/project/index.ts
+Signatures::
+(stored at emit) /home/src/workspaces/project/index.ts
+
+
+Edit [0]:: remove outputs
+//// [/home/src/workspaces/project/out/index.d.ts] *deleted*
+//// [/home/src/workspaces/project/out/index.js] *deleted*
+//// [/home/src/workspaces/project/out/tsconfig.tsbuildinfo] *deleted*
+//// [/home/src/workspaces/project/out/tsconfig.tsbuildinfo.readable.baseline.txt] *deleted*
+
+tsgo --build --verbose --buildCacheDir ../cache
+ExitStatus:: Success
+Output::
+[[90mHH:MM:SS AM[0m] Projects in this build: 
+    * tsconfig.json
+
+[[90mHH:MM:SS AM[0m] Project 'tsconfig.json' is out of date because output file 'out/tsconfig.tsbuildinfo' does not exist
+
+[[90mHH:MM:SS AM[0m] Restoring outputs of project 'tsconfig.json' from the build cache...
+
+//// [/home/src/workspaces/project/out/index.d.ts] *new* 
+export declare const copy: number;
+
+//// [/home/src/workspaces/project/out/index.js] *new* 
+import { value } from "pkg";
+export const copy = value;
+
+//// [/home/src/workspaces/project/out/tsconfig.tsbuildinfo] *new* 
+{"version":"FakeTSVersion","root":[3],"missingPackageJsons":["../../node_modules/package.json","../../node_modules/pkg/package.json"],"fileNames":["lib.es2025.full.d.ts","../../node_modules/pkg/index.d.ts","../index.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},"063e55552b6163ff589adb460fd37fdd-export declare const value: number;",{"version":"d673c5da1e5e9c18d2b4913a15f11aeb-import { value } from \"pkg\"; export const copy = value;","signature":"3eaf954f319965b713b82f9bd9676a9e-export declare const copy: number;\n","impliedNodeFormat":1}],"fileIdsList":[[2]],"options":{"composite":true,"outDir":"./"},"referencedMap":[[3,1]],"latestChangedDtsFile":"./index.d.ts"}
+//// [/home/src/workspaces/project/out/tsconfig.tsbuildinfo.readable.baseline.txt] *new* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "../index.ts"
+      ],
+      "original": 3
+    }
+  ],
+  "missingPackageJsons": [
+    "../../node_modules/package.json",
+    "../../node_modules/pkg/package.json"
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "../../node_modules/pkg/index.d.ts",
+    "../index.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "../../node_modules/pkg/index.d.ts",
+      "version": "063e55552b6163ff589adb460fd37fdd-export declare const value: number;",
+      "signature": "063e55552b6163ff589adb460fd37fdd-export declare const value: number;",
+      "impliedNodeFormat": "CommonJS"
+    },
+    {
+      "fileName": "../index.ts",
+      "version": "d673c5da1e5e9c18d2b4913a15f11aeb-import { value } from \"pkg\"; export const copy = value;",
+      "signature": "3eaf954f319965b713b82f9bd9676a9e-export declare const copy: number;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "d673c5da1e5e9c18d2b4913a15f11aeb-import { value } from \"pkg\"; export const copy = value;",
+        "signature": "3eaf954f319965b713b82f9bd9676a9e-export declare const copy: number;\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "fileIdsList": [
+    [
+      "../../node_modules/pkg/index.d.ts"
+    ]
+  ],
+  "options": {
+    "composite": true,
+    "outDir": "./"
+  },
+  "referencedMap": {
+    "../index.ts": [
+      "../../node_modules/pkg/index.d.ts"
+    ]
+  },
+  "latestChangedDtsFile": "./index.d.ts",
+  "size": 1412
+}
+
+
+
+Edit [1]:: add package in a location that was looked up and remove outputs
+//// [/home/src/workspaces/project/node_modules/pkg/index.d.ts] *new* 
+export declare const value: string;
+//// [/home/src/workspaces/project/out/index.d.ts] *deleted*
+//// [/home/src/workspaces/project/out/index.js] *deleted*
+//// [/home/src/workspaces/project/out/tsconfig.tsbuildinfo] *deleted*
+//// [/home/src/workspaces/project/out/tsconfig.tsbuildinfo.readable.baseline.txt] *deleted*
+
+tsgo --build --verbose --buildCacheDir ../cache
+ExitStatus:: Success
+Output::
+[[90mHH:MM:SS AM[0m] Projects in this build: 
+    * tsconfig.json
+
+[[90mHH:MM:SS AM[0m] Project 'tsconfig.json' is out of date because output file 'out/tsconfig.tsbuildinfo' does not exist
+
+[[90mHH:MM:SS AM[0m] Building project 'tsconfig.json'...
+
+//// [/home/src/workspaces/cache/3e320cd255ee9cb1a84e2e969f356da2.json] *modified* 
+{"inputs":{"../../../../package.json":"","../../../package.json":"","../../package.json":"","../package.json":"","node_modules/package.json":"","node_modules/pkg/index.d.ts":"f6064dd4cf37650708d1be255d835fa1","node_modules/pkg/package.json":"","package.json":""},"resolutions":{"index.ts":[{"name":"pkg","mode":99,"resolvedFileName":"node_modules/pkg/index.d.ts"}]},"outputs":{"out/index.d.ts":"export declare const copy: string;\n","out/index.js":"import { value } from \"pkg\";\nexport const copy = value;\n","out/tsconfig.tsbuildinfo":"{\"version\":\"7.1.0-dev\",\"root\":[3],\"missingPackageJsons\":[\"../node_modules/package.json\",\"../node_modules/pkg/package.json\"],\"fileNames\":[\"lib.es2025.full.d.ts\",\"../node_modules/pkg/index.d.ts\",\"../index.ts\"],\"fileInfos\":[{\"version\":\"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\\\"true\\\"/>\\ninterface Boolean {}\\ninterface Function {}\\ninterface CallableFunction {}\\ninterface NewableFunction {}\\ninterface IArguments {}\\ninterface Number { toExponential: any; }\\ninterface Object {}\\ninterface RegExp {}\\ninterface String { charAt: any; }\\ninterface Array<T> { length: number; [n: number]: T; }\\ninterface ReadonlyArray<T> {}\\ninterface SymbolConstructor {\\n    (desc?: string | number): symbol;\\n    for(name: string): symbol;\\n    readonly toStringTag: symbol;\\n}\\ndeclare var Symbol: SymbolConstructor;\\ninterface Symbol {\\n    readonly [Symbol.toStringTag]: string;\\n}\\ndeclare const console: { log(msg: any): void; };\",\"affectsGlobalScope\":true,\"impliedNodeFormat\":1},\"f6064dd4cf37650708d1be255d835fa1-export declare const value: string;\",{\"version\":\"d673c5da1e5e9c18d2b4913a15f11aeb-import { value } from \\\"pkg\\\"; export const copy = value;\",\"signature\":\"8e3ad4bc9426a17c09fc112b77237a9c-export declare const copy: string;\\n\",\"impliedNodeFormat\":1}],\"fileIdsList\":[[2]],\"options\":{\"composite\":true,\"outDir\":\"./\"},\"referencedMap\":[[3,1]],\"latestChangedDtsFile\":\"./index.d.ts\"}"}}
+//// [/home/src/workspaces/project/out/index.d.ts] *new* 
+export declare const copy: string;
+
+//// [/home/src/workspaces/project/out/index.js] *new* 
+import { value } from "pkg";
+export const copy = value;
+
+//// [/home/src/workspaces/project/out/tsconfig.tsbuildinfo] *new* 
+{"version":"FakeTSVersion","root":[3],"missingPackageJsons":["../node_modules/package.json","../node_modules/pkg/package.json"],"fileNames":["lib.es2025.full.d.ts","../node_modules/pkg/index.d.ts","../index.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},"f6064dd4cf37650708d1be255d835fa1-export declare const value: string;",{"version":"d673c5da1e5e9c18d2b4913a15f11aeb-import { value } from \"pkg\"; export const copy = value;","signature":"8e3ad4bc9426a17c09fc112b77237a9c-export declare const copy: string;\n","impliedNodeFormat":1}],"fileIdsList":[[2]],"options":{"composite":true,"outDir":"./"},"referencedMap":[[3,1]],"latestChangedDtsFile":"./index.d.ts"}
+//// [/home/src/workspaces/project/out/tsconfig.tsbuildinfo.readable.baseline.txt] *new* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "../index.ts"
+      ],
+      "original": 3
+    }
+  ],
+  "missingPackageJsons": [
+    "../node_modules/package.json",
+    "../node_modules/pkg/package.json"
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "../node_modules/pkg/index.d.ts",
+    "../index.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "../node_modules/pkg/index.d.ts",
+      "version": "f6064dd4cf37650708d1be255d835fa1-export declare const value: string;",
+      "signature": "f6064dd4cf37650708d1be255d835fa1-export declare const value: string;",
+      "impliedNodeFormat": "CommonJS"
+    },
+    {
+      "fileName": "../index.ts",
+      "version": "d673c5da1e5e9c18d2b4913a15f11aeb-import { value } from \"pkg\"; export const copy = value;",
+      "signature": "8e3ad4bc9426a17c09fc112b77237a9c-export declare const copy: string;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "d673c5da1e5e9c18d2b4913a15f11aeb-import { value } from \"pkg\"; export const copy = value;",
+        "signature": "8e3ad4bc9426a17c09fc112b77237a9c-export declare const copy: string;\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "fileIdsList": [
+    [
+      "../node_modules/pkg/index.d.ts"
+    ]
+  ],
+  "options": {
+    "composite": true,
+    "outDir": "./"
+  },
+  "referencedMap": {
+    "../index.ts": [
+      "../node_modules/pkg/index.d.ts"
+    ]
+  },
+  "latestChangedDtsFile": "./index.d.ts",
+  "size": 1403
+}
+
+tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/tslibs/TS/Lib/lib.es2025.full.d.ts
+*refresh*    /home/src/workspaces/project/node_modules/pkg/index.d.ts
+*refresh*    /home/src/workspaces/project/index.ts
+Signatures::
+(stored at emit) /home/src/workspaces/project/index.ts
+
+
+Edit [2]:: add types package and remove outputs
+//// [/home/src/workspaces/project/node_modules/@types/extra/index.d.ts] *new* 
+declare const extra: number;
+//// [/home/src/workspaces/project/out/index.d.ts] *deleted*
+//// [/home/src/workspaces/project/out/index.js] *deleted*
+//// [/home/src/workspaces/project/out/tsconfig.tsbuildinfo] *deleted*
+//// [/home/src/workspaces/project/out/tsconfig.tsbuildinfo.readable.baseline.txt] *deleted*
+
+tsgo --build --verbose --buildCacheDir ../cache
+ExitStatus:: Success
+Output::
+[[90mHH:MM:SS AM[0m] Projects in this build: 
+    * tsconfig.json
+
+[[90mHH:MM:SS AM[0m] Project 'tsconfig.json' is out of date because output file 'out/tsconfig.tsbuildinfo' does not exist
+
+[[90mHH:MM:SS AM[0m] Building project 'tsconfig.json'...
+
+//// [/home/src/workspaces/cache/3e320cd255ee9cb1a84e2e969f356da2.json] *modified* 
+{"inputs":{"../../../../package.json":"","../../../package.json":"","../../package.json":"","../package.json":"","node_modules/@types/extra/index.d.ts":"c5d4a50b84460c4a55dcbc5ccca5ded5","node_modules/@types/extra/package.json":"","node_modules/@types/package.json":"","node_modules/package.json":"","node_modules/pkg/index.d.ts":"f6064dd4cf37650708d1be255d835fa1","node_modules/pkg/package.json":"","package.json":""},"resolutions":{"__inferred type names__.ts":[{"name":"extra","typeReference":true,"resolvedFileName":"node_modules/@types/extra/index.d.ts"}],"index.ts":[{"name":"pkg","mode":99,"resolvedFileName":"node_modules/pkg/index.d.ts"}]},"typeDirectives":["extra"],"outputs":{"out/index.d.ts":"export declare const copy: string;\n","out/index.js":"import { value } from \"pkg\";\nexport const copy = value;\n","out/tsconfig.tsbuildinfo":"{\"version\":\"7.1.0-dev\",\"root\":[3],\"missingPackageJsons\":[\"../node_modules/@types/extra/package.json\",\"../node_modules/@types/package.json\",\"../node_modules/package.json\",\"../node_modules/pkg/package.json\"],\"fileNames\":[\"lib.es2025.full.d.ts\",\"../node_modules/pkg/index.d.ts\",\"../index.ts\",\"../node_modules/@types/extra/index.d.ts\"],\"fileInfos\":[{\"version\":\"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\\\"true\\\"/>\\ninterface Boolean {}\\ninterface Function {}\\ninterface CallableFunction {}\\ninterface NewableFunction {}\\ninterface IArguments {}\\ninterface Number { toExponential: any; }\\ninterface Object {}\\ninterface RegExp {}\\ninterface String { charAt: any; }\\ninterface Array<T> { length: number; [n: number]: T; }\\ninterface ReadonlyArray<T> {}\\ninterface SymbolConstructor {\\n    (desc?: string | number): symbol;\\n    for(name: string): symbol;\\n    readonly toStringTag: symbol;\\n}\\ndeclare var Symbol: SymbolConstructor;\\ninterface Symbol {\\n    readonly [Symbol.toStringTag]: string;\\n}\\ndeclare const console: { log(msg: any): void; };\",\"affectsGlobalScope\":true,\"impliedNodeFormat\":1},\"f6064dd4cf37650708d1be255d835fa1-export declare const value: string;\",{\"version\":\"d673c5da1e5e9c18d2b4913a15f11aeb-import { value } from \\\"pkg\\\"; export const copy = value;\",\"signature\":\"8e3ad4bc9426a17c09fc112b77237a9c-export declare const copy: string;\\n\",\"impliedNodeFormat\":1},{\"version\":\"c5d4a50b84460c4a55dcbc5ccca5ded5-declare const extra: number;\",\"affectsGlobalScope\":true,\"impliedNodeFormat\":1}],\"fileIdsList\":[[2]],\"options\":{\"composite\":true,\"outDir\":\"./\"},\"referencedMap\":[[3,1]],\"latestChangedDtsFile\":\"./index.d.ts\"}"}}
+//// [/home/src/workspaces/project/out/index.d.ts] *new* 
+export declare const copy: string;
+
+//// [/home/src/workspaces/project/out/index.js] *new* 
+import { value } from "pkg";
+export const copy = value;
+
+//// [/home/src/workspaces/project/out/tsconfig.tsbuildinfo] *new* 
+{"version":"FakeTSVersion","root":[3],"missingPackageJsons":["../node_modules/@types/extra/package.json","../node_modules/@types/package.json","../node_modules/package.json","../node_modules/pkg/package.json"],"fileNames":["lib.es2025.full.d.ts","../node_modules/pkg/index.d.ts","../index.ts","../node_modules/@types/extra/index.d.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},"f6064dd4cf37650708d1be255d835fa1-export declare const value: string;",{"version":"d673c5da1e5e9c18d2b4913a15f11aeb-import { value } from \"pkg\"; export const copy = value;","signature":"8e3ad4bc9426a17c09fc112b77237a9c-export declare const copy: string;\n","impliedNodeFormat":1},{"version":"c5d4a50b84460c4a55dcbc5ccca5ded5-declare const extra: number;","affectsGlobalScope":true,"impliedNodeFormat":1}],"fileIdsList":[[2]],"options":{"composite":true,"outDir":"./"},"referencedMap":[[3,1]],"latestChangedDtsFile":"./index.d.ts"}
+//// [/home/src/workspaces/project/out/tsconfig.tsbuildinfo.readable.baseline.txt] *new* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "../index.ts"
+      ],
+      "original": 3
+    }
+  ],
+  "missingPackageJsons": [
+    "../node_modules/@types/extra/package.json",
+    "../node_modules/@types/package.json",
+    "../node_modules/package.json",
+    "../node_modules/pkg/package.json"
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "../node_modules/pkg/index.d.ts",
+    "../index.ts",
+    "../node_modules/@types/extra/index.d.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "../node_modules/pkg/index.d.ts",
+      "version": "f6064dd4cf37650708d1be255d835fa1-export declare const value: string;",
+      "signature": "f6064dd4cf37650708d1be255d835fa1-export declare const value: string;",
+      "impliedNodeFormat": "CommonJS"
+    },
+    {
+      "fileName": "../index.ts",
+      "version": "d673c5da1e5e9c18d2b4913a15f11aeb-import { value } from \"pkg\"; export const copy = value;",
+      "signature": "8e3ad4bc9426a17c09fc112b77237a9c-export declare const copy: string;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "d673c5da1e5e9c18d2b4913a15f11aeb-import { value } from \"pkg\"; export const copy = value;",
+        "signature": "8e3ad4bc9426a17c09fc112b77237a9c-export declare const copy: string;\n",
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "../node_modules/@types/extra/index.d.ts",
+      "version": "c5d4a50b84460c4a55dcbc5ccca5ded5-declare const extra: number;",
+      "signature": "c5d4a50b84460c4a55dcbc5ccca5ded5-declare const extra: number;",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "c5d4a50b84460c4a55dcbc5ccca5ded5-declare const extra: number;",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "fileIdsList": [
+    [
+      "../node_modules/pkg/index.d.ts"
+    ]
+  ],
+  "options": {
+    "composite": true,
+    "outDir": "./"
+  },
+  "referencedMap": {
+    "../index.ts": [
+      "../node_modules/pkg/index.d.ts"
+    ]
+  },
+  "latestChangedDtsFile": "./index.d.ts",
+  "size": 1651
+}
+
+tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/tslibs/TS/Lib/lib.es2025.full.d.ts
+*refresh*    /home/src/workspaces/project/node_modules/pkg/index.d.ts
+*refresh*    /home/src/workspaces/project/index.ts
+*refresh*    /home/src/workspaces/project/node_modules/@types/extra/index.d.ts
+Signatures::
+(stored at emit) /home/src/workspaces/project/index.ts
@@ -0,0 +1,724 @@
+currentDirectory::/home/src/workspaces/solution
+useCaseSensitiveFileNames::true
+Input::
+//// [/home/src/workspaces/solution/app/index.ts] *new* 
+import { shared } from "../shared"; export const app = shared + 1;
+//// [/home/src/workspaces/solution/app/tsconfig.json] *new* 
+{
+    "compilerOptions": { "composite": true, "outDir": "out" },
+    "references": [{ "path": "../shared" }]
+}
+//// [/home/src/workspaces/solution/shared/index.ts] *new* 
+export const shared = 1;
+//// [/home/src/workspaces/solution/shared/tsconfig.json] *new* 
+{
+    "compilerOptions": { "composite": true, "outDir": "out" }
+}
+
+tsgo --build app --verbose --buildCacheDir ../cache
+ExitStatus:: Success
+Output::
+[[90mHH:MM:SS AM[0m] Projects in this build: 
+    * shared/tsconfig.json
+    * app/tsconfig.json
+
+[[90mHH:MM:SS AM[0m] Project 'shared/tsconfig.json' is out of date because output file 'shared/out/tsconfig.tsbuildinfo' does not exist
+
+[[90mHH:MM:SS AM[0m] Building project 'shared/tsconfig.json'...
+
+[[90mHH:MM:SS AM[0m] Project 'app/tsconfig.json' is out of date because output file 'app/out/tsconfig.tsbuildinfo' does not exist
+
+[[90mHH:MM:SS AM[0m] Building project 'app/tsconfig.json'...
+
+//// [/home/src/tslibs/TS/Lib/lib.es2025.full.d.ts] *Lib*
+/// <reference no-default-lib="true"/>
+interface Boolean {}
+interface Function {}
+interface CallableFunction {}
+interface NewableFunction {}
+interface IArguments {}
+interface Number { toExponential: any; }
+interface Object {}
+interface RegExp {}
+interface String { charAt: any; }
+interface Array<T> { length: number; [n: number]: T; }
+interface ReadonlyArray<T> {}
+interface SymbolConstructor {
+    (desc?: string | number): symbol;
+    for(name: string): symbol;
+    readonly toStringTag: symbol;
+}
+declare var Symbol: SymbolConstructor;
+interface Symbol {
+    readonly [Symbol.toStringTag]: string;
+}
+declare const console: { log(msg: any): void; };
+//// [/home/src/workspaces/cache/256bc0b45d8b702f646c06260bcb0b2b.json] *new* 
+{"inputs":{"../../../../../package.json":"","../../../../package.json":"","../../../package.json":"","../../package.json":"","../package.json":"","../shared/out/index.d.ts":"01da704875703ac3ee2bf0319473b16f","../shared/out/package.json":"","../shared/package.json":"","package.json":""},"resolutions":{"index.ts":[{"name":"../shared","mode":99,"resolvedFileName":"../shared/index.ts"}]},"typeDirectives":[],"outputs":{"out/index.d.ts":"export declare const app: number;\n","out/index.js":"import { shared } from \"../shared\";\nexport const app = shared + 1;\n","out/tsconfig.tsbuildinfo":"{\"version\":\"7.1.0-dev\",\"root\":[3],\"fileNames\":[\"lib.es2025.full.d.ts\",\"../../shared/out/index.d.ts\",\"../index.ts\"],\"fileInfos\":[{\"version\":\"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\\\"true\\\"/>\\ninterface Boolean {}\\ninterface Function {}\\ninterface CallableFunction {}\\ninterface NewableFunction {}\\ninterface IArguments {}\\ninterface Number { toExponential: any; }\\ninterface Object {}\\ninterface RegExp {}\\ninterface String { charAt: any; }\\ninterface Array<T> { length: number; [n: number]: T; }\\ninterface ReadonlyArray<T> {}\\ninterface SymbolConstructor {\\n    (desc?: string | number): symbol;\\n    for(name: string): symbol;\\n    readonly toStringTag: symbol;\\n}\\ndeclare var Symbol: SymbolConstructor;\\ninterface Symbol {\\n    readonly [Symbol.toStringTag]: string;\\n}\\ndeclare const console: { log(msg: any): void; };\",\"affectsGlobalScope\":true,\"impliedNodeFormat\":1},\"01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\\n\",{\"version\":\"e4a25fbd8f56e6c57a309c9881a8362e-import { shared } from \\\"../shared\\\"; export const app = shared + 1;\",\"signature\":\"553f59a1a1d5dead16edc400a48cdb17-export declare const app: number;\\n\",\"impliedNodeFormat\":1}],\"fileIdsList\":[[2]],\"options\":{\"composite\":true,\"outDir\":\"./\"},\"referencedMap\":[[3,1]],\"latestChangedDtsFile\":\"./index.d.ts\"}"}}
+//// [/home/src/workspaces/cache/7fdaf99c915a418ec35503fc9bb8f3a4.json] *new* 
+{"inputs":{"../../../../../package.json":"","../../../../package.json":"","../../../package.json":"","../../package.json":"","../package.json":"","package.json":""},"resolutions":{},"typeDirectives":[],"outputs":{"out/index.d.ts":"export declare const shared = 1;\n","out/index.js":"export const shared = 1;\n","out/tsconfig.tsbuildinfo":"{\"version\":\"7.1.0-dev\",\"root\":[2],\"fileNames\":[\"lib.es2025.full.d.ts\",\"../index.ts\"],\"fileInfos\":[{\"version\":\"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\\\"true\\\"/>\\ninterface Boolean {}\\ninterface Function {}\\ninterface CallableFunction {}\\ninterface NewableFunction {}\\ninterface IArguments {}\\ninterface Number { toExponential: any; }\\ninterface Object {}\\ninterface RegExp {}\\ninterface String { charAt: any; }\\ninterface Array<T> { length: number; [n: number]: T; }\\ninterface ReadonlyArray<T> {}\\ninterface SymbolConstructor {\\n    (desc?: string | number): symbol;\\n    for(name: string): symbol;\\n    readonly toStringTag: symbol;\\n}\\ndeclare var Symbol: SymbolConstructor;\\ninterface Symbol {\\n    readonly [Symbol.toStringTag]: string;\\n}\\ndeclare const console: { log(msg: any): void; };\",\"affectsGlobalScope\":true,\"impliedNodeFormat\":1},{\"version\":\"587a897ca44ef0e2d921d4398af51184-export const shared = 1;\",\"signature\":\"01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\\n\",\"impliedNodeFormat\":1}],\"options\":{\"composite\":true,\"outDir\":\"./\"},\"latestChangedDtsFile\":\"./index.d.ts\"}"}}
+//// [/home/src/workspaces/solution/app/out/index.d.ts] *new* 
+export declare const app: number;
+
+//// [/home/src/workspaces/solution/app/out/index.js] *new* 
+import { shared } from "../shared";
+export const app = shared + 1;
+
+//// [/home/src/workspaces/solution/app/out/tsconfig.tsbuildinfo] *new* 
+{"version":"FakeTSVersion","root":[3],"fileNames":["lib.es2025.full.d.ts","../../shared/out/index.d.ts","../index.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},"01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",{"version":"e4a25fbd8f56e6c57a309c9881a8362e-import { shared } from \"../shared\"; export const app = shared + 1;","signature":"553f59a1a1d5dead16edc400a48cdb17-export declare const app: number;\n","impliedNodeFormat":1}],"fileIdsList":[[2]],"options":{"composite":true,"outDir":"./"},"referencedMap":[[3,1]],"latestChangedDtsFile":"./index.d.ts"}
+//// [/home/src/workspaces/solution/app/out/tsconfig.tsbuildinfo.readable.baseline.txt] *new* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "../index.ts"
+      ],
+      "original": 3
+    }
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "../../shared/out/index.d.ts",
+    "../index.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "../../shared/out/index.d.ts",
+      "version": "01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",
+      "signature": "01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",
+      "impliedNodeFormat": "CommonJS"
+    },
+    {
+      "fileName": "../index.ts",
+      "version": "e4a25fbd8f56e6c57a309c9881a8362e-import { shared } from \"../shared\"; export const app = shared + 1;",
+      "signature": "553f59a1a1d5dead16edc400a48cdb17-export declare const app: number;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "e4a25fbd8f56e6c57a309c9881a8362e-import { shared } from \"../shared\"; export const app = shared + 1;",
+        "signature": "553f59a1a1d5dead16edc400a48cdb17-export declare const app: number;\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "fileIdsList": [
+    [
+      "../../shared/out/index.d.ts"
+    ]
+  ],
+  "options": {
+    "composite": true,
+    "outDir": "./"
+  },
+  "referencedMap": {
+    "../index.ts": [
+      "../../shared/out/index.d.ts"
+    ]
+  },
+  "latestChangedDtsFile": "./index.d.ts",
+  "size": 1319
+}
+//// [/home/src/workspaces/solution/shared/out/index.d.ts] *new* 
+export declare const shared = 1;
+
+//// [/home/src/workspaces/solution/shared/out/index.js] *new* 
+export const shared = 1;
+
+//// [/home/src/workspaces/solution/shared/out/tsconfig.tsbuildinfo] *new* 
+{"version":"FakeTSVersion","root":[2],"fileNames":["lib.es2025.full.d.ts","../index.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},{"version":"587a897ca44ef0e2d921d4398af51184-export const shared = 1;","signature":"01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n","impliedNodeFormat":1}],"options":{"composite":true,"outDir":"./"},"latestChangedDtsFile":"./index.d.ts"}
+//// [/home/src/workspaces/solution/shared/out/tsconfig.tsbuildinfo.readable.baseline.txt] *new* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "../index.ts"
+      ],
+      "original": 2
+    }
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "../index.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "../index.ts",
+      "version": "587a897ca44ef0e2d921d4398af51184-export const shared = 1;",
+      "signature": "01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "587a897ca44ef0e2d921d4398af51184-export const shared = 1;",
+        "signature": "01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "options": {
+    "composite": true,
+    "outDir": "./"
+  },
+  "latestChangedDtsFile": "./index.d.ts",
+  "size": 1130
+}
+
+shared/tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/tslibs/TS/Lib/lib.es2025.full.d.ts
+*refresh*    /home/src/workspaces/solution/shared/index.ts
+Signatures::
+(stored at emit) /home/src/workspaces/solution/shared/index.ts
+
+app/tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/tslibs/TS/Lib/lib.es2025.full.d.ts
+*refresh*    /home/src/workspaces/solution/shared/out/index.d.ts
+*refresh*    /home/src/workspaces/solution/app/index.ts
+Signatures::
+(stored at emit) /home/src/workspaces/solution/app/index.ts
+
+
+Edit [0]:: remove outputs
+//// [/home/src/workspaces/solution/app/out/index.d.ts] *deleted*
+//// [/home/src/workspaces/solution/app/out/index.js] *deleted*
+//// [/home/src/workspaces/solution/app/out/tsconfig.tsbuildinfo] *deleted*
+//// [/home/src/workspaces/solution/app/out/tsconfig.tsbuildinfo.readable.baseline.txt] *deleted*
+//// [/home/src/workspaces/solution/shared/out/index.d.ts] *deleted*
+//// [/home/src/workspaces/solution/shared/out/index.js] *deleted*
+//// [/home/src/workspaces/solution/shared/out/tsconfig.tsbuildinfo] *deleted*
+//// [/home/src/workspaces/solution/shared/out/tsconfig.tsbuildinfo.readable.baseline.txt] *deleted*
+
+tsgo --build app --verbose --buildCacheDir ../cache
+ExitStatus:: Success
+Output::
+[[90mHH:MM:SS AM[0m] Projects in this build: 
+    * shared/tsconfig.json
+    * app/tsconfig.json
+
+[[90mHH:MM:SS AM[0m] Project 'shared/tsconfig.json' is out of date because output file 'shared/out/tsconfig.tsbuildinfo' does not exist
+
+[[90mHH:MM:SS AM[0m] Restoring outputs of project 'shared/tsconfig.json' from the build cache...
+
+[[90mHH:MM:SS AM[0m] Project 'app/tsconfig.json' is out of date because output file 'app/out/tsconfig.tsbuildinfo' does not exist
+
+[[90mHH:MM:SS AM[0m] Restoring outputs of project 'app/tsconfig.json' from the build cache...
+
+//// [/home/src/workspaces/solution/app/out/index.d.ts] *new* 
+export declare const app: number;
+
+//// [/home/src/workspaces/solution/app/out/index.js] *new* 
+import { shared } from "../shared";
+export const app = shared + 1;
+
+//// [/home/src/workspaces/solution/app/out/tsconfig.tsbuildinfo] *new* 
+{"version":"FakeTSVersion","root":[3],"fileNames":["lib.es2025.full.d.ts","../../shared/out/index.d.ts","../index.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},"01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",{"version":"e4a25fbd8f56e6c57a309c9881a8362e-import { shared } from \"../shared\"; export const app = shared + 1;","signature":"553f59a1a1d5dead16edc400a48cdb17-export declare const app: number;\n","impliedNodeFormat":1}],"fileIdsList":[[2]],"options":{"composite":true,"outDir":"./"},"referencedMap":[[3,1]],"latestChangedDtsFile":"./index.d.ts"}
+//// [/home/src/workspaces/solution/app/out/tsconfig.tsbuildinfo.readable.baseline.txt] *new* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "../index.ts"
+      ],
+      "original": 3
+    }
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "../../shared/out/index.d.ts",
+    "../index.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "../../shared/out/index.d.ts",
+      "version": "01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",
+      "signature": "01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",
+      "impliedNodeFormat": "CommonJS"
+    },
+    {
+      "fileName": "../index.ts",
+      "version": "e4a25fbd8f56e6c57a309c9881a8362e-import { shared } from \"../shared\"; export const app = shared + 1;",
+      "signature": "553f59a1a1d5dead16edc400a48cdb17-export declare const app: number;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "e4a25fbd8f56e6c57a309c9881a8362e-import { shared } from \"../shared\"; export const app = shared + 1;",
+        "signature": "553f59a1a1d5dead16edc400a48cdb17-export declare const app: number;\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "fileIdsList": [
+    [
+      "../../shared/out/index.d.ts"
+    ]
+  ],
+  "options": {
+    "composite": true,
+    "outDir": "./"
+  },
+  "referencedMap": {
+    "../index.ts": [
+      "../../shared/out/index.d.ts"
+    ]
+  },
+  "latestChangedDtsFile": "./index.d.ts",
+  "size": 1319
+}
+//// [/home/src/workspaces/solution/shared/out/index.d.ts] *new* 
+export declare const shared = 1;
+
+//// [/home/src/workspaces/solution/shared/out/index.js] *new* 
+export const shared = 1;
+
+//// [/home/src/workspaces/solution/shared/out/tsconfig.tsbuildinfo] *new* 
+{"version":"FakeTSVersion","root":[2],"fileNames":["lib.es2025.full.d.ts","../index.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},{"version":"587a897ca44ef0e2d921d4398af51184-export const shared = 1;","signature":"01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n","impliedNodeFormat":1}],"options":{"composite":true,"outDir":"./"},"latestChangedDtsFile":"./index.d.ts"}
+//// [/home/src/workspaces/solution/shared/out/tsconfig.tsbuildinfo.readable.baseline.txt] *new* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "../index.ts"
+      ],
+      "original": 2
+    }
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "../index.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "../index.ts",
+      "version": "587a897ca44ef0e2d921d4398af51184-export const shared = 1;",
+      "signature": "01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "587a897ca44ef0e2d921d4398af51184-export const shared = 1;",
+        "signature": "01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "options": {
+    "composite": true,
+    "outDir": "./"
+  },
+  "latestChangedDtsFile": "./index.d.ts",
+  "size": 1130
+}
+
+
+
+Edit [1]:: change declarations of upstream project
+//// [/home/src/workspaces/solution/shared/index.ts] *modified* 
+export const shared = "1";
+
+tsgo --build app --verbose --buildCacheDir ../cache
+ExitStatus:: Success
+Output::
+[[90mHH:MM:SS AM[0m] Projects in this build: 
+    * shared/tsconfig.json
+    * app/tsconfig.json
+
+[[90mHH:MM:SS AM[0m] Project 'shared/tsconfig.json' is out of date because output 'shared/out/tsconfig.tsbuildinfo' is older than input 'shared/index.ts'
+
+[[90mHH:MM:SS AM[0m] Building project 'shared/tsconfig.json'...
+
+[[90mHH:MM:SS AM[0m] Project 'app/tsconfig.json' is out of date because output 'app/out/tsconfig.tsbuildinfo' is older than input 'shared/out/index.d.ts'
+
+[[90mHH:MM:SS AM[0m] Building project 'app/tsconfig.json'...
+
+//// [/home/src/workspaces/cache/86985c2bbeff3aa0ec6cfc126115861c.json] *new* 
+{"inputs":{"../../../../../package.json":"","../../../../package.json":"","../../../package.json":"","../../package.json":"","../package.json":"","package.json":""},"resolutions":{},"typeDirectives":[],"outputs":{"out/index.d.ts":"export declare const shared = \"1\";\n","out/index.js":"export const shared = \"1\";\n","out/tsconfig.tsbuildinfo":"{\"version\":\"7.1.0-dev\",\"root\":[2],\"fileNames\":[\"lib.es2025.full.d.ts\",\"../index.ts\"],\"fileInfos\":[{\"version\":\"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\\\"true\\\"/>\\ninterface Boolean {}\\ninterface Function {}\\ninterface CallableFunction {}\\ninterface NewableFunction {}\\ninterface IArguments {}\\ninterface Number { toExponential: any; }\\ninterface Object {}\\ninterface RegExp {}\\ninterface String { charAt: any; }\\ninterface Array<T> { length: number; [n: number]: T; }\\ninterface ReadonlyArray<T> {}\\ninterface SymbolConstructor {\\n    (desc?: string | number): symbol;\\n    for(name: string): symbol;\\n    readonly toStringTag: symbol;\\n}\\ndeclare var Symbol: SymbolConstructor;\\ninterface Symbol {\\n    readonly [Symbol.toStringTag]: string;\\n}\\ndeclare const console: { log(msg: any): void; };\",\"affectsGlobalScope\":true,\"impliedNodeFormat\":1},{\"version\":\"da0dc6fd4d223475e65aa3c10401b8af-export const shared = \\\"1\\\";\",\"signature\":\"7d0288960a8092e8f13eaca70e2859b0-export declare const shared = \\\"1\\\";\\n\",\"impliedNodeFormat\":1}],\"options\":{\"composite\":true,\"outDir\":\"./\"},\"latestChangedDtsFile\":\"./index.d.ts\"}"}}
+//// [/home/src/workspaces/cache/92e5cbd69ab5cca2511457cf1d0c38a6.json] *new* 
+{"inputs":{"../../../../../package.json":"","../../../../package.json":"","../../../package.json":"","../../package.json":"","../package.json":"","../shared/out/index.d.ts":"7d0288960a8092e8f13eaca70e2859b0","../shared/out/package.json":"","../shared/package.json":"","package.json":""},"resolutions":{"index.ts":[{"name":"../shared","mode":99,"resolvedFileName":"../shared/index.ts"}]},"typeDirectives":[],"outputs":{"out/index.d.ts":"export declare const app: string;\n","out/index.js":"import { shared } from \"../shared\";\nexport const app = shared + 1;\n","out/tsconfig.tsbuildinfo":"{\"version\":\"7.1.0-dev\",\"root\":[3],\"fileNames\":[\"lib.es2025.full.d.ts\",\"../../shared/out/index.d.ts\",\"../index.ts\"],\"fileInfos\":[{\"version\":\"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\\\"true\\\"/>\\ninterface Boolean {}\\ninterface Function {}\\ninterface CallableFunction {}\\ninterface NewableFunction {}\\ninterface IArguments {}\\ninterface Number { toExponential: any; }\\ninterface Object {}\\ninterface RegExp {}\\ninterface String { charAt: any; }\\ninterface Array<T> { length: number; [n: number]: T; }\\ninterface ReadonlyArray<T> {}\\ninterface SymbolConstructor {\\n    (desc?: string | number): symbol;\\n    for(name: string): symbol;\\n    readonly toStringTag: symbol;\\n}\\ndeclare var Symbol: SymbolConstructor;\\ninterface Symbol {\\n    readonly [Symbol.toStringTag]: string;\\n}\\ndeclare const console: { log(msg: any): void; };\",\"affectsGlobalScope\":true,\"impliedNodeFormat\":1},\"7d0288960a8092e8f13eaca70e2859b0-export declare const shared = \\\"1\\\";\\n\",{\"version\":\"e4a25fbd8f56e6c57a309c9881a8362e-import { shared } from \\\"../shared\\\"; export const app = shared + 1;\",\"signature\":\"eb35892c816d3e3ffc8370a1f34ed077-export declare const app: string;\\n\",\"impliedNodeFormat\":1}],\"fileIdsList\":[[2]],\"options\":{\"composite\":true,\"outDir\":\"./\"},\"referencedMap\":[[3,1]],\"latestChangedDtsFile\":\"./index.d.ts\"}"}}
+//// [/home/src/workspaces/solution/app/out/index.d.ts] *modified* 
+export declare const app: string;
+
+//// [/home/src/workspaces/solution/app/out/index.js] *rewrite with same content*
+//// [/home/src/workspaces/solution/app/out/tsconfig.tsbuildinfo] *modified* 
+{"version":"FakeTSVersion","root":[3],"fileNames":["lib.es2025.full.d.ts","../../shared/out/index.d.ts","../index.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},"7d0288960a8092e8f13eaca70e2859b0-export declare const shared = \"1\";\n",{"version":"e4a25fbd8f56e6c57a309c9881a8362e-import { shared } from \"../shared\"; export const app = shared + 1;","signature":"eb35892c816d3e3ffc8370a1f34ed077-export declare const app: string;\n","impliedNodeFormat":1}],"fileIdsList":[[2]],"options":{"composite":true,"outDir":"./"},"referencedMap":[[3,1]],"latestChangedDtsFile":"./index.d.ts"}
+//// [/home/src/workspaces/solution/app/out/tsconfig.tsbuildinfo.readable.baseline.txt] *modified* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "../index.ts"
+      ],
+      "original": 3
+    }
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "../../shared/out/index.d.ts",
+    "../index.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "../../shared/out/index.d.ts",
+      "version": "7d0288960a8092e8f13eaca70e2859b0-export declare const shared = \"1\";\n",
+      "signature": "7d0288960a8092e8f13eaca70e2859b0-export declare const shared = \"1\";\n",
+      "impliedNodeFormat": "CommonJS"
+    },
+    {
+      "fileName": "../index.ts",
+      "version": "e4a25fbd8f56e6c57a309c9881a8362e-import { shared } from \"../shared\"; export const app = shared + 1;",
+      "signature": "eb35892c816d3e3ffc8370a1f34ed077-export declare const app: string;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "e4a25fbd8f56e6c57a309c9881a8362e-import { shared } from \"../shared\"; export const app = shared + 1;",
+        "signature": "eb35892c816d3e3ffc8370a1f34ed077-export declare const app: string;\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "fileIdsList": [
+    [
+      "../../shared/out/index.d.ts"
+    ]
+  ],
+  "options": {
+    "composite": true,
+    "outDir": "./"
+  },
+  "referencedMap": {
+    "../index.ts": [
+      "../../shared/out/index.d.ts"
+    ]
+  },
+  "latestChangedDtsFile": "./index.d.ts",
+  "size": 1323
+}
+//// [/home/src/workspaces/solution/shared/out/index.d.ts] *modified* 
+export declare const shared = "1";
+
+//// [/home/src/workspaces/solution/shared/out/index.js] *modified* 
+export const shared = "1";
+
+//// [/home/src/workspaces/solution/shared/out/tsconfig.tsbuildinfo] *modified* 
+{"version":"FakeTSVersion","root":[2],"fileNames":["lib.es2025.full.d.ts","../index.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},{"version":"da0dc6fd4d223475e65aa3c10401b8af-export const shared = \"1\";","signature":"7d0288960a8092e8f13eaca70e2859b0-export declare const shared = \"1\";\n","impliedNodeFormat":1}],"options":{"composite":true,"outDir":"./"},"latestChangedDtsFile":"./index.d.ts"}
+//// [/home/src/workspaces/solution/shared/out/tsconfig.tsbuildinfo.readable.baseline.txt] *modified* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "../index.ts"
+      ],
+      "original": 2
+    }
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "../index.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "../index.ts",
+      "version": "da0dc6fd4d223475e65aa3c10401b8af-export const shared = \"1\";",
+      "signature": "7d0288960a8092e8f13eaca70e2859b0-export declare const shared = \"1\";\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "da0dc6fd4d223475e65aa3c10401b8af-export const shared = \"1\";",
+        "signature": "7d0288960a8092e8f13eaca70e2859b0-export declare const shared = \"1\";\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "options": {
+    "composite": true,
+    "outDir": "./"
+  },
+  "latestChangedDtsFile": "./index.d.ts",
+  "size": 1138
+}
+
+shared/tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/workspaces/solution/shared/index.ts
+Signatures::
+(computed .d.ts) /home/src/workspaces/solution/shared/index.ts
+
+app/tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/workspaces/solution/shared/out/index.d.ts
+*refresh*    /home/src/workspaces/solution/app/index.ts
+Signatures::
+(used version)   /home/src/workspaces/solution/shared/out/index.d.ts
+(computed .d.ts) /home/src/workspaces/solution/app/index.ts
+
+
+Edit [2]:: revert change and remove outputs
+//// [/home/src/workspaces/solution/app/out/index.d.ts] *deleted*
+//// [/home/src/workspaces/solution/app/out/index.js] *deleted*
+//// [/home/src/workspaces/solution/app/out/tsconfig.tsbuildinfo] *deleted*
+//// [/home/src/workspaces/solution/app/out/tsconfig.tsbuildinfo.readable.baseline.txt] *deleted*
+//// [/home/src/workspaces/solution/shared/index.ts] *modified* 
+export const shared = 1;
+//// [/home/src/workspaces/solution/shared/out/index.d.ts] *deleted*
+//// [/home/src/workspaces/solution/shared/out/index.js] *deleted*
+//// [/home/src/workspaces/solution/shared/out/tsconfig.tsbuildinfo] *deleted*
+//// [/home/src/workspaces/solution/shared/out/tsconfig.tsbuildinfo.readable.baseline.txt] *deleted*
+
+tsgo --build app --verbose --buildCacheDir ../cache
+ExitStatus:: Success
+Output::
+[[90mHH:MM:SS AM[0m] Projects in this build: 
+    * shared/tsconfig.json
+    * app/tsconfig.json
+
+[[90mHH:MM:SS AM[0m] Project 'shared/tsconfig.json' is out of date because output file 'shared/out/tsconfig.tsbuildinfo' does not exist
+
+[[90mHH:MM:SS AM[0m] Restoring outputs of project 'shared/tsconfig.json' from the build cache...
+
+[[90mHH:MM:SS AM[0m] Project 'app/tsconfig.json' is out of date because output file 'app/out/tsconfig.tsbuildinfo' does not exist
+
+[[90mHH:MM:SS AM[0m] Restoring outputs of project 'app/tsconfig.json' from the build cache...
+
+//// [/home/src/workspaces/solution/app/out/index.d.ts] *new* 
+export declare const app: number;
+
+//// [/home/src/workspaces/solution/app/out/index.js] *new* 
+import { shared } from "../shared";
+export const app = shared + 1;
+
+//// [/home/src/workspaces/solution/app/out/tsconfig.tsbuildinfo] *new* 
+{"version":"FakeTSVersion","root":[3],"fileNames":["lib.es2025.full.d.ts","../../shared/out/index.d.ts","../index.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},"01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",{"version":"e4a25fbd8f56e6c57a309c9881a8362e-import { shared } from \"../shared\"; export const app = shared + 1;","signature":"553f59a1a1d5dead16edc400a48cdb17-export declare const app: number;\n","impliedNodeFormat":1}],"fileIdsList":[[2]],"options":{"composite":true,"outDir":"./"},"referencedMap":[[3,1]],"latestChangedDtsFile":"./index.d.ts"}
+//// [/home/src/workspaces/solution/app/out/tsconfig.tsbuildinfo.readable.baseline.txt] *new* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "../index.ts"
+      ],
+      "original": 3
+    }
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "../../shared/out/index.d.ts",
+    "../index.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "../../shared/out/index.d.ts",
+      "version": "01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",
+      "signature": "01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",
+      "impliedNodeFormat": "CommonJS"
+    },
+    {
+      "fileName": "../index.ts",
+      "version": "e4a25fbd8f56e6c57a309c9881a8362e-import { shared } from \"../shared\"; export const app = shared + 1;",
+      "signature": "553f59a1a1d5dead16edc400a48cdb17-export declare const app: number;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "e4a25fbd8f56e6c57a309c9881a8362e-import { shared } from \"../shared\"; export const app = shared + 1;",
+        "signature": "553f59a1a1d5dead16edc400a48cdb17-export declare const app: number;\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "fileIdsList": [
+    [
+      "../../shared/out/index.d.ts"
+    ]
+  ],
+  "options": {
+    "composite": true,
+    "outDir": "./"
+  },
+  "referencedMap": {
+    "../index.ts": [
+      "../../shared/out/index.d.ts"
+    ]
+  },
+  "latestChangedDtsFile": "./index.d.ts",
+  "size": 1319
+}
+//// [/home/src/workspaces/solution/shared/out/index.d.ts] *new* 
+export declare const shared = 1;
+
+//// [/home/src/workspaces/solution/shared/out/index.js] *new* 
+export const shared = 1;
+
+//// [/home/src/workspaces/solution/shared/out/tsconfig.tsbuildinfo] *new* 
+{"version":"FakeTSVersion","root":[2],"fileNames":["lib.es2025.full.d.ts","../index.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},{"version":"587a897ca44ef0e2d921d4398af51184-export const shared = 1;","signature":"01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n","impliedNodeFormat":1}],"options":{"composite":true,"outDir":"./"},"latestChangedDtsFile":"./index.d.ts"}
+//// [/home/src/workspaces/solution/shared/out/tsconfig.tsbuildinfo.readable.baseline.txt] *new* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "../index.ts"
+      ],
+      "original": 2
+    }
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "../index.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "../index.ts",
+      "version": "587a897ca44ef0e2d921d4398af51184-export const shared = 1;",
+      "signature": "01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "587a897ca44ef0e2d921d4398af51184-export const shared = 1;",
+        "signature": "01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "options": {
+    "composite": true,
+    "outDir": "./"
+  },
+  "latestChangedDtsFile": "./index.d.ts",
+  "size": 1130
+}
+
+
+
+Edit [3]:: force ignores cache
+
+tsgo --build app --verbose --buildCacheDir ../cache --force
+ExitStatus:: Success
+Output::
+[[90mHH:MM:SS AM[0m] Projects in this build: 
+    * shared/tsconfig.json
+    * app/tsconfig.json
+
+[[90mHH:MM:SS AM[0m] Project 'shared/tsconfig.json' is being forcibly rebuilt
+
+[[90mHH:MM:SS AM[0m] Building project 'shared/tsconfig.json'...
+
+[[90mHH:MM:SS AM[0m] Project 'app/tsconfig.json' is being forcibly rebuilt
+
+[[90mHH:MM:SS AM[0m] Building project 'app/tsconfig.json'...
+
+//// [/home/src/workspaces/cache/256bc0b45d8b702f646c06260bcb0b2b.json] *rewrite with same content*
+//// [/home/src/workspaces/cache/7fdaf99c915a418ec35503fc9bb8f3a4.json] *rewrite with same content*
+//// [/home/src/workspaces/solution/app/out/index.d.ts] *rewrite with same content*
+//// [/home/src/workspaces/solution/app/out/index.js] *rewrite with same content*
+//// [/home/src/workspaces/solution/app/out/tsconfig.tsbuildinfo] *rewrite with same content*
+//// [/home/src/workspaces/solution/app/out/tsconfig.tsbuildinfo.readable.baseline.txt] *rewrite with same content*
+//// [/home/src/workspaces/solution/shared/out/index.d.ts] *rewrite with same content*
+//// [/home/src/workspaces/solution/shared/out/index.js] *rewrite with same content*
+//// [/home/src/workspaces/solution/shared/out/tsconfig.tsbuildinfo] *rewrite with same content*
+//// [/home/src/workspaces/solution/shared/out/tsconfig.tsbuildinfo.readable.baseline.txt] *rewrite with same content*
+
+shared/tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/tslibs/TS/Lib/lib.es2025.full.d.ts
+*refresh*    /home/src/workspaces/solution/shared/index.ts
+Signatures::
+(stored at emit) /home/src/workspaces/solution/shared/index.ts
+
+app/tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/tslibs/TS/Lib/lib.es2025.full.d.ts
+*refresh*    /home/src/workspaces/solution/shared/out/index.d.ts
+*refresh*    /home/src/workspaces/solution/app/index.ts
+Signatures::
+(stored at emit) /home/src/workspaces/solution/app/index.ts
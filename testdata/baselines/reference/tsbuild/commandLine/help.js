@@ -46,6 +46,14 @@ Enable color and formatting in TypeScript's output to make compiler errors easie
 type: boolean
 default: true
 
+[94m--diagnosticFormat[39m
+Report diagnostics as a single machine-readable document instead of formatted text.
+one of: json, sarif
+default: undefined
+
+[94m--watchStatusPipe[39m
+Serve the watch status and rebuild events over JSON-RPC on the given pipe or socket.
+
 [94m--traceResolution[39m
 Log paths used during the 'moduleResolution' process.
 type: boolean
@@ -69,6 +77,11 @@ default: profile.cpuprofile
 [94m--generateTrace[39m
 Generates an event trace and a list of types.
 
+[94m--checkPerformanceReport[39m
+Report the files, declarations and type relations that take the longest to check.
+type: boolean
+default: false
+
 [94m--incremental, -i[39m
 Save .tsbuildinfo files to allow for incremental compilation of projects.
 type: boolean
@@ -131,6 +144,9 @@ Run in single threaded mode.
 [94m--pprofDir[39m
 Generate pprof CPU/memory profiles to the given directory.
 
+[94m--astCacheDir[39m
+Cache parsed declaration files in the given directory and reuse them in later compilations.
+
 [94m--checkers[39m
 Set the number of checkers per project.
 
@@ -155,4 +171,16 @@ Set the number of projects to build concurrently.
 [94m--stopBuildOnErrors[39m
 Skip building downstream projects on error in upstream project.
 
+[94m--buildCacheDir[39m
+Reuse project outputs from the build cache in the given directory and store the outputs of built projects there.
+
+[94m--graph[39m
+Print the project reference graph and the up-to-date status of each project instead of building.
+
+[94m--affectedBy[39m
+Build only the projects affected by changes to the given files and the projects they reference.
+
+[94m--listAffected[39m
+Print the files affected by changes to the files given to '--affectedBy' instead of building.
+
 
@@ -0,0 +1,33 @@
+currentDirectory::/home/src/workspaces/solution
+useCaseSensitiveFileNames::true
+Input::
+//// [/home/src/workspaces/solution/app/index.ts] *new* 
+import { shared } from "../shared"; export const app: number = shared;
+//// [/home/src/workspaces/solution/app/tsconfig.json] *new* 
+{
+    "compilerOptions": { "composite": true },
+    "references": [{ "path": "../shared" }]
+}
+//// [/home/src/workspaces/solution/shared/index.ts] *new* 
+export const shared: string = 1;
+//// [/home/src/workspaces/solution/shared/tsconfig.json] *new* 
+{ "compilerOptions": { "composite": true } }
+//// [/home/src/workspaces/solution/tsconfig.json] *new* 
+{
+    "files": [],
+    "references": [{ "path": "./shared" }, { "path": "./app" }]
+}
+
+tsgo --build --diagnosticFormat json --extendedDiagnostics
+ExitStatus:: DiagnosticsPresent_OutputsSkipped
+Output::
+{
+  "diagnostics": [
+    {
+      "code": 6370,
+      "category": "error",
+      "message": "Options 'diagnosticFormat' and 'extendedDiagnostics' cannot be combined."
+    }
+  ]
+}
+
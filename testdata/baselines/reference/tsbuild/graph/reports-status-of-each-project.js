@@ -0,0 +1,359 @@
+currentDirectory::/home/src/workspaces/solution
+useCaseSensitiveFileNames::true
+Input::
+//// [/home/src/workspaces/solution/app/index.ts] *new* 
+import { shared } from "../shared"; export const app = shared;
+//// [/home/src/workspaces/solution/app/tsconfig.json] *new* 
+{
+    "compilerOptions": { "composite": true },
+    "references": [{ "path": "../shared" }]
+}
+//// [/home/src/workspaces/solution/shared/index.ts] *new* 
+export const shared = 1;
+//// [/home/src/workspaces/solution/shared/tsconfig.json] *new* 
+{ "compilerOptions": { "composite": true } }
+//// [/home/src/workspaces/solution/tsconfig.json] *new* 
+{
+    "files": [],
+    "references": [{ "path": "./shared" }, { "path": "./app" }]
+}
+
+tsgo --build --graph json
+ExitStatus:: Success
+Output::
+{
+    "projects": [
+        {
+            "config": "shared/tsconfig.json",
+            "status": "outOfDate",
+            "reason": "Project 'shared/tsconfig.json' is out of date because output file 'shared/tsconfig.tsbuildinfo' does not exist"
+        },
+        {
+            "config": "app/tsconfig.json",
+            "status": "outOfDate",
+            "reason": "Project 'app/tsconfig.json' is out of date because output file 'app/tsconfig.tsbuildinfo' does not exist",
+            "references": [
+                "shared/tsconfig.json"
+            ]
+        },
+        {
+            "config": "tsconfig.json",
+            "status": "solution",
+            "references": [
+                "shared/tsconfig.json",
+                "app/tsconfig.json"
+            ]
+        }
+    ]
+}
+
+
+
+Edit [0]:: build
+
+tsgo --build
+ExitStatus:: Success
+Output::
+//// [/home/src/tslibs/TS/Lib/lib.es2025.full.d.ts] *Lib*
+/// <reference no-default-lib="true"/>
+interface Boolean {}
+interface Function {}
+interface CallableFunction {}
+interface NewableFunction {}
+interface IArguments {}
+interface Number { toExponential: any; }
+interface Object {}
+interface RegExp {}
+interface String { charAt: any; }
+interface Array<T> { length: number; [n: number]: T; }
+interface ReadonlyArray<T> {}
+interface SymbolConstructor {
+    (desc?: string | number): symbol;
+    for(name: string): symbol;
+    readonly toStringTag: symbol;
+}
+declare var Symbol: SymbolConstructor;
+interface Symbol {
+    readonly [Symbol.toStringTag]: string;
+}
+declare const console: { log(msg: any): void; };
+//// [/home/src/workspaces/solution/app/index.d.ts] *new* 
+export declare const app = 1;
+
+//// [/home/src/workspaces/solution/app/index.js] *new* 
+import { shared } from "../shared";
+export const app = shared;
+
+//// [/home/src/workspaces/solution/app/tsconfig.tsbuildinfo] *new* 
+{"version":"FakeTSVersion","root":[3],"fileNames":["lib.es2025.full.d.ts","../shared/index.d.ts","./index.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},"01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",{"version":"f96c18fae745244985e4f93509351d88-import { shared } from \"../shared\"; export const app = shared;","signature":"f8dad51f84f5c63555d33a2ac858a42d-export declare const app = 1;\n","impliedNodeFormat":1}],"fileIdsList":[[2]],"options":{"composite":true},"referencedMap":[[3,1]],"latestChangedDtsFile":"./index.d.ts"}
+//// [/home/src/workspaces/solution/app/tsconfig.tsbuildinfo.readable.baseline.txt] *new* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "./index.ts"
+      ],
+      "original": 3
+    }
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "../shared/index.d.ts",
+    "./index.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "../shared/index.d.ts",
+      "version": "01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",
+      "signature": "01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",
+      "impliedNodeFormat": "CommonJS"
+    },
+    {
+      "fileName": "./index.ts",
+      "version": "f96c18fae745244985e4f93509351d88-import { shared } from \"../shared\"; export const app = shared;",
+      "signature": "f8dad51f84f5c63555d33a2ac858a42d-export declare const app = 1;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "f96c18fae745244985e4f93509351d88-import { shared } from \"../shared\"; export const app = shared;",
+        "signature": "f8dad51f84f5c63555d33a2ac858a42d-export declare const app = 1;\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "fileIdsList": [
+    [
+      "../shared/index.d.ts"
+    ]
+  ],
+  "options": {
+    "composite": true
+  },
+  "referencedMap": {
+    "./index.ts": [
+      "../shared/index.d.ts"
+    ]
+  },
+  "latestChangedDtsFile": "./index.d.ts",
+  "size": 1289
+}
+//// [/home/src/workspaces/solution/shared/index.d.ts] *new* 
+export declare const shared = 1;
+
+//// [/home/src/workspaces/solution/shared/index.js] *new* 
+export const shared = 1;
+
+//// [/home/src/workspaces/solution/shared/tsconfig.tsbuildinfo] *new* 
+{"version":"FakeTSVersion","root":[2],"fileNames":["lib.es2025.full.d.ts","./index.ts"],"fileInfos":[{"version":"8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };","affectsGlobalScope":true,"impliedNodeFormat":1},{"version":"587a897ca44ef0e2d921d4398af51184-export const shared = 1;","signature":"01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n","impliedNodeFormat":1}],"options":{"composite":true},"latestChangedDtsFile":"./index.d.ts"}
+//// [/home/src/workspaces/solution/shared/tsconfig.tsbuildinfo.readable.baseline.txt] *new* 
+{
+  "version": "FakeTSVersion",
+  "root": [
+    {
+      "files": [
+        "./index.ts"
+      ],
+      "original": 2
+    }
+  ],
+  "fileNames": [
+    "lib.es2025.full.d.ts",
+    "./index.ts"
+  ],
+  "fileInfos": [
+    {
+      "fileName": "lib.es2025.full.d.ts",
+      "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "signature": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+      "affectsGlobalScope": true,
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "8859c12c614ce56ba9a18e58384a198f-/// <reference no-default-lib=\"true\"/>\ninterface Boolean {}\ninterface Function {}\ninterface CallableFunction {}\ninterface NewableFunction {}\ninterface IArguments {}\ninterface Number { toExponential: any; }\ninterface Object {}\ninterface RegExp {}\ninterface String { charAt: any; }\ninterface Array<T> { length: number; [n: number]: T; }\ninterface ReadonlyArray<T> {}\ninterface SymbolConstructor {\n    (desc?: string | number): symbol;\n    for(name: string): symbol;\n    readonly toStringTag: symbol;\n}\ndeclare var Symbol: SymbolConstructor;\ninterface Symbol {\n    readonly [Symbol.toStringTag]: string;\n}\ndeclare const console: { log(msg: any): void; };",
+        "affectsGlobalScope": true,
+        "impliedNodeFormat": 1
+      }
+    },
+    {
+      "fileName": "./index.ts",
+      "version": "587a897ca44ef0e2d921d4398af51184-export const shared = 1;",
+      "signature": "01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",
+      "impliedNodeFormat": "CommonJS",
+      "original": {
+        "version": "587a897ca44ef0e2d921d4398af51184-export const shared = 1;",
+        "signature": "01da704875703ac3ee2bf0319473b16f-export declare const shared = 1;\n",
+        "impliedNodeFormat": 1
+      }
+    }
+  ],
+  "options": {
+    "composite": true
+  },
+  "latestChangedDtsFile": "./index.d.ts",
+  "size": 1115
+}
+
+shared/tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/tslibs/TS/Lib/lib.es2025.full.d.ts
+*refresh*    /home/src/workspaces/solution/shared/index.ts
+Signatures::
+(stored at emit) /home/src/workspaces/solution/shared/index.ts
+
+app/tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/tslibs/TS/Lib/lib.es2025.full.d.ts
+*refresh*    /home/src/workspaces/solution/shared/index.d.ts
+*refresh*    /home/src/workspaces/solution/app/index.ts
+Signatures::
+(stored at emit) /home/src/workspaces/solution/app/index.ts
+
+
+Edit [1]:: dot after build
+
+tsgo --build --graph dot
+ExitStatus:: Success
+Output::
+digraph projects {
+    "shared/tsconfig.json" [label="shared/tsconfig.json\nupToDate", color=green, tooltip="Project 'shared/tsconfig.json' is up to date because newest input 'shared/index.ts' is older than output 'shared/tsconfig.tsbuildinfo'"];
+    "app/tsconfig.json" [label="app/tsconfig.json\nupToDate", color=green, tooltip="Project 'app/tsconfig.json' is up to date because newest input 'app/index.ts' is older than output 'app/tsconfig.tsbuildinfo'"];
+    "tsconfig.json" [label="tsconfig.json\nsolution", color=green];
+    "app/tsconfig.json" -> "shared/tsconfig.json";
+    "tsconfig.json" -> "shared/tsconfig.json";
+    "tsconfig.json" -> "app/tsconfig.json";
+}
+
+
+
+Diff:: graph reports the status of outputs on disk, which a clean build does not have
+--- nonIncremental.output.txt
++++ incremental.output.txt
+@@ -1,6 +1,6 @@
+ digraph projects {
+-    "shared/tsconfig.json" [label="shared/tsconfig.json\noutOfDate", color=orange, tooltip="Project 'shared/tsconfig.json' is out of date because output file 'shared/tsconfig.tsbuildinfo' does not exist"];
+-    "app/tsconfig.json" [label="app/tsconfig.json\noutOfDate", color=orange, tooltip="Project 'app/tsconfig.json' is out of date because output file 'app/tsconfig.tsbuildinfo' does not exist"];
++    "shared/tsconfig.json" [label="shared/tsconfig.json\nupToDate", color=green, tooltip="Project 'shared/tsconfig.json' is up to date because newest input 'shared/index.ts' is older than output 'shared/tsconfig.tsbuildinfo'"];
++    "app/tsconfig.json" [label="app/tsconfig.json\nupToDate", color=green, tooltip="Project 'app/tsconfig.json' is up to date because newest input 'app/index.ts' is older than output 'app/tsconfig.tsbuildinfo'"];
+     "tsconfig.json" [label="tsconfig.json\nsolution", color=green];
+     "app/tsconfig.json" -> "shared/tsconfig.json";
+     "tsconfig.json" -> "shared/tsconfig.json";
+
+Edit [2]:: change upstream project
+//// [/home/src/workspaces/solution/shared/index.ts] *modified* 
+export const shared = 1;export const other = 2;
+
+tsgo --build --graph json
+ExitStatus:: Success
+Output::
+{
+    "projects": [
+        {
+            "config": "shared/tsconfig.json",
+            "status": "outOfDate",
+            "reason": "Project 'shared/tsconfig.json' is out of date because output 'shared/tsconfig.tsbuildinfo' is older than input 'shared/index.ts'"
+        },
+        {
+            "config": "app/tsconfig.json",
+            "status": "updateTimestamps",
+            "reason": "Project 'app/tsconfig.json' is up to date with .d.ts files from its dependencies",
+            "references": [
+                "shared/tsconfig.json"
+            ]
+        },
+        {
+            "config": "tsconfig.json",
+            "status": "solution",
+            "references": [
+                "shared/tsconfig.json",
+                "app/tsconfig.json"
+            ]
+        }
+    ]
+}
+
+
+
+Diff:: graph reports the status of outputs on disk, which a clean build does not have
+--- nonIncremental.output.txt
++++ incremental.output.txt
+@@ -3,12 +3,12 @@
+         {
+             "config": "shared/tsconfig.json",
+             "status": "outOfDate",
+-            "reason": "Project 'shared/tsconfig.json' is out of date because output file 'shared/tsconfig.tsbuildinfo' does not exist"
++            "reason": "Project 'shared/tsconfig.json' is out of date because output 'shared/tsconfig.tsbuildinfo' is older than input 'shared/index.ts'"
+         },
+         {
+             "config": "app/tsconfig.json",
+-            "status": "outOfDate",
+-            "reason": "Project 'app/tsconfig.json' is out of date because output file 'app/tsconfig.tsbuildinfo' does not exist",
++            "status": "updateTimestamps",
++            "reason": "Project 'app/tsconfig.json' is up to date with .d.ts files from its dependencies",
+             "references": [
+                 "shared/tsconfig.json"
+             ]
+
+Edit [3]:: graph without format
+
+tsgo --build --graph
+ExitStatus:: Success
+Output::
+digraph projects {
+    "shared/tsconfig.json" [label="shared/tsconfig.json\noutOfDate", color=orange, tooltip="Project 'shared/tsconfig.json' is out of date because output 'shared/tsconfig.tsbuildinfo' is older than input 'shared/index.ts'"];
+    "app/tsconfig.json" [label="app/tsconfig.json\nupdateTimestamps", color=yellow, tooltip="Project 'app/tsconfig.json' is up to date with .d.ts files from its dependencies"];
+    "tsconfig.json" [label="tsconfig.json\nsolution", color=green];
+    "app/tsconfig.json" -> "shared/tsconfig.json";
+    "tsconfig.json" -> "shared/tsconfig.json";
+    "tsconfig.json" -> "app/tsconfig.json";
+}
+
+
+
+Diff:: graph reports the status of outputs on disk, which a clean build does not have
+--- nonIncremental.output.txt
++++ incremental.output.txt
+@@ -1,6 +1,6 @@
+ digraph projects {
+-    "shared/tsconfig.json" [label="shared/tsconfig.json\noutOfDate", color=orange, tooltip="Project 'shared/tsconfig.json' is out of date because output file 'shared/tsconfig.tsbuildinfo' does not exist"];
+-    "app/tsconfig.json" [label="app/tsconfig.json\noutOfDate", color=orange, tooltip="Project 'app/tsconfig.json' is out of date because output file 'app/tsconfig.tsbuildinfo' does not exist"];
++    "shared/tsconfig.json" [label="shared/tsconfig.json\noutOfDate", color=orange, tooltip="Project 'shared/tsconfig.json' is out of date because output 'shared/tsconfig.tsbuildinfo' is older than input 'shared/index.ts'"];
++    "app/tsconfig.json" [label="app/tsconfig.json\nupdateTimestamps", color=yellow, tooltip="Project 'app/tsconfig.json' is up to date with .d.ts files from its dependencies"];
+     "tsconfig.json" [label="tsconfig.json\nsolution", color=green];
+     "app/tsconfig.json" -> "shared/tsconfig.json";
+     "tsconfig.json" -> "shared/tsconfig.json";
+
+Edit [4]:: graph without format followed by project
+
+tsgo --build --graph app
+ExitStatus:: Success
+Output::
+digraph projects {
+    "shared/tsconfig.json" [label="shared/tsconfig.json\noutOfDate", color=orange, tooltip="Project 'shared/tsconfig.json' is out of date because output 'shared/tsconfig.tsbuildinfo' is older than input 'shared/index.ts'"];
+    "app/tsconfig.json" [label="app/tsconfig.json\nupdateTimestamps", color=yellow, tooltip="Project 'app/tsconfig.json' is up to date with .d.ts files from its dependencies"];
+    "app/tsconfig.json" -> "shared/tsconfig.json";
+}
+
+
+
+Diff:: graph reports the status of outputs on disk, which a clean build does not have
+--- nonIncremental.output.txt
++++ incremental.output.txt
+@@ -1,5 +1,5 @@
+ digraph projects {
+-    "shared/tsconfig.json" [label="shared/tsconfig.json\noutOfDate", color=orange, tooltip="Project 'shared/tsconfig.json' is out of date because output file 'shared/tsconfig.tsbuildinfo' does not exist"];
+-    "app/tsconfig.json" [label="app/tsconfig.json\noutOfDate", color=orange, tooltip="Project 'app/tsconfig.json' is out of date because output file 'app/tsconfig.tsbuildinfo' does not exist"];
++    "shared/tsconfig.json" [label="shared/tsconfig.json\noutOfDate", color=orange, tooltip="Project 'shared/tsconfig.json' is out of date because output 'shared/tsconfig.tsbuildinfo' is older than input 'shared/index.ts'"];
++    "app/tsconfig.json" [label="app/tsconfig.json\nupdateTimestamps", color=yellow, tooltip="Project 'app/tsconfig.json' is up to date with .d.ts files from its dependencies"];
+     "app/tsconfig.json" -> "shared/tsconfig.json";
+ }
\ No newline at end of file
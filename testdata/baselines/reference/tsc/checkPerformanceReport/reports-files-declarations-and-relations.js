@@ -0,0 +1,146 @@
+currentDirectory::/home/src/workspaces/project
+useCaseSensitiveFileNames::true
+Input::
+//// [/home/src/workspaces/project/main.ts] *new* 
+import { Box, Pair } from "./types";
+function box<T>(value: T): Box<T> {
+    return { value, map: fn => box(fn(value)) };
+}
+class Store {
+    pairs: Pair<string, Box<number>>[] = [];
+    add(key: string, value: number) {
+        this.pairs[this.pairs.length] = { first: key, second: box(value) };
+    }
+}
+const labels: Box<string> = box(1).map(n => "#" + n);
+//// [/home/src/workspaces/project/tsconfig.json] *new* 
+{
+    "compilerOptions": {
+        "strict": true,
+        "noEmit": true
+    }
+}
+//// [/home/src/workspaces/project/types.ts] *new* 
+export interface Box<T> {
+    value: T;
+    map<U>(fn: (x: T) => U): Box<U>;
+}
+export type Pair<A, B> = { first: A; second: B };
+
+tsgo --checkPerformanceReport --singleThreaded
+ExitStatus:: Success
+Output::
+Slowest files:
+  File                                      Instantiations
+  main.ts                                   67
+  ../../tslibs/TS/Lib/lib.es2025.full.d.ts  1
+  types.ts                                  0
+
+Slowest declarations:
+  Declaration       Location                                       Instantiations
+  box               main.ts:2:1                                    43
+  labels            main.ts:11:7                                   17
+  Store             main.ts:5:1                                    7
+  add               main.ts:7:5                                    5
+  pairs             main.ts:6:5                                    2
+  Array             ../../tslibs/TS/Lib/lib.es2025.full.d.ts:11:1  1
+  Boolean           ../../tslibs/TS/Lib/lib.es2025.full.d.ts:2:1   0
+  Box               types.ts:1:1                                   0
+  CallableFunction  ../../tslibs/TS/Lib/lib.es2025.full.d.ts:4:1   0
+  Function          ../../tslibs/TS/Lib/lib.es2025.full.d.ts:3:1   0
+
+Most expensive type relations:
+  Relation                                                              Location       Count
+  Box<?> -> Box<?>                                                      main.ts:3:32   2
+  (n: number) => string -> (x: number) => string                        main.ts:11:29  1
+  <U>(fn: (x: ?) => U) => Box<U> -> <U>(fn: (x: ?) => U) => Box<U>      main.ts:3:32   1
+  <U>(fn: (x: T) => U) => Box<U> -> <U>(fn: (x: T) => U) => Box<U>      main.ts:3:5    1
+  ? -> ?                                                                main.ts:3:32   1
+  never[] -> Pair<string, Box<number>>[]                                main.ts:6:5    1
+  unknown -> ?                                                          main.ts:3:32   1
+  { first: string; second: Box<number>; } -> Pair<string, Box<number>>  main.ts:8:9    1
+  { value: T; map: <U>(fn: (x: T) => U) => Box<U>; } -> Box<T>          main.ts:3:5    1
+  {} -> (x: number) => string                                           main.ts:11:29  1
+
+Largest instantiation counts:
+  Declaration  Location                                       Instantiations
+  box          main.ts:2:1                                    43
+  labels       main.ts:11:7                                   17
+  Store        main.ts:5:1                                    7
+  add          main.ts:7:5                                    5
+  pairs        main.ts:6:5                                    2
+  Array        ../../tslibs/TS/Lib/lib.es2025.full.d.ts:11:1  1
+
+//// [/home/src/tslibs/TS/Lib/lib.es2025.full.d.ts] *Lib*
+/// <reference no-default-lib="true"/>
+interface Boolean {}
+interface Function {}
+interface CallableFunction {}
+interface NewableFunction {}
+interface IArguments {}
+interface Number { toExponential: any; }
+interface Object {}
+interface RegExp {}
+interface String { charAt: any; }
+interface Array<T> { length: number; [n: number]: T; }
+interface ReadonlyArray<T> {}
+interface SymbolConstructor {
+    (desc?: string | number): symbol;
+    for(name: string): symbol;
+    readonly toStringTag: symbol;
+}
+declare var Symbol: SymbolConstructor;
+interface Symbol {
+    readonly [Symbol.toStringTag]: string;
+}
+declare const console: { log(msg: any): void; };
+
+
+
+Edit [0]:: no change
+
+tsgo --checkPerformanceReport --singleThreaded
+ExitStatus:: Success
+Output::
+Slowest files:
+  File                                      Instantiations
+  main.ts                                   67
+  ../../tslibs/TS/Lib/lib.es2025.full.d.ts  1
+  types.ts                                  0
+
+Slowest declarations:
+  Declaration       Location                                       Instantiations
+  box               main.ts:2:1                                    43
+  labels            main.ts:11:7                                   17
+  Store             main.ts:5:1                                    7
+  add               main.ts:7:5                                    5
+  pairs             main.ts:6:5                                    2
+  Array             ../../tslibs/TS/Lib/lib.es2025.full.d.ts:11:1  1
+  Boolean           ../../tslibs/TS/Lib/lib.es2025.full.d.ts:2:1   0
+  Box               types.ts:1:1                                   0
+  CallableFunction  ../../tslibs/TS/Lib/lib.es2025.full.d.ts:4:1   0
+  Function          ../../tslibs/TS/Lib/lib.es2025.full.d.ts:3:1   0
+
+Most expensive type relations:
+  Relation                                                              Location       Count
+  Box<?> -> Box<?>                                                      main.ts:3:32   2
+  (n: number) => string -> (x: number) => string                        main.ts:11:29  1
+  <U>(fn: (x: ?) => U) => Box<U> -> <U>(fn: (x: ?) => U) => Box<U>      main.ts:3:32   1
+  <U>(fn: (x: T) => U) => Box<U> -> <U>(fn: (x: T) => U) => Box<U>      main.ts:3:5    1
+  ? -> ?                                                                main.ts:3:32   1
+  never[] -> Pair<string, Box<number>>[]                                main.ts:6:5    1
+  unknown -> ?                                                          main.ts:3:32   1
+  { first: string; second: Box<number>; } -> Pair<string, Box<number>>  main.ts:8:9    1
+  { value: T; map: <U>(fn: (x: T) => U) => Box<U>; } -> Box<T>          main.ts:3:5    1
+  {} -> (x: number) => string                                           main.ts:11:29  1
+
+Largest instantiation counts:
+  Declaration  Location                                       Instantiations
+  box          main.ts:2:1                                    43
+  labels       main.ts:11:7                                   17
+  Store        main.ts:5:1                                    7
+  add          main.ts:7:5                                    5
+  pairs        main.ts:6:5                                    2
+  Array        ../../tslibs/TS/Lib/lib.es2025.full.d.ts:11:1  1
+
+
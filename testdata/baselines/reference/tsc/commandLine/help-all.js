@@ -14,18 +14,21 @@ tsc: The TypeScript Compiler - Version FakeTSVersion
 [94m--all[39m
 Show all compiler options.
 
+[94m--astCacheDir[39m
+Cache parsed declaration files in the given directory and reuse them in later compilations.
+
 [94m--build, -b[39m
 Build one or more projects and their dependencies, if out of date
 
 [94m--checkers[39m
 Set the number of checkers per project.
 
-[94m--help, -h[39m
-Print this message.
-
 [94m--help, -?[39m
 
 
+[94m--help, -h[39m
+Print this message.
+
 [94m--ignoreConfig[39m
 Ignore the tsconfig found and build with commandline options and files.
 
@@ -326,6 +329,59 @@ Have recompiles in projects that use 'incremental' and 'watch' mode assume that
 type: boolean
 default: false
 
+[94m--watchStatusPipe[39m
+Serve the watch status and rebuild events over JSON-RPC on the given pipe or socket.
+
+### Compiler Diagnostics
+
+[94m--checkPerformanceReport[39m
+Report the files, declarations and type relations that take the longest to check.
+type: boolean
+default: false
+
+[94m--diagnostics[39m
+Output compiler performance information after building.
+type: boolean
+default: false
+
+[94m--explainFiles[39m
+Print files read during the compilation including why it was included.
+type: boolean
+default: false
+
+[94m--extendedDiagnostics[39m
+Output more detailed compiler performance information after building.
+type: boolean
+default: false
+
+[94m--generateCpuProfile[39m
+Emit a v8 CPU profile of the compiler run for debugging.
+type: string
+default: profile.cpuprofile
+
+[94m--generateTrace[39m
+Generates an event trace and a list of types.
+
+[94m--listEmittedFiles[39m
+Print the names of emitted files after a compilation.
+type: boolean
+default: false
+
+[94m--listFiles[39m
+Print all of the files read during the compilation.
+type: boolean
+default: false
+
+[94m--noCheck[39m
+Disable full type checking (only critical parse and emit errors will be reported).
+type: boolean
+default: false
+
+[94m--traceResolution[39m
+Log paths used during the 'moduleResolution' process.
+type: boolean
+default: false
+
 ### Projects
 
 [94m--composite[39m
@@ -454,50 +510,27 @@ Disable emitting declarations that have '@internal' in their JSDoc comments.
 type: boolean
 default: false
 
-### Compiler Diagnostics
-
-[94m--diagnostics[39m
-Output compiler performance information after building.
-type: boolean
-default: false
-
-[94m--explainFiles[39m
-Print files read during the compilation including why it was included.
-type: boolean
-default: false
-
-[94m--extendedDiagnostics[39m
-Output more detailed compiler performance information after building.
-type: boolean
-default: false
-
-[94m--generateCpuProfile[39m
-Emit a v8 CPU profile of the compiler run for debugging.
-type: string
-default: profile.cpuprofile
-
-[94m--generateTrace[39m
-Generates an event trace and a list of types.
+### Output Formatting
 
-[94m--listEmittedFiles[39m
-Print the names of emitted files after a compilation.
-type: boolean
-default: false
+[94m--diagnosticFormat[39m
+Report diagnostics as a single machine-readable document instead of formatted text.
+one of: json, sarif
+default: undefined
 
-[94m--listFiles[39m
-Print all of the files read during the compilation.
+[94m--noErrorTruncation[39m
+Disable truncating types in error messages.
 type: boolean
 default: false
 
-[94m--noCheck[39m
-Disable full type checking (only critical parse and emit errors will be reported).
+[94m--preserveWatchOutput[39m
+Disable wiping the console in watch mode.
 type: boolean
 default: false
 
-[94m--traceResolution[39m
-Log paths used during the 'moduleResolution' process.
+[94m--pretty[39m
+Enable color and formatting in TypeScript's output to make compiler errors easier to read.
 type: boolean
-default: false
+default: true
 
 ### Editor Support
 
@@ -578,23 +611,6 @@ Emit ECMAScript-standard-compliant class fields.
 type: boolean
 default: `true` for ES2022 and above, including ESNext.
 
-### Output Formatting
-
-[94m--noErrorTruncation[39m
-Disable truncating types in error messages.
-type: boolean
-default: false
-
-[94m--preserveWatchOutput[39m
-Disable wiping the console in watch mode.
-type: boolean
-default: false
-
-[94m--pretty[39m
-Enable color and formatting in TypeScript's output to make compiler errors easier to read.
-type: boolean
-default: true
-
 ### Completeness
 
 [94m--skipDefaultLibCheck[39m
@@ -666,4 +682,16 @@ Set the number of projects to build concurrently.
 [94m--stopBuildOnErrors[39m
 Skip building downstream projects on error in upstream project.
 
+[94m--buildCacheDir[39m
+Reuse project outputs from the build cache in the given directory and store the outputs of built projects there.
+
+[94m--graph[39m
+Print the project reference graph and the up-to-date status of each project instead of building.
+
+[94m--affectedBy[39m
+Build only the projects affected by changes to the given files and the projects they reference.
+
+[94m--listAffected[39m
+Print the files affected by changes to the files given to '--affectedBy' instead of building.
+
 
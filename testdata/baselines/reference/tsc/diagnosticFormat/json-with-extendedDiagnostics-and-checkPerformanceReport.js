@@ -0,0 +1,30 @@
+currentDirectory::/home/src/workspaces/project
+useCaseSensitiveFileNames::true
+Input::
+//// [/home/src/workspaces/project/index.ts] *new* 
+interface Options { nested: { value: string } }
+const fromNumber = { nested: { value: 1 } };
+const options: Options = fromNumber;
+const literal: Options = { nested: { value: 2 } };
+import "./missing";
+//// [/home/src/workspaces/project/tsconfig.json] *new* 
+{}
+
+tsgo --diagnosticFormat json --extendedDiagnostics --checkPerformanceReport
+ExitStatus:: DiagnosticsPresent_OutputsSkipped
+Output::
+{
+  "diagnostics": [
+    {
+      "code": 6370,
+      "category": "error",
+      "message": "Options 'diagnosticFormat' and 'extendedDiagnostics' cannot be combined."
+    },
+    {
+      "code": 6370,
+      "category": "error",
+      "message": "Options 'diagnosticFormat' and 'checkPerformanceReport' cannot be combined."
+    }
+  ]
+}
+
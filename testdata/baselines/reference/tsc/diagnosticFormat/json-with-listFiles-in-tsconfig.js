@@ -0,0 +1,21 @@
+currentDirectory::/home/src/workspaces/project
+useCaseSensitiveFileNames::true
+Input::
+//// [/home/src/workspaces/project/index.ts] *new* 
+export const x = 1;
+//// [/home/src/workspaces/project/tsconfig.json] *new* 
+{ "compilerOptions": { "listFiles": true } }
+
+tsgo --diagnosticFormat json
+ExitStatus:: DiagnosticsPresent_OutputsSkipped
+Output::
+{
+  "diagnostics": [
+    {
+      "code": 6370,
+      "category": "error",
+      "message": "Options 'diagnosticFormat' and 'listFiles' cannot be combined."
+    }
+  ]
+}
+
@@ -0,0 +1,129 @@
+currentDirectory::/home/src/workspaces/project
+useCaseSensitiveFileNames::true
+Input::
+//// [/home/src/workspaces/project/index.ts] *new* 
+interface Options { nested: { value: string } }
+const fromNumber = { nested: { value: 1 } };
+const options: Options = fromNumber;
+const literal: Options = { nested: { value: 2 } };
+import "./missing";
+//// [/home/src/workspaces/project/tsconfig.json] *new* 
+{}
+
+tsgo --diagnosticFormat json
+ExitStatus:: DiagnosticsPresent_OutputsGenerated
+Output::
+{
+  "diagnostics": [
+    {
+      "code": 2322,
+      "category": "error",
+      "file": "index.ts",
+      "range": {
+        "start": {
+          "line": 3,
+          "column": 7
+        },
+        "end": {
+          "line": 3,
+          "column": 14
+        }
+      },
+      "message": "Type '{ nested: { value: number; }; }' is not assignable to type 'Options'.",
+      "messageChain": [
+        {
+          "code": 2200,
+          "category": "error",
+          "message": "The types of 'nested.value' are incompatible between these types.",
+          "next": [
+            {
+              "code": 2322,
+              "category": "error",
+              "message": "Type 'number' is not assignable to type 'string'."
+            }
+          ]
+        }
+      ]
+    },
+    {
+      "code": 2322,
+      "category": "error",
+      "file": "index.ts",
+      "range": {
+        "start": {
+          "line": 4,
+          "column": 38
+        },
+        "end": {
+          "line": 4,
+          "column": 43
+        }
+      },
+      "message": "Type 'number' is not assignable to type 'string'.",
+      "relatedInformation": [
+        {
+          "code": 6500,
+          "category": "message",
+          "file": "index.ts",
+          "range": {
+            "start": {
+              "line": 1,
+              "column": 31
+            },
+            "end": {
+              "line": 1,
+              "column": 36
+            }
+          },
+          "message": "The expected type comes from property 'value' which is declared here on type '{ value: string; }'"
+        }
+      ]
+    },
+    {
+      "code": 2882,
+      "category": "error",
+      "file": "index.ts",
+      "range": {
+        "start": {
+          "line": 5,
+          "column": 8
+        },
+        "end": {
+          "line": 5,
+          "column": 19
+        }
+      },
+      "message": "Cannot find module or type declarations for side-effect import of './missing'."
+    }
+  ]
+}
+//// [/home/src/tslibs/TS/Lib/lib.es2025.full.d.ts] *Lib*
+/// <reference no-default-lib="true"/>
+interface Boolean {}
+interface Function {}
+interface CallableFunction {}
+interface NewableFunction {}
+interface IArguments {}
+interface Number { toExponential: any; }
+interface Object {}
+interface RegExp {}
+interface String { charAt: any; }
+interface Array<T> { length: number; [n: number]: T; }
+interface ReadonlyArray<T> {}
+interface SymbolConstructor {
+    (desc?: string | number): symbol;
+    for(name: string): symbol;
+    readonly toStringTag: symbol;
+}
+declare var Symbol: SymbolConstructor;
+interface Symbol {
+    readonly [Symbol.toStringTag]: string;
+}
+declare const console: { log(msg: any): void; };
+//// [/home/src/workspaces/project/index.js] *new* 
+const fromNumber = { nested: { value: 1 } };
+const options = fromNumber;
+const literal = { nested: { value: 2 } };
+import "./missing";
+
+
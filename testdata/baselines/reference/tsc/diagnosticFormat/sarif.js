@@ -0,0 +1,147 @@
+currentDirectory::/home/src/workspaces/project
+useCaseSensitiveFileNames::true
+Input::
+//// [/home/src/workspaces/project/index.ts] *new* 
+interface Options { nested: { value: string } }
+const fromNumber = { nested: { value: 1 } };
+const options: Options = fromNumber;
+const literal: Options = { nested: { value: 2 } };
+import "./missing";
+//// [/home/src/workspaces/project/tsconfig.json] *new* 
+{}
+
+tsgo --diagnosticFormat sarif
+ExitStatus:: DiagnosticsPresent_OutputsGenerated
+Output::
+{
+  "$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {
+        "driver": {
+          "name": "tsc",
+          "version": "7.1.0-dev",
+          "informationUri": "https://www.typescriptlang.org/"
+        }
+      },
+      "columnKind": "utf16CodeUnits",
+      "results": [
+        {
+          "ruleId": "TS2322",
+          "level": "error",
+          "message": {
+            "text": "Type '{ nested: { value: number; }; }' is not assignable to type 'Options'.\n  The types of 'nested.value' are incompatible between these types.\n    Type 'number' is not assignable to type 'string'."
+          },
+          "locations": [
+            {
+              "physicalLocation": {
+                "artifactLocation": {
+                  "uri": "index.ts"
+                },
+                "region": {
+                  "startLine": 3,
+                  "startColumn": 7,
+                  "endLine": 3,
+                  "endColumn": 14
+                }
+              }
+            }
+          ]
+        },
+        {
+          "ruleId": "TS2322",
+          "level": "error",
+          "message": {
+            "text": "Type 'number' is not assignable to type 'string'."
+          },
+          "locations": [
+            {
+              "physicalLocation": {
+                "artifactLocation": {
+                  "uri": "index.ts"
+                },
+                "region": {
+                  "startLine": 4,
+                  "startColumn": 38,
+                  "endLine": 4,
+                  "endColumn": 43
+                }
+              }
+            }
+          ],
+          "relatedLocations": [
+            {
+              "physicalLocation": {
+                "artifactLocation": {
+                  "uri": "index.ts"
+                },
+                "region": {
+                  "startLine": 1,
+                  "startColumn": 31,
+                  "endLine": 1,
+                  "endColumn": 36
+                }
+              },
+              "message": {
+                "text": "The expected type comes from property 'value' which is declared here on type '{ value: string; }'"
+              }
+            }
+          ]
+        },
+        {
+          "ruleId": "TS2882",
+          "level": "error",
+          "message": {
+            "text": "Cannot find module or type declarations for side-effect import of './missing'."
+          },
+          "locations": [
+            {
+              "physicalLocation": {
+                "artifactLocation": {
+                  "uri": "index.ts"
+                },
+                "region": {
+                  "startLine": 5,
+                  "startColumn": 8,
+                  "endLine": 5,
+                  "endColumn": 19
+                }
+              }
+            }
+          ]
+        }
+      ]
+    }
+  ]
+}
+//// [/home/src/tslibs/TS/Lib/lib.es2025.full.d.ts] *Lib*
+/// <reference no-default-lib="true"/>
+interface Boolean {}
+interface Function {}
+interface CallableFunction {}
+interface NewableFunction {}
+interface IArguments {}
+interface Number { toExponential: any; }
+interface Object {}
+interface RegExp {}
+interface String { charAt: any; }
+interface Array<T> { length: number; [n: number]: T; }
+interface ReadonlyArray<T> {}
+interface SymbolConstructor {
+    (desc?: string | number): symbol;
+    for(name: string): symbol;
+    readonly toStringTag: symbol;
+}
+declare var Symbol: SymbolConstructor;
+interface Symbol {
+    readonly [Symbol.toStringTag]: string;
+}
+declare const console: { log(msg: any): void; };
+//// [/home/src/workspaces/project/index.js] *new* 
+const fromNumber = { nested: { value: 1 } };
+const options = fromNumber;
+const literal = { nested: { value: 2 } };
+import "./missing";
+
+
@@ -0,0 +1,13 @@
+currentDirectory::/home/src/workspaces/project
+useCaseSensitiveFileNames::true
+Input::
+//// [/home/src/workspaces/project/main.ts] *new* 
+export const x = 1;
+//// [/home/src/workspaces/project/tsconfig.json] *new* 
+{ "compilerOptions": { "noEmit": true } }
+
+tsgo --checkPerformanceReport --watch
+ExitStatus:: DiagnosticsPresent_OutputsSkipped
+Output::
+[91merror[0m[90m TS6370: [0mOptions 'watch' and 'checkPerformanceReport' cannot be combined.
+
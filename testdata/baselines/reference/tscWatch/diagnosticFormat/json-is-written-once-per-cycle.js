@@ -0,0 +1,130 @@
+currentDirectory::/home/src/workspaces/project
+useCaseSensitiveFileNames::true
+Input::
+//// [/home/src/workspaces/project/index.ts] *new* 
+const x: number = "one";
+//// [/home/src/workspaces/project/tsconfig.json] *new* 
+{}
+
+tsgo --watch --diagnosticFormat json
+ExitStatus:: Success
+Output::
+{
+  "diagnostics": [
+    {
+      "code": 2322,
+      "category": "error",
+      "file": "index.ts",
+      "range": {
+        "start": {
+          "line": 1,
+          "column": 7
+        },
+        "end": {
+          "line": 1,
+          "column": 8
+        }
+      },
+      "message": "Type 'string' is not assignable to type 'number'."
+    }
+  ]
+}
+//// [/home/src/tslibs/TS/Lib/lib.es2025.full.d.ts] *Lib*
+/// <reference no-default-lib="true"/>
+interface Boolean {}
+interface Function {}
+interface CallableFunction {}
+interface NewableFunction {}
+interface IArguments {}
+interface Number { toExponential: any; }
+interface Object {}
+interface RegExp {}
+interface String { charAt: any; }
+interface Array<T> { length: number; [n: number]: T; }
+interface ReadonlyArray<T> {}
+interface SymbolConstructor {
+    (desc?: string | number): symbol;
+    for(name: string): symbol;
+    readonly toStringTag: symbol;
+}
+declare var Symbol: SymbolConstructor;
+interface Symbol {
+    readonly [Symbol.toStringTag]: string;
+}
+declare const console: { log(msg: any): void; };
+//// [/home/src/workspaces/project/index.js] *new* 
+"use strict";
+const x = "one";
+
+
+Watch Registrations::
+Directory watches::
+  /home/src/tslibs/TS/Lib
+  /home/src/workspaces/project (recursive)
+tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/tslibs/TS/Lib/lib.es2025.full.d.ts
+*refresh*    /home/src/workspaces/project/index.ts
+Signatures::
+
+
+Edit [0]:: fix error
+//// [/home/src/workspaces/project/index.ts] *modified* 
+const x: number = 1;
+
+
+Output::
+{
+  "diagnostics": []
+}
+//// [/home/src/workspaces/project/index.js] *modified* 
+"use strict";
+const x = 1;
+
+
+Watch Registrations::
+Directory watches::
+  /home/src/tslibs/TS/Lib
+  /home/src/workspaces/project (recursive)
+tsconfig.json::
+SemanticDiagnostics::
+*refresh*    /home/src/tslibs/TS/Lib/lib.es2025.full.d.ts
+*refresh*    /home/src/workspaces/project/index.ts
+Signatures::
+(computed .d.ts) /home/src/workspaces/project/index.ts
+
+
+Edit [1]:: break config
+//// [/home/src/workspaces/project/tsconfig.json] *modified* 
+{ "compilerOptions": { "target": "es2099" } }
+
+
+Output::
+{
+  "diagnostics": [
+    {
+      "code": 6046,
+      "category": "error",
+      "file": "tsconfig.json",
+      "range": {
+        "start": {
+          "line": 1,
+          "column": 34
+        },
+        "end": {
+          "line": 1,
+          "column": 42
+        }
+      },
+      "message": "Argument for '--target' option must be: 'es6', 'es2015', 'es2016', 'es2017', 'es2018', 'es2019', 'es2020', 'es2021', 'es2022', 'es2023', 'es2024', 'es2025', 'esnext'."
+    }
+  ]
+}
+
+Watch Registrations::
+Directory watches::
+  /home/src/tslibs/TS/Lib
+  /home/src/workspaces/project (recursive)
+tsconfig.json::
+SemanticDiagnostics::
+Signatures::